@@ -0,0 +1,183 @@
+package application
+
+import (
+	"context"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/LuizFJP/pet-ms/domain/repository"
+	"github.com/google/uuid"
+)
+
+const (
+	DefaultApplicationPageSize = 100
+	MaxApplicationPageSize     = 1000
+)
+
+// WithAdoptions habilita os pedidos de adoção dos pets disponíveis.
+func WithAdoptions(ad repository.AdoptionApplicationRepository) Option {
+	return func(p *petApplication) {
+		p.ad = ad
+	}
+}
+
+func (p *petApplication) adoptionsUnavailable() map[string]string {
+	if p.ad == nil {
+		return map[string]string{"unavailable": "adoption applications are not enabled"}
+	}
+	return nil
+}
+
+// SubmitApplication registra o pedido de um guardião para adotar um pet disponível.
+func (p *petApplication) SubmitApplication(ctx context.Context, application *entity.AdoptionApplication) (*entity.AdoptionApplication, map[string]string) {
+	if errData := p.adoptionsUnavailable(); errData != nil {
+		return nil, errData
+	}
+	if errs := application.Validate(); len(errs) > 0 {
+		return nil, invalidArgument(errs)
+	}
+	pet, errData := p.loadPet(ctx, application.PetUuid)
+	if errData != nil {
+		return nil, errData
+	}
+	if pet.CurrentStatus() != entity.StatusAvailable {
+		return nil, map[string]string{"failed_precondition": "pet is not available for adoption"}
+	}
+	if pet.UuidGuardian == application.ApplicantUuid {
+		return nil, map[string]string{"failed_precondition": "applicant already owns this pet"}
+	}
+	if errData := p.requireGuardian(ctx, application.ApplicantUuid); errData != nil {
+		return nil, errData
+	}
+
+	application.Uuid = uuid.New()
+	application.TenantID = pet.TenantID
+	return p.ad.SubmitApplication(application)
+}
+
+func (p *petApplication) GetApplication(ctx context.Context, id string) (*entity.AdoptionApplication, map[string]string) {
+	if errData := p.adoptionsUnavailable(); errData != nil {
+		return nil, errData
+	}
+	parsed, errData := parseApplicationUuid(id)
+	if errData != nil {
+		return nil, errData
+	}
+	return p.ad.GetApplication(TenantFromContext(ctx), parsed)
+}
+
+// ApproveApplication aprova o pedido: o pet fica reservado para o candidato e os
+// outros pedidos pendentes dele são recusados.
+func (p *petApplication) ApproveApplication(ctx context.Context, id, reason string) (*entity.ApplicationApproval, map[string]string) {
+	if errData := p.adoptionsUnavailable(); errData != nil {
+		return nil, errData
+	}
+	parsed, errData := parseApplicationUuid(id)
+	if errData != nil {
+		return nil, errData
+	}
+	decision, errData := p.decision(ctx, entity.ApplicationApproved, reason)
+	if errData != nil {
+		return nil, errData
+	}
+	application, errData := p.ad.GetApplication(TenantFromContext(ctx), parsed)
+	if errData != nil {
+		return nil, errData
+	}
+	before, errData := p.loadPet(ctx, application.PetUuid)
+	if errData != nil {
+		return nil, errData
+	}
+
+	approval, errData := p.ad.ApproveApplication(TenantFromContext(ctx), parsed, decision)
+	if errData != nil {
+		return nil, errData
+	}
+	// o pet foi gravado pelo repositório de pedidos, por fora do cache de pets
+	if cache, ok := p.pr.(repository.PetCache); ok {
+		cache.Forget(approval.Pet.Uuid)
+	}
+	if p.bus != nil {
+		p.bus.Publish(entity.PetEvent{Type: entity.PetUpdated, Pet: *approval.Pet, OccurredAt: p.now()})
+	}
+	p.recordAudit(ctx, entity.AuditStatusChange, petChange{before: before, after: approval.Pet})
+	return approval, nil
+}
+
+// RejectApplication recusa um pedido pendente; o pet continua disponível.
+func (p *petApplication) RejectApplication(ctx context.Context, id, reason string) (*entity.AdoptionApplication, map[string]string) {
+	return p.closeApplication(ctx, id, entity.ApplicationRejected, reason)
+}
+
+// WithdrawApplication registra a desistência do candidato.
+func (p *petApplication) WithdrawApplication(ctx context.Context, id, reason string) (*entity.AdoptionApplication, map[string]string) {
+	return p.closeApplication(ctx, id, entity.ApplicationWithdrawn, reason)
+}
+
+func (p *petApplication) closeApplication(ctx context.Context, id string, status entity.ApplicationStatus, reason string) (*entity.AdoptionApplication, map[string]string) {
+	if errData := p.adoptionsUnavailable(); errData != nil {
+		return nil, errData
+	}
+	parsed, errData := parseApplicationUuid(id)
+	if errData != nil {
+		return nil, errData
+	}
+	decision, errData := p.decision(ctx, status, reason)
+	if errData != nil {
+		return nil, errData
+	}
+	return p.ad.DecideApplication(TenantFromContext(ctx), parsed, decision)
+}
+
+func (p *petApplication) decision(ctx context.Context, status entity.ApplicationStatus, reason string) (entity.ApplicationDecision, map[string]string) {
+	decision := entity.ApplicationDecision{
+		Status:    status,
+		Reason:    reason,
+		DecidedBy: ActorFromContext(ctx).Principal,
+		DecidedAt: p.now(),
+	}
+	if errs := decision.Validate(); len(errs) > 0 {
+		return decision, invalidArgument(errs)
+	}
+	return decision, nil
+}
+
+func (p *petApplication) ListPetApplications(ctx context.Context, petUuid string, afterID uint, pageSize int) ([]*entity.AdoptionApplication, map[string]string) {
+	if errData := p.adoptionsUnavailable(); errData != nil {
+		return nil, errData
+	}
+	pet, errData := parsePetUuid(petUuid)
+	if errData != nil {
+		return nil, errData
+	}
+	return p.ad.ListApplicationsByPet(TenantFromContext(ctx), pet, afterID, ApplicationPageSize(pageSize))
+}
+
+func (p *petApplication) ListApplicantApplications(ctx context.Context, applicant string, afterID uint, pageSize int) ([]*entity.AdoptionApplication, map[string]string) {
+	if errData := p.adoptionsUnavailable(); errData != nil {
+		return nil, errData
+	}
+	parsed, err := uuid.Parse(applicant)
+	if err != nil {
+		return nil, map[string]string{"invalid_argument": "applicant_uuid must be a valid uuid"}
+	}
+	return p.ad.ListApplicationsByApplicant(TenantFromContext(ctx), parsed, afterID, ApplicationPageSize(pageSize))
+}
+
+// ApplicationPageSize aplica o padrão e o teto de itens por página.
+func ApplicationPageSize(pageSize int) int {
+	if pageSize <= 0 {
+		return DefaultApplicationPageSize
+	}
+	if pageSize > MaxApplicationPageSize {
+		return MaxApplicationPageSize
+	}
+	return pageSize
+}
+
+func parseApplicationUuid(id string) (uuid.UUID, map[string]string) {
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, map[string]string{"invalid_argument": "uuid must be a valid uuid"}
+	}
+	return parsed, nil
+}
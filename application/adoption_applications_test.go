@@ -0,0 +1,156 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/LuizFJP/pet-ms/domain/repository"
+	"github.com/google/uuid"
+)
+
+var _ repository.AdoptionApplicationRepository = (*adoptionRepoMock)(nil)
+
+// adoptionRepoMock guarda os pedidos em memória; a aprovação devolve o pet recebido.
+type adoptionRepoMock struct {
+	saved     map[uuid.UUID]*entity.AdoptionApplication
+	pet       *entity.Pet
+	decisions []entity.ApplicationDecision
+}
+
+func newAdoptionRepoMock(pet *entity.Pet) *adoptionRepoMock {
+	return &adoptionRepoMock{saved: map[uuid.UUID]*entity.AdoptionApplication{}, pet: pet}
+}
+
+func (m *adoptionRepoMock) SubmitApplication(a *entity.AdoptionApplication) (*entity.AdoptionApplication, map[string]string) {
+	m.saved[a.Uuid] = a
+	return a, nil
+}
+
+func (m *adoptionRepoMock) GetApplication(tenant string, id uuid.UUID) (*entity.AdoptionApplication, map[string]string) {
+	a, ok := m.saved[id]
+	if !ok || (tenant != "" && a.TenantID != tenant) {
+		return nil, map[string]string{"not_found": "adoption application not found"}
+	}
+	return a, nil
+}
+
+func (m *adoptionRepoMock) DecideApplication(tenant string, id uuid.UUID, decision entity.ApplicationDecision) (*entity.AdoptionApplication, map[string]string) {
+	a, errData := m.GetApplication(tenant, id)
+	if errData != nil {
+		return nil, errData
+	}
+	m.decisions = append(m.decisions, decision)
+	decision.Apply(a)
+	return a, nil
+}
+
+func (m *adoptionRepoMock) ApproveApplication(tenant string, id uuid.UUID, decision entity.ApplicationDecision) (*entity.ApplicationApproval, map[string]string) {
+	a, errData := m.DecideApplication(tenant, id, decision)
+	if errData != nil {
+		return nil, errData
+	}
+	pet := *m.pet
+	decision.Reservation(a).Apply(&pet)
+	return &entity.ApplicationApproval{Application: a, Pet: &pet}, nil
+}
+
+func (m *adoptionRepoMock) ListApplicationsByPet(tenant string, petUuid uuid.UUID, afterID uint, limit int) ([]*entity.AdoptionApplication, map[string]string) {
+	return nil, nil
+}
+
+func (m *adoptionRepoMock) ListApplicationsByApplicant(tenant string, applicant uuid.UUID, afterID uint, limit int) ([]*entity.AdoptionApplication, map[string]string) {
+	return nil, nil
+}
+
+// cachedRepo conta os pets descartados do cache.
+type cachedRepo struct {
+	*mockPetRepository
+	forgotten []uuid.UUID
+}
+
+func (r *cachedRepo) Forget(ids ...uuid.UUID) {
+	r.forgotten = append(r.forgotten, ids...)
+}
+
+func TestSubmitApplication(t *testing.T) {
+	owner, applicant := uuid.New(), uuid.New()
+	pet := &entity.Pet{Uuid: uuid.New(), UuidGuardian: owner, TenantID: "shelter"}
+	repo := &mockPetRepository{
+		getPetsFunc: func([]string) ([]*entity.Pet, map[string]string) { return []*entity.Pet{pet}, nil },
+	}
+	ad := newAdoptionRepoMock(pet)
+	app := NewPetApplication(repo, WithAdoptions(ad))
+	ctx := context.Background()
+
+	if _, errData := app.SubmitApplication(ctx, &entity.AdoptionApplication{PetUuid: pet.Uuid, ApplicantUuid: applicant}); errData["failed_precondition"] != "pet is not available for adoption" {
+		t.Fatalf("expected failed_precondition for a pet in intake, got %v", errData)
+	}
+	pet.Status = entity.StatusAvailable
+	if _, errData := app.SubmitApplication(ctx, &entity.AdoptionApplication{PetUuid: pet.Uuid, ApplicantUuid: owner}); errData["failed_precondition"] == "" {
+		t.Fatalf("expected failed_precondition for the owner, got %v", errData)
+	}
+	if _, errData := app.SubmitApplication(ctx, &entity.AdoptionApplication{PetUuid: pet.Uuid}); errData["invalid_argument"] == "" {
+		t.Fatalf("expected invalid_argument without applicant, got %v", errData)
+	}
+
+	submitted, errData := app.SubmitApplication(ctx, &entity.AdoptionApplication{PetUuid: pet.Uuid, ApplicantUuid: applicant})
+	if errData != nil {
+		t.Fatalf("unexpected error: %v", errData)
+	}
+	if submitted.Uuid == uuid.Nil || submitted.TenantID != "shelter" || submitted.Status != entity.ApplicationPending {
+		t.Fatalf("expected a pending application in the pet's tenant, got %+v", submitted)
+	}
+}
+
+func TestApproveApplication_ReservesPet(t *testing.T) {
+	pet := &entity.Pet{Uuid: uuid.New(), UuidGuardian: uuid.New(), Status: entity.StatusAvailable}
+	repo := &cachedRepo{mockPetRepository: &mockPetRepository{
+		getPetsFunc: func([]string) ([]*entity.Pet, map[string]string) { return []*entity.Pet{pet}, nil },
+	}}
+	ad := newAdoptionRepoMock(pet)
+	submitted := &entity.AdoptionApplication{Uuid: uuid.New(), PetUuid: pet.Uuid, ApplicantUuid: uuid.New(), Status: entity.ApplicationPending}
+	ad.saved[submitted.Uuid] = submitted
+	bus, audit := &busMock{}, &auditRepoMock{}
+	app := NewPetApplication(repo, WithAdoptions(ad), WithEventBus(bus), WithAudit(audit))
+	ctx := ContextWithActor(context.Background(), Actor{Principal: "staff"})
+
+	approval, errData := app.ApproveApplication(ctx, submitted.Uuid.String(), "good fit")
+	if errData != nil {
+		t.Fatalf("unexpected error: %v", errData)
+	}
+	if approval.Application.Status != entity.ApplicationApproved || approval.Application.DecidedBy != "staff" || approval.Pet.Status != entity.StatusReserved {
+		t.Fatalf("unexpected approval: %+v %+v", approval.Application, approval.Pet)
+	}
+	if len(repo.forgotten) != 1 || repo.forgotten[0] != pet.Uuid {
+		t.Fatalf("expected the reserved pet dropped from the cache, got %v", repo.forgotten)
+	}
+	if len(bus.published) != 1 || bus.published[0].Type != entity.PetUpdated {
+		t.Fatalf("expected a pet updated event, got %+v", bus.published)
+	}
+	if len(audit.entries) != 1 || audit.entries[0].Action != entity.AuditStatusChange {
+		t.Fatalf("expected a status change entry, got %+v", audit.entries)
+	}
+}
+
+func TestCloseApplication(t *testing.T) {
+	ad := newAdoptionRepoMock(nil)
+	submitted := &entity.AdoptionApplication{Uuid: uuid.New(), TenantID: "shelter", Status: entity.ApplicationPending}
+	ad.saved[submitted.Uuid] = submitted
+	app := NewPetApplication(&mockPetRepository{}, WithAdoptions(ad))
+	ctx := ContextWithTenant(context.Background(), "shelter")
+
+	if _, errData := app.RejectApplication(ContextWithTenant(context.Background(), "clinic"), submitted.Uuid.String(), ""); errData["not_found"] == "" {
+		t.Fatalf("expected not_found for another tenant, got %v", errData)
+	}
+	if _, errData := app.WithdrawApplication(ctx, "bad", ""); errData["invalid_argument"] == "" {
+		t.Fatalf("expected invalid_argument, got %v", errData)
+	}
+	withdrawn, errData := app.WithdrawApplication(ctx, submitted.Uuid.String(), "moved abroad")
+	if errData != nil || withdrawn.Status != entity.ApplicationWithdrawn || withdrawn.DecisionReason != "moved abroad" {
+		t.Fatalf("expected the application withdrawn, got %+v %v", withdrawn, errData)
+	}
+	if _, errData := NewPetApplication(&mockPetRepository{}).RejectApplication(ctx, submitted.Uuid.String(), ""); errData["unavailable"] == "" {
+		t.Fatalf("expected unavailable without adoptions, got %v", errData)
+	}
+}
@@ -0,0 +1,287 @@
+package application
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/LuizFJP/pet-ms/domain/repository"
+)
+
+const (
+	DefaultBreedCacheTTL    = 5 * time.Minute
+	DefaultBreedSearchLimit = 10
+	MaxBreedSearchLimit     = 50
+)
+
+// BreedQuery filtra a busca de raças. Species vazio busca em todas as espécies.
+type BreedQuery struct {
+	Text    string
+	Species []entity.PetType
+	Limit   int
+}
+
+// BreedMatch é um resultado da busca. Matched é o nome ou alias que casou com o texto;
+// Mixed marca a opção "sem raça definida", que vale para qualquer espécie.
+type BreedMatch struct {
+	Breed   *entity.Breed
+	Matched string
+	Mixed   bool
+	score   int
+}
+
+type breedKey struct {
+	key      string
+	original string
+}
+
+type breedIndex struct {
+	bySpecie map[entity.PetType]map[string]*entity.Breed
+	keys     map[*entity.Breed][]breedKey
+	all      []*entity.Breed
+}
+
+// BreedCatalog mantém as raças em memória, indexadas por espécie e por nome normalizado.
+type BreedCatalog struct {
+	repo repository.BreedRepository
+	ttl  time.Duration
+	now  func() time.Time
+
+	mu       sync.RWMutex
+	index    *breedIndex
+	loadedAt time.Time
+}
+
+func NewBreedCatalog(repo repository.BreedRepository, ttl time.Duration) *BreedCatalog {
+	if ttl <= 0 {
+		ttl = DefaultBreedCacheTTL
+	}
+	return &BreedCatalog{repo: repo, ttl: ttl, now: time.Now}
+}
+
+// Resolve devolve a raça canônica para o nome informado, aceitando aliases e variações
+// de caixa e acentos. known é falso quando o catálogo não tem raças para a espécie.
+func (c *BreedCatalog) Resolve(specie entity.PetType, name string) (breed *entity.Breed, known bool) {
+	if entity.IsMixedBreed(name) {
+		return entity.NewMixedBreed(specie), true
+	}
+	breeds, known := c.snapshot().bySpecie[specie]
+	return breeds[entity.NormalizeBreedName(name)], known
+}
+
+// Search ordena por exato, prefixo, prefixo de palavra, substring e, por último,
+// nomes a poucas letras de distância do texto buscado.
+func (c *BreedCatalog) Search(query BreedQuery) []BreedMatch {
+	limit := query.Limit
+	if limit <= 0 {
+		limit = DefaultBreedSearchLimit
+	}
+	if limit > MaxBreedSearchLimit {
+		limit = MaxBreedSearchLimit
+	}
+
+	allowed := make(map[entity.PetType]bool, len(query.Species))
+	for _, specie := range query.Species {
+		allowed[specie] = true
+	}
+
+	text := entity.NormalizeBreedName(query.Text)
+	index := c.snapshot()
+
+	var matches []BreedMatch
+	mixed := entity.NewMixedBreed(0)
+	if match, ok := bestMatch(mixed, breedKeys(mixed), text); ok {
+		match.Mixed = true
+		matches = append(matches, match)
+	}
+	for _, breed := range index.all {
+		if len(allowed) > 0 && !allowed[breed.Specie] {
+			continue
+		}
+		if match, ok := bestMatch(breed, index.keys[breed], text); ok {
+			matches = append(matches, match)
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].score != matches[j].score {
+			return matches[i].score < matches[j].score
+		}
+		return matches[i].Breed.Name < matches[j].Breed.Name
+	})
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches
+}
+
+func bestMatch(breed *entity.Breed, keys []breedKey, text string) (BreedMatch, bool) {
+	best := BreedMatch{score: -1}
+	for _, k := range keys {
+		score, ok := matchScore(k.key, text)
+		if ok && (best.score < 0 || score < best.score) {
+			best = BreedMatch{Breed: breed, Matched: k.original, score: score}
+		}
+	}
+	return best, best.score >= 0
+}
+
+func matchScore(key, text string) (int, bool) {
+	switch {
+	case text == "" || key == text:
+		return 0, true
+	case strings.HasPrefix(key, text):
+		return 1, true
+	case strings.Contains(" "+key, " "+text):
+		return 2, true
+	case strings.Contains(key, text):
+		return 3, true
+	}
+
+	typos := maxTypos(text)
+	if typos == 0 {
+		return 0, false
+	}
+	best := levenshtein(key, text)
+	for _, word := range strings.Fields(key) {
+		if d := levenshtein(word, text); d < best {
+			best = d
+		}
+	}
+	if best > typos {
+		return 0, false
+	}
+	return 10 + best, true
+}
+
+// maxTypos evita que buscas curtas casem com quase qualquer raça.
+func maxTypos(text string) int {
+	switch n := len([]rune(text)); {
+	case n <= 3:
+		return 0
+	case n <= 6:
+		return 1
+	default:
+		return 2
+	}
+}
+
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
+
+func (c *BreedCatalog) snapshot() *breedIndex {
+	if c == nil || c.repo == nil {
+		return indexBreeds(nil)
+	}
+
+	c.mu.RLock()
+	if c.index != nil && c.now().Sub(c.loadedAt) < c.ttl {
+		defer c.mu.RUnlock()
+		return c.index
+	}
+	c.mu.RUnlock()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.index != nil && c.now().Sub(c.loadedAt) < c.ttl {
+		return c.index
+	}
+
+	list, errData := c.repo.ListBreeds()
+	if errData != nil {
+		if c.index != nil {
+			// mantém a última versão conhecida até o banco voltar
+			return c.index
+		}
+		return indexBreeds(nil)
+	}
+	c.index = indexBreeds(list)
+	c.loadedAt = c.now()
+	return c.index
+}
+
+func indexBreeds(list []*entity.Breed) *breedIndex {
+	index := &breedIndex{
+		bySpecie: make(map[entity.PetType]map[string]*entity.Breed),
+		keys:     make(map[*entity.Breed][]breedKey, len(list)),
+		all:      list,
+	}
+	for _, breed := range list {
+		byKey, ok := index.bySpecie[breed.Specie]
+		if !ok {
+			byKey = make(map[string]*entity.Breed)
+			index.bySpecie[breed.Specie] = byKey
+		}
+		index.keys[breed] = breedKeys(breed)
+		for _, k := range index.keys[breed] {
+			if _, taken := byKey[k.key]; !taken {
+				byKey[k.key] = breed
+			}
+		}
+	}
+	return index
+}
+
+// breedKeys guarda, junto de cada chave normalizada, o nome como foi cadastrado.
+func breedKeys(breed *entity.Breed) []breedKey {
+	var keys []breedKey
+	for _, name := range append([]string{breed.Name}, breed.AliasList()...) {
+		if key := entity.NormalizeBreedName(name); key != "" {
+			keys = append(keys, breedKey{key: key, original: name})
+		}
+	}
+	return keys
+}
+
+// WithBreedCatalog passa a validar Pet.Breed contra as raças da espécie e grava o
+// nome canônico. Espécies sem raças cadastradas continuam aceitando texto livre.
+func WithBreedCatalog(catalog *BreedCatalog) Option {
+	return func(p *petApplication) {
+		p.breeds = catalog
+	}
+}
+
+// unknownBreed devolve a mensagem de erro quando a raça não pertence à espécie do pet;
+// quando pertence, troca Pet.Breed pelo nome canônico.
+func (p *petApplication) unknownBreed(pet *entity.Pet) string {
+	if p.breeds == nil || strings.TrimSpace(pet.Breed) == "" {
+		return ""
+	}
+	breed, known := p.breeds.Resolve(pet.Specie, pet.Breed)
+	if breed != nil {
+		pet.Breed = breed.Name
+		return ""
+	}
+	if !known {
+		return ""
+	}
+	return fmt.Sprintf("unknown breed %q for species %d", pet.Breed, pet.Specie)
+}
+
+func (p *petApplication) SearchBreeds(query BreedQuery) ([]BreedMatch, map[string]string) {
+	if p.breeds == nil || p.breeds.repo == nil {
+		return nil, map[string]string{"unavailable": "breed catalog is not enabled"}
+	}
+	return p.breeds.Search(query), nil
+}
@@ -0,0 +1,112 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+)
+
+type breedRepoMock struct {
+	breeds []*entity.Breed
+}
+
+func (r *breedRepoMock) ListBreeds() ([]*entity.Breed, map[string]string) {
+	return r.breeds, nil
+}
+
+func newBreed(specie entity.PetType, name string, aliases ...string) *entity.Breed {
+	breed := &entity.Breed{Specie: specie, Name: name}
+	_ = breed.SetAliases(aliases)
+	return breed
+}
+
+func newTestBreedCatalog() *BreedCatalog {
+	return NewBreedCatalog(&breedRepoMock{breeds: []*entity.Breed{
+		newBreed(entity.Dog, "Labrador Retriever", "Labrador", "Lab"),
+		newBreed(entity.Dog, "Pastor Alemão", "German Shepherd"),
+		newBreed(entity.Dog, "Beagle"),
+		newBreed(entity.Cat, "Siamês", "Siamese"),
+	}}, 0)
+}
+
+func TestBreedCatalog_ResolveNormalizesAliases(t *testing.T) {
+	catalog := newTestBreedCatalog()
+
+	for _, name := range []string{"labrador", "LAB", "Labrador Retriever"} {
+		breed, _ := catalog.Resolve(entity.Dog, name)
+		if breed == nil || breed.Name != "Labrador Retriever" {
+			t.Fatalf("expected %q to resolve to Labrador Retriever, got %+v", name, breed)
+		}
+	}
+	if breed, known := catalog.Resolve(entity.Cat, "Labrador"); breed != nil || !known {
+		t.Fatalf("labrador must not be a cat breed, got %+v", breed)
+	}
+	if breed, _ := catalog.Resolve(entity.Cat, "vira-lata"); breed == nil || breed.Name != entity.MixedBreed {
+		t.Fatalf("mixed breed must be accepted for any species, got %+v", breed)
+	}
+	if _, known := catalog.Resolve(entity.FirstCustomPetType, "Furão Albino"); known {
+		t.Fatal("species without breeds must not be known")
+	}
+}
+
+func TestBreedCatalog_SearchRanksPrefixBeforeFuzzy(t *testing.T) {
+	catalog := newTestBreedCatalog()
+
+	matches := catalog.Search(BreedQuery{Text: "lab", Species: []entity.PetType{entity.Dog}})
+	if len(matches) != 1 || matches[0].Breed.Name != "Labrador Retriever" || matches[0].Matched != "Lab" {
+		t.Fatalf("unexpected matches: %+v", matches)
+	}
+
+	matches = catalog.Search(BreedQuery{Text: "shepard"})
+	if len(matches) != 1 || matches[0].Breed.Name != "Pastor Alemão" {
+		t.Fatalf("expected fuzzy match on German Shepherd, got %+v", matches)
+	}
+
+	matches = catalog.Search(BreedQuery{Text: "alemao"})
+	if len(matches) != 1 || matches[0].Matched != "Pastor Alemão" {
+		t.Fatalf("expected word prefix match without accents, got %+v", matches)
+	}
+
+	matches = catalog.Search(BreedQuery{Species: []entity.PetType{entity.Cat}})
+	if len(matches) != 2 || !matches[0].Mixed || matches[1].Breed.Name != "Siamês" {
+		t.Fatalf("empty query must list the mixed option and the species breeds, got %+v", matches)
+	}
+
+	if matches := catalog.Search(BreedQuery{Limit: 2}); len(matches) != 2 {
+		t.Fatalf("expected limit to be applied, got %d matches", len(matches))
+	}
+}
+
+func TestPetApplication_ValidatesBreedAgainstSpecies(t *testing.T) {
+	app := NewPetApplication(&mockPetRepository{}, WithBreedCatalog(newTestBreedCatalog()))
+
+	pet := validBatchPet("Rex")
+	pet.Breed = "lab"
+	saved, errData := app.SavePet(context.Background(), pet)
+	if errData != nil {
+		t.Fatalf("unexpected error: %v", errData)
+	}
+	if saved.Breed != "Labrador Retriever" {
+		t.Fatalf("expected canonical breed name, got %q", saved.Breed)
+	}
+
+	cat := validBatchPet("Mia")
+	cat.Specie = entity.Cat
+	cat.Breed = "Beagle"
+	if _, errData := app.SavePet(context.Background(), cat); errData["invalid_argument"] == "" {
+		t.Fatalf("expected invalid_argument, got %v", errData)
+	}
+
+	results, _, _ := app.BatchSavePets(context.Background(), []*entity.Pet{cat}, BatchPerItem)
+	if results[0].Errors["breed"] == "" {
+		t.Fatalf("expected breed error on the item, got %v", results[0].Errors)
+	}
+}
+
+func TestPetApplication_SearchBreedsNeedsCatalog(t *testing.T) {
+	app := NewPetApplication(&mockPetRepository{})
+	if _, errData := app.SearchBreeds(BreedQuery{Text: "lab"}); errData["unavailable"] == "" {
+		t.Fatalf("expected unavailable, got %v", errData)
+	}
+}
@@ -0,0 +1,164 @@
+package application
+
+import (
+	"context"
+	"log"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/LuizFJP/pet-ms/domain/repository"
+	"github.com/google/uuid"
+)
+
+const (
+	DefaultGuardianPageSize = 100
+	MaxGuardianPageSize     = 1000
+)
+
+// WithGuardians habilita o cadastro de guardiões deste serviço.
+func WithGuardians(gr repository.GuardianRepository) Option {
+	return func(p *petApplication) {
+		p.gr = gr
+	}
+}
+
+// WithGuardianCheck passa a recusar pets cujo guardião directory não conhece. Sem ela
+// uuid_guardian é aceito sem conferência.
+func WithGuardianCheck(directory repository.GuardianDirectory) Option {
+	return func(p *petApplication) {
+		p.gd = directory
+	}
+}
+
+func (p *petApplication) guardiansUnavailable() map[string]string {
+	if p.gr == nil {
+		return map[string]string{"unavailable": "guardian registry is not enabled"}
+	}
+	return nil
+}
+
+func parseGuardianUuid(id string) (uuid.UUID, map[string]string) {
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, map[string]string{"invalid_argument": "uuid must be a valid uuid"}
+	}
+	return parsed, nil
+}
+
+// CreateGuardian cadastra o guardião no tenant da requisição.
+func (p *petApplication) CreateGuardian(ctx context.Context, guardian *entity.Guardian) (*entity.Guardian, map[string]string) {
+	if errData := p.guardiansUnavailable(); errData != nil {
+		return nil, errData
+	}
+	if errs := guardian.Validate(); len(errs) > 0 {
+		return nil, invalidArgument(errs)
+	}
+
+	guardian.Uuid = uuid.New()
+	guardian.TenantID = TenantFromContext(ctx)
+	guardian.TouchConsent(nil, p.now())
+	return p.gr.CreateGuardian(guardian)
+}
+
+func (p *petApplication) GetGuardian(ctx context.Context, id string) (*entity.Guardian, map[string]string) {
+	if errData := p.guardiansUnavailable(); errData != nil {
+		return nil, errData
+	}
+	parsed, errData := parseGuardianUuid(id)
+	if errData != nil {
+		return nil, errData
+	}
+	return p.gr.GetGuardian(TenantFromContext(ctx), parsed)
+}
+
+// UpdateGuardian substitui o cadastro. ConsentUpdatedAt só avança quando algum
+// consentimento muda.
+func (p *petApplication) UpdateGuardian(ctx context.Context, guardian *entity.Guardian) (*entity.Guardian, map[string]string) {
+	if errData := p.guardiansUnavailable(); errData != nil {
+		return nil, errData
+	}
+	current, errData := p.gr.GetGuardian(TenantFromContext(ctx), guardian.Uuid)
+	if errData != nil {
+		return nil, errData
+	}
+	if errs := guardian.Validate(); len(errs) > 0 {
+		return nil, invalidArgument(errs)
+	}
+
+	guardian.TenantID = current.TenantID
+	guardian.TouchConsent(current, p.now())
+	return p.gr.UpdateGuardian(guardian)
+}
+
+// DeleteGuardian só remove guardiões que não são donos de pets; os pets precisam ser
+// transferidos ou removidos antes. As ligações com outros papéis vão junto.
+func (p *petApplication) DeleteGuardian(ctx context.Context, id string) map[string]string {
+	if errData := p.guardiansUnavailable(); errData != nil {
+		return errData
+	}
+	parsed, errData := parseGuardianUuid(id)
+	if errData != nil {
+		return errData
+	}
+	pets, errData := p.writer(ctx).ListPets(entity.PetFilter{UuidGuardian: parsed}, "", 1)
+	if errData != nil {
+		return errData
+	}
+	if len(pets) > 0 {
+		return map[string]string{"failed_precondition": "guardian still has pets"}
+	}
+	if errData := p.gr.DeleteGuardian(TenantFromContext(ctx), parsed); errData != nil {
+		return errData
+	}
+	if p.pg != nil {
+		if errData := p.pg.DeleteGuardianLinks(TenantFromContext(ctx), parsed); errData != nil {
+			log.Printf("failed to remove pet links of guardian %s: %v", parsed, errData)
+		}
+	}
+	return nil
+}
+
+func (p *petApplication) ListGuardians(ctx context.Context, afterID uint, pageSize int) ([]*entity.Guardian, map[string]string) {
+	if errData := p.guardiansUnavailable(); errData != nil {
+		return nil, errData
+	}
+	return p.gr.ListGuardians(TenantFromContext(ctx), afterID, GuardianPageSize(pageSize))
+}
+
+// GuardianPageSize aplica o padrão e o teto de itens por página.
+func GuardianPageSize(pageSize int) int {
+	if pageSize <= 0 {
+		return DefaultGuardianPageSize
+	}
+	if pageSize > MaxGuardianPageSize {
+		return MaxGuardianPageSize
+	}
+	return pageSize
+}
+
+// checkGuardian devolve a mensagem do campo uuid_guardian quando o guardião não existe
+// no tenant da requisição e errData quando a consulta falha.
+func (p *petApplication) checkGuardian(ctx context.Context, guardian uuid.UUID) (string, map[string]string) {
+	if p.gd == nil || guardian == uuid.Nil {
+		return "", nil
+	}
+	ok, err := p.gd.GuardianExists(ctx, TenantFromContext(ctx), guardian)
+	if err != nil {
+		return "", map[string]string{"unavailable": "guardian lookup failed: " + err.Error()}
+	}
+	if !ok {
+		return "guardian not found", nil
+	}
+	return "", nil
+}
+
+// requireGuardian é o checkGuardian das escritas de um pet só.
+func (p *petApplication) requireGuardian(ctx context.Context, guardian uuid.UUID) map[string]string {
+	msg, errData := p.checkGuardian(ctx, guardian)
+	if errData != nil {
+		return errData
+	}
+	if msg != "" {
+		return invalidArgument(map[string]string{"uuid_guardian": msg})
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/LuizFJP/pet-ms/domain/repository"
+	"github.com/google/uuid"
+)
+
+var _ repository.GuardianRepository = (*guardianRepoMock)(nil)
+
+// guardianRepoMock guarda os guardiões em memória e também serve de diretório.
+type guardianRepoMock struct {
+	saved   map[uuid.UUID]*entity.Guardian
+	lookups int
+	err     error
+}
+
+func newGuardianRepoMock(known ...uuid.UUID) *guardianRepoMock {
+	m := &guardianRepoMock{saved: map[uuid.UUID]*entity.Guardian{}}
+	for _, id := range known {
+		m.saved[id] = &entity.Guardian{Uuid: id}
+	}
+	return m
+}
+
+func (m *guardianRepoMock) CreateGuardian(g *entity.Guardian) (*entity.Guardian, map[string]string) {
+	m.saved[g.Uuid] = g
+	return g, nil
+}
+
+func (m *guardianRepoMock) GetGuardian(tenant string, id uuid.UUID) (*entity.Guardian, map[string]string) {
+	g, ok := m.saved[id]
+	if !ok || (tenant != "" && g.TenantID != tenant) {
+		return nil, map[string]string{"not_found": "guardian not found"}
+	}
+	return g, nil
+}
+
+func (m *guardianRepoMock) UpdateGuardian(g *entity.Guardian) (*entity.Guardian, map[string]string) {
+	m.saved[g.Uuid] = g
+	return g, nil
+}
+
+func (m *guardianRepoMock) DeleteGuardian(tenant string, id uuid.UUID) map[string]string {
+	if _, errData := m.GetGuardian(tenant, id); errData != nil {
+		return errData
+	}
+	delete(m.saved, id)
+	return nil
+}
+
+func (m *guardianRepoMock) ListGuardians(tenant string, afterID uint, limit int) ([]*entity.Guardian, map[string]string) {
+	return nil, nil
+}
+
+func (m *guardianRepoMock) GuardianExists(ctx context.Context, tenant string, id uuid.UUID) (bool, error) {
+	m.lookups++
+	if m.err != nil {
+		return false, m.err
+	}
+	_, errData := m.GetGuardian(tenant, id)
+	return errData == nil, nil
+}
+
+func TestSavePet_RejectsUnknownGuardian(t *testing.T) {
+	known := uuid.New()
+	gr := newGuardianRepoMock(known)
+	repo := &mockPetRepository{}
+	app := NewPetApplication(repo, WithGuardianCheck(gr))
+
+	_, errData := app.SavePet(context.Background(), &entity.Pet{Name: "Rex", Breed: "SRD", UuidGuardian: uuid.New()})
+	if errData["invalid_argument"] == "" || repo.saveCalledWith != nil {
+		t.Fatalf("expected invalid_argument without saving, got %v", errData)
+	}
+	if _, errData := app.SavePet(context.Background(), &entity.Pet{Name: "Rex", Breed: "SRD", UuidGuardian: known}); errData != nil {
+		t.Fatalf("unexpected error for a known guardian: %v", errData)
+	}
+
+	gr.err = errors.New("connection refused")
+	if _, errData := app.SavePet(context.Background(), &entity.Pet{Name: "Rex", Breed: "SRD", UuidGuardian: known}); errData["unavailable"] == "" {
+		t.Fatalf("expected unavailable when the lookup fails, got %v", errData)
+	}
+}
+
+func TestUpdatePet_ChecksGuardianOnlyWhenItChanges(t *testing.T) {
+	petUuid, legacy := uuid.New(), uuid.New()
+	gr := newGuardianRepoMock()
+	repo := &mockPetRepository{
+		getFunc: func(string) (*entity.Pet, map[string]string) {
+			return &entity.Pet{Uuid: petUuid, Name: "Rex", UuidGuardian: legacy}, nil
+		},
+	}
+	app := NewPetApplication(repo, WithGuardianCheck(gr))
+
+	if _, errData := app.UpdatePet(context.Background(), &entity.Pet{Uuid: petUuid, Name: "Rex II", Breed: "SRD", UuidGuardian: legacy}); errData != nil {
+		t.Fatalf("expected a pet with an unregistered guardian to stay editable, got %v", errData)
+	}
+	// o Update do cliente não traz o guardião
+	if _, errData := app.UpdatePet(context.Background(), &entity.Pet{Uuid: petUuid, Name: "Rex II", Breed: "SRD"}); errData != nil {
+		t.Fatalf("expected an update without guardian to keep the stored one, got %v", errData)
+	}
+	if gr.lookups != 0 {
+		t.Fatalf("expected no lookup for an unchanged guardian, got %d", gr.lookups)
+	}
+	if _, errData := app.UpdatePet(context.Background(), &entity.Pet{Uuid: petUuid, Name: "Rex", Breed: "SRD", UuidGuardian: uuid.New()}); errData["invalid_argument"] == "" {
+		t.Fatalf("expected invalid_argument for a new unknown guardian, got %v", errData)
+	}
+}
+
+func TestGuardians_Lifecycle(t *testing.T) {
+	gr := newGuardianRepoMock()
+	var withPets bool
+	repo := &mockPetRepository{
+		listPetsFunc: func(filter entity.PetFilter, afterUuid string, limit int) ([]*entity.Pet, map[string]string) {
+			if withPets {
+				return []*entity.Pet{{UuidGuardian: filter.UuidGuardian}}, nil
+			}
+			return nil, nil
+		},
+	}
+	pg := &petGuardianRepoMock{}
+	app := NewPetApplication(repo, WithGuardians(gr), WithPetGuardians(pg))
+	ctx := ContextWithTenant(context.Background(), "shelter")
+
+	if _, errData := app.CreateGuardian(ctx, &entity.Guardian{Name: "Ana"}); errData["invalid_argument"] == "" {
+		t.Fatalf("expected invalid_argument without contact, got %v", errData)
+	}
+	created, errData := app.CreateGuardian(ctx, &entity.Guardian{Name: "Ana", Email: "ana@example.com", Consent: entity.Consent{Contact: true}})
+	if errData != nil {
+		t.Fatalf("unexpected error: %v", errData)
+	}
+	if created.Uuid == uuid.Nil || created.TenantID != "shelter" || created.ConsentUpdatedAt == nil {
+		t.Fatalf("expected uuid, tenant and consent date set, got %+v", created)
+	}
+
+	if _, errData := app.GetGuardian(ContextWithTenant(context.Background(), "clinic"), created.Uuid.String()); errData["not_found"] == "" {
+		t.Fatalf("expected not_found for another tenant, got %v", errData)
+	}
+
+	withPets = true
+	if errData := app.DeleteGuardian(ctx, created.Uuid.String()); errData["failed_precondition"] == "" {
+		t.Fatalf("expected failed_precondition while the guardian has pets, got %v", errData)
+	}
+	withPets = false
+	if errData := app.DeleteGuardian(ctx, created.Uuid.String()); errData != nil {
+		t.Fatalf("unexpected error: %v", errData)
+	}
+	if pg.deletedLinksOf != created.Uuid {
+		t.Fatalf("expected the guardian's links removed, got %v", pg.deletedLinksOf)
+	}
+}
+
+func TestGuardians_UnavailableWithoutRegistry(t *testing.T) {
+	app := NewPetApplication(&mockPetRepository{})
+
+	if _, errData := app.CreateGuardian(context.Background(), &entity.Guardian{Name: "Ana", Email: "ana@example.com"}); errData["unavailable"] == "" {
+		t.Fatalf("expected unavailable, got %v", errData)
+	}
+	if _, errData := app.ListGuardians(context.Background(), 0, 0); errData["unavailable"] == "" {
+		t.Fatalf("expected unavailable, got %v", errData)
+	}
+}
@@ -1,39 +1,325 @@
 package application
 
 import (
+	"context"
+	"encoding/json"
+	"io"
+	"time"
+
 	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/LuizFJP/pet-ms/domain/event"
 	"github.com/LuizFJP/pet-ms/domain/repository"
+	"github.com/google/uuid"
 )
 
+const DefaultIdempotencyTTL = 24 * time.Hour
+
 type petApplication struct {
-	pr repository.PetRepository
+	pr             repository.PetRepository
+	ir             repository.IdempotencyRepository
+	ar             repository.AuditRepository
+	vr             repository.VaccinationRepository
+	mr             repository.MedicalRecordRepository
+	attachments    repository.AttachmentRepository
+	blobs          repository.BlobStore
+	gr             repository.GuardianRepository
+	gd             repository.GuardianDirectory
+	pg             repository.PetGuardianRepository
+	ad             repository.AdoptionApplicationRepository
+	species        *SpeciesCatalog
+	breeds         *BreedCatalog
+	idempotencyTTL time.Duration
+	bus            event.Bus
+	now            func() time.Time
 }
 
 var _ PetApplicationInterface = &petApplication{}
 
-func NewPetApplication(pr repository.PetRepository) PetApplicationInterface {
-	return &petApplication{pr}
+// Option configura dependências opcionais do petApplication.
+type Option func(*petApplication)
+
+// WithIdempotency habilita o Create idempotente, guardando cada chave por ttl.
+func WithIdempotency(ir repository.IdempotencyRepository, ttl time.Duration) Option {
+	return func(p *petApplication) {
+		p.ir = ir
+		if ttl > 0 {
+			p.idempotencyTTL = ttl
+		}
+	}
+}
+
+func NewPetApplication(pr repository.PetRepository, opts ...Option) PetApplicationInterface {
+	p := &petApplication{pr: pr, idempotencyTTL: DefaultIdempotencyTTL, now: time.Now}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
 }
 
 type PetApplicationInterface interface {
-	SavePet(pet *entity.Pet) (*entity.Pet, map[string]string)
-	GetPet(uuid string) (*entity.Pet, map[string]string)
-	UpdatePet(pet *entity.Pet) (*entity.Pet, map[string]string)
-	DeletePet(uuid string) (map[string]string, map[string]string)
+	SavePet(ctx context.Context, pet *entity.Pet) (*entity.Pet, map[string]string)
+	SavePetWithIdempotencyKey(ctx context.Context, key, requestHash string, pet *entity.Pet) (*entity.Pet, map[string]string)
+	GetPet(ctx context.Context, uuid string) (*entity.Pet, map[string]string)
+	UpdatePet(ctx context.Context, pet *entity.Pet) (*entity.Pet, map[string]string)
+	UpdatePetFields(ctx context.Context, changes *entity.Pet, fields []string) (*entity.Pet, map[string]string)
+	DeletePet(ctx context.Context, uuid string) (map[string]string, map[string]string)
+	TransferPet(ctx context.Context, uuid, uuidGuardian string) (*entity.Pet, map[string]string)
+	TransitionPet(ctx context.Context, uuid string, transition entity.StatusTransition) (*entity.Pet, map[string]string)
+	BatchSavePets(ctx context.Context, pets []*entity.Pet, mode BatchMode) ([]BatchItemResult, bool, map[string]string)
+	BatchGetPets(ctx context.Context, uuids []string) ([]*entity.Pet, []string, map[string]string)
+	BatchUpdatePets(ctx context.Context, pets []*entity.Pet, mode BatchMode) ([]BatchItemResult, bool, map[string]string)
+	ImportPets(ctx context.Context, pets []*entity.Pet) (int, map[int]map[string]string)
+	ExportPets(ctx context.Context, filter entity.PetFilter, pageSize int, send func(*entity.Pet) error) map[string]string
+	WatchPets(ctx context.Context, afterSequence uint64, filter entity.PetFilter, send func(entity.PetEvent) error) map[string]string
+	GetPetAuditLog(ctx context.Context, uuid string, afterID uint, pageSize int) ([]*entity.AuditEntry, map[string]string)
+	ListGuardianAuditLog(ctx context.Context, uuidGuardian string, afterID uint, pageSize int) ([]*entity.AuditEntry, map[string]string)
+	LookupSpecies(value entity.PetType) (*entity.Species, bool)
+	LookupSpeciesCode(code string) (*entity.Species, bool)
+	ListSpecies() ([]*entity.Species, map[string]string)
+	GetSpecies(code string) (*entity.Species, map[string]string)
+	CreateSpecies(species *entity.Species) (*entity.Species, map[string]string)
+	UpdateSpecies(species *entity.Species) (*entity.Species, map[string]string)
+	DeleteSpecies(code string) map[string]string
+	SearchBreeds(query BreedQuery) ([]BreedMatch, map[string]string)
+	LookupByMicrochip(ctx context.Context, number string) (*entity.Pet, map[string]string)
+	SearchPets(ctx context.Context, search entity.PetSearch) ([]*entity.PetSearchHit, map[string]string)
+	AddVaccination(ctx context.Context, vaccination *entity.Vaccination) (*entity.Vaccination, map[string]string)
+	UpdateVaccination(ctx context.Context, vaccination *entity.Vaccination) (*entity.Vaccination, map[string]string)
+	ListVaccinations(ctx context.Context, petUuid string) ([]*entity.Vaccination, map[string]string)
+	ListOverdueVaccinations(ctx context.Context, asOf time.Time, afterID uint, pageSize int) ([]*entity.Vaccination, map[string]string)
+	AddMedicalRecord(ctx context.Context, record *entity.MedicalRecord) (*entity.MedicalRecord, map[string]string)
+	UpdateMedicalRecord(ctx context.Context, record *entity.MedicalRecord) (*entity.MedicalRecord, map[string]string)
+	ListMedicalRecords(ctx context.Context, petUuid string) ([]*entity.MedicalRecord, map[string]string)
+	UploadAttachment(ctx context.Context, attachment *entity.Attachment, content io.Reader) (*entity.Attachment, map[string]string)
+	OpenAttachment(ctx context.Context, uuid string) (*entity.Attachment, io.ReadCloser, map[string]string)
+	ListAttachments(ctx context.Context, petUuid string) ([]*entity.Attachment, map[string]string)
+	DeleteAttachment(ctx context.Context, uuid string) map[string]string
+	CreateGuardian(ctx context.Context, guardian *entity.Guardian) (*entity.Guardian, map[string]string)
+	GetGuardian(ctx context.Context, uuid string) (*entity.Guardian, map[string]string)
+	UpdateGuardian(ctx context.Context, guardian *entity.Guardian) (*entity.Guardian, map[string]string)
+	DeleteGuardian(ctx context.Context, uuid string) map[string]string
+	ListGuardians(ctx context.Context, afterID uint, pageSize int) ([]*entity.Guardian, map[string]string)
+	AddPetGuardian(ctx context.Context, link *entity.PetGuardian) (*entity.PetGuardian, map[string]string)
+	RemovePetGuardian(ctx context.Context, petUuid, uuidGuardian string) map[string]string
+	ListPetGuardians(ctx context.Context, petUuid string) ([]*entity.PetGuardian, map[string]string)
+	SubmitApplication(ctx context.Context, application *entity.AdoptionApplication) (*entity.AdoptionApplication, map[string]string)
+	GetApplication(ctx context.Context, uuid string) (*entity.AdoptionApplication, map[string]string)
+	ApproveApplication(ctx context.Context, uuid, reason string) (*entity.ApplicationApproval, map[string]string)
+	RejectApplication(ctx context.Context, uuid, reason string) (*entity.AdoptionApplication, map[string]string)
+	WithdrawApplication(ctx context.Context, uuid, reason string) (*entity.AdoptionApplication, map[string]string)
+	ListPetApplications(ctx context.Context, petUuid string, afterID uint, pageSize int) ([]*entity.AdoptionApplication, map[string]string)
+	ListApplicantApplications(ctx context.Context, applicantUuid string, afterID uint, pageSize int) ([]*entity.AdoptionApplication, map[string]string)
+}
+
+func (p *petApplication) SavePet(ctx context.Context, pet *entity.Pet) (*entity.Pet, map[string]string) {
+	if errs := p.validateEntity(pet, "create"); len(errs) > 0 {
+		return nil, invalidArgument(errs)
+	}
+	if errData := p.requireGuardian(ctx, pet.UuidGuardian); errData != nil {
+		return nil, errData
+	}
+	if msg := p.microchipConflict(ctx, pet); msg != "" {
+		return nil, map[string]string{"conflict": msg}
+	}
+
+	saved, errData := p.writer(ctx).SavePet(pet)
+	if errData == nil {
+		p.publish(entity.PetCreated, saved)
+		p.recordAudit(ctx, entity.AuditCreate, createdChanges(saved)...)
+	}
+	return saved, errData
+}
+
+// SavePetWithIdempotencyKey cria o pet uma única vez por chave. Repetições com o mesmo
+// payload devolvem o pet da primeira chamada; com payload diferente são rejeitadas.
+// Sem chave é um SavePet. Com chave, se o armazenamento não grava chaves (como o
+// STORAGE_BACKEND=memory), a chamada falha com unavailable em vez de criar sem garantia.
+func (p *petApplication) SavePetWithIdempotencyKey(ctx context.Context, key, requestHash string, pet *entity.Pet) (*entity.Pet, map[string]string) {
+	if key == "" {
+		return p.SavePet(ctx, pet)
+	}
+	writer, ok := p.writer(ctx).(repository.IdempotentWriter)
+	if p.ir == nil || !ok {
+		return nil, map[string]string{"unavailable": "idempotency keys are not supported by this storage backend"}
+	}
+
+	if existing := p.activeIdempotencyKey(ctx, key); existing != nil {
+		return replayIdempotencyKey(existing, requestHash)
+	}
+	if errs := p.validateEntity(pet, "create"); len(errs) > 0 {
+		return nil, invalidArgument(errs)
+	}
+	if errData := p.requireGuardian(ctx, pet.UuidGuardian); errData != nil {
+		return nil, errData
+	}
+	if msg := p.microchipConflict(ctx, pet); msg != "" {
+		return nil, map[string]string{"conflict": msg}
+	}
+
+	now := p.now()
+	record := &entity.IdempotencyKey{
+		TenantID:    TenantFromContext(ctx),
+		Key:         key,
+		RequestHash: requestHash,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(p.idempotencyTTL),
+	}
+	saved, errData := writer.SavePetWithIdempotencyKey(pet, record)
+	if errData != nil {
+		// outra requisição com a mesma chave pode ter vencido a corrida
+		if existing := p.activeIdempotencyKey(ctx, key); existing != nil {
+			return replayIdempotencyKey(existing, requestHash)
+		}
+		return nil, errData
+	}
+	p.publish(entity.PetCreated, saved)
+	p.recordAudit(ctx, entity.AuditCreate, createdChanges(saved)...)
+	return saved, nil
+}
+
+func (p *petApplication) activeIdempotencyKey(ctx context.Context, key string) *entity.IdempotencyKey {
+	existing, errData := p.ir.GetIdempotencyKey(TenantFromContext(ctx), key)
+	if errData != nil || existing.Expired(p.now()) {
+		return nil
+	}
+	return existing
 }
 
-func (p *petApplication) SavePet(pet *entity.Pet) (*entity.Pet, map[string]string) {
-	return p.pr.SavePet(pet)
+func replayIdempotencyKey(existing *entity.IdempotencyKey, requestHash string) (*entity.Pet, map[string]string) {
+	if existing.RequestHash != requestHash {
+		return nil, map[string]string{"conflict": "idempotency key already used with a different payload"}
+	}
+
+	pet := &entity.Pet{}
+	if err := json.Unmarshal([]byte(existing.Response), pet); err != nil {
+		return nil, map[string]string{"message": err.Error()}
+	}
+	return pet, nil
+}
+
+func (p *petApplication) GetPet(ctx context.Context, uuid string) (*entity.Pet, map[string]string) {
+	return p.reader(ctx).GetPet(uuid)
+}
+
+func (p *petApplication) UpdatePet(ctx context.Context, pet *entity.Pet) (*entity.Pet, map[string]string) {
+	if errs := p.validateEntity(pet, "update"); len(errs) > 0 {
+		return nil, invalidArgument(errs)
+	}
+
+	var before *entity.Pet
+	if p.ar != nil || p.gd != nil {
+		before, _ = p.writer(ctx).GetPet(pet.Uuid.String())
+	}
+	// pets de antes do cadastro de guardiões continuam editáveis sem trocar de guardião;
+	// sem guardião no pedido o repositório mantém o gravado
+	if pet.UuidGuardian != uuid.Nil && (before == nil || before.UuidGuardian != pet.UuidGuardian) {
+		if errData := p.requireGuardian(ctx, pet.UuidGuardian); errData != nil {
+			return nil, errData
+		}
+	}
+
+	updated, errData := p.writer(ctx).UpdatePet(pet)
+	if errData == nil {
+		p.publish(entity.PetUpdated, updated)
+		p.recordAudit(ctx, entity.AuditUpdate, petChange{before: before, after: updated})
+	}
+	return updated, errData
 }
 
-func (p *petApplication) GetPet(uuid string) (*entity.Pet, map[string]string) {
-	return p.pr.GetPet(uuid)
+// UpdatePetFields aplica sobre o pet atual só os campos listados em fields e valida o
+// resultado completo antes de gravar.
+func (p *petApplication) UpdatePetFields(ctx context.Context, changes *entity.Pet, fields []string) (*entity.Pet, map[string]string) {
+	if len(fields) == 0 {
+		return nil, map[string]string{"invalid_argument": "update mask is empty"}
+	}
+
+	current, errData := p.writer(ctx).GetPet(changes.Uuid.String())
+	if errData != nil {
+		return nil, errData
+	}
+	before := *current
+
+	if errs := current.CopyFields(changes, fields); len(errs) > 0 {
+		return nil, invalidArgument(errs)
+	}
+	if errs := p.validateEntity(current, "update"); len(errs) > 0 {
+		return nil, invalidArgument(errs)
+	}
+	if current.UuidGuardian != before.UuidGuardian {
+		if errData := p.requireGuardian(ctx, current.UuidGuardian); errData != nil {
+			return nil, errData
+		}
+	}
+	if msg := p.microchipConflict(ctx, current); msg != "" {
+		return nil, map[string]string{"conflict": msg}
+	}
+
+	updated, errData := p.writer(ctx).UpdatePetFields(current, maskColumns(fields))
+	if errData == nil {
+		p.publish(entity.PetUpdated, updated)
+		p.recordAudit(ctx, entity.AuditUpdate, petChange{before: &before, after: updated})
+	}
+	return updated, errData
 }
 
-func (p *petApplication) UpdatePet(pet *entity.Pet) (*entity.Pet, map[string]string) {
-	return p.pr.UpdatePet(pet)
+// maskColumns inclui os campos que a validação pode ter normalizado junto com os pedidos:
+// a data de nascimento também atualiza o ano e a precisão.
+func maskColumns(fields []string) []string {
+	columns := append([]string{}, fields...)
+	for _, field := range fields {
+		if field == "birth_date" {
+			columns = append(columns, "birth_year", "birth_date_accuracy")
+		}
+	}
+	return columns
 }
 
-func (p *petApplication) DeletePet(uuid string) (map[string]string, map[string]string) {
-	return p.pr.DeletePet(uuid)
+// DeletePet apaga os pets de que o guardião é dono; as ligações dele como co-dono,
+// lar temporário ou contato de emergência de outros pets continuam.
+func (p *petApplication) DeletePet(ctx context.Context, uuid string) (map[string]string, map[string]string) {
+	deleted := p.petsOfGuardian(ctx, uuid)
+	attachments := p.petAttachments(deleted)
+	res, errData := p.writer(ctx).DeletePet(uuid)
+	if errData == nil {
+		p.publish(entity.PetDeleted, deleted...)
+		changes := make([]petChange, 0, len(deleted))
+		for _, pet := range deleted {
+			changes = append(changes, petChange{before: pet})
+		}
+		p.recordAudit(ctx, entity.AuditDelete, changes...)
+		p.removeAttachmentBlobs(ctx, attachments)
+		p.removePetLinks(deleted)
+	}
+	return res, errData
+}
+
+// TransferPet passa o pet para outro guardião.
+func (p *petApplication) TransferPet(ctx context.Context, petUuid, uuidGuardian string) (*entity.Pet, map[string]string) {
+	guardian, err := uuid.Parse(uuidGuardian)
+	if err != nil || guardian == uuid.Nil {
+		return nil, map[string]string{"invalid_argument": "uuid_guardian must be a valid uuid"}
+	}
+	if errData := p.requireGuardian(ctx, guardian); errData != nil {
+		return nil, errData
+	}
+
+	var before *entity.Pet
+	if p.bus != nil || p.ar != nil {
+		before, _ = p.writer(ctx).GetPet(petUuid)
+	}
+
+	transferred, errData := p.writer(ctx).TransferPet(petUuid, guardian.String())
+	if errData != nil {
+		return nil, errData
+	}
+	if p.bus != nil {
+		ev := entity.PetEvent{Type: entity.PetTransferred, Pet: *transferred, OccurredAt: p.now()}
+		if before != nil {
+			ev.PreviousGuardian = before.UuidGuardian
+		}
+		p.bus.Publish(ev)
+	}
+	p.recordAudit(ctx, entity.AuditTransfer, petChange{before: before, after: transferred})
+	p.dropOwnerRole(transferred.Uuid, guardian)
+	return transferred, nil
 }
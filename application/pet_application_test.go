@@ -1,10 +1,15 @@
 package application
 
 import (
+	"context"
+	"encoding/json"
 	"github.com/LuizFJP/pet-ms/domain/entity"
 	"github.com/LuizFJP/pet-ms/domain/repository"
+	"github.com/google/uuid"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 )
 
 // Compile-time check: our mock satisfies the repository interface
@@ -13,10 +18,22 @@ var _ repository.PetRepository = (*mockPetRepository)(nil)
 // mockPetRepository is a lightweight stub with pluggable behavior
 // and call tracking for assertions
 type mockPetRepository struct {
-	saveFunc   func(p *entity.Pet) (*entity.Pet, map[string]string)
-	getFunc    func(id string) (*entity.Pet, map[string]string)
-	updateFunc func(p *entity.Pet) (*entity.Pet, map[string]string)
-	deleteFunc func(id string) (map[string]string, map[string]string)
+	saveFunc     func(p *entity.Pet) (*entity.Pet, map[string]string)
+	getFunc      func(id string) (*entity.Pet, map[string]string)
+	updateFunc   func(p *entity.Pet) (*entity.Pet, map[string]string)
+	deleteFunc   func(id string) (map[string]string, map[string]string)
+	transferFunc func(id, guardian string) (*entity.Pet, map[string]string)
+
+	updateFieldsFunc func(p *entity.Pet, fields []string) (*entity.Pet, map[string]string)
+	byMicrochipFunc  func(number string) (*entity.Pet, map[string]string)
+	changeStatusFunc func(id string, change entity.StatusChange) (*entity.Pet, map[string]string)
+
+	savePetsFunc   func(pets []*entity.Pet, allOrNothing bool) ([]*entity.Pet, []map[string]string)
+	getPetsFunc    func(uuids []string) ([]*entity.Pet, map[string]string)
+	updatePetsFunc func(pets []*entity.Pet, allOrNothing bool) ([]*entity.Pet, []map[string]string)
+	insertPetsFunc func(pets []*entity.Pet) map[string]string
+	listPetsFunc   func(filter entity.PetFilter, afterUuid string, limit int) ([]*entity.Pet, map[string]string)
+	searchFunc     func(search entity.PetSearch) ([]*entity.PetSearchHit, map[string]string)
 
 	saveCalledWith   *entity.Pet
 	getCalledWith    string
@@ -40,6 +57,13 @@ func (m *mockPetRepository) GetPet(id string) (*entity.Pet, map[string]string) {
 	return &entity.Pet{}, nil
 }
 
+func (m *mockPetRepository) GetPetByMicrochip(number string) (*entity.Pet, map[string]string) {
+	if m.byMicrochipFunc != nil {
+		return m.byMicrochipFunc(number)
+	}
+	return nil, map[string]string{"not_found": "no pet with this microchip"}
+}
+
 func (m *mockPetRepository) UpdatePet(p *entity.Pet) (*entity.Pet, map[string]string) {
 	m.updateCalledWith = p
 	if m.updateFunc != nil {
@@ -48,6 +72,14 @@ func (m *mockPetRepository) UpdatePet(p *entity.Pet) (*entity.Pet, map[string]st
 	return p, nil
 }
 
+func (m *mockPetRepository) UpdatePetFields(p *entity.Pet, fields []string) (*entity.Pet, map[string]string) {
+	m.updateCalledWith = p
+	if m.updateFieldsFunc != nil {
+		return m.updateFieldsFunc(p, fields)
+	}
+	return p, nil
+}
+
 func (m *mockPetRepository) DeletePet(id string) (map[string]string, map[string]string) {
 	m.deleteCalledWith = id
 	if m.deleteFunc != nil {
@@ -56,6 +88,64 @@ func (m *mockPetRepository) DeletePet(id string) (map[string]string, map[string]
 	return map[string]string{"status": "deleted"}, nil
 }
 
+func (m *mockPetRepository) TransferPet(id, guardian string) (*entity.Pet, map[string]string) {
+	if m.transferFunc != nil {
+		return m.transferFunc(id, guardian)
+	}
+	return &entity.Pet{}, nil
+}
+
+func (m *mockPetRepository) ChangePetStatus(id string, change entity.StatusChange) (*entity.Pet, map[string]string) {
+	if m.changeStatusFunc != nil {
+		return m.changeStatusFunc(id, change)
+	}
+	pet := &entity.Pet{}
+	change.Apply(pet)
+	return pet, nil
+}
+
+func (m *mockPetRepository) SavePets(pets []*entity.Pet, allOrNothing bool) ([]*entity.Pet, []map[string]string) {
+	if m.savePetsFunc != nil {
+		return m.savePetsFunc(pets, allOrNothing)
+	}
+	return pets, make([]map[string]string, len(pets))
+}
+
+func (m *mockPetRepository) GetPets(uuids []string) ([]*entity.Pet, map[string]string) {
+	if m.getPetsFunc != nil {
+		return m.getPetsFunc(uuids)
+	}
+	return nil, nil
+}
+
+func (m *mockPetRepository) UpdatePets(pets []*entity.Pet, allOrNothing bool) ([]*entity.Pet, []map[string]string) {
+	if m.updatePetsFunc != nil {
+		return m.updatePetsFunc(pets, allOrNothing)
+	}
+	return pets, make([]map[string]string, len(pets))
+}
+
+func (m *mockPetRepository) InsertPets(pets []*entity.Pet) map[string]string {
+	if m.insertPetsFunc != nil {
+		return m.insertPetsFunc(pets)
+	}
+	return nil
+}
+
+func (m *mockPetRepository) ListPets(filter entity.PetFilter, afterUuid string, limit int) ([]*entity.Pet, map[string]string) {
+	if m.listPetsFunc != nil {
+		return m.listPetsFunc(filter, afterUuid, limit)
+	}
+	return nil, nil
+}
+
+func (m *mockPetRepository) SearchPets(search entity.PetSearch) ([]*entity.PetSearchHit, map[string]string) {
+	if m.searchFunc != nil {
+		return m.searchFunc(search)
+	}
+	return nil, nil
+}
+
 func TestNewPetApplication_ReturnsConcreteAndWrapsRepo(t *testing.T) {
 	mock := &mockPetRepository{}
 	app := NewPetApplication(mock)
@@ -74,7 +164,7 @@ func TestNewPetApplication_ReturnsConcreteAndWrapsRepo(t *testing.T) {
 }
 
 func TestSavePet_DelegatesToRepository(t *testing.T) {
-	in := &entity.Pet{ /* fill fields if you have them */ }
+	in := &entity.Pet{UuidGuardian: uuid.New(), Name: "Rex", Breed: "SRD"}
 	wantPet := &entity.Pet{}
 	wantErrs := map[string]string{"ok": "true"}
 
@@ -88,7 +178,7 @@ func TestSavePet_DelegatesToRepository(t *testing.T) {
 	}
 
 	app := NewPetApplication(mock)
-	gotPet, gotErrs := app.SavePet(in)
+	gotPet, gotErrs := app.SavePet(context.Background(), in)
 
 	if mock.saveCalledWith != in {
 		t.Fatalf("SavePet should forward the same pointer to repo")
@@ -116,7 +206,7 @@ func TestGetPet_DelegatesToRepository(t *testing.T) {
 	}
 
 	app := NewPetApplication(mock)
-	gotPet, gotErrs := app.GetPet(wantID)
+	gotPet, gotErrs := app.GetPet(context.Background(), wantID)
 
 	if mock.getCalledWith != wantID {
 		t.Fatalf("GetPet should pass the id to repo. got=%s want=%s", mock.getCalledWith, wantID)
@@ -130,7 +220,7 @@ func TestGetPet_DelegatesToRepository(t *testing.T) {
 }
 
 func TestUpdatePet_DelegatesToRepository(t *testing.T) {
-	in := &entity.Pet{}
+	in := &entity.Pet{Uuid: uuid.New(), Name: "Rex", Breed: "SRD"}
 	wantPet := &entity.Pet{}
 	wantErrs := map[string]string{"updated": "true"}
 
@@ -144,7 +234,7 @@ func TestUpdatePet_DelegatesToRepository(t *testing.T) {
 	}
 
 	app := NewPetApplication(mock)
-	gotPet, gotErrs := app.UpdatePet(in)
+	gotPet, gotErrs := app.UpdatePet(context.Background(), in)
 
 	if mock.updateCalledWith != in {
 		t.Fatalf("UpdatePet should forward the same pointer to repo")
@@ -157,6 +247,23 @@ func TestUpdatePet_DelegatesToRepository(t *testing.T) {
 	}
 }
 
+func TestSavePetAndUpdatePet_ValidateBeforeRepository(t *testing.T) {
+	repo := &mockPetRepository{}
+	app := NewPetApplication(repo)
+
+	_, errs := app.SavePet(context.Background(), &entity.Pet{Name: "Rex", Breed: "SRD"})
+	if !strings.Contains(errs["invalid_argument"], "uuid_guardian") {
+		t.Fatalf("expected invalid_argument for a pet without guardian, got %v", errs)
+	}
+	_, errs = app.UpdatePet(context.Background(), &entity.Pet{Name: "Rex"})
+	if !strings.Contains(errs["invalid_argument"], "uuid") || !strings.Contains(errs["invalid_argument"], "breed") {
+		t.Fatalf("expected invalid_argument for a pet without uuid and breed, got %v", errs)
+	}
+	if repo.saveCalledWith != nil || repo.updateCalledWith != nil {
+		t.Fatalf("invalid pets must not reach the repository")
+	}
+}
+
 func TestDeletePet_DelegatesToRepository(t *testing.T) {
 	wantID := "abc-uuid"
 	wantResp := map[string]string{"status": "ok"}
@@ -172,7 +279,7 @@ func TestDeletePet_DelegatesToRepository(t *testing.T) {
 	}
 
 	app := NewPetApplication(mock)
-	gotResp, gotErrs := app.DeletePet(wantID)
+	gotResp, gotErrs := app.DeletePet(context.Background(), wantID)
 
 	if mock.deleteCalledWith != wantID {
 		t.Fatalf("DeletePet should pass the id to repo. got=%s want=%s", mock.deleteCalledWith, wantID)
@@ -184,3 +291,226 @@ func TestDeletePet_DelegatesToRepository(t *testing.T) {
 		t.Fatalf("DeletePet should return repo's errors. got=%v want=%v", gotErrs, wantErrs)
 	}
 }
+
+// mockIdempotencyRepository keeps keys in memory and mimics the unique constraint
+type mockIdempotencyRepository struct {
+	keys      map[string]*entity.IdempotencyKey
+	saveCalls int
+	onSave    func(key *entity.IdempotencyKey) map[string]string
+}
+
+var _ repository.IdempotencyRepository = (*mockIdempotencyRepository)(nil)
+
+func newMockIdempotencyRepository() *mockIdempotencyRepository {
+	return &mockIdempotencyRepository{keys: map[string]*entity.IdempotencyKey{}}
+}
+
+func (m *mockIdempotencyRepository) GetIdempotencyKey(tenant, key string) (*entity.IdempotencyKey, map[string]string) {
+	if k, ok := m.keys[key]; ok && k.TenantID == tenant {
+		return k, nil
+	}
+	return nil, map[string]string{"not_found": "idempotency key not found"}
+}
+
+func (m *mockIdempotencyRepository) SavePetWithIdempotencyKey(pet *entity.Pet, key *entity.IdempotencyKey) (*entity.Pet, map[string]string) {
+	m.saveCalls++
+	if m.onSave != nil {
+		if errs := m.onSave(key); errs != nil {
+			return nil, errs
+		}
+	}
+	body, _ := json.Marshal(pet)
+	key.Response = string(body)
+	m.keys[key.Key] = key
+	return pet, nil
+}
+
+func (m *mockIdempotencyRepository) DeleteExpiredIdempotencyKeys(now time.Time) (int64, map[string]string) {
+	return 0, nil
+}
+
+// idempotentPetRepository creates pets through the idempotency mock, like the
+// persistence repository that writes the pet and the key in one transaction
+type idempotentPetRepository struct {
+	mockPetRepository
+	idem *mockIdempotencyRepository
+}
+
+var _ repository.IdempotentWriter = (*idempotentPetRepository)(nil)
+
+func (m *mockIdempotencyRepository) petRepository() *idempotentPetRepository {
+	return &idempotentPetRepository{idem: m}
+}
+
+func (r *idempotentPetRepository) SavePetWithIdempotencyKey(pet *entity.Pet, key *entity.IdempotencyKey) (*entity.Pet, map[string]string) {
+	return r.idem.SavePetWithIdempotencyKey(pet, key)
+}
+
+func TestSavePetWithIdempotencyKey_EmptyKeyFallsBackToSavePet(t *testing.T) {
+	repo := &mockPetRepository{}
+	idem := newMockIdempotencyRepository()
+	app := NewPetApplication(repo, WithIdempotency(idem, time.Hour))
+
+	in := &entity.Pet{UuidGuardian: uuid.New(), Name: "Rex", Breed: "SRD"}
+	got, errs := app.SavePetWithIdempotencyKey(context.Background(), "", "hash", in)
+
+	if errs != nil || got != in {
+		t.Fatalf("expected plain SavePet result, got pet=%v errs=%v", got, errs)
+	}
+	if repo.saveCalledWith != in {
+		t.Fatalf("empty key should delegate to PetRepository.SavePet")
+	}
+	if idem.saveCalls != 0 {
+		t.Fatalf("empty key must not touch the idempotency repository")
+	}
+}
+
+func TestSavePetWithIdempotencyKey_ReplaysOriginalPet(t *testing.T) {
+	idem := newMockIdempotencyRepository()
+	app := NewPetApplication(idem.petRepository(), WithIdempotency(idem, time.Hour))
+
+	first, errs := app.SavePetWithIdempotencyKey(context.Background(), "key-1", "hash", &entity.Pet{UuidGuardian: uuid.New(), Name: "Rex", BirthYear: 2020, Breed: "SRD"})
+	if errs != nil {
+		t.Fatalf("unexpected errors on first call: %v", errs)
+	}
+
+	second, errs := app.SavePetWithIdempotencyKey(context.Background(), "key-1", "hash", &entity.Pet{UuidGuardian: uuid.New(), Name: "Rex", BirthYear: 2020, Breed: "SRD"})
+	if errs != nil {
+		t.Fatalf("unexpected errors on replay: %v", errs)
+	}
+	if idem.saveCalls != 1 {
+		t.Fatalf("replay must not create another pet, save calls=%d", idem.saveCalls)
+	}
+	if !reflect.DeepEqual(first, second) {
+		t.Fatalf("replay should return the original pet. got=%v want=%v", second, first)
+	}
+}
+
+func TestSavePetWithIdempotencyKey_RejectsDifferentPayload(t *testing.T) {
+	idem := newMockIdempotencyRepository()
+	app := NewPetApplication(idem.petRepository(), WithIdempotency(idem, time.Hour))
+
+	if _, errs := app.SavePetWithIdempotencyKey(context.Background(), "key-1", "hash-a", &entity.Pet{UuidGuardian: uuid.New(), Name: "Rex", Breed: "SRD"}); errs != nil {
+		t.Fatalf("unexpected errors on first call: %v", errs)
+	}
+
+	got, errs := app.SavePetWithIdempotencyKey(context.Background(), "key-1", "hash-b", &entity.Pet{UuidGuardian: uuid.New(), Name: "Thor", Breed: "SRD"})
+	if got != nil {
+		t.Fatalf("expected no pet on key reuse, got %v", got)
+	}
+	if _, ok := errs["conflict"]; !ok {
+		t.Fatalf("expected conflict error, got %v", errs)
+	}
+}
+
+func TestSavePetWithIdempotencyKey_ExpiredKeyCreatesAgain(t *testing.T) {
+	idem := newMockIdempotencyRepository()
+	idem.keys["key-1"] = &entity.IdempotencyKey{Key: "key-1", RequestHash: "old", ExpiresAt: time.Now().Add(-time.Minute)}
+	app := NewPetApplication(idem.petRepository(), WithIdempotency(idem, time.Hour))
+
+	got, errs := app.SavePetWithIdempotencyKey(context.Background(), "key-1", "new", &entity.Pet{UuidGuardian: uuid.New(), Name: "Rex", Breed: "SRD"})
+	if errs != nil || got == nil {
+		t.Fatalf("expected a new pet for an expired key, got pet=%v errs=%v", got, errs)
+	}
+	if idem.saveCalls != 1 {
+		t.Fatalf("expired key should create a new pet, save calls=%d", idem.saveCalls)
+	}
+}
+
+func TestSavePetWithIdempotencyKey_KeysAreScopedToTenant(t *testing.T) {
+	idem := newMockIdempotencyRepository()
+	app := NewPetApplication(idem.petRepository(), WithIdempotency(idem, time.Hour))
+
+	shelter := ContextWithTenant(context.Background(), "shelter")
+	if _, errs := app.SavePetWithIdempotencyKey(shelter, "key-1", "hash", &entity.Pet{UuidGuardian: uuid.New(), Name: "Rex", Breed: "SRD"}); errs != nil {
+		t.Fatalf("unexpected errors on first call: %v", errs)
+	}
+	if got := idem.keys["key-1"].TenantID; got != "shelter" {
+		t.Fatalf("key should be stored in the caller's tenant, got %q", got)
+	}
+
+	clinic := ContextWithTenant(context.Background(), "clinic")
+	got, errs := app.SavePetWithIdempotencyKey(clinic, "key-1", "hash", &entity.Pet{UuidGuardian: uuid.New(), Name: "Thor", Breed: "SRD"})
+	if errs != nil {
+		t.Fatalf("unexpected errors for another tenant: %v", errs)
+	}
+	if got.Name != "Thor" || idem.saveCalls != 2 {
+		t.Fatalf("another tenant must not replay the shelter's pet, got %v after %d saves", got, idem.saveCalls)
+	}
+}
+
+func TestSavePetWithIdempotencyKey_LostRaceReplaysWinner(t *testing.T) {
+	idem := newMockIdempotencyRepository()
+	winner, _ := json.Marshal(&entity.Pet{Name: "Winner"})
+	// simula outra requisição gravando a mesma chave antes da nossa transação
+	idem.onSave = func(key *entity.IdempotencyKey) map[string]string {
+		idem.keys[key.Key] = &entity.IdempotencyKey{Key: key.Key, RequestHash: "hash", Response: string(winner), ExpiresAt: time.Now().Add(time.Hour)}
+		return map[string]string{"db_error": "duplicate key"}
+	}
+	app := NewPetApplication(idem.petRepository(), WithIdempotency(idem, time.Hour))
+
+	got, errs := app.SavePetWithIdempotencyKey(context.Background(), "key-1", "hash", &entity.Pet{UuidGuardian: uuid.New(), Name: "Loser", Breed: "SRD"})
+	if errs != nil {
+		t.Fatalf("expected replay of the winner, got errs=%v", errs)
+	}
+	if got.Name != "Winner" {
+		t.Fatalf("expected the winner's pet, got %v", got)
+	}
+}
+
+func TestSavePetWithIdempotencyKey_UnsupportedStorageIsUnavailable(t *testing.T) {
+	repo := &mockPetRepository{}
+	app := NewPetApplication(repo, WithIdempotency(newMockIdempotencyRepository(), time.Hour))
+
+	got, errs := app.SavePetWithIdempotencyKey(context.Background(), "key-1", "hash", &entity.Pet{UuidGuardian: uuid.New(), Name: "Rex", Breed: "SRD"})
+	if got != nil || errs["unavailable"] == "" {
+		t.Fatalf("expected unavailable when the pet repository cannot store keys, got pet=%v errs=%v", got, errs)
+	}
+	if repo.saveCalledWith != nil {
+		t.Fatalf("the pet must not be created without its idempotency key")
+	}
+
+	app = NewPetApplication(newMockIdempotencyRepository().petRepository())
+	if _, errs := app.SavePetWithIdempotencyKey(context.Background(), "key-1", "hash", &entity.Pet{UuidGuardian: uuid.New(), Name: "Rex", Breed: "SRD"}); errs["unavailable"] == "" {
+		t.Fatalf("expected unavailable without an idempotency repository, got %v", errs)
+	}
+}
+
+func TestUpdatePetFields_MergesAndValidatesResult(t *testing.T) {
+	current := &entity.Pet{Uuid: uuid.New(), UuidGuardian: uuid.New(), Name: "Rex", BirthYear: 2020, Breed: "SRD", Color: "Preto"}
+	var gotFields []string
+	repo := &mockPetRepository{
+		getFunc: func(id string) (*entity.Pet, map[string]string) {
+			copied := *current
+			return &copied, nil
+		},
+		updateFieldsFunc: func(p *entity.Pet, fields []string) (*entity.Pet, map[string]string) {
+			gotFields = fields
+			return p, nil
+		},
+	}
+	app := NewPetApplication(repo)
+
+	changes := &entity.Pet{Uuid: current.Uuid, Name: "ignored", WeightHistory: entity.WeightHistory{{Grams: 9000}}}
+	updated, errData := app.UpdatePetFields(context.Background(), changes, []string{"weight_history"})
+	if errData != nil {
+		t.Fatalf("unexpected error: %v", errData)
+	}
+	if updated.Name != "Rex" || updated.Color != "Preto" || updated.WeightHistory.Latest().Grams != 9000 {
+		t.Fatalf("expected only the weight to change, got %+v", updated)
+	}
+	if updated.WeightHistory[0].MeasuredAt.IsZero() {
+		t.Fatal("expected measurement date to be filled")
+	}
+	if len(gotFields) != 1 || gotFields[0] != "weight_history" {
+		t.Fatalf("expected only weight_history to be written, got %v", gotFields)
+	}
+
+	changes.MicrochipNumber = "12"
+	if _, errData := app.UpdatePetFields(context.Background(), changes, []string{"microchip_number"}); errData["invalid_argument"] == "" {
+		t.Fatalf("expected invalid_argument, got %v", errData)
+	}
+	if _, errData := app.UpdatePetFields(context.Background(), changes, nil); errData["invalid_argument"] == "" {
+		t.Fatalf("expected invalid_argument for empty mask, got %v", errData)
+	}
+}
@@ -0,0 +1,201 @@
+package application
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/LuizFJP/pet-ms/domain/repository"
+	"github.com/google/uuid"
+)
+
+// WithAttachments habilita os anexos dos pets, com o conteúdo guardado em blobs.
+func WithAttachments(repo repository.AttachmentRepository, blobs repository.BlobStore) Option {
+	return func(p *petApplication) {
+		p.attachments = repo
+		p.blobs = blobs
+	}
+}
+
+func (p *petApplication) attachmentsUnavailable() map[string]string {
+	if p.attachments == nil || p.blobs == nil {
+		return map[string]string{"unavailable": "attachments are not enabled"}
+	}
+	return nil
+}
+
+// UploadAttachment grava o conteúdo e depois os metadados. O tipo declarado é conferido
+// com os primeiros bytes e o tamanho precisa bater exatamente com SizeBytes.
+func (p *petApplication) UploadAttachment(ctx context.Context, attachment *entity.Attachment, content io.Reader) (*entity.Attachment, map[string]string) {
+	if errData := p.attachmentsUnavailable(); errData != nil {
+		return nil, errData
+	}
+	if errs := attachment.Validate(); len(errs) > 0 {
+		return nil, invalidArgument(errs)
+	}
+	if errData := p.requirePet(ctx, attachment.PetUuid); errData != nil {
+		return nil, errData
+	}
+
+	buffered := bufio.NewReader(content)
+	head, err := buffered.Peek(512)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, bufio.ErrBufferFull) {
+		return nil, map[string]string{"aborted": "upload interrupted: " + err.Error()}
+	}
+	if sniffed := http.DetectContentType(head); sniffed != attachment.ContentType {
+		return nil, map[string]string{"invalid_argument": "content does not look like " + attachment.ContentType}
+	}
+
+	attachment.Uuid = uuid.New()
+	attachment.StorageKey = entity.AttachmentStorageKey(attachment.PetUuid, attachment.Uuid)
+
+	hash := sha256.New()
+	counted := &countingReader{r: io.TeeReader(buffered, hash)}
+	if err := p.blobs.Put(ctx, attachment.StorageKey, counted, attachment.SizeBytes, attachment.ContentType); err != nil {
+		p.discardBlob(attachment.StorageKey)
+		if counted.n < attachment.SizeBytes && counted.err != nil {
+			if errors.Is(counted.err, io.EOF) {
+				return nil, map[string]string{"invalid_argument": "content is smaller than size_bytes"}
+			}
+			return nil, map[string]string{"aborted": "upload interrupted: " + counted.err.Error()}
+		}
+		return nil, map[string]string{"unavailable": "blob store: " + err.Error()}
+	}
+	if extra, _ := buffered.Read(make([]byte, 1)); extra > 0 {
+		p.discardBlob(attachment.StorageKey)
+		return nil, map[string]string{"invalid_argument": "content is larger than size_bytes"}
+	}
+	attachment.Checksum = hex.EncodeToString(hash.Sum(nil))
+
+	saved, errData := p.attachments.CreateAttachment(attachment)
+	if errData != nil {
+		p.discardBlob(attachment.StorageKey)
+		return nil, errData
+	}
+	return saved, nil
+}
+
+// countingReader guarda quantos bytes foram lidos e o erro de leitura, para distinguir
+// um cliente que mandou menos bytes de uma falha do armazenamento.
+type countingReader struct {
+	r   io.Reader
+	n   int64
+	err error
+}
+
+func (c *countingReader) Read(b []byte) (int, error) {
+	n, err := c.r.Read(b)
+	c.n += int64(n)
+	if err != nil {
+		c.err = err
+	}
+	return n, err
+}
+
+func (p *petApplication) discardBlob(key string) {
+	if err := p.blobs.Delete(context.Background(), key); err != nil {
+		log.Printf("failed to delete blob %s: %v", key, err)
+	}
+}
+
+// OpenAttachment devolve os metadados e o conteúdo; quem chama fecha o reader.
+func (p *petApplication) OpenAttachment(ctx context.Context, attachmentUuid string) (*entity.Attachment, io.ReadCloser, map[string]string) {
+	if errData := p.attachmentsUnavailable(); errData != nil {
+		return nil, nil, errData
+	}
+	id, err := uuid.Parse(attachmentUuid)
+	if err != nil {
+		return nil, nil, map[string]string{"invalid_argument": "uuid must be a valid uuid"}
+	}
+	attachment, errData := p.attachments.GetAttachment(id)
+	if errData != nil {
+		return nil, nil, errData
+	}
+	if !p.tenantOwns(ctx, attachment.PetUuid) {
+		return nil, nil, map[string]string{"not_found": "attachment not found"}
+	}
+
+	content, err := p.blobs.Get(ctx, attachment.StorageKey)
+	if errors.Is(err, repository.ErrBlobNotFound) {
+		return nil, nil, map[string]string{"not_found": "attachment content not found"}
+	}
+	if err != nil {
+		return nil, nil, map[string]string{"unavailable": "blob store: " + err.Error()}
+	}
+	return attachment, content, nil
+}
+
+func (p *petApplication) ListAttachments(ctx context.Context, petUuid string) ([]*entity.Attachment, map[string]string) {
+	if errData := p.attachmentsUnavailable(); errData != nil {
+		return nil, errData
+	}
+	id, errData := parsePetUuid(petUuid)
+	if errData != nil {
+		return nil, errData
+	}
+	if errData := p.requirePet(ctx, id); errData != nil {
+		return nil, errData
+	}
+	return p.attachments.ListAttachments(id)
+}
+
+// DeleteAttachment apaga o conteúdo antes dos metadados: se o blob falhar, o registro
+// continua lá e a remoção pode ser repetida.
+func (p *petApplication) DeleteAttachment(ctx context.Context, attachmentUuid string) map[string]string {
+	if errData := p.attachmentsUnavailable(); errData != nil {
+		return errData
+	}
+	id, err := uuid.Parse(attachmentUuid)
+	if err != nil {
+		return map[string]string{"invalid_argument": "uuid must be a valid uuid"}
+	}
+	attachment, errData := p.attachments.GetAttachment(id)
+	if errData != nil {
+		return errData
+	}
+	if !p.tenantOwns(ctx, attachment.PetUuid) {
+		return map[string]string{"not_found": "attachment not found"}
+	}
+	return p.removeAttachment(ctx, attachment)
+}
+
+func (p *petApplication) removeAttachment(ctx context.Context, attachment *entity.Attachment) map[string]string {
+	if err := p.blobs.Delete(ctx, attachment.StorageKey); err != nil {
+		return map[string]string{"unavailable": "blob store: " + err.Error()}
+	}
+	return p.attachments.DeleteAttachment(attachment.Uuid)
+}
+
+// petAttachments lista os anexos dos pets antes da remoção, que apaga os metadados
+// junto com os pets; as chaves ficam guardadas para remover os blobs depois.
+func (p *petApplication) petAttachments(pets []*entity.Pet) []*entity.Attachment {
+	if p.attachments == nil || p.blobs == nil {
+		return nil
+	}
+	var attachments []*entity.Attachment
+	for _, pet := range pets {
+		list, errData := p.attachments.ListAttachments(pet.Uuid)
+		if errData != nil {
+			log.Printf("failed to list attachments of pet %s: %v", pet.Uuid, errData)
+			continue
+		}
+		attachments = append(attachments, list...)
+	}
+	return attachments
+}
+
+// removeAttachmentBlobs apaga o conteúdo dos anexos de pets já removidos. Os metadados
+// saíram na transação da remoção, então falhas aqui só são registradas no log.
+func (p *petApplication) removeAttachmentBlobs(ctx context.Context, attachments []*entity.Attachment) {
+	for _, attachment := range attachments {
+		if err := p.blobs.Delete(ctx, attachment.StorageKey); err != nil {
+			log.Printf("failed to delete blob %s: %v", attachment.StorageKey, err)
+		}
+	}
+}
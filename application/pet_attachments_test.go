@@ -0,0 +1,199 @@
+package application
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/LuizFJP/pet-ms/domain/repository"
+	"github.com/google/uuid"
+)
+
+type attachmentRepoMock struct {
+	saved map[uuid.UUID]*entity.Attachment
+}
+
+func (m *attachmentRepoMock) CreateAttachment(a *entity.Attachment) (*entity.Attachment, map[string]string) {
+	m.saved[a.Uuid] = a
+	return a, nil
+}
+
+func (m *attachmentRepoMock) GetAttachment(id uuid.UUID) (*entity.Attachment, map[string]string) {
+	if a, ok := m.saved[id]; ok {
+		return a, nil
+	}
+	return nil, map[string]string{"not_found": "attachment not found"}
+}
+
+func (m *attachmentRepoMock) ListAttachments(petUuid uuid.UUID) ([]*entity.Attachment, map[string]string) {
+	var list []*entity.Attachment
+	for _, a := range m.saved {
+		if a.PetUuid == petUuid {
+			list = append(list, a)
+		}
+	}
+	return list, nil
+}
+
+func (m *attachmentRepoMock) DeleteAttachment(id uuid.UUID) map[string]string {
+	delete(m.saved, id)
+	return nil
+}
+
+type blobStoreMock struct {
+	blobs map[string][]byte
+}
+
+func (m *blobStoreMock) Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error {
+	data, err := io.ReadAll(io.LimitReader(content, size))
+	if err != nil {
+		return err
+	}
+	if int64(len(data)) < size {
+		return io.ErrUnexpectedEOF
+	}
+	m.blobs[key] = data
+	return nil
+}
+
+func (m *blobStoreMock) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	data, ok := m.blobs[key]
+	if !ok {
+		return nil, repository.ErrBlobNotFound
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (m *blobStoreMock) Delete(ctx context.Context, key string) error {
+	delete(m.blobs, key)
+	return nil
+}
+
+var pngContent = append([]byte("\x89PNG\r\n\x1a\n"), bytes.Repeat([]byte{0}, 100)...)
+
+func newAttachmentApp(known ...uuid.UUID) (*petApplication, *attachmentRepoMock, *blobStoreMock) {
+	repo := &mockPetRepository{
+		getPetsFunc: func(uuids []string) ([]*entity.Pet, map[string]string) {
+			var pets []*entity.Pet
+			for _, id := range known {
+				if id.String() == uuids[0] {
+					pets = append(pets, &entity.Pet{Uuid: id})
+				}
+			}
+			return pets, nil
+		},
+	}
+	ar := &attachmentRepoMock{saved: map[uuid.UUID]*entity.Attachment{}}
+	blobs := &blobStoreMock{blobs: map[string][]byte{}}
+	app := NewPetApplication(repo, WithAttachments(ar, blobs)).(*petApplication)
+	return app, ar, blobs
+}
+
+func newPhoto(petUuid uuid.UUID, size int) *entity.Attachment {
+	return &entity.Attachment{PetUuid: petUuid, Kind: entity.AttachmentPhoto, FileName: "../rex.png", ContentType: "image/png", SizeBytes: int64(size)}
+}
+
+func TestUploadAttachment_StoresContentAndChecksum(t *testing.T) {
+	petUuid := uuid.New()
+	app, ar, blobs := newAttachmentApp(petUuid)
+
+	saved, errData := app.UploadAttachment(context.Background(), newPhoto(petUuid, len(pngContent)), bytes.NewReader(pngContent))
+	if errData != nil {
+		t.Fatalf("unexpected error: %v", errData)
+	}
+	if saved.FileName != "rex.png" || len(saved.Checksum) != 64 {
+		t.Fatalf("expected sanitized name and sha256 checksum, got %+v", saved)
+	}
+	if !bytes.Equal(blobs.blobs[saved.StorageKey], pngContent) || ar.saved[saved.Uuid] == nil {
+		t.Fatal("expected content and metadata to be stored")
+	}
+
+	attachment, content, errData := app.OpenAttachment(context.Background(), saved.Uuid.String())
+	if errData != nil {
+		t.Fatalf("unexpected error: %v", errData)
+	}
+	defer content.Close()
+	got, _ := io.ReadAll(content)
+	if attachment.Uuid != saved.Uuid || !bytes.Equal(got, pngContent) {
+		t.Fatal("expected the uploaded content back")
+	}
+}
+
+func TestUploadAttachment_RejectsMismatchedContent(t *testing.T) {
+	petUuid := uuid.New()
+	app, ar, blobs := newAttachmentApp(petUuid)
+
+	pdf := []byte("%PDF-1.4\n")
+	if _, errData := app.UploadAttachment(context.Background(), newPhoto(petUuid, len(pdf)), bytes.NewReader(pdf)); errData["invalid_argument"] == "" {
+		t.Fatalf("expected invalid_argument for sniffed type, got %v", errData)
+	}
+
+	if _, errData := app.UploadAttachment(context.Background(), newPhoto(petUuid, len(pngContent)+1), bytes.NewReader(pngContent)); !strings.Contains(errData["invalid_argument"], "smaller") {
+		t.Fatalf("expected invalid_argument for short content, got %v", errData)
+	}
+	if _, errData := app.UploadAttachment(context.Background(), newPhoto(petUuid, len(pngContent)-1), bytes.NewReader(pngContent)); !strings.Contains(errData["invalid_argument"], "larger") {
+		t.Fatalf("expected invalid_argument for extra content, got %v", errData)
+	}
+	if len(blobs.blobs) != 0 || len(ar.saved) != 0 {
+		t.Fatalf("failed uploads must not leave blobs or metadata, got %d / %d", len(blobs.blobs), len(ar.saved))
+	}
+
+	if _, errData := app.UploadAttachment(context.Background(), newPhoto(uuid.New(), len(pngContent)), bytes.NewReader(pngContent)); errData["not_found"] == "" {
+		t.Fatalf("expected not_found for unknown pet, got %v", errData)
+	}
+}
+
+func TestDeletePet_RemovesAttachments(t *testing.T) {
+	petUuid := uuid.New()
+	app, ar, blobs := newAttachmentApp(petUuid)
+	saved, _ := app.UploadAttachment(context.Background(), newPhoto(petUuid, len(pngContent)), bytes.NewReader(pngContent))
+
+	repo := app.pr.(*mockPetRepository)
+	repo.listPetsFunc = func(filter entity.PetFilter, afterUuid string, limit int) ([]*entity.Pet, map[string]string) {
+		return []*entity.Pet{{Uuid: petUuid}}, nil
+	}
+	// o repositório apaga os metadados na transação da remoção do pet
+	repo.deleteFunc = func(id string) (map[string]string, map[string]string) {
+		delete(ar.saved, saved.Uuid)
+		return map[string]string{"message": "1 pet(s) deletados!"}, nil
+	}
+	if _, errData := app.DeletePet(context.Background(), uuid.New().String()); errData != nil {
+		t.Fatalf("unexpected error: %v", errData)
+	}
+	if len(blobs.blobs) != 0 {
+		t.Fatal("expected blobs of deleted pets to be removed")
+	}
+}
+
+func TestDeletePet_KeepsAttachmentsWhenDeleteFails(t *testing.T) {
+	petUuid := uuid.New()
+	app, ar, blobs := newAttachmentApp(petUuid)
+	saved, _ := app.UploadAttachment(context.Background(), newPhoto(petUuid, len(pngContent)), bytes.NewReader(pngContent))
+
+	repo := app.pr.(*mockPetRepository)
+	repo.listPetsFunc = func(filter entity.PetFilter, afterUuid string, limit int) ([]*entity.Pet, map[string]string) {
+		return []*entity.Pet{{Uuid: petUuid}}, nil
+	}
+	repo.deleteFunc = func(id string) (map[string]string, map[string]string) {
+		return nil, map[string]string{"db_error": "boom"}
+	}
+	if _, errData := app.DeletePet(context.Background(), uuid.New().String()); errData["db_error"] == "" {
+		t.Fatalf("expected db_error, got %v", errData)
+	}
+	if len(blobs.blobs) != 1 || ar.saved[saved.Uuid] == nil {
+		t.Fatal("a failed delete must keep the attachments and their blobs")
+	}
+}
+
+func TestAttachments_Disabled(t *testing.T) {
+	app := NewPetApplication(&mockPetRepository{})
+	if _, errData := app.ListAttachments(context.Background(), uuid.New().String()); errData["unavailable"] == "" {
+		t.Fatalf("expected unavailable, got %v", errData)
+	}
+	if errData := app.DeleteAttachment(context.Background(), uuid.New().String()); errData["unavailable"] == "" {
+		t.Fatalf("expected unavailable, got %v", errData)
+	}
+}
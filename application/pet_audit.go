@@ -0,0 +1,137 @@
+package application
+
+import (
+	"context"
+	"log"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/LuizFJP/pet-ms/domain/repository"
+	"github.com/google/uuid"
+)
+
+const (
+	DefaultAuditPageSize = 100
+	MaxAuditPageSize     = 1000
+)
+
+// Actor identifica quem fez a requisição; vai para cada entrada de auditoria.
+type Actor struct {
+	Principal string
+	RequestID string
+}
+
+type actorKey struct{}
+
+// ContextWithActor anexa o autor da requisição ao contexto.
+func ContextWithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+func ActorFromContext(ctx context.Context) Actor {
+	actor, _ := ctx.Value(actorKey{}).(Actor)
+	return actor
+}
+
+// WithAudit faz cada escrita bem-sucedida gerar entradas no log de auditoria.
+func WithAudit(ar repository.AuditRepository) Option {
+	return func(p *petApplication) {
+		p.ar = ar
+	}
+}
+
+// petChange é o estado de um pet antes e depois de uma escrita.
+type petChange struct {
+	before *entity.Pet
+	after  *entity.Pet
+}
+
+// recordAudit grava uma entrada por pet alterado. A escrita já foi confirmada,
+// então uma falha aqui é só registrada no log em vez de devolvida ao cliente.
+func (p *petApplication) recordAudit(ctx context.Context, action entity.AuditAction, changes ...petChange) {
+	if p.ar == nil || len(changes) == 0 {
+		return
+	}
+
+	actor := ActorFromContext(ctx)
+	now := p.now()
+	entries := make([]*entity.AuditEntry, 0, len(changes))
+	for _, change := range changes {
+		current := change.after
+		if current == nil {
+			current = change.before
+		}
+		if current == nil {
+			continue
+		}
+
+		entry := &entity.AuditEntry{
+			PetUuid:      current.Uuid,
+			UuidGuardian: current.UuidGuardian,
+			TenantID:     current.TenantID,
+			Action:       action,
+			Principal:    actor.Principal,
+			RequestID:    actor.RequestID,
+			OccurredAt:   now,
+		}
+		if change.before != nil && (action == entity.AuditTransfer || change.before.UuidGuardian != current.UuidGuardian) {
+			entry.PreviousGuardian = change.before.UuidGuardian
+		}
+		if err := entry.SetChanges(entity.DiffPets(change.before, change.after)); err != nil {
+			log.Printf("audit: failed to encode changes for pet %s: %v", current.Uuid, err)
+			continue
+		}
+		entries = append(entries, entry)
+	}
+
+	if len(entries) == 0 {
+		return
+	}
+	if errData := p.ar.AppendAuditEntries(entries); errData != nil {
+		log.Printf("audit: failed to record %s by %q (request %q): %v", action, actor.Principal, actor.RequestID, errData)
+	}
+}
+
+func createdChanges(pets ...*entity.Pet) []petChange {
+	changes := make([]petChange, 0, len(pets))
+	for _, pet := range pets {
+		if pet != nil {
+			changes = append(changes, petChange{after: pet})
+		}
+	}
+	return changes
+}
+
+// GetPetAuditLog devolve o histórico de um pet em ordem cronológica, paginado por id.
+func (p *petApplication) GetPetAuditLog(ctx context.Context, petUuid string, afterID uint, pageSize int) ([]*entity.AuditEntry, map[string]string) {
+	if p.ar == nil {
+		return nil, map[string]string{"unavailable": "audit log is not enabled"}
+	}
+	id, err := uuid.Parse(petUuid)
+	if err != nil {
+		return nil, map[string]string{"invalid_argument": "uuid must be a valid uuid"}
+	}
+	return p.ar.ListAuditEntriesByPet(TenantFromContext(ctx), id, afterID, auditPageSize(pageSize))
+}
+
+// ListGuardianAuditLog devolve as alterações em todos os pets do guardião, incluindo
+// os que ele transferiu para outra pessoa.
+func (p *petApplication) ListGuardianAuditLog(ctx context.Context, uuidGuardian string, afterID uint, pageSize int) ([]*entity.AuditEntry, map[string]string) {
+	if p.ar == nil {
+		return nil, map[string]string{"unavailable": "audit log is not enabled"}
+	}
+	id, err := uuid.Parse(uuidGuardian)
+	if err != nil {
+		return nil, map[string]string{"invalid_argument": "uuid_guardian must be a valid uuid"}
+	}
+	return p.ar.ListAuditEntriesByGuardian(TenantFromContext(ctx), id, afterID, auditPageSize(pageSize))
+}
+
+func auditPageSize(pageSize int) int {
+	if pageSize <= 0 {
+		return DefaultAuditPageSize
+	}
+	if pageSize > MaxAuditPageSize {
+		return MaxAuditPageSize
+	}
+	return pageSize
+}
@@ -0,0 +1,130 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+)
+
+// auditRepoMock keeps appended entries in memory
+type auditRepoMock struct {
+	entries []*entity.AuditEntry
+}
+
+func (r *auditRepoMock) AppendAuditEntries(entries []*entity.AuditEntry) map[string]string {
+	r.entries = append(r.entries, entries...)
+	return nil
+}
+
+func (r *auditRepoMock) ListAuditEntriesByPet(tenant string, petUuid uuid.UUID, afterID uint, limit int) ([]*entity.AuditEntry, map[string]string) {
+	var found []*entity.AuditEntry
+	for _, entry := range r.entries {
+		if entry.PetUuid == petUuid && len(found) < limit {
+			found = append(found, entry)
+		}
+	}
+	return found, nil
+}
+
+func (r *auditRepoMock) ListAuditEntriesByGuardian(tenant string, uuidGuardian uuid.UUID, afterID uint, limit int) ([]*entity.AuditEntry, map[string]string) {
+	return nil, nil
+}
+
+func TestPetApplication_Audit_RecordsActorAndDiff(t *testing.T) {
+	audit := &auditRepoMock{}
+	stored := validBatchPet("Rex")
+	stored.Uuid = uuid.New()
+	stored.Breed = "Labrador"
+	repo := &mockPetRepository{
+		getFunc: func(id string) (*entity.Pet, map[string]string) {
+			before := *stored
+			return &before, nil
+		},
+	}
+	app := NewPetApplication(repo, WithAudit(audit))
+	ctx := ContextWithActor(context.Background(), Actor{Principal: "alice", RequestID: "req-1"})
+
+	updated := *stored
+	updated.Breed = "Golden"
+	if _, errData := app.UpdatePet(ctx, &updated); errData != nil {
+		t.Fatalf("unexpected error: %v", errData)
+	}
+
+	if len(audit.entries) != 1 {
+		t.Fatalf("expected 1 audit entry, got %d", len(audit.entries))
+	}
+	entry := audit.entries[0]
+	if entry.Action != entity.AuditUpdate || entry.Principal != "alice" || entry.RequestID != "req-1" {
+		t.Fatalf("unexpected entry: %+v", entry)
+	}
+	changes, err := entry.FieldChanges()
+	if err != nil || len(changes) != 1 || changes[0].Field != "breed" || changes[0].Before != "Labrador" || changes[0].After != "Golden" {
+		t.Fatalf("unexpected changes: %+v, %v", changes, err)
+	}
+}
+
+func TestPetApplication_Audit_TransferKeepsPreviousGuardian(t *testing.T) {
+	audit := &auditRepoMock{}
+	previous := uuid.New()
+	newGuardian := uuid.New()
+	petUuid := uuid.New()
+	repo := &mockPetRepository{
+		getFunc: func(id string) (*entity.Pet, map[string]string) {
+			return &entity.Pet{Uuid: petUuid, UuidGuardian: previous}, nil
+		},
+		transferFunc: func(id, guardian string) (*entity.Pet, map[string]string) {
+			return &entity.Pet{Uuid: petUuid, UuidGuardian: uuid.MustParse(guardian)}, nil
+		},
+	}
+	app := NewPetApplication(repo, WithAudit(audit))
+
+	if _, errData := app.TransferPet(context.Background(), petUuid.String(), newGuardian.String()); errData != nil {
+		t.Fatalf("unexpected error: %v", errData)
+	}
+	if len(audit.entries) != 1 {
+		t.Fatalf("expected 1 audit entry, got %d", len(audit.entries))
+	}
+	entry := audit.entries[0]
+	if entry.Action != entity.AuditTransfer || entry.PreviousGuardian != previous || entry.UuidGuardian != newGuardian {
+		t.Fatalf("unexpected entry: %+v", entry)
+	}
+}
+
+func TestPetApplication_Audit_SkipsFailedWrites(t *testing.T) {
+	audit := &auditRepoMock{}
+	repo := &mockPetRepository{
+		saveFunc: func(p *entity.Pet) (*entity.Pet, map[string]string) {
+			return nil, map[string]string{"db_error": "boom"}
+		},
+	}
+	app := NewPetApplication(repo, WithAudit(audit))
+
+	_, _ = app.SavePet(context.Background(), validBatchPet("Rex"))
+	_, _, _ = app.BatchSavePets(context.Background(), []*entity.Pet{validBatchPet("")}, BatchPerItem)
+
+	if len(audit.entries) != 0 {
+		t.Fatalf("failed writes must not be audited, got %+v", audit.entries)
+	}
+}
+
+func TestPetApplication_GetPetAuditLog(t *testing.T) {
+	app := NewPetApplication(&mockPetRepository{})
+	if _, errData := app.GetPetAuditLog(context.Background(), uuid.New().String(), 0, 0); errData["unavailable"] == "" {
+		t.Fatalf("expected unavailable without audit repository, got %v", errData)
+	}
+
+	audit := &auditRepoMock{}
+	app = NewPetApplication(&mockPetRepository{}, WithAudit(audit))
+	_, _ = app.SavePet(context.Background(), validBatchPet("Rex"))
+
+	if _, errData := app.GetPetAuditLog(context.Background(), "nope", 0, 0); errData["invalid_argument"] == "" {
+		t.Fatalf("expected invalid_argument, got %v", errData)
+	}
+	entries, errData := app.GetPetAuditLog(context.Background(), audit.entries[0].PetUuid.String(), 0, 0)
+	if errData != nil || len(entries) != 1 || entries[0].Action != entity.AuditCreate {
+		t.Fatalf("unexpected result: %+v, %v", entries, errData)
+	}
+}
@@ -0,0 +1,220 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+)
+
+const MaxBatchSize = 1000
+
+type BatchMode int
+
+const (
+	BatchAllOrNothing BatchMode = iota
+	BatchPerItem
+)
+
+// BatchItemResult é o resultado de um item do lote, na mesma posição da entrada.
+type BatchItemResult struct {
+	Pet    *entity.Pet
+	Errors map[string]string
+}
+
+// BatchSavePets valida e grava os pets numa única transação. O bool indica se algum
+// item foi efetivamente gravado; o mapa de erros só é usado para falhas do lote inteiro.
+func (p *petApplication) BatchSavePets(ctx context.Context, pets []*entity.Pet, mode BatchMode) ([]BatchItemResult, bool, map[string]string) {
+	results, committed, errData := p.runBatch(ctx, pets, mode, "create", nil, p.writer(ctx).SavePets)
+	p.publishBatch(entity.PetCreated, results)
+
+	saved := make([]*entity.Pet, 0, len(results))
+	for _, result := range results {
+		saved = append(saved, result.Pet)
+	}
+	p.recordAudit(ctx, entity.AuditCreate, createdChanges(saved...)...)
+	return results, committed, errData
+}
+
+func (p *petApplication) BatchUpdatePets(ctx context.Context, pets []*entity.Pet, mode BatchMode) ([]BatchItemResult, bool, map[string]string) {
+	before := p.currentPets(ctx, pets)
+	results, committed, errData := p.runBatch(ctx, pets, mode, "update", before, p.writer(ctx).UpdatePets)
+	p.publishBatch(entity.PetUpdated, results)
+
+	changes := make([]petChange, 0, len(results))
+	for _, result := range results {
+		if result.Pet != nil {
+			changes = append(changes, petChange{before: before[result.Pet.Uuid.String()], after: result.Pet})
+		}
+	}
+	p.recordAudit(ctx, entity.AuditUpdate, changes...)
+	return results, committed, errData
+}
+
+// currentPets carrega o estado anterior dos pets do lote para o diff da auditoria e
+// para saber quais trocam de guardião.
+func (p *petApplication) currentPets(ctx context.Context, pets []*entity.Pet) map[string]*entity.Pet {
+	if p.ar == nil && p.gd == nil || len(pets) == 0 || len(pets) > MaxBatchSize {
+		return nil
+	}
+	uuids := make([]string, 0, len(pets))
+	for _, pet := range pets {
+		uuids = append(uuids, pet.Uuid.String())
+	}
+	found, errData := p.writer(ctx).GetPets(uuids)
+	if errData != nil {
+		return nil
+	}
+	byUuid := make(map[string]*entity.Pet, len(found))
+	for _, pet := range found {
+		byUuid[pet.Uuid.String()] = pet
+	}
+	return byUuid
+}
+
+// BatchGetPets devolve os pets na ordem pedida e a lista de uuids não encontrados.
+func (p *petApplication) BatchGetPets(ctx context.Context, uuids []string) ([]*entity.Pet, []string, map[string]string) {
+	if errData := checkBatchSize(len(uuids)); errData != nil {
+		return nil, nil, errData
+	}
+
+	found, errData := p.reader(ctx).GetPets(uuids)
+	if errData != nil {
+		return nil, nil, errData
+	}
+
+	byUuid := make(map[string]*entity.Pet, len(found))
+	for _, pet := range found {
+		byUuid[pet.Uuid.String()] = pet
+	}
+
+	pets := make([]*entity.Pet, 0, len(found))
+	var notFound []string
+	for _, id := range uuids {
+		if pet, ok := byUuid[id]; ok {
+			pets = append(pets, pet)
+		} else {
+			notFound = append(notFound, id)
+		}
+	}
+	return pets, notFound, nil
+}
+
+func (p *petApplication) runBatch(
+	ctx context.Context,
+	pets []*entity.Pet,
+	mode BatchMode,
+	action string,
+	before map[string]*entity.Pet,
+	persist func([]*entity.Pet, bool) ([]*entity.Pet, []map[string]string),
+) ([]BatchItemResult, bool, map[string]string) {
+	if errData := checkBatchSize(len(pets)); errData != nil {
+		return nil, false, errData
+	}
+
+	results := make([]BatchItemResult, len(pets))
+	valid := make([]*entity.Pet, 0, len(pets))
+	positions := make([]int, 0, len(pets))
+	for i, pet := range pets {
+		if errs := p.validatePet(ctx, pet, action, before[pet.Uuid.String()]); len(errs) > 0 {
+			results[i].Errors = errs
+			continue
+		}
+		valid = append(valid, pet)
+		positions = append(positions, i)
+	}
+
+	allOrNothing := mode == BatchAllOrNothing
+	if allOrNothing && len(valid) < len(pets) {
+		for _, i := range positions {
+			results[i].Errors = map[string]string{"aborted": "batch rolled back"}
+		}
+		return results, false, nil
+	}
+	if len(valid) == 0 {
+		return results, false, nil
+	}
+
+	saved, itemErrs := persist(valid, allOrNothing)
+	committed := false
+	for j, i := range positions {
+		results[i].Pet = saved[j]
+		results[i].Errors = itemErrs[j]
+		if saved[j] != nil {
+			committed = true
+		}
+	}
+	return results, committed, nil
+}
+
+// validatePet completa validateEntity acusando o microchip já ligado a outro pet e o
+// guardião desconhecido.
+// before é o pet atual nas atualizações; sem troca de guardião ele não é conferido de
+// novo.
+func (p *petApplication) validatePet(ctx context.Context, pet *entity.Pet, action string, before *entity.Pet) map[string]string {
+	errs := p.validateEntity(pet, action)
+	if _, invalid := errs["uuid_guardian"]; !invalid && (before == nil || before.UuidGuardian != pet.UuidGuardian) {
+		msg, errData := p.checkGuardian(ctx, pet.UuidGuardian)
+		if errData != nil {
+			msg = errData["unavailable"]
+		}
+		if msg != "" {
+			errs["uuid_guardian"] = msg
+		}
+	}
+	if _, invalid := errs["microchip_number"]; !invalid {
+		if msg := p.microchipConflict(ctx, pet); msg != "" {
+			errs["microchip_number"] = msg
+		}
+	}
+	return errs
+}
+
+// validateEntity junta a validação da entidade para a ação com as checagens de checkPet
+// (checkNewPet nas criações).
+func (p *petApplication) validateEntity(pet *entity.Pet, action string) map[string]string {
+	errs := pet.Validate(action)
+	check := p.checkPet
+	if action == "create" {
+		check = p.checkNewPet
+	}
+	for field, msg := range check(pet) {
+		errs[field] = msg
+	}
+	return errs
+}
+
+// checkPet valida o que também vale nas escritas unitárias: espécie e raça nos
+// catálogos, data de nascimento e campos de perfil.
+func (p *petApplication) checkPet(pet *entity.Pet) map[string]string {
+	errs := pet.ValidateBirth(p.now())
+	for field, msg := range pet.ValidateProfile(p.now()) {
+		errs[field] = msg
+	}
+	if msg := p.unknownSpecies(pet); msg != "" {
+		errs["specie"] = msg
+	}
+	if msg := p.unknownBreed(pet); msg != "" {
+		errs["breed"] = msg
+	}
+	return errs
+}
+
+// checkNewPet é o checkPet das criações, que também fixam o status inicial.
+func (p *petApplication) checkNewPet(pet *entity.Pet) map[string]string {
+	errs := p.checkPet(pet)
+	for field, msg := range pet.ValidateNewStatus() {
+		errs[field] = msg
+	}
+	return errs
+}
+
+func checkBatchSize(n int) map[string]string {
+	if n == 0 {
+		return map[string]string{"invalid_argument": "batch is empty"}
+	}
+	if n > MaxBatchSize {
+		return map[string]string{"invalid_argument": fmt.Sprintf("batch exceeds %d items", MaxBatchSize)}
+	}
+	return nil
+}
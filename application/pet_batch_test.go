@@ -0,0 +1,159 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+)
+
+func validBatchPet(name string) *entity.Pet {
+	return &entity.Pet{Uuid: uuid.New(), UuidGuardian: uuid.New(), Name: name, BirthYear: 2020, Breed: "SRD"}
+}
+
+func TestBatchSavePets_AllOrNothingStopsOnValidationError(t *testing.T) {
+	called := false
+	repo := &mockPetRepository{
+		savePetsFunc: func(pets []*entity.Pet, allOrNothing bool) ([]*entity.Pet, []map[string]string) {
+			called = true
+			return pets, make([]map[string]string, len(pets))
+		},
+	}
+	app := NewPetApplication(repo)
+
+	invalid := validBatchPet("")
+	results, committed, errs := app.BatchSavePets(context.Background(), []*entity.Pet{validBatchPet("Rex"), invalid}, BatchAllOrNothing)
+
+	if errs != nil {
+		t.Fatalf("unexpected batch error: %v", errs)
+	}
+	if called {
+		t.Fatalf("repository must not be called when any item is invalid in all-or-nothing mode")
+	}
+	if committed {
+		t.Fatalf("batch should not be committed")
+	}
+	if _, ok := results[0].Errors["aborted"]; !ok {
+		t.Fatalf("valid item should be reported as aborted, got %v", results[0].Errors)
+	}
+	if _, ok := results[1].Errors["pet name is required"]; !ok {
+		t.Fatalf("invalid item should carry its validation errors, got %v", results[1].Errors)
+	}
+}
+
+func TestBatchSavePets_PerItemPersistsValidItems(t *testing.T) {
+	var persisted []*entity.Pet
+	var gotAllOrNothing bool
+	repo := &mockPetRepository{
+		savePetsFunc: func(pets []*entity.Pet, allOrNothing bool) ([]*entity.Pet, []map[string]string) {
+			persisted, gotAllOrNothing = pets, allOrNothing
+			return pets, make([]map[string]string, len(pets))
+		},
+	}
+	app := NewPetApplication(repo)
+
+	invalid := validBatchPet("Ghost")
+	invalid.UuidGuardian = uuid.Nil
+	results, committed, errs := app.BatchSavePets(context.Background(), []*entity.Pet{invalid, validBatchPet("Rex")}, BatchPerItem)
+
+	if errs != nil {
+		t.Fatalf("unexpected batch error: %v", errs)
+	}
+	if gotAllOrNothing {
+		t.Fatalf("per-item mode must not ask the repository for all-or-nothing")
+	}
+	if len(persisted) != 1 || persisted[0].Name != "Rex" {
+		t.Fatalf("only the valid pet should be persisted, got %v", persisted)
+	}
+	if !committed {
+		t.Fatalf("batch should be committed")
+	}
+	if _, ok := results[0].Errors["uuid_guardian"]; !ok {
+		t.Fatalf("invalid item should report uuid_guardian, got %v", results[0].Errors)
+	}
+	if results[1].Pet == nil || results[1].Errors != nil {
+		t.Fatalf("valid item should be saved in its original position, got %+v", results[1])
+	}
+}
+
+func TestBatchSavePets_RejectsNonInitialStatus(t *testing.T) {
+	var persisted []*entity.Pet
+	repo := &mockPetRepository{
+		savePetsFunc: func(pets []*entity.Pet, allOrNothing bool) ([]*entity.Pet, []map[string]string) {
+			persisted = pets
+			return pets, make([]map[string]string, len(pets))
+		},
+	}
+	app := NewPetApplication(repo)
+
+	adopted := validBatchPet("Rex")
+	adopted.Status = entity.StatusAdopted
+	results, _, errs := app.BatchSavePets(context.Background(), []*entity.Pet{adopted, validBatchPet("Mia")}, BatchPerItem)
+
+	if errs != nil {
+		t.Fatalf("unexpected batch error: %v", errs)
+	}
+	if results[0].Errors["status"] == "" {
+		t.Fatalf("a new pet must not skip the state machine, got %v", results[0].Errors)
+	}
+	if len(persisted) != 1 || persisted[0].Status != entity.StatusIntake {
+		t.Fatalf("only the pet without status should be persisted, in intake, got %v", persisted)
+	}
+}
+
+func TestBatchSavePets_RejectsEmptyAndOversizedBatches(t *testing.T) {
+	app := NewPetApplication(&mockPetRepository{})
+
+	if _, _, errs := app.BatchSavePets(context.Background(), nil, BatchPerItem); errs["invalid_argument"] == "" {
+		t.Fatalf("expected invalid_argument for empty batch, got %v", errs)
+	}
+
+	big := make([]*entity.Pet, MaxBatchSize+1)
+	if _, _, errs := app.BatchSavePets(context.Background(), big, BatchPerItem); errs["invalid_argument"] == "" {
+		t.Fatalf("expected invalid_argument for oversized batch, got %v", errs)
+	}
+}
+
+func TestBatchUpdatePets_ReportsRepositoryItemErrors(t *testing.T) {
+	repo := &mockPetRepository{
+		updatePetsFunc: func(pets []*entity.Pet, allOrNothing bool) ([]*entity.Pet, []map[string]string) {
+			return []*entity.Pet{nil, nil}, []map[string]string{{"not_found": "pet not found"}, {"aborted": "batch rolled back"}}
+		},
+	}
+	app := NewPetApplication(repo)
+
+	results, committed, errs := app.BatchUpdatePets(context.Background(), []*entity.Pet{validBatchPet("A"), validBatchPet("B")}, BatchAllOrNothing)
+	if errs != nil {
+		t.Fatalf("unexpected batch error: %v", errs)
+	}
+	if committed {
+		t.Fatalf("batch should not be committed")
+	}
+	if results[0].Errors["not_found"] == "" || results[1].Errors["aborted"] == "" {
+		t.Fatalf("repository item errors should be propagated, got %+v", results)
+	}
+}
+
+func TestBatchGetPets_KeepsOrderAndReportsMissing(t *testing.T) {
+	a, b := validBatchPet("A"), validBatchPet("B")
+	missing := uuid.New().String()
+	repo := &mockPetRepository{
+		getPetsFunc: func(uuids []string) ([]*entity.Pet, map[string]string) {
+			return []*entity.Pet{a, b}, nil
+		},
+	}
+	app := NewPetApplication(repo)
+
+	pets, notFound, errs := app.BatchGetPets(context.Background(), []string{b.Uuid.String(), missing, a.Uuid.String()})
+	if errs != nil {
+		t.Fatalf("unexpected error: %v", errs)
+	}
+	if len(pets) != 2 || pets[0] != b || pets[1] != a {
+		t.Fatalf("pets should follow the requested order, got %v", pets)
+	}
+	if len(notFound) != 1 || notFound[0] != missing {
+		t.Fatalf("expected %s to be reported as not found, got %v", missing, notFound)
+	}
+}
@@ -0,0 +1,104 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/LuizFJP/pet-ms/domain/event"
+	"github.com/google/uuid"
+)
+
+// WithEventBus faz o petApplication publicar um evento após cada escrita bem-sucedida.
+func WithEventBus(bus event.Bus) Option {
+	return func(p *petApplication) {
+		p.bus = bus
+	}
+}
+
+func (p *petApplication) publish(eventType entity.PetEventType, pets ...*entity.Pet) {
+	if p.bus == nil || len(pets) == 0 {
+		return
+	}
+	events := make([]entity.PetEvent, 0, len(pets))
+	for _, pet := range pets {
+		if pet == nil {
+			continue
+		}
+		events = append(events, entity.PetEvent{Type: eventType, Pet: *pet, OccurredAt: p.now()})
+	}
+	p.bus.Publish(events...)
+}
+
+func (p *petApplication) publishBatch(eventType entity.PetEventType, results []BatchItemResult) {
+	pets := make([]*entity.Pet, 0, len(results))
+	for _, result := range results {
+		pets = append(pets, result.Pet)
+	}
+	p.publish(eventType, pets...)
+}
+
+// petsOfGuardian carrega os pets que serão apagados, para publicar um evento,
+// registrar a auditoria e limpar os anexos e as ligações de cada pet.
+func (p *petApplication) petsOfGuardian(ctx context.Context, uuidGuardian string) []*entity.Pet {
+	if p.bus == nil && p.ar == nil && p.attachments == nil && p.pg == nil {
+		return nil
+	}
+	guardian, err := uuid.Parse(uuidGuardian)
+	if err != nil {
+		return nil
+	}
+
+	// uma escrita de agora força a leitura no primário
+	primary := ContextWithLastWrite(ctx, time.Now())
+	var pets []*entity.Pet
+	_ = p.ExportPets(primary, entity.PetFilter{UuidGuardian: guardian}, 0, func(pet *entity.Pet) error {
+		pets = append(pets, pet)
+		return nil
+	})
+	return pets
+}
+
+// WatchPets envia para send os eventos que casam com o filtro até ctx ser cancelado.
+// afterSequence retoma o fluxo logo após um evento já recebido; zero começa agora.
+func (p *petApplication) WatchPets(ctx context.Context, afterSequence uint64, filter entity.PetFilter, send func(entity.PetEvent) error) map[string]string {
+	if p.bus == nil {
+		return map[string]string{"unavailable": "change feed is not enabled"}
+	}
+
+	// as ligações valem as do momento da inscrição
+	filter, errData := p.resolveGuardianRoles(ctx, filter)
+	if errData != nil {
+		return errData
+	}
+
+	sub, err := p.bus.Subscribe(afterSequence)
+	if errors.Is(err, event.ErrResumeExpired) {
+		return map[string]string{"failed_precondition": "resume token expired, reload the pets and watch again"}
+	}
+	if err != nil {
+		return map[string]string{"message": err.Error()}
+	}
+	defer sub.Close()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case ev, ok := <-sub.Events():
+			if !ok {
+				if sub.Err() != nil {
+					return map[string]string{"aborted": sub.Err().Error()}
+				}
+				return nil
+			}
+			if !filter.Matches(&ev.Pet) || !inTenant(ctx, &ev.Pet) {
+				continue
+			}
+			if err := send(ev); err != nil {
+				return map[string]string{"aborted": err.Error()}
+			}
+		}
+	}
+}
@@ -0,0 +1,222 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/LuizFJP/pet-ms/domain/event"
+	"github.com/LuizFJP/pet-ms/infrastructure/eventbus"
+)
+
+// busMock records published events
+type busMock struct {
+	published []entity.PetEvent
+}
+
+func (b *busMock) Publish(events ...entity.PetEvent) {
+	b.published = append(b.published, events...)
+}
+
+func (b *busMock) Subscribe(afterSequence uint64) (event.Subscription, error) {
+	return nil, errors.New("not implemented")
+}
+
+func TestPetApplication_PublishesAfterSuccessfulWrites(t *testing.T) {
+	bus := &busMock{}
+	app := NewPetApplication(&mockPetRepository{}, WithEventBus(bus))
+
+	pet := validBatchPet("Rex")
+	_, _ = app.SavePet(context.Background(), pet)
+	_, _ = app.UpdatePet(context.Background(), pet)
+	_, _, _ = app.BatchSavePets(context.Background(), []*entity.Pet{validBatchPet("A"), validBatchPet("")}, BatchPerItem)
+
+	if len(bus.published) != 3 {
+		t.Fatalf("expected 3 events, got %d: %+v", len(bus.published), bus.published)
+	}
+	if bus.published[0].Type != entity.PetCreated || bus.published[1].Type != entity.PetUpdated {
+		t.Fatalf("unexpected event types: %v, %v", bus.published[0].Type, bus.published[1].Type)
+	}
+	if bus.published[2].Pet.Name != "A" {
+		t.Fatalf("only the saved batch item should be published, got %v", bus.published[2].Pet.Name)
+	}
+}
+
+func TestPetApplication_DoesNotPublishFailedWrites(t *testing.T) {
+	bus := &busMock{}
+	repo := &mockPetRepository{
+		saveFunc: func(p *entity.Pet) (*entity.Pet, map[string]string) {
+			return nil, map[string]string{"db_error": "boom"}
+		},
+	}
+	app := NewPetApplication(repo, WithEventBus(bus))
+
+	_, _ = app.SavePet(context.Background(), validBatchPet("Rex"))
+
+	if len(bus.published) != 0 {
+		t.Fatalf("failed writes must not publish events, got %+v", bus.published)
+	}
+}
+
+func TestPetApplication_DeletePublishesOneEventPerPet(t *testing.T) {
+	bus := &busMock{}
+	guardian := uuid.New()
+	pets := []*entity.Pet{validBatchPet("A"), validBatchPet("B")}
+	var gotFilter entity.PetFilter
+	repo := &mockPetRepository{
+		listPetsFunc: func(filter entity.PetFilter, afterUuid string, limit int) ([]*entity.Pet, map[string]string) {
+			gotFilter = filter
+			return pets, nil
+		},
+	}
+	app := NewPetApplication(repo, WithEventBus(bus))
+
+	_, errs := app.DeletePet(context.Background(), guardian.String())
+	if errs != nil {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if gotFilter.UuidGuardian != guardian {
+		t.Fatalf("pets should be listed by guardian before deleting, got %v", gotFilter.UuidGuardian)
+	}
+	if len(bus.published) != 2 || bus.published[0].Type != entity.PetDeleted {
+		t.Fatalf("expected 2 deleted events, got %+v", bus.published)
+	}
+}
+
+func TestPetApplication_IdempotentReplayDoesNotPublishAgain(t *testing.T) {
+	bus := &busMock{}
+	idem := newMockIdempotencyRepository()
+	app := NewPetApplication(idem.petRepository(), WithEventBus(bus), WithIdempotency(idem, time.Hour))
+
+	_, _ = app.SavePetWithIdempotencyKey(context.Background(), "k", "h", validBatchPet("Rex"))
+	_, _ = app.SavePetWithIdempotencyKey(context.Background(), "k", "h", validBatchPet("Rex"))
+
+	if len(bus.published) != 1 {
+		t.Fatalf("expected a single created event, got %d", len(bus.published))
+	}
+}
+
+func TestWatchPets_FiltersAndResumes(t *testing.T) {
+	bus := eventbus.NewMemoryBus(100)
+	app := NewPetApplication(&mockPetRepository{}, WithEventBus(bus))
+
+	guardian := uuid.New()
+	mine := validBatchPet("Mine")
+	mine.UuidGuardian = guardian
+	_, _ = app.SavePet(context.Background(), mine)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	received := make(chan entity.PetEvent, 10)
+	done := make(chan map[string]string, 1)
+	go func() {
+		done <- app.WatchPets(ctx, 0, entity.PetFilter{UuidGuardian: guardian}, func(ev entity.PetEvent) error {
+			received <- ev
+			return nil
+		})
+	}()
+
+	// espera a assinatura ser registrada publicando até o evento chegar
+	var first entity.PetEvent
+	deadline := time.After(2 * time.Second)
+	for first.Sequence == 0 {
+		_, _ = app.SavePet(context.Background(), validBatchPet("Other"))
+		_, _ = app.UpdatePet(context.Background(), mine)
+		select {
+		case first = <-received:
+		case <-time.After(10 * time.Millisecond):
+		case <-deadline:
+			t.Fatalf("watch did not receive events")
+		}
+	}
+	if first.Pet.UuidGuardian != guardian || first.Type != entity.PetUpdated {
+		t.Fatalf("unexpected event: %+v", first)
+	}
+
+	cancel()
+	if errs := <-done; errs != nil {
+		t.Fatalf("cancelled watch should end cleanly, got %v", errs)
+	}
+
+	_, _ = app.UpdatePet(context.Background(), mine)
+
+	var resumed []entity.PetEvent
+	ctx2, cancel2 := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		cancel2()
+	}()
+	_ = app.WatchPets(ctx2, first.Sequence, entity.PetFilter{UuidGuardian: guardian}, func(ev entity.PetEvent) error {
+		resumed = append(resumed, ev)
+		return nil
+	})
+	if len(resumed) == 0 || resumed[0].Sequence <= first.Sequence {
+		t.Fatalf("resume should replay the events after the token, got %+v", resumed)
+	}
+	for _, ev := range resumed {
+		if ev.Pet.UuidGuardian != guardian {
+			t.Fatalf("filter must apply to replayed events, got %+v", ev)
+		}
+	}
+}
+
+func TestWatchPets_Errors(t *testing.T) {
+	app := NewPetApplication(&mockPetRepository{})
+	if errs := app.WatchPets(context.Background(), 0, entity.PetFilter{}, nil); errs["unavailable"] == "" {
+		t.Fatalf("expected unavailable without a bus, got %v", errs)
+	}
+
+	app = NewPetApplication(&mockPetRepository{}, WithEventBus(eventbus.NewMemoryBus(10)))
+	if errs := app.WatchPets(context.Background(), 1, entity.PetFilter{}, nil); errs["failed_precondition"] == "" {
+		t.Fatalf("expected failed_precondition for an expired token, got %v", errs)
+	}
+}
+
+func TestPetApplication_TransferPet_PublishesPreviousGuardian(t *testing.T) {
+	bus := &busMock{}
+	previous := uuid.New()
+	newGuardian := uuid.New()
+	petUuid := uuid.New()
+	repo := &mockPetRepository{
+		getFunc: func(id string) (*entity.Pet, map[string]string) {
+			return &entity.Pet{Uuid: petUuid, UuidGuardian: previous}, nil
+		},
+		transferFunc: func(id, guardian string) (*entity.Pet, map[string]string) {
+			return &entity.Pet{Uuid: petUuid, UuidGuardian: uuid.MustParse(guardian)}, nil
+		},
+	}
+	app := NewPetApplication(repo, WithEventBus(bus))
+
+	pet, errData := app.TransferPet(context.Background(), petUuid.String(), newGuardian.String())
+	if errData != nil {
+		t.Fatalf("unexpected error: %v", errData)
+	}
+	if pet.UuidGuardian != newGuardian {
+		t.Fatalf("expected guardian %v, got %v", newGuardian, pet.UuidGuardian)
+	}
+	if len(bus.published) != 1 || bus.published[0].Type != entity.PetTransferred {
+		t.Fatalf("expected one transferred event, got %+v", bus.published)
+	}
+	if bus.published[0].PreviousGuardian != previous {
+		t.Fatalf("expected previous guardian %v, got %v", previous, bus.published[0].PreviousGuardian)
+	}
+}
+
+func TestPetApplication_TransferPet_InvalidGuardian(t *testing.T) {
+	repo := &mockPetRepository{
+		transferFunc: func(id, guardian string) (*entity.Pet, map[string]string) {
+			t.Fatal("repository must not be called")
+			return nil, nil
+		},
+	}
+	app := NewPetApplication(repo)
+
+	_, errData := app.TransferPet(context.Background(), uuid.New().String(), "not-a-uuid")
+	if _, ok := errData["invalid_argument"]; !ok {
+		t.Fatalf("expected invalid_argument, got %v", errData)
+	}
+}
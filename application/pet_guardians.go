@@ -0,0 +1,147 @@
+package application
+
+import (
+	"context"
+	"log"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/LuizFJP/pet-ms/domain/repository"
+	"github.com/google/uuid"
+)
+
+// WithPetGuardians habilita guardiões além do dono: co-donos, lares temporários e
+// contatos de emergência.
+func WithPetGuardians(pg repository.PetGuardianRepository) Option {
+	return func(p *petApplication) {
+		p.pg = pg
+	}
+}
+
+func (p *petApplication) petGuardiansUnavailable() map[string]string {
+	if p.pg == nil {
+		return map[string]string{"unavailable": "pet guardians are not enabled"}
+	}
+	return nil
+}
+
+// AddPetGuardian liga um guardião ao pet. O dono não entra aqui: ele muda só por
+// TransferPet.
+func (p *petApplication) AddPetGuardian(ctx context.Context, link *entity.PetGuardian) (*entity.PetGuardian, map[string]string) {
+	if errData := p.petGuardiansUnavailable(); errData != nil {
+		return nil, errData
+	}
+	if errs := link.Validate(); len(errs) > 0 {
+		return nil, invalidArgument(errs)
+	}
+	pet, errData := p.loadPet(ctx, link.PetUuid)
+	if errData != nil {
+		return nil, errData
+	}
+	if pet.UuidGuardian == link.GuardianUuid {
+		return nil, map[string]string{"failed_precondition": "guardian already owns this pet"}
+	}
+	if errData := p.requireGuardian(ctx, link.GuardianUuid); errData != nil {
+		return nil, errData
+	}
+
+	link.TenantID = pet.TenantID
+	return p.pg.AddPetGuardian(link)
+}
+
+func (p *petApplication) RemovePetGuardian(ctx context.Context, petUuid, uuidGuardian string) map[string]string {
+	if errData := p.petGuardiansUnavailable(); errData != nil {
+		return errData
+	}
+	pet, errData := parsePetUuid(petUuid)
+	if errData != nil {
+		return errData
+	}
+	guardian, err := uuid.Parse(uuidGuardian)
+	if err != nil {
+		return map[string]string{"invalid_argument": "uuid_guardian must be a valid uuid"}
+	}
+	if !p.tenantOwns(ctx, pet) {
+		return map[string]string{"not_found": "pet not found"}
+	}
+	return p.pg.RemovePetGuardian(pet, guardian)
+}
+
+// ListPetGuardians devolve o dono primeiro e depois as demais ligações, da mais antiga
+// para a mais recente. Sem WithPetGuardians a lista traz só o dono.
+func (p *petApplication) ListPetGuardians(ctx context.Context, petUuid string) ([]*entity.PetGuardian, map[string]string) {
+	id, errData := parsePetUuid(petUuid)
+	if errData != nil {
+		return nil, errData
+	}
+	pet, errData := p.loadPet(ctx, id)
+	if errData != nil {
+		return nil, errData
+	}
+
+	links := []*entity.PetGuardian{{PetUuid: pet.Uuid, GuardianUuid: pet.UuidGuardian, Role: entity.RoleOwner, TenantID: pet.TenantID}}
+	if p.pg == nil {
+		return links, nil
+	}
+	others, errData := p.pg.ListPetGuardians(pet.Uuid)
+	if errData != nil {
+		return nil, errData
+	}
+	return append(links, others...), nil
+}
+
+// resolveGuardianRoles preenche LinkedPets quando o filtro pede papéis além do dono.
+func (p *petApplication) resolveGuardianRoles(ctx context.Context, filter entity.PetFilter) (entity.PetFilter, map[string]string) {
+	if filter.UuidGuardian == uuid.Nil || len(filter.GuardianRoles) == 0 {
+		return filter, nil
+	}
+	var linked []entity.GuardianRole
+	for _, role := range filter.GuardianRoles {
+		if !role.Valid() {
+			return filter, map[string]string{"invalid_argument": "unknown guardian role " + string(role)}
+		}
+		if role != entity.RoleOwner {
+			linked = append(linked, role)
+		}
+	}
+	if len(linked) == 0 {
+		return filter, nil
+	}
+	if errData := p.petGuardiansUnavailable(); errData != nil {
+		return filter, errData
+	}
+
+	pets, errData := p.pg.PetsOfGuardian(TenantFromContext(ctx), filter.UuidGuardian, linked)
+	if errData != nil {
+		return filter, errData
+	}
+	filter.LinkedPets = pets
+	return filter, nil
+}
+
+// removePetLinks apaga as ligações dos pets removidos; uma falha fica só no log.
+func (p *petApplication) removePetLinks(pets []*entity.Pet) {
+	if p.pg == nil || len(pets) == 0 {
+		return
+	}
+	if errData := p.pg.DeletePetLinks(petUuidsOf(pets)); errData != nil {
+		log.Printf("failed to remove guardian links of deleted pets: %v", errData)
+	}
+}
+
+// dropOwnerRole tira o papel que o novo dono tinha no pet; uma falha fica só no log.
+func (p *petApplication) dropOwnerRole(petUuid, owner uuid.UUID) {
+	if p.pg == nil {
+		return
+	}
+	if errData := p.pg.RemovePetGuardian(petUuid, owner); errData != nil && errData["not_found"] == "" {
+		log.Printf("failed to remove previous role of guardian %s: %v", owner, errData)
+	}
+}
+
+func petUuidsOf(pets []*entity.Pet) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(pets))
+	for _, pet := range pets {
+		ids = append(ids, pet.Uuid)
+	}
+	return ids
+}
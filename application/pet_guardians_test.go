@@ -0,0 +1,189 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/LuizFJP/pet-ms/domain/repository"
+	"github.com/google/uuid"
+)
+
+var _ repository.PetGuardianRepository = (*petGuardianRepoMock)(nil)
+
+type petGuardianRepoMock struct {
+	links          []*entity.PetGuardian
+	deletedPets    []uuid.UUID
+	deletedLinksOf uuid.UUID
+}
+
+func (m *petGuardianRepoMock) AddPetGuardian(link *entity.PetGuardian) (*entity.PetGuardian, map[string]string) {
+	m.links = append(m.links, link)
+	return link, nil
+}
+
+func (m *petGuardianRepoMock) ListPetGuardians(petUuid uuid.UUID) ([]*entity.PetGuardian, map[string]string) {
+	var links []*entity.PetGuardian
+	for _, link := range m.links {
+		if link.PetUuid == petUuid {
+			links = append(links, link)
+		}
+	}
+	return links, nil
+}
+
+func (m *petGuardianRepoMock) RemovePetGuardian(petUuid, guardian uuid.UUID) map[string]string {
+	for i, link := range m.links {
+		if link.PetUuid == petUuid && link.GuardianUuid == guardian {
+			m.links = append(m.links[:i], m.links[i+1:]...)
+			return nil
+		}
+	}
+	return map[string]string{"not_found": "guardian is not linked to this pet"}
+}
+
+func (m *petGuardianRepoMock) PetsOfGuardian(tenant string, guardian uuid.UUID, roles []entity.GuardianRole) ([]uuid.UUID, map[string]string) {
+	var pets []uuid.UUID
+	for _, link := range m.links {
+		for _, role := range roles {
+			if link.GuardianUuid == guardian && link.Role == role {
+				pets = append(pets, link.PetUuid)
+			}
+		}
+	}
+	return pets, nil
+}
+
+func (m *petGuardianRepoMock) DeletePetLinks(petUuids []uuid.UUID) map[string]string {
+	m.deletedPets = append(m.deletedPets, petUuids...)
+	return nil
+}
+
+func (m *petGuardianRepoMock) DeleteGuardianLinks(tenant string, guardian uuid.UUID) map[string]string {
+	m.deletedLinksOf = guardian
+	return nil
+}
+
+func TestAddPetGuardian(t *testing.T) {
+	owner, foster := uuid.New(), uuid.New()
+	pet := &entity.Pet{Uuid: uuid.New(), UuidGuardian: owner, TenantID: "shelter"}
+	repo := &mockPetRepository{
+		getPetsFunc: func([]string) ([]*entity.Pet, map[string]string) { return []*entity.Pet{pet}, nil },
+	}
+	pg := &petGuardianRepoMock{}
+	app := NewPetApplication(repo, WithPetGuardians(pg), WithGuardianCheck(newGuardianRepoMock(owner)))
+	ctx := context.Background()
+
+	if _, errData := app.AddPetGuardian(ctx, &entity.PetGuardian{PetUuid: pet.Uuid, GuardianUuid: foster, Role: entity.RoleOwner}); errData["invalid_argument"] == "" {
+		t.Fatalf("expected invalid_argument for the owner role, got %v", errData)
+	}
+	if _, errData := app.AddPetGuardian(ctx, &entity.PetGuardian{PetUuid: pet.Uuid, GuardianUuid: owner, Role: entity.RoleCoOwner}); errData["failed_precondition"] == "" {
+		t.Fatalf("expected failed_precondition for the owner, got %v", errData)
+	}
+	if _, errData := app.AddPetGuardian(ctx, &entity.PetGuardian{PetUuid: pet.Uuid, GuardianUuid: foster, Role: entity.RoleFoster}); errData["invalid_argument"] == "" {
+		t.Fatalf("expected invalid_argument for an unknown guardian, got %v", errData)
+	}
+
+	app = NewPetApplication(repo, WithPetGuardians(pg))
+	link, errData := app.AddPetGuardian(ctx, &entity.PetGuardian{PetUuid: pet.Uuid, GuardianUuid: foster, Role: entity.RoleFoster})
+	if errData != nil || link.TenantID != "shelter" {
+		t.Fatalf("expected the link in the pet's tenant, got %+v %v", link, errData)
+	}
+
+	links, errData := app.ListPetGuardians(ctx, pet.Uuid.String())
+	if errData != nil || len(links) != 2 {
+		t.Fatalf("expected owner and foster, got %v %v", links, errData)
+	}
+	if links[0].Role != entity.RoleOwner || links[0].GuardianUuid != owner || links[1].Role != entity.RoleFoster {
+		t.Fatalf("expected the owner first, got %+v %+v", links[0], links[1])
+	}
+}
+
+func TestListPetGuardians_OwnerOnlyWithoutLinks(t *testing.T) {
+	pet := &entity.Pet{Uuid: uuid.New(), UuidGuardian: uuid.New()}
+	app := NewPetApplication(&mockPetRepository{
+		getPetsFunc: func([]string) ([]*entity.Pet, map[string]string) { return []*entity.Pet{pet}, nil },
+	})
+
+	links, errData := app.ListPetGuardians(context.Background(), pet.Uuid.String())
+	if errData != nil || len(links) != 1 || links[0].Role != entity.RoleOwner {
+		t.Fatalf("expected only the owner, got %v %v", links, errData)
+	}
+	if _, errData := app.AddPetGuardian(context.Background(), &entity.PetGuardian{PetUuid: pet.Uuid, GuardianUuid: uuid.New(), Role: entity.RoleFoster}); errData["unavailable"] == "" {
+		t.Fatalf("expected unavailable, got %v", errData)
+	}
+}
+
+func TestExportPets_ResolvesGuardianRoles(t *testing.T) {
+	guardian, fostered := uuid.New(), uuid.New()
+	pg := &petGuardianRepoMock{links: []*entity.PetGuardian{{PetUuid: fostered, GuardianUuid: guardian, Role: entity.RoleFoster}}}
+	var got entity.PetFilter
+	repo := &mockPetRepository{
+		listPetsFunc: func(filter entity.PetFilter, afterUuid string, limit int) ([]*entity.Pet, map[string]string) {
+			got = filter
+			return nil, nil
+		},
+	}
+	app := NewPetApplication(repo, WithPetGuardians(pg))
+	send := func(*entity.Pet) error { return nil }
+
+	filter := entity.PetFilter{UuidGuardian: guardian, GuardianRoles: []entity.GuardianRole{entity.RoleOwner, entity.RoleFoster}}
+	if errData := app.ExportPets(context.Background(), filter, 0, send); errData != nil {
+		t.Fatalf("unexpected error: %v", errData)
+	}
+	if len(got.LinkedPets) != 1 || got.LinkedPets[0] != fostered {
+		t.Fatalf("expected the fostered pet linked, got %v", got.LinkedPets)
+	}
+
+	filter.GuardianRoles = []entity.GuardianRole{"neighbor"}
+	if errData := app.ExportPets(context.Background(), filter, 0, send); errData["invalid_argument"] == "" {
+		t.Fatalf("expected invalid_argument, got %v", errData)
+	}
+	filter.GuardianRoles = []entity.GuardianRole{entity.RoleFoster}
+	if errData := NewPetApplication(repo).ExportPets(context.Background(), filter, 0, send); errData["unavailable"] == "" {
+		t.Fatalf("expected unavailable without pet guardians, got %v", errData)
+	}
+}
+
+func TestDeletePet_RemovesLinksOfDeletedPets(t *testing.T) {
+	guardian := uuid.New()
+	owned := &entity.Pet{Uuid: uuid.New(), UuidGuardian: guardian}
+	repo := &mockPetRepository{
+		listPetsFunc: func(filter entity.PetFilter, afterUuid string, limit int) ([]*entity.Pet, map[string]string) {
+			if len(filter.GuardianRoles) != 0 || afterUuid != "" {
+				return nil, nil
+			}
+			return []*entity.Pet{owned}, nil
+		},
+		deleteFunc: func(string) (map[string]string, map[string]string) {
+			return map[string]string{"message": "1 pet(s) deletados!"}, nil
+		},
+	}
+	pg := &petGuardianRepoMock{}
+	app := NewPetApplication(repo, WithPetGuardians(pg))
+
+	if _, errData := app.DeletePet(context.Background(), guardian.String()); errData != nil {
+		t.Fatalf("unexpected error: %v", errData)
+	}
+	if len(pg.deletedPets) != 1 || pg.deletedPets[0] != owned.Uuid {
+		t.Fatalf("expected the links of the deleted pet removed, got %v", pg.deletedPets)
+	}
+}
+
+func TestTransferPet_DropsNewOwnerRole(t *testing.T) {
+	pet, coOwner := uuid.New(), uuid.New()
+	pg := &petGuardianRepoMock{links: []*entity.PetGuardian{{PetUuid: pet, GuardianUuid: coOwner, Role: entity.RoleCoOwner}}}
+	repo := &mockPetRepository{
+		transferFunc: func(id, guardian string) (*entity.Pet, map[string]string) {
+			return &entity.Pet{Uuid: pet, UuidGuardian: uuid.MustParse(guardian)}, nil
+		},
+	}
+	app := NewPetApplication(repo, WithPetGuardians(pg))
+
+	if _, errData := app.TransferPet(context.Background(), pet.String(), coOwner.String()); errData != nil {
+		t.Fatalf("unexpected error: %v", errData)
+	}
+	if len(pg.links) != 0 {
+		t.Fatalf("expected the co-owner link removed after the transfer, got %v", pg.links)
+	}
+}
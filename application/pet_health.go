@@ -0,0 +1,179 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/LuizFJP/pet-ms/domain/repository"
+	"github.com/google/uuid"
+)
+
+const (
+	DefaultOverduePageSize = 100
+	MaxOverduePageSize     = 1000
+)
+
+// WithHealthRecords habilita o registro de vacinas e o prontuário dos pets.
+func WithHealthRecords(vr repository.VaccinationRepository, mr repository.MedicalRecordRepository) Option {
+	return func(p *petApplication) {
+		p.vr = vr
+		p.mr = mr
+	}
+}
+
+func (p *petApplication) healthUnavailable() map[string]string {
+	if p.vr == nil || p.mr == nil {
+		return map[string]string{"unavailable": "health records are not enabled"}
+	}
+	return nil
+}
+
+// requirePet confirma que o pet existe, no tenant da requisição, antes de gravar ou
+// listar seus registros.
+func (p *petApplication) requirePet(ctx context.Context, petUuid uuid.UUID) map[string]string {
+	_, errData := p.loadPet(ctx, petUuid)
+	return errData
+}
+
+func (p *petApplication) loadPet(ctx context.Context, petUuid uuid.UUID) (*entity.Pet, map[string]string) {
+	pets, errData := p.writer(ctx).GetPets([]string{petUuid.String()})
+	if errData != nil {
+		return nil, errData
+	}
+	if len(pets) == 0 {
+		return nil, map[string]string{"not_found": "pet not found"}
+	}
+	return pets[0], nil
+}
+
+func parsePetUuid(petUuid string) (uuid.UUID, map[string]string) {
+	id, err := uuid.Parse(petUuid)
+	if err != nil {
+		return uuid.Nil, map[string]string{"invalid_argument": "pet_uuid must be a valid uuid"}
+	}
+	return id, nil
+}
+
+// AddVaccination registra uma dose e calcula a data do reforço.
+func (p *petApplication) AddVaccination(ctx context.Context, vaccination *entity.Vaccination) (*entity.Vaccination, map[string]string) {
+	if errData := p.healthUnavailable(); errData != nil {
+		return nil, errData
+	}
+	if errs := vaccination.Validate(p.now()); len(errs) > 0 {
+		return nil, invalidArgument(errs)
+	}
+	if errData := p.requirePet(ctx, vaccination.PetUuid); errData != nil {
+		return nil, errData
+	}
+
+	vaccination.Uuid = uuid.New()
+	vaccination.ScheduleBooster()
+	return p.vr.CreateVaccination(vaccination)
+}
+
+// UpdateVaccination corrige uma dose já registrada e recalcula o reforço.
+func (p *petApplication) UpdateVaccination(ctx context.Context, vaccination *entity.Vaccination) (*entity.Vaccination, map[string]string) {
+	if errData := p.healthUnavailable(); errData != nil {
+		return nil, errData
+	}
+	current, errData := p.vr.GetVaccination(vaccination.Uuid)
+	if errData != nil {
+		return nil, errData
+	}
+	if !p.tenantOwns(ctx, current.PetUuid) {
+		return nil, map[string]string{"not_found": "vaccination not found"}
+	}
+
+	vaccination.PetUuid = current.PetUuid
+	if errs := vaccination.Validate(p.now()); len(errs) > 0 {
+		return nil, invalidArgument(errs)
+	}
+	vaccination.ScheduleBooster()
+	return p.vr.UpdateVaccination(vaccination)
+}
+
+func (p *petApplication) ListVaccinations(ctx context.Context, petUuid string) ([]*entity.Vaccination, map[string]string) {
+	if errData := p.healthUnavailable(); errData != nil {
+		return nil, errData
+	}
+	id, errData := parsePetUuid(petUuid)
+	if errData != nil {
+		return nil, errData
+	}
+	if errData := p.requirePet(ctx, id); errData != nil {
+		return nil, errData
+	}
+	return p.vr.ListVaccinations(id)
+}
+
+// ListOverdueVaccinations devolve os reforços vencidos antes de asOf (hoje, se zero),
+// paginados por id.
+func (p *petApplication) ListOverdueVaccinations(ctx context.Context, asOf time.Time, afterID uint, pageSize int) ([]*entity.Vaccination, map[string]string) {
+	if errData := p.healthUnavailable(); errData != nil {
+		return nil, errData
+	}
+	if asOf.IsZero() {
+		asOf = p.now()
+	}
+	return p.vr.ListOverdueVaccinations(TenantFromContext(ctx), asOf, afterID, OverduePageSize(pageSize))
+}
+
+// OverduePageSize aplica o padrão e o teto de itens por página.
+func OverduePageSize(pageSize int) int {
+	if pageSize <= 0 {
+		return DefaultOverduePageSize
+	}
+	if pageSize > MaxOverduePageSize {
+		return MaxOverduePageSize
+	}
+	return pageSize
+}
+
+func (p *petApplication) AddMedicalRecord(ctx context.Context, record *entity.MedicalRecord) (*entity.MedicalRecord, map[string]string) {
+	if errData := p.healthUnavailable(); errData != nil {
+		return nil, errData
+	}
+	if errs := record.Validate(p.now()); len(errs) > 0 {
+		return nil, invalidArgument(errs)
+	}
+	if errData := p.requirePet(ctx, record.PetUuid); errData != nil {
+		return nil, errData
+	}
+
+	record.Uuid = uuid.New()
+	return p.mr.CreateMedicalRecord(record)
+}
+
+func (p *petApplication) UpdateMedicalRecord(ctx context.Context, record *entity.MedicalRecord) (*entity.MedicalRecord, map[string]string) {
+	if errData := p.healthUnavailable(); errData != nil {
+		return nil, errData
+	}
+	current, errData := p.mr.GetMedicalRecord(record.Uuid)
+	if errData != nil {
+		return nil, errData
+	}
+	if !p.tenantOwns(ctx, current.PetUuid) {
+		return nil, map[string]string{"not_found": "medical record not found"}
+	}
+
+	record.PetUuid = current.PetUuid
+	if errs := record.Validate(p.now()); len(errs) > 0 {
+		return nil, invalidArgument(errs)
+	}
+	return p.mr.UpdateMedicalRecord(record)
+}
+
+func (p *petApplication) ListMedicalRecords(ctx context.Context, petUuid string) ([]*entity.MedicalRecord, map[string]string) {
+	if errData := p.healthUnavailable(); errData != nil {
+		return nil, errData
+	}
+	id, errData := parsePetUuid(petUuid)
+	if errData != nil {
+		return nil, errData
+	}
+	if errData := p.requirePet(ctx, id); errData != nil {
+		return nil, errData
+	}
+	return p.mr.ListMedicalRecords(id)
+}
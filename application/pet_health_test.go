@@ -0,0 +1,198 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/google/uuid"
+)
+
+type vaccinationRepoMock struct {
+	saved   map[uuid.UUID]*entity.Vaccination
+	asOf    time.Time
+	limitOf int
+	tenant  string
+}
+
+func (m *vaccinationRepoMock) CreateVaccination(v *entity.Vaccination) (*entity.Vaccination, map[string]string) {
+	m.saved[v.Uuid] = v
+	return v, nil
+}
+
+func (m *vaccinationRepoMock) GetVaccination(id uuid.UUID) (*entity.Vaccination, map[string]string) {
+	if v, ok := m.saved[id]; ok {
+		copied := *v
+		return &copied, nil
+	}
+	return nil, map[string]string{"not_found": "vaccination not found"}
+}
+
+func (m *vaccinationRepoMock) UpdateVaccination(v *entity.Vaccination) (*entity.Vaccination, map[string]string) {
+	m.saved[v.Uuid] = v
+	return v, nil
+}
+
+func (m *vaccinationRepoMock) ListVaccinations(petUuid uuid.UUID) ([]*entity.Vaccination, map[string]string) {
+	var list []*entity.Vaccination
+	for _, v := range m.saved {
+		if v.PetUuid == petUuid {
+			list = append(list, v)
+		}
+	}
+	return list, nil
+}
+
+func (m *vaccinationRepoMock) ListOverdueVaccinations(tenant string, asOf time.Time, afterID uint, limit int) ([]*entity.Vaccination, map[string]string) {
+	m.asOf, m.limitOf, m.tenant = asOf, limit, tenant
+	return nil, nil
+}
+
+type medicalRecordRepoMock struct {
+	saved map[uuid.UUID]*entity.MedicalRecord
+}
+
+func (m *medicalRecordRepoMock) CreateMedicalRecord(r *entity.MedicalRecord) (*entity.MedicalRecord, map[string]string) {
+	m.saved[r.Uuid] = r
+	return r, nil
+}
+
+func (m *medicalRecordRepoMock) GetMedicalRecord(id uuid.UUID) (*entity.MedicalRecord, map[string]string) {
+	if r, ok := m.saved[id]; ok {
+		copied := *r
+		return &copied, nil
+	}
+	return nil, map[string]string{"not_found": "medical record not found"}
+}
+
+func (m *medicalRecordRepoMock) UpdateMedicalRecord(r *entity.MedicalRecord) (*entity.MedicalRecord, map[string]string) {
+	m.saved[r.Uuid] = r
+	return r, nil
+}
+
+func (m *medicalRecordRepoMock) ListMedicalRecords(petUuid uuid.UUID) ([]*entity.MedicalRecord, map[string]string) {
+	return nil, nil
+}
+
+func newHealthApp(known ...uuid.UUID) (*petApplication, *vaccinationRepoMock, *medicalRecordRepoMock) {
+	repo := &mockPetRepository{
+		getPetsFunc: func(uuids []string) ([]*entity.Pet, map[string]string) {
+			var pets []*entity.Pet
+			for _, id := range known {
+				if id.String() == uuids[0] {
+					pets = append(pets, &entity.Pet{Uuid: id})
+				}
+			}
+			return pets, nil
+		},
+	}
+	vr := &vaccinationRepoMock{saved: map[uuid.UUID]*entity.Vaccination{}}
+	mr := &medicalRecordRepoMock{saved: map[uuid.UUID]*entity.MedicalRecord{}}
+	app := NewPetApplication(repo, WithHealthRecords(vr, mr)).(*petApplication)
+	app.now = func() time.Time { return time.Date(2024, time.June, 15, 10, 0, 0, 0, time.UTC) }
+	return app, vr, mr
+}
+
+func TestAddVaccination_SchedulesBooster(t *testing.T) {
+	petUuid := uuid.New()
+	app, _, _ := newHealthApp(petUuid)
+
+	v, errData := app.AddVaccination(context.Background(), &entity.Vaccination{
+		PetUuid:             petUuid,
+		Vaccine:             "Antirrábica",
+		AdministeredAt:      time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC),
+		BoosterIntervalDays: 365,
+	})
+	if errData != nil {
+		t.Fatalf("unexpected error: %v", errData)
+	}
+	if v.Uuid == uuid.Nil || v.NextDueAt == nil || !v.NextDueAt.Equal(time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("expected uuid and booster on 2025-06-01, got %v / %v", v.Uuid, v.NextDueAt)
+	}
+
+	_, errData = app.AddVaccination(context.Background(), &entity.Vaccination{PetUuid: uuid.New(), Vaccine: "V10", AdministeredAt: time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)})
+	if errData["not_found"] == "" {
+		t.Fatalf("expected not_found for unknown pet, got %v", errData)
+	}
+	if _, errData := app.AddVaccination(context.Background(), &entity.Vaccination{PetUuid: petUuid}); errData["invalid_argument"] == "" {
+		t.Fatalf("expected invalid_argument, got %v", errData)
+	}
+}
+
+func TestUpdateVaccination_KeepsPetAndReschedules(t *testing.T) {
+	petUuid := uuid.New()
+	app, vr, _ := newHealthApp(petUuid)
+	v, _ := app.AddVaccination(context.Background(), &entity.Vaccination{PetUuid: petUuid, Vaccine: "V10", AdministeredAt: time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)})
+
+	updated, errData := app.UpdateVaccination(context.Background(), &entity.Vaccination{
+		Uuid:                v.Uuid,
+		PetUuid:             uuid.New(),
+		Vaccine:             "V10",
+		Dose:                2,
+		AdministeredAt:      time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC),
+		BoosterIntervalDays: 21,
+	})
+	if errData != nil {
+		t.Fatalf("unexpected error: %v", errData)
+	}
+	if updated.PetUuid != petUuid || updated.NextDueAt == nil || !updated.NextDueAt.Equal(time.Date(2024, time.June, 22, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("expected same pet and booster on 2024-06-22, got %+v", updated)
+	}
+	if vr.saved[v.Uuid].Dose != 2 {
+		t.Fatal("expected update to be persisted")
+	}
+
+	if _, errData := app.UpdateVaccination(context.Background(), &entity.Vaccination{Uuid: uuid.New()}); errData["not_found"] == "" {
+		t.Fatalf("expected not_found, got %v", errData)
+	}
+}
+
+func TestListOverdueVaccinations_DefaultsToToday(t *testing.T) {
+	app, vr, _ := newHealthApp()
+
+	if _, errData := app.ListOverdueVaccinations(context.Background(), time.Time{}, 0, 0); errData != nil {
+		t.Fatalf("unexpected error: %v", errData)
+	}
+	if !vr.asOf.Equal(app.now()) || vr.limitOf != DefaultOverduePageSize {
+		t.Fatalf("expected today and default page size, got %v / %d", vr.asOf, vr.limitOf)
+	}
+
+	app.ListOverdueVaccinations(context.Background(), time.Time{}, 0, MaxOverduePageSize+1)
+	if vr.limitOf != MaxOverduePageSize {
+		t.Fatalf("expected page size capped at %d, got %d", MaxOverduePageSize, vr.limitOf)
+	}
+}
+
+func TestMedicalRecords(t *testing.T) {
+	petUuid := uuid.New()
+	app, _, _ := newHealthApp(petUuid)
+
+	record, errData := app.AddMedicalRecord(context.Background(), &entity.MedicalRecord{
+		PetUuid:    petUuid,
+		Kind:       entity.MedicalConsultation,
+		Title:      "Check-up",
+		OccurredAt: time.Date(2024, time.June, 10, 0, 0, 0, 0, time.UTC),
+	})
+	if errData != nil {
+		t.Fatalf("unexpected error: %v", errData)
+	}
+
+	record.Title = ""
+	if _, errData := app.UpdateMedicalRecord(context.Background(), record); errData["invalid_argument"] == "" {
+		t.Fatalf("expected invalid_argument, got %v", errData)
+	}
+	if _, errData := app.ListMedicalRecords(context.Background(), "bad"); errData["invalid_argument"] == "" {
+		t.Fatalf("expected invalid_argument, got %v", errData)
+	}
+}
+
+func TestHealthRecords_Disabled(t *testing.T) {
+	app := NewPetApplication(&mockPetRepository{})
+	if _, errData := app.ListVaccinations(context.Background(), uuid.New().String()); errData["unavailable"] == "" {
+		t.Fatalf("expected unavailable, got %v", errData)
+	}
+	if _, errData := app.AddMedicalRecord(context.Background(), &entity.MedicalRecord{}); errData["unavailable"] == "" {
+		t.Fatalf("expected unavailable, got %v", errData)
+	}
+}
@@ -0,0 +1,91 @@
+package application
+
+import (
+	"context"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+)
+
+const (
+	ImportChunkSize       = 500
+	DefaultExportPageSize = 500
+	MaxExportPageSize     = 5000
+)
+
+// ImportPets valida e grava os pets em blocos de ImportChunkSize, usando um INSERT
+// por bloco. Se o bloco falhar no banco ele é refeito item a item para apontar
+// exatamente quais registros foram rejeitados. Os erros são indexados pela posição em pets.
+func (p *petApplication) ImportPets(ctx context.Context, pets []*entity.Pet) (int, map[int]map[string]string) {
+	itemErrs := map[int]map[string]string{}
+	valid := make([]*entity.Pet, 0, len(pets))
+	positions := make([]int, 0, len(pets))
+	for i, pet := range pets {
+		if errs := p.validatePet(ctx, pet, "create", nil); len(errs) > 0 {
+			itemErrs[i] = errs
+			continue
+		}
+		valid = append(valid, pet)
+		positions = append(positions, i)
+	}
+
+	imported := 0
+	for start := 0; start < len(valid); start += ImportChunkSize {
+		end := start + ImportChunkSize
+		if end > len(valid) {
+			end = len(valid)
+		}
+		chunk := valid[start:end]
+
+		if errData := p.writer(ctx).InsertPets(chunk); errData == nil {
+			imported += len(chunk)
+			p.publish(entity.PetCreated, chunk...)
+			p.recordAudit(ctx, entity.AuditCreate, createdChanges(chunk...)...)
+			continue
+		}
+
+		saved, chunkErrs := p.writer(ctx).SavePets(chunk, false)
+		for j := range chunk {
+			if saved[j] != nil {
+				imported++
+			} else {
+				itemErrs[positions[start+j]] = chunkErrs[j]
+			}
+		}
+		p.publish(entity.PetCreated, saved...)
+		p.recordAudit(ctx, entity.AuditCreate, createdChanges(saved...)...)
+	}
+	return imported, itemErrs
+}
+
+// ExportPets percorre todos os pets do filtro página a página e entrega cada um para send.
+func (p *petApplication) ExportPets(ctx context.Context, filter entity.PetFilter, pageSize int, send func(*entity.Pet) error) map[string]string {
+	if pageSize <= 0 {
+		pageSize = DefaultExportPageSize
+	}
+	if pageSize > MaxExportPageSize {
+		pageSize = MaxExportPageSize
+	}
+
+	filter, errData := p.resolveGuardianRoles(ctx, filter)
+	if errData != nil {
+		return errData
+	}
+
+	reader := p.reader(ctx)
+	after := ""
+	for {
+		page, errData := reader.ListPets(filter, after, pageSize)
+		if errData != nil {
+			return errData
+		}
+		for _, pet := range page {
+			if err := send(pet); err != nil {
+				return map[string]string{"aborted": err.Error()}
+			}
+		}
+		if len(page) < pageSize {
+			return nil
+		}
+		after = page[len(page)-1].Uuid.String()
+	}
+}
@@ -0,0 +1,152 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+)
+
+func TestImportPets_InsertsValidPetsInChunks(t *testing.T) {
+	var chunks [][]*entity.Pet
+	repo := &mockPetRepository{
+		insertPetsFunc: func(pets []*entity.Pet) map[string]string {
+			chunks = append(chunks, pets)
+			return nil
+		},
+	}
+	app := NewPetApplication(repo)
+
+	pets := make([]*entity.Pet, ImportChunkSize+2)
+	for i := range pets {
+		pets[i] = validBatchPet("Rex")
+	}
+	pets[1] = validBatchPet("")
+
+	imported, itemErrs := app.ImportPets(context.Background(), pets)
+
+	if imported != len(pets)-1 {
+		t.Fatalf("expected %d imported pets, got %d", len(pets)-1, imported)
+	}
+	if len(chunks) != 2 || len(chunks[0]) != ImportChunkSize || len(chunks[1]) != 1 {
+		t.Fatalf("expected chunks of %d and 1, got %d chunks", ImportChunkSize, len(chunks))
+	}
+	if len(itemErrs) != 1 || itemErrs[1]["pet name is required"] == "" {
+		t.Fatalf("expected validation error for record 1 only, got %v", itemErrs)
+	}
+}
+
+func TestImportPets_RejectsNonInitialStatus(t *testing.T) {
+	app := NewPetApplication(&mockPetRepository{})
+
+	adopted := validBatchPet("Rex")
+	adopted.Status = entity.StatusAdopted
+	imported, itemErrs := app.ImportPets(context.Background(), []*entity.Pet{adopted})
+
+	if imported != 0 || itemErrs[0]["status"] == "" {
+		t.Fatalf("expected the adopted pet to be rejected, got imported=%d errs=%v", imported, itemErrs)
+	}
+}
+
+func TestImportPets_FallsBackToPerItemOnChunkFailure(t *testing.T) {
+	bad := validBatchPet("Bad")
+	repo := &mockPetRepository{
+		insertPetsFunc: func(pets []*entity.Pet) map[string]string {
+			return map[string]string{"db_error": "constraint violation"}
+		},
+		savePetsFunc: func(pets []*entity.Pet, allOrNothing bool) ([]*entity.Pet, []map[string]string) {
+			if allOrNothing {
+				t.Fatalf("fallback must run per item")
+			}
+			saved := make([]*entity.Pet, len(pets))
+			errs := make([]map[string]string, len(pets))
+			for i, pet := range pets {
+				if pet == bad {
+					errs[i] = map[string]string{"db_error": "constraint violation"}
+				} else {
+					saved[i] = pet
+				}
+			}
+			return saved, errs
+		},
+	}
+	app := NewPetApplication(repo)
+
+	imported, itemErrs := app.ImportPets(context.Background(), []*entity.Pet{validBatchPet(""), validBatchPet("Ok"), bad})
+
+	if imported != 1 {
+		t.Fatalf("expected 1 imported pet, got %d", imported)
+	}
+	if itemErrs[0]["pet name is required"] == "" {
+		t.Fatalf("record 0 should fail validation, got %v", itemErrs[0])
+	}
+	if itemErrs[2]["db_error"] == "" {
+		t.Fatalf("record 2 should report the database error, got %v", itemErrs[2])
+	}
+	if _, ok := itemErrs[1]; ok {
+		t.Fatalf("record 1 should have been imported, got %v", itemErrs[1])
+	}
+}
+
+func TestExportPets_WalksAllPages(t *testing.T) {
+	pets := []*entity.Pet{validBatchPet("A"), validBatchPet("B"), validBatchPet("C")}
+	var afters []string
+	repo := &mockPetRepository{
+		listPetsFunc: func(filter entity.PetFilter, afterUuid string, limit int) ([]*entity.Pet, map[string]string) {
+			afters = append(afters, afterUuid)
+			start := 0
+			for i, pet := range pets {
+				if pet.Uuid.String() == afterUuid {
+					start = i + 1
+				}
+			}
+			end := start + limit
+			if end > len(pets) {
+				end = len(pets)
+			}
+			return pets[start:end], nil
+		},
+	}
+	app := NewPetApplication(repo)
+
+	var sent []*entity.Pet
+	errData := app.ExportPets(context.Background(), entity.PetFilter{}, 2, func(pet *entity.Pet) error {
+		sent = append(sent, pet)
+		return nil
+	})
+
+	if errData != nil {
+		t.Fatalf("unexpected error: %v", errData)
+	}
+	if len(sent) != 3 {
+		t.Fatalf("expected 3 pets, got %d", len(sent))
+	}
+	if len(afters) != 2 || afters[0] != "" || afters[1] != pets[1].Uuid.String() {
+		t.Fatalf("expected keyset cursor to advance after each page, got %v", afters)
+	}
+}
+
+func TestExportPets_StopsWhenSendFails(t *testing.T) {
+	repo := &mockPetRepository{
+		listPetsFunc: func(filter entity.PetFilter, afterUuid string, limit int) ([]*entity.Pet, map[string]string) {
+			return []*entity.Pet{{Uuid: uuid.New()}, {Uuid: uuid.New()}}, nil
+		},
+	}
+	app := NewPetApplication(repo)
+
+	calls := 0
+	errData := app.ExportPets(context.Background(), entity.PetFilter{}, 10, func(pet *entity.Pet) error {
+		calls++
+		return errors.New("client went away")
+	})
+
+	if calls != 1 {
+		t.Fatalf("export should stop at the first send error, got %d calls", calls)
+	}
+	if errData["aborted"] == "" {
+		t.Fatalf("expected aborted error, got %v", errData)
+	}
+}
@@ -0,0 +1,31 @@
+package application
+
+import (
+	"context"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+)
+
+const microchipTaken = "microchip already registered to another pet"
+
+// microchipConflict avisa quando o chip do pet já está ligado a outro pet do mesmo
+// tenant. O índice único no banco cobre as corridas entre a checagem e a gravação.
+func (p *petApplication) microchipConflict(ctx context.Context, pet *entity.Pet) string {
+	if pet.MicrochipNumber == "" {
+		return ""
+	}
+	owner, errData := p.writer(ctx).GetPetByMicrochip(pet.MicrochipNumber)
+	if errData != nil || owner.Uuid == pet.Uuid {
+		return ""
+	}
+	return microchipTaken
+}
+
+// LookupByMicrochip aceita o número como o leitor exibe (com espaços ou hífens).
+func (p *petApplication) LookupByMicrochip(ctx context.Context, number string) (*entity.Pet, map[string]string) {
+	number = entity.NormalizeMicrochip(number)
+	if !entity.ValidMicrochip(number) {
+		return nil, map[string]string{"invalid_argument": "microchip must be an ISO 11784/11785 number with 15 digits"}
+	}
+	return p.reader(ctx).GetPetByMicrochip(number)
+}
@@ -0,0 +1,105 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/google/uuid"
+)
+
+func TestLookupByMicrochip_NormalizesNumber(t *testing.T) {
+	owner := &entity.Pet{Uuid: uuid.New(), MicrochipNumber: "985112000123456"}
+	var got string
+	repo := &mockPetRepository{
+		byMicrochipFunc: func(number string) (*entity.Pet, map[string]string) {
+			got = number
+			return owner, nil
+		},
+	}
+	app := NewPetApplication(repo)
+
+	pet, errData := app.LookupByMicrochip(context.Background(), " 985.112-000 123456 ")
+	if errData != nil {
+		t.Fatalf("unexpected error: %v", errData)
+	}
+	if got != "985112000123456" || pet != owner {
+		t.Fatalf("expected lookup by normalized number, got %q", got)
+	}
+
+	if _, errData := app.LookupByMicrochip(context.Background(), "12345"); errData["invalid_argument"] == "" {
+		t.Fatalf("expected invalid_argument, got %v", errData)
+	}
+}
+
+func TestSavePet_MicrochipBoundToAnotherPet(t *testing.T) {
+	other := &entity.Pet{Uuid: uuid.New(), MicrochipNumber: "985112000123456"}
+	repo := &mockPetRepository{
+		byMicrochipFunc: func(number string) (*entity.Pet, map[string]string) {
+			return other, nil
+		},
+	}
+	app := NewPetApplication(repo)
+
+	pet := &entity.Pet{Uuid: uuid.New(), UuidGuardian: uuid.New(), Name: "Rex", Breed: "SRD", MicrochipNumber: "985 112 000 123 456"}
+	if _, errData := app.SavePet(context.Background(), pet); errData["conflict"] == "" {
+		t.Fatalf("expected conflict, got %v", errData)
+	}
+	if repo.saveCalledWith != nil {
+		t.Fatal("pet must not be saved")
+	}
+}
+
+func TestUpdatePetFields_KeepsOwnMicrochip(t *testing.T) {
+	current := &entity.Pet{Uuid: uuid.New(), UuidGuardian: uuid.New(), NIdentification: 1, Name: "Rex", BirthYear: 2020, Breed: "SRD", MicrochipNumber: "985112000123456"}
+	repo := &mockPetRepository{
+		getFunc: func(id string) (*entity.Pet, map[string]string) {
+			copied := *current
+			return &copied, nil
+		},
+		byMicrochipFunc: func(number string) (*entity.Pet, map[string]string) {
+			return current, nil
+		},
+	}
+	app := NewPetApplication(repo)
+
+	changes := &entity.Pet{Uuid: current.Uuid, MicrochipNumber: current.MicrochipNumber, Color: "Preto"}
+	if _, errData := app.UpdatePetFields(context.Background(), changes, []string{"microchip_number", "color"}); errData != nil {
+		t.Fatalf("unexpected error: %v", errData)
+	}
+
+	changes.Uuid = uuid.New()
+	repo.getFunc = func(id string) (*entity.Pet, map[string]string) {
+		return &entity.Pet{Uuid: changes.Uuid, UuidGuardian: uuid.New(), NIdentification: 2, Name: "Mel", BirthYear: 2021, Breed: "SRD"}, nil
+	}
+	if _, errData := app.UpdatePetFields(context.Background(), changes, []string{"microchip_number"}); errData["conflict"] == "" {
+		t.Fatalf("expected conflict, got %v", errData)
+	}
+}
+
+func TestBatchSavePets_MicrochipConflictIsPerItem(t *testing.T) {
+	repo := &mockPetRepository{
+		byMicrochipFunc: func(number string) (*entity.Pet, map[string]string) {
+			if number == "985112000123456" {
+				return &entity.Pet{Uuid: uuid.New()}, nil
+			}
+			return nil, map[string]string{"not_found": "no pet with this microchip"}
+		},
+	}
+	app := NewPetApplication(repo)
+
+	pets := []*entity.Pet{
+		{Uuid: uuid.New(), UuidGuardian: uuid.New(), NIdentification: 1, Name: "Rex", BirthYear: 2020, Breed: "SRD", MicrochipNumber: "985112000123456"},
+		{Uuid: uuid.New(), UuidGuardian: uuid.New(), NIdentification: 2, Name: "Mel", BirthYear: 2021, Breed: "SRD", MicrochipNumber: "985112000654321"},
+	}
+	results, _, errData := app.BatchSavePets(context.Background(), pets, BatchPerItem)
+	if errData != nil {
+		t.Fatalf("unexpected error: %v", errData)
+	}
+	if results[0].Errors["microchip_number"] == "" {
+		t.Fatalf("expected microchip error on first item, got %v", results[0].Errors)
+	}
+	if results[1].Errors != nil {
+		t.Fatalf("expected second item to be saved, got %v", results[1].Errors)
+	}
+}
@@ -0,0 +1,41 @@
+package application
+
+import (
+	"context"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+)
+
+const (
+	DefaultSearchPageSize = 20
+	MaxSearchPageSize     = 100
+)
+
+// SearchPets busca pets pelo nome, raça e observações e marca os termos encontrados.
+func (p *petApplication) SearchPets(ctx context.Context, search entity.PetSearch) ([]*entity.PetSearchHit, map[string]string) {
+	terms := search.Terms()
+	if len(terms) == 0 {
+		return nil, map[string]string{"invalid_argument": "query must have at least one letter or digit"}
+	}
+	switch {
+	case search.Limit <= 0:
+		search.Limit = DefaultSearchPageSize
+	case search.Limit > MaxSearchPageSize:
+		search.Limit = MaxSearchPageSize
+	}
+
+	filter, errData := p.resolveGuardianRoles(ctx, search.Filter)
+	if errData != nil {
+		return nil, errData
+	}
+	search.Filter = filter
+
+	hits, errData := p.reader(ctx).SearchPets(search)
+	if errData != nil {
+		return nil, errData
+	}
+	for _, hit := range hits {
+		hit.Highlights = entity.HighlightPet(hit.Pet, terms)
+	}
+	return hits, nil
+}
@@ -0,0 +1,42 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+)
+
+func TestSearchPets_HighlightsAndCapsLimit(t *testing.T) {
+	var got entity.PetSearch
+	repo := &mockPetRepository{
+		searchFunc: func(search entity.PetSearch) ([]*entity.PetSearchHit, map[string]string) {
+			got = search
+			return []*entity.PetSearchHit{{Pet: &entity.Pet{Name: "Rex", Breed: "Golden Retriever"}, Rank: 1}}, nil
+		},
+	}
+	app := NewPetApplication(repo)
+
+	hits, errData := app.SearchPets(context.Background(), entity.PetSearch{Query: "rex golden", Limit: MaxSearchPageSize + 1})
+	if errData != nil {
+		t.Fatalf("unexpected error: %v", errData)
+	}
+	if got.Limit != MaxSearchPageSize {
+		t.Fatalf("expected limit capped at %d, got %d", MaxSearchPageSize, got.Limit)
+	}
+	if hits[0].Highlights[entity.SearchFieldName] != "<mark>Rex</mark>" || hits[0].Highlights[entity.SearchFieldBreed] != "<mark>Golden</mark> Retriever" {
+		t.Fatalf("unexpected highlights: %v", hits[0].Highlights)
+	}
+
+	app.SearchPets(context.Background(), entity.PetSearch{Query: "rex"})
+	if got.Limit != DefaultSearchPageSize {
+		t.Fatalf("expected default limit %d, got %d", DefaultSearchPageSize, got.Limit)
+	}
+}
+
+func TestSearchPets_RejectsEmptyQuery(t *testing.T) {
+	app := NewPetApplication(&mockPetRepository{})
+	if _, errData := app.SearchPets(context.Background(), entity.PetSearch{Query: " ?! "}); errData["invalid_argument"] == "" {
+		t.Fatalf("expected invalid_argument, got %v", errData)
+	}
+}
@@ -0,0 +1,43 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/LuizFJP/pet-ms/domain/repository"
+)
+
+type lastWriteKey struct{}
+
+// ContextWithLastWrite marca quando a sessão do cliente escreveu pela última vez. As
+// leituras dessa requisição só vão às réplicas se elas já tiverem essa escrita.
+func ContextWithLastWrite(ctx context.Context, lastWrite time.Time) context.Context {
+	return context.WithValue(ctx, lastWriteKey{}, lastWrite)
+}
+
+// LastWriteFromContext devolve o instante zero quando o cliente não mandou a sessão.
+func LastWriteFromContext(ctx context.Context) time.Time {
+	lastWrite, _ := ctx.Value(lastWriteKey{}).(time.Time)
+	return lastWrite
+}
+
+// writer é o repositório das escritas da requisição: restrito ao tenant dela e preso
+// ao contexto quando o repositório sabe usá-lo (para parar as novas tentativas quando
+// o prazo acaba).
+func (p *petApplication) writer(ctx context.Context) repository.PetRepository {
+	repo := forTenant(ctx, p.pr)
+	if binder, ok := repo.(repository.ContextBinder); ok {
+		return binder.WithContext(ctx)
+	}
+	return repo
+}
+
+// reader é o repositório das consultas expostas na API, que podem ir às réplicas. As
+// leituras feitas durante uma escrita usam writer, que lê do primário.
+func (p *petApplication) reader(ctx context.Context) repository.PetRepository {
+	repo := p.writer(ctx)
+	if router, ok := repo.(repository.ReplicaRouter); ok {
+		return router.ReadReplica(LastWriteFromContext(ctx))
+	}
+	return repo
+}
@@ -0,0 +1,98 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/LuizFJP/pet-ms/domain/repository"
+	"github.com/google/uuid"
+)
+
+// routedRepo lê do primário e devolve replica em ReadReplica, guardando a dica recebida.
+type routedRepo struct {
+	*mockPetRepository
+	replica   *mockPetRepository
+	lastWrite *time.Time
+}
+
+func (r *routedRepo) ReadReplica(lastWrite time.Time) repository.PetRepository {
+	r.lastWrite = &lastWrite
+	return r.replica
+}
+
+func newRoutedRepo(primary, replica *entity.Pet) *routedRepo {
+	return &routedRepo{
+		mockPetRepository: &mockPetRepository{
+			getFunc:          func(string) (*entity.Pet, map[string]string) { copied := *primary; return &copied, nil },
+			updateFieldsFunc: func(p *entity.Pet, _ []string) (*entity.Pet, map[string]string) { return p, nil },
+		},
+		replica: &mockPetRepository{
+			getFunc: func(string) (*entity.Pet, map[string]string) { copied := *replica; return &copied, nil },
+		},
+	}
+}
+
+func TestGetPet_ReadsFromReplicaWithSessionHint(t *testing.T) {
+	pet := &entity.Pet{Uuid: uuid.New(), UuidGuardian: uuid.New(), Name: "Rex", BirthYear: 2020, Breed: "SRD"}
+	repo := newRoutedRepo(pet, &entity.Pet{Uuid: pet.Uuid, Name: "réplica"})
+	app := NewPetApplication(repo)
+
+	got, _ := app.GetPet(context.Background(), pet.Uuid.String())
+	if got.Name != "réplica" {
+		t.Fatalf("expected the read to go to the replica, got %q", got.Name)
+	}
+	if repo.lastWrite == nil || !repo.lastWrite.IsZero() {
+		t.Fatalf("expected a zero hint without a session, got %v", repo.lastWrite)
+	}
+
+	written := time.Now().Add(-time.Minute)
+	app.GetPet(ContextWithLastWrite(context.Background(), written), pet.Uuid.String())
+	if !repo.lastWrite.Equal(written) {
+		t.Fatalf("expected the session hint to reach the repository, got %v", repo.lastWrite)
+	}
+}
+
+func TestUpdatePetFields_ReadsCurrentFromPrimary(t *testing.T) {
+	pet := &entity.Pet{Uuid: uuid.New(), UuidGuardian: uuid.New(), Name: "Rex", BirthYear: 2020, Breed: "SRD"}
+	repo := newRoutedRepo(pet, &entity.Pet{Uuid: pet.Uuid, Name: "antigo", BirthYear: 2020, Breed: "SRD"})
+	app := NewPetApplication(repo)
+
+	updated, errData := app.UpdatePetFields(context.Background(), &entity.Pet{Uuid: pet.Uuid, Color: "preto"}, []string{"color"})
+	if errData != nil {
+		t.Fatalf("unexpected error: %v", errData)
+	}
+	if updated.Name != "Rex" || repo.lastWrite != nil {
+		t.Fatalf("expected the write to merge over the primary copy, got %+v", updated)
+	}
+}
+
+// boundRepo guarda o contexto recebido em WithContext.
+type boundRepo struct {
+	*mockPetRepository
+	ctx context.Context
+}
+
+func (r *boundRepo) WithContext(ctx context.Context) repository.PetRepository {
+	r.ctx = ctx
+	return r
+}
+
+func TestWrites_BindRequestContext(t *testing.T) {
+	repo := &boundRepo{mockPetRepository: &mockPetRepository{}}
+	app := NewPetApplication(repo)
+
+	type key struct{}
+	ctx := context.WithValue(context.Background(), key{}, "req")
+	app.SavePet(ctx, &entity.Pet{Uuid: uuid.New(), UuidGuardian: uuid.New(), Name: "Rex", BirthYear: 2020, Breed: "SRD", Specie: entity.Dog})
+	if repo.ctx == nil || repo.ctx.Value(key{}) != "req" {
+		t.Fatalf("expected the write to bind the request context, got %v", repo.ctx)
+	}
+
+	repo.ctx = nil
+	app.GetPet(ctx, uuid.NewString())
+	if repo.ctx == nil || repo.ctx.Value(key{}) != "req" {
+		t.Fatalf("expected the read to bind the request context, got %v", repo.ctx)
+	}
+}
@@ -0,0 +1,62 @@
+package application
+
+import (
+	"context"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/google/uuid"
+)
+
+// TransitionPet move o pet no fluxo de adoção. Na adoção, e na devolução com guardião,
+// o pet passa para esse guardião como numa transferência.
+func (p *petApplication) TransitionPet(ctx context.Context, petUuid string, transition entity.StatusTransition) (*entity.Pet, map[string]string) {
+	id, errData := parsePetUuid(petUuid)
+	if errData != nil {
+		return nil, errData
+	}
+	if errs := transition.Validate(); len(errs) > 0 {
+		return nil, invalidArgument(errs)
+	}
+
+	before, errData := p.loadPet(ctx, id)
+	if errData != nil {
+		return nil, errData
+	}
+	from := before.CurrentStatus()
+	if msg := transition.CheckFrom(from); msg != "" {
+		return nil, map[string]string{"failed_precondition": msg}
+	}
+
+	guardian := transition.Guardian
+	if guardian == before.UuidGuardian {
+		guardian = uuid.Nil
+	}
+	if guardian != uuid.Nil {
+		if errData := p.requireGuardian(ctx, guardian); errData != nil {
+			return nil, errData
+		}
+	}
+
+	changed, errData := p.writer(ctx).ChangePetStatus(id.String(), entity.StatusChange{
+		From:      from,
+		To:        transition.To,
+		Reason:    transition.Reason,
+		Guardian:  guardian,
+		ChangedAt: p.now(),
+	})
+	if errData != nil {
+		return nil, errData
+	}
+	if p.bus != nil {
+		ev := entity.PetEvent{Type: entity.PetUpdated, Pet: *changed, OccurredAt: p.now()}
+		if guardian != uuid.Nil {
+			ev.Type, ev.PreviousGuardian = entity.PetTransferred, before.UuidGuardian
+		}
+		p.bus.Publish(ev)
+	}
+	p.recordAudit(ctx, entity.AuditStatusChange, petChange{before: before, after: changed})
+	if guardian != uuid.Nil {
+		p.dropOwnerRole(changed.Uuid, guardian)
+	}
+	return changed, nil
+}
@@ -0,0 +1,80 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/google/uuid"
+)
+
+func TestTransitionPet_Adoption(t *testing.T) {
+	owner, adopter := uuid.New(), uuid.New()
+	stored := &entity.Pet{Uuid: uuid.New(), Name: "Rex", UuidGuardian: owner, Status: entity.StatusReserved}
+	var got entity.StatusChange
+	repo := &mockPetRepository{
+		getPetsFunc: func([]string) ([]*entity.Pet, map[string]string) {
+			pet := *stored
+			return []*entity.Pet{&pet}, nil
+		},
+		changeStatusFunc: func(id string, change entity.StatusChange) (*entity.Pet, map[string]string) {
+			got = change
+			pet := *stored
+			change.Apply(&pet)
+			return &pet, nil
+		},
+	}
+	bus, audit := &busMock{}, &auditRepoMock{}
+	pg := &petGuardianRepoMock{links: []*entity.PetGuardian{{PetUuid: stored.Uuid, GuardianUuid: adopter, Role: entity.RoleFoster}}}
+	app := NewPetApplication(repo, WithEventBus(bus), WithAudit(audit), WithPetGuardians(pg), WithGuardianCheck(newGuardianRepoMock(adopter)))
+
+	adopted, errData := app.TransitionPet(context.Background(), stored.Uuid.String(), entity.StatusTransition{To: entity.StatusAdopted, Guardian: adopter})
+	if errData != nil {
+		t.Fatalf("unexpected error: %v", errData)
+	}
+	if got.From != entity.StatusReserved || got.Guardian != adopter || got.ChangedAt.IsZero() {
+		t.Fatalf("unexpected change: %+v", got)
+	}
+	if adopted.Status != entity.StatusAdopted || adopted.UuidGuardian != adopter {
+		t.Fatalf("expected the pet adopted by the adopter, got %+v", adopted)
+	}
+	if len(bus.published) != 1 || bus.published[0].Type != entity.PetTransferred || bus.published[0].PreviousGuardian != owner {
+		t.Fatalf("expected a transfer event from the owner, got %+v", bus.published)
+	}
+	if len(audit.entries) != 1 || audit.entries[0].Action != entity.AuditStatusChange || audit.entries[0].PreviousGuardian != owner {
+		t.Fatalf("expected a status change entry with the previous owner, got %+v", audit.entries)
+	}
+	if len(pg.links) != 0 {
+		t.Fatalf("expected the adopter's foster link removed, got %v", pg.links)
+	}
+}
+
+func TestTransitionPet_Rejections(t *testing.T) {
+	stored := &entity.Pet{Uuid: uuid.New(), UuidGuardian: uuid.New()}
+	repo := &mockPetRepository{
+		getPetsFunc: func([]string) ([]*entity.Pet, map[string]string) { return []*entity.Pet{stored}, nil },
+		changeStatusFunc: func(string, entity.StatusChange) (*entity.Pet, map[string]string) {
+			t.Fatal("the status must not change")
+			return nil, nil
+		},
+	}
+	app := NewPetApplication(repo, WithGuardianCheck(newGuardianRepoMock()))
+	ctx := context.Background()
+
+	if _, errData := app.TransitionPet(ctx, "bad", entity.StatusTransition{To: entity.StatusAvailable}); errData["invalid_argument"] == "" {
+		t.Fatalf("expected invalid_argument for the uuid, got %v", errData)
+	}
+	if _, errData := app.TransitionPet(ctx, stored.Uuid.String(), entity.StatusTransition{To: entity.StatusAdopted}); errData["invalid_argument"] == "" {
+		t.Fatalf("expected invalid_argument without adopter, got %v", errData)
+	}
+	// pets sem status estão em intake
+	if _, errData := app.TransitionPet(ctx, stored.Uuid.String(), entity.StatusTransition{To: entity.StatusReserved}); errData["failed_precondition"] != "cannot move pet from intake to reserved" {
+		t.Fatalf("expected failed_precondition, got %v", errData)
+	}
+
+	stored.Status = entity.StatusAdopted
+	transition := entity.StatusTransition{To: entity.StatusReturned, Reason: "allergy", Guardian: uuid.New()}
+	if _, errData := app.TransitionPet(ctx, stored.Uuid.String(), transition); errData["invalid_argument"] == "" {
+		t.Fatalf("expected invalid_argument for an unknown guardian, got %v", errData)
+	}
+}
@@ -0,0 +1,51 @@
+package application
+
+import (
+	"context"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/LuizFJP/pet-ms/domain/repository"
+	"github.com/google/uuid"
+)
+
+type tenantKey struct{}
+
+// ContextWithTenant marca a organização dona da requisição. Todas as operações de pets
+// da requisição ficam restritas aos pets dela.
+func ContextWithTenant(ctx context.Context, tenant string) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenant)
+}
+
+// TenantFromContext devolve "" quando a requisição não tem tenant (instalações de uma
+// organização só e tarefas internas), o que enxerga todos os pets.
+func TenantFromContext(ctx context.Context) string {
+	tenant, _ := ctx.Value(tenantKey{}).(string)
+	return tenant
+}
+
+// forTenant restringe repo ao tenant de ctx.
+func forTenant(ctx context.Context, repo repository.PetRepository) repository.PetRepository {
+	tenant := TenantFromContext(ctx)
+	if tenant == "" {
+		return repo
+	}
+	if scoper, ok := repo.(repository.TenantScoper); ok {
+		return scoper.ForTenant(tenant)
+	}
+	return repo
+}
+
+// inTenant diz se o pet pertence ao tenant de ctx; sem tenant, todos pertencem.
+func inTenant(ctx context.Context, pet *entity.Pet) bool {
+	tenant := TenantFromContext(ctx)
+	return tenant == "" || pet.TenantID == tenant
+}
+
+// tenantOwns confere, pelo pet, os registros buscados pelo próprio uuid (vacinas,
+// prontuários, anexos), que não guardam o tenant.
+func (p *petApplication) tenantOwns(ctx context.Context, petUuid uuid.UUID) bool {
+	if TenantFromContext(ctx) == "" {
+		return true
+	}
+	return p.requirePet(ctx, petUuid) == nil
+}
@@ -0,0 +1,81 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/LuizFJP/pet-ms/domain/repository"
+	"github.com/google/uuid"
+)
+
+// tenantRepo devolve scoped em ForTenant, guardando o tenant pedido.
+type tenantRepo struct {
+	*mockPetRepository
+	scoped *mockPetRepository
+	tenant string
+}
+
+func (r *tenantRepo) ForTenant(tenant string) repository.PetRepository {
+	r.tenant = tenant
+	return r.scoped
+}
+
+func TestGetPet_UsesRequestTenant(t *testing.T) {
+	pet := &entity.Pet{Uuid: uuid.New(), Name: "Rex", TenantID: "shelter"}
+	repo := &tenantRepo{
+		mockPetRepository: &mockPetRepository{},
+		scoped: &mockPetRepository{
+			getFunc: func(string) (*entity.Pet, map[string]string) { return pet, nil },
+		},
+	}
+	app := NewPetApplication(repo)
+
+	got, errData := app.GetPet(ContextWithTenant(context.Background(), "shelter"), pet.Uuid.String())
+	if errData != nil || got.Name != "Rex" {
+		t.Fatalf("expected the scoped repository to answer, got %v %v", got, errData)
+	}
+	if repo.tenant != "shelter" {
+		t.Fatalf("expected the repository scoped to shelter, got %q", repo.tenant)
+	}
+
+	repo.tenant = ""
+	app.GetPet(context.Background(), pet.Uuid.String())
+	if repo.tenant != "" {
+		t.Fatalf("expected no scope without a tenant, got %q", repo.tenant)
+	}
+}
+
+func TestHealthRecords_HideOtherTenants(t *testing.T) {
+	petUuid := uuid.New()
+	app, vr, _ := newHealthApp(petUuid)
+	v, errData := app.AddVaccination(context.Background(), &entity.Vaccination{PetUuid: petUuid, Vaccine: "V10", AdministeredAt: time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)})
+	if errData != nil {
+		t.Fatalf("unexpected error: %v", errData)
+	}
+
+	// na visão do outro tenant o pet não existe
+	app.pr = &tenantRepo{
+		mockPetRepository: app.pr.(*mockPetRepository),
+		scoped: &mockPetRepository{
+			getPetsFunc: func([]string) ([]*entity.Pet, map[string]string) { return nil, nil },
+		},
+	}
+	ctx := ContextWithTenant(context.Background(), "clinic")
+
+	if _, errData := app.UpdateVaccination(ctx, &entity.Vaccination{Uuid: v.Uuid, Vaccine: "V10", Dose: 2, AdministeredAt: v.AdministeredAt}); errData["not_found"] == "" {
+		t.Fatalf("expected not_found for another tenant's vaccination, got %v", errData)
+	}
+	if vr.saved[v.Uuid].Dose == 2 {
+		t.Fatal("expected the vaccination to stay untouched")
+	}
+	if _, errData := app.ListVaccinations(ctx, petUuid.String()); errData["not_found"] == "" {
+		t.Fatalf("expected not_found listing another tenant's pet, got %v", errData)
+	}
+
+	app.ListOverdueVaccinations(ctx, time.Time{}, 0, 0)
+	if vr.tenant != "clinic" {
+		t.Fatalf("expected the overdue listing scoped to clinic, got %q", vr.tenant)
+	}
+}
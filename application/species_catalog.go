@@ -0,0 +1,196 @@
+package application
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/LuizFJP/pet-ms/domain/repository"
+)
+
+const DefaultSpeciesCacheTTL = time.Minute
+
+// SpeciesCatalog mantém o catálogo de espécies em memória. Escritas feitas por esta
+// instância invalidam o cache na hora; as das demais aparecem depois de ttl.
+type SpeciesCatalog struct {
+	repo repository.SpeciesRepository
+	ttl  time.Duration
+	now  func() time.Time
+
+	mu       sync.RWMutex
+	byValue  map[entity.PetType]*entity.Species
+	byCode   map[string]*entity.Species
+	loadedAt time.Time
+}
+
+func NewSpeciesCatalog(repo repository.SpeciesRepository, ttl time.Duration) *SpeciesCatalog {
+	if ttl <= 0 {
+		ttl = DefaultSpeciesCacheTTL
+	}
+	return &SpeciesCatalog{repo: repo, ttl: ttl, now: time.Now}
+}
+
+// Lookup procura a espécie pelo valor gravado no pet. Sem repositório, ou se o
+// catálogo não puder ser carregado, usa as espécies padrão.
+func (c *SpeciesCatalog) Lookup(value entity.PetType) (*entity.Species, bool) {
+	byValue, _ := c.snapshot()
+	species, ok := byValue[value]
+	return species, ok
+}
+
+func (c *SpeciesCatalog) LookupCode(code string) (*entity.Species, bool) {
+	_, byCode := c.snapshot()
+	species, ok := byCode[code]
+	return species, ok
+}
+
+func (c *SpeciesCatalog) snapshot() (map[entity.PetType]*entity.Species, map[string]*entity.Species) {
+	if c == nil || c.repo == nil {
+		return defaultSpeciesIndex()
+	}
+
+	c.mu.RLock()
+	if c.byValue != nil && c.now().Sub(c.loadedAt) < c.ttl {
+		defer c.mu.RUnlock()
+		return c.byValue, c.byCode
+	}
+	c.mu.RUnlock()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.byValue != nil && c.now().Sub(c.loadedAt) < c.ttl {
+		return c.byValue, c.byCode
+	}
+
+	list, errData := c.repo.ListSpecies()
+	if errData != nil {
+		if c.byValue != nil {
+			// mantém a última versão conhecida até o banco voltar
+			return c.byValue, c.byCode
+		}
+		return defaultSpeciesIndex()
+	}
+	c.byValue, c.byCode = indexSpecies(list)
+	c.loadedAt = c.now()
+	return c.byValue, c.byCode
+}
+
+func (c *SpeciesCatalog) invalidate() {
+	c.mu.Lock()
+	c.byValue = nil
+	c.byCode = nil
+	c.mu.Unlock()
+}
+
+func defaultSpeciesIndex() (map[entity.PetType]*entity.Species, map[string]*entity.Species) {
+	defaults := entity.DefaultSpecies()
+	list := make([]*entity.Species, len(defaults))
+	for i := range defaults {
+		list[i] = &defaults[i]
+	}
+	return indexSpecies(list)
+}
+
+func indexSpecies(list []*entity.Species) (map[entity.PetType]*entity.Species, map[string]*entity.Species) {
+	byValue := make(map[entity.PetType]*entity.Species, len(list))
+	byCode := make(map[string]*entity.Species, len(list))
+	for _, species := range list {
+		byValue[species.Value] = species
+		byCode[species.Code] = species
+	}
+	return byValue, byCode
+}
+
+// WithSpeciesCatalog valida Pet.Specie contra o catálogo e habilita o CRUD de espécies.
+func WithSpeciesCatalog(catalog *SpeciesCatalog) Option {
+	return func(p *petApplication) {
+		p.species = catalog
+	}
+}
+
+// unknownSpecies devolve a mensagem de erro quando o pet usa uma espécie fora do catálogo.
+func (p *petApplication) unknownSpecies(pet *entity.Pet) string {
+	if _, ok := p.species.Lookup(pet.Specie); ok {
+		return ""
+	}
+	return fmt.Sprintf("unknown species %d", pet.Specie)
+}
+
+func (p *petApplication) LookupSpecies(value entity.PetType) (*entity.Species, bool) {
+	return p.species.Lookup(value)
+}
+
+func (p *petApplication) LookupSpeciesCode(code string) (*entity.Species, bool) {
+	return p.species.LookupCode(code)
+}
+
+func (p *petApplication) ListSpecies() ([]*entity.Species, map[string]string) {
+	if p.species == nil || p.species.repo == nil {
+		return nil, map[string]string{"unavailable": "species catalog is not enabled"}
+	}
+	return p.species.repo.ListSpecies()
+}
+
+func (p *petApplication) GetSpecies(code string) (*entity.Species, map[string]string) {
+	if p.species == nil || p.species.repo == nil {
+		return nil, map[string]string{"unavailable": "species catalog is not enabled"}
+	}
+	return p.species.repo.GetSpeciesByCode(code)
+}
+
+func (p *petApplication) CreateSpecies(species *entity.Species) (*entity.Species, map[string]string) {
+	if p.species == nil || p.species.repo == nil {
+		return nil, map[string]string{"unavailable": "species catalog is not enabled"}
+	}
+	if errs := species.Validate(); len(errs) > 0 {
+		return nil, invalidArgument(errs)
+	}
+	created, errData := p.species.repo.CreateSpecies(species)
+	if errData == nil {
+		p.species.invalidate()
+	}
+	return created, errData
+}
+
+func (p *petApplication) UpdateSpecies(species *entity.Species) (*entity.Species, map[string]string) {
+	if p.species == nil || p.species.repo == nil {
+		return nil, map[string]string{"unavailable": "species catalog is not enabled"}
+	}
+	if errs := species.Validate(); len(errs) > 0 {
+		return nil, invalidArgument(errs)
+	}
+	updated, errData := p.species.repo.UpdateSpecies(species)
+	if errData == nil {
+		p.species.invalidate()
+	}
+	return updated, errData
+}
+
+func (p *petApplication) DeleteSpecies(code string) map[string]string {
+	if p.species == nil || p.species.repo == nil {
+		return map[string]string{"unavailable": "species catalog is not enabled"}
+	}
+	errData := p.species.repo.DeleteSpecies(code)
+	if errData == nil {
+		p.species.invalidate()
+	}
+	return errData
+}
+
+// invalidArgument junta os erros de validação numa mensagem com a chave invalid_argument.
+func invalidArgument(errs map[string]string) map[string]string {
+	keys := make([]string, 0, len(errs))
+	for key := range errs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, key := range keys {
+		parts = append(parts, key+": "+errs[key])
+	}
+	return map[string]string{"invalid_argument": strings.Join(parts, "; ")}
+}
@@ -0,0 +1,123 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+)
+
+// speciesRepoMock counts list calls to observe caching
+type speciesRepoMock struct {
+	species   []*entity.Species
+	listCalls int
+}
+
+func (r *speciesRepoMock) ListSpecies() ([]*entity.Species, map[string]string) {
+	r.listCalls++
+	return r.species, nil
+}
+
+func (r *speciesRepoMock) GetSpeciesByCode(code string) (*entity.Species, map[string]string) {
+	for _, s := range r.species {
+		if s.Code == code {
+			return s, nil
+		}
+	}
+	return nil, map[string]string{"not_found": "species not found"}
+}
+
+func (r *speciesRepoMock) CreateSpecies(species *entity.Species) (*entity.Species, map[string]string) {
+	species.Value = entity.FirstCustomPetType + entity.PetType(len(r.species))
+	r.species = append(r.species, species)
+	return species, nil
+}
+
+func (r *speciesRepoMock) UpdateSpecies(species *entity.Species) (*entity.Species, map[string]string) {
+	return species, nil
+}
+
+func (r *speciesRepoMock) DeleteSpecies(code string) map[string]string {
+	return nil
+}
+
+func TestSpeciesCatalog_DefaultsWithoutRepository(t *testing.T) {
+	catalog := NewSpeciesCatalog(nil, 0)
+
+	dog, ok := catalog.Lookup(entity.Dog)
+	if !ok || dog.Code != "dog" {
+		t.Fatalf("expected built-in dog, got %+v", dog)
+	}
+	if _, ok := catalog.LookupCode("unicorn"); ok {
+		t.Fatal("unknown code must not be found")
+	}
+}
+
+func TestSpeciesCatalog_CachesAndInvalidatesOnWrite(t *testing.T) {
+	repo := &speciesRepoMock{species: []*entity.Species{{Value: entity.Dog, Code: "dog", DisplayName: "Cachorro"}}}
+	catalog := NewSpeciesCatalog(repo, time.Hour)
+	app := NewPetApplication(&mockPetRepository{}, WithSpeciesCatalog(catalog))
+
+	app.LookupSpecies(entity.Dog)
+	app.LookupSpecies(entity.Dog)
+	if repo.listCalls != 1 {
+		t.Fatalf("expected catalog to be loaded once, got %d loads", repo.listCalls)
+	}
+
+	created, errData := app.CreateSpecies(&entity.Species{Code: "Ferret", DisplayName: "Furão"})
+	if errData != nil {
+		t.Fatalf("unexpected error: %v", errData)
+	}
+	if created.Code != "ferret" {
+		t.Fatalf("expected normalized code, got %q", created.Code)
+	}
+	if _, ok := app.LookupSpeciesCode("ferret"); !ok {
+		t.Fatal("new species must be visible right after creation")
+	}
+	if repo.listCalls != 2 {
+		t.Fatalf("expected reload after write, got %d loads", repo.listCalls)
+	}
+}
+
+func TestSpeciesCatalog_ExpiresAfterTTL(t *testing.T) {
+	repo := &speciesRepoMock{}
+	catalog := NewSpeciesCatalog(repo, time.Minute)
+	now := time.Now()
+	catalog.now = func() time.Time { return now }
+
+	catalog.Lookup(entity.Dog)
+	now = now.Add(2 * time.Minute)
+	catalog.Lookup(entity.Dog)
+
+	if repo.listCalls != 2 {
+		t.Fatalf("expected reload after ttl, got %d loads", repo.listCalls)
+	}
+}
+
+func TestPetApplication_RejectsUnknownSpecies(t *testing.T) {
+	app := NewPetApplication(&mockPetRepository{})
+
+	pet := validBatchPet("Rex")
+	pet.Specie = 42
+	if _, errData := app.SavePet(context.Background(), pet); errData["invalid_argument"] == "" {
+		t.Fatalf("expected invalid_argument, got %v", errData)
+	}
+
+	results, _, _ := app.BatchSavePets(context.Background(), []*entity.Pet{pet}, BatchPerItem)
+	if results[0].Errors["specie"] == "" {
+		t.Fatalf("expected specie error on the item, got %v", results[0].Errors)
+	}
+}
+
+func TestPetApplication_SpeciesCrudNeedsCatalog(t *testing.T) {
+	app := NewPetApplication(&mockPetRepository{})
+	if _, errData := app.ListSpecies(); errData["unavailable"] == "" {
+		t.Fatalf("expected unavailable, got %v", errData)
+	}
+
+	app = NewPetApplication(&mockPetRepository{}, WithSpeciesCatalog(NewSpeciesCatalog(&speciesRepoMock{}, 0)))
+	if _, errData := app.CreateSpecies(&entity.Species{Code: "x"}); errData["invalid_argument"] == "" {
+		t.Fatalf("expected invalid_argument, got %v", errData)
+	}
+}
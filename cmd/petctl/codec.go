@@ -0,0 +1,287 @@
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/google/uuid"
+)
+
+const (
+	formatCSV    = "csv"
+	formatNDJSON = "ndjson"
+)
+
+var csvHeader = []string{
+	"uuid", "uuid_guardian", "name", "birth_year", "breed", "specie", "birth_date", "birth_date_accuracy",
+	"sex", "neutered", "color", "markings", "microchip_number", "notes",
+}
+
+// birthDateLayout é o formato da coluna birth_date. As colunas depois de specie são
+// opcionais; fotos e histórico de peso só passam pelo NDJSON.
+const birthDateLayout = "2006-01-02"
+
+// record é uma linha lida do arquivo, com o conteúdo original para o arquivo de rejeitados.
+type record struct {
+	line int
+	raw  []string
+	pet  *entity.Pet
+	errs map[string]string
+}
+
+// readRecords lê o arquivo inteiro; para CSV também devolve o cabeçalho original.
+func readRecords(format string, r io.Reader) ([]string, []*record, error) {
+	switch format {
+	case formatCSV:
+		return readCSV(r)
+	case formatNDJSON:
+		records, err := readNDJSON(r)
+		return nil, records, err
+	default:
+		return nil, nil, fmt.Errorf("unknown format %q", format)
+	}
+}
+
+func readCSV(r io.Reader) ([]string, []*record, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading csv header: %w", err)
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, required := range []string{"uuid_guardian", "name", "birth_year", "breed", "specie"} {
+		if _, ok := columns[required]; !ok {
+			return nil, nil, fmt.Errorf("csv header is missing column %q", required)
+		}
+	}
+
+	var records []*record
+	for line := 2; ; line++ {
+		row, err := reader.Read()
+		if err == io.EOF {
+			return header, records, nil
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		records = append(records, parseCSVRow(line, row, columns))
+	}
+}
+
+func parseCSVRow(line int, row []string, columns map[string]int) *record {
+	rec := &record{line: line, raw: row, errs: map[string]string{}}
+	get := func(name string) string {
+		if i, ok := columns[name]; ok && i < len(row) {
+			return strings.TrimSpace(row[i])
+		}
+		return ""
+	}
+
+	pet := &entity.Pet{Name: get("name"), Breed: get("breed")}
+	if v := get("uuid"); v != "" {
+		id, err := uuid.Parse(v)
+		if err != nil {
+			rec.errs["uuid"] = "invalid uuid"
+		}
+		pet.Uuid = id
+	}
+	guardian, err := uuid.Parse(get("uuid_guardian"))
+	if err != nil {
+		rec.errs["uuid_guardian"] = "invalid uuid"
+	}
+	pet.UuidGuardian = guardian
+	if v := get("birth_year"); v != "" {
+		year, err := strconv.Atoi(v)
+		if err != nil {
+			rec.errs["birth_year"] = "not a number"
+		}
+		pet.BirthYear = year
+	}
+	specie, err := strconv.Atoi(get("specie"))
+	if err != nil {
+		rec.errs["specie"] = "not a number"
+	}
+	pet.Specie = entity.PetType(specie)
+	if v := get("birth_date"); v != "" {
+		date, err := time.Parse(birthDateLayout, v)
+		if err != nil {
+			rec.errs["birth_date"] = "expected YYYY-MM-DD"
+		}
+		pet.BirthDate = &date
+	}
+	pet.BirthDateAccuracy = entity.BirthDateAccuracy(strings.ToLower(get("birth_date_accuracy")))
+	pet.Sex = entity.PetSex(strings.ToLower(get("sex")))
+	if v := get("neutered"); v != "" {
+		neutered, err := strconv.ParseBool(v)
+		if err != nil {
+			rec.errs["neutered"] = "expected true or false"
+		}
+		pet.Neutered = &neutered
+	}
+	pet.Color = get("color")
+	pet.Markings = get("markings")
+	pet.MicrochipNumber = get("microchip_number")
+	pet.Notes = get("notes")
+
+	rec.pet = pet
+	return rec
+}
+
+func readNDJSON(r io.Reader) ([]*record, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var records []*record
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		rec := &record{line: line, raw: []string{text}, errs: map[string]string{}}
+		pet := &entity.Pet{}
+		if err := json.Unmarshal([]byte(text), pet); err != nil {
+			rec.errs["json"] = err.Error()
+		}
+		rec.pet = pet
+		records = append(records, rec)
+	}
+	return records, scanner.Err()
+}
+
+type petWriter interface {
+	Write(pet *entity.Pet) error
+	Flush() error
+}
+
+func newPetWriter(format string, w io.Writer) (petWriter, error) {
+	switch format {
+	case formatCSV:
+		writer := csv.NewWriter(w)
+		if err := writer.Write(csvHeader); err != nil {
+			return nil, err
+		}
+		return &csvPetWriter{writer}, nil
+	case formatNDJSON:
+		return &ndjsonPetWriter{json.NewEncoder(w)}, nil
+	default:
+		return nil, fmt.Errorf("unknown format %q", format)
+	}
+}
+
+type csvPetWriter struct {
+	w *csv.Writer
+}
+
+func (c *csvPetWriter) Write(pet *entity.Pet) error {
+	birthDate := ""
+	if pet.BirthDate != nil {
+		birthDate = pet.BirthDate.Format(birthDateLayout)
+	}
+	neutered := ""
+	if pet.Neutered != nil {
+		neutered = strconv.FormatBool(*pet.Neutered)
+	}
+	return c.w.Write([]string{
+		pet.Uuid.String(),
+		pet.UuidGuardian.String(),
+		pet.Name,
+		strconv.Itoa(pet.BirthYear),
+		pet.Breed,
+		strconv.Itoa(int(pet.Specie)),
+		birthDate,
+		string(pet.BirthDateAccuracy),
+		string(pet.Sex),
+		neutered,
+		pet.Color,
+		pet.Markings,
+		pet.MicrochipNumber,
+		pet.Notes,
+	})
+}
+
+func (c *csvPetWriter) Flush() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+type ndjsonPetWriter struct {
+	enc *json.Encoder
+}
+
+func (n *ndjsonPetWriter) Write(pet *entity.Pet) error {
+	return n.enc.Encode(pet)
+}
+
+func (n *ndjsonPetWriter) Flush() error {
+	return nil
+}
+
+// writeRejects grava as linhas rejeitadas no mesmo formato da entrada, junto com os erros.
+func writeRejects(format string, w io.Writer, header []string, rejected []*record) error {
+	switch format {
+	case formatCSV:
+		writer := csv.NewWriter(w)
+		if err := writer.Write(append(append([]string{}, header...), "line", "errors")); err != nil {
+			return err
+		}
+		for _, rec := range rejected {
+			row := append(append([]string{}, rec.raw...), strconv.Itoa(rec.line), formatErrors(rec.errs))
+			if err := writer.Write(row); err != nil {
+				return err
+			}
+		}
+		writer.Flush()
+		return writer.Error()
+	case formatNDJSON:
+		enc := json.NewEncoder(w)
+		for _, rec := range rejected {
+			entry := struct {
+				Line   int               `json:"line"`
+				Record json.RawMessage   `json:"record"`
+				Errors map[string]string `json:"errors"`
+			}{rec.line, rawJSON(rec.raw[0]), rec.errs}
+			if err := enc.Encode(entry); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown format %q", format)
+	}
+}
+
+func rawJSON(text string) json.RawMessage {
+	if json.Valid([]byte(text)) {
+		return json.RawMessage(text)
+	}
+	quoted, _ := json.Marshal(text)
+	return quoted
+}
+
+func formatErrors(errs map[string]string) string {
+	keys := make([]string, 0, len(errs))
+	for k := range errs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, len(keys))
+	for i, k := range keys {
+		parts[i] = k + ": " + errs[k]
+	}
+	return strings.Join(parts, "; ")
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+)
+
+func TestReadRecords_CSV(t *testing.T) {
+	guardian := uuid.New()
+	input := "name,uuid_guardian,birth_year,breed,specie\n" +
+		"Rex," + guardian.String() + ",2020,SRD,0\n" +
+		"Mia,not-a-uuid,abc,Siamês,1\n"
+
+	header, records, err := readRecords(formatCSV, strings.NewReader(input))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"name", "uuid_guardian", "birth_year", "breed", "specie"}, header)
+	require.Len(t, records, 2)
+
+	assert.Equal(t, 2, records[0].line)
+	assert.Empty(t, records[0].errs)
+	assert.Equal(t, "Rex", records[0].pet.Name)
+	assert.Equal(t, guardian, records[0].pet.UuidGuardian)
+	assert.Equal(t, 2020, records[0].pet.BirthYear)
+	assert.Equal(t, entity.Dog, records[0].pet.Specie)
+
+	assert.Equal(t, 3, records[1].line)
+	assert.Contains(t, records[1].errs, "uuid_guardian")
+	assert.Contains(t, records[1].errs, "birth_year")
+}
+
+func TestReadRecords_CSVMissingColumn(t *testing.T) {
+	_, _, err := readRecords(formatCSV, strings.NewReader("name,breed\nRex,SRD\n"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "uuid_guardian")
+}
+
+func TestReadRecords_NDJSON(t *testing.T) {
+	guardian := uuid.New()
+	input := `{"uuid_guardian":"` + guardian.String() + `","name":"Rex","birth_year":2020,"breed":"SRD","specie":0}` + "\n" +
+		"\n" +
+		`{"name": 1}` + "\n"
+
+	_, records, err := readRecords(formatNDJSON, strings.NewReader(input))
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Empty(t, records[0].errs)
+	assert.Equal(t, guardian, records[0].pet.UuidGuardian)
+	assert.Equal(t, 3, records[1].line)
+	assert.Contains(t, records[1].errs, "json")
+}
+
+func TestPetWriter_CSVRoundTrip(t *testing.T) {
+	pet := &entity.Pet{Uuid: uuid.New(), UuidGuardian: uuid.New(), Name: "Rex, o bravo", BirthYear: 2020, Breed: "SRD", Specie: entity.Cat}
+
+	var buf bytes.Buffer
+	writer, err := newPetWriter(formatCSV, &buf)
+	require.NoError(t, err)
+	require.NoError(t, writer.Write(pet))
+	require.NoError(t, writer.Flush())
+
+	_, records, err := readRecords(formatCSV, &buf)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Empty(t, records[0].errs)
+	assert.Equal(t, *pet, *records[0].pet)
+}
+
+func TestPetWriter_NDJSON(t *testing.T) {
+	pet := &entity.Pet{Uuid: uuid.New(), UuidGuardian: uuid.New(), Name: "Rex", Breed: "SRD"}
+
+	var buf bytes.Buffer
+	writer, err := newPetWriter(formatNDJSON, &buf)
+	require.NoError(t, err)
+	require.NoError(t, writer.Write(pet))
+
+	var got entity.Pet
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &got))
+	assert.Equal(t, pet.Uuid, got.Uuid)
+}
+
+func TestWriteRejects_CSV(t *testing.T) {
+	rejected := []*record{{line: 3, raw: []string{"Mia", "x"}, errs: map[string]string{"uuid_guardian": "invalid uuid", "birth_year": "not a number"}}}
+
+	var buf bytes.Buffer
+	require.NoError(t, writeRejects(formatCSV, &buf, []string{"name", "uuid_guardian"}, rejected))
+	assert.Equal(t, "name,uuid_guardian,line,errors\nMia,x,3,birth_year: not a number; uuid_guardian: invalid uuid\n", buf.String())
+}
+
+func TestWriteRejects_NDJSON(t *testing.T) {
+	rejected := []*record{
+		{line: 1, raw: []string{`{"name":""}`}, errs: map[string]string{"pet name is required": "pet name is empty"}},
+		{line: 2, raw: []string{`{broken`}, errs: map[string]string{"json": "invalid"}},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, writeRejects(formatNDJSON, &buf, nil, rejected))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+	assert.JSONEq(t, `{"line":1,"record":{"name":""},"errors":{"pet name is required":"pet name is empty"}}`, lines[0])
+	assert.JSONEq(t, `{"line":2,"record":"{broken","errors":{"json":"invalid"}}`, lines[1])
+}
@@ -0,0 +1,291 @@
+// petctl importa e exporta pets em CSV ou NDJSON, direto no banco ou
+// através de um servidor pet-ms em execução. No banco direto a aplicação é montada
+// como a do servidor, pelas mesmas variáveis de ambiente.
+//
+//	petctl import -format csv -file pets.csv -rejects rejeitados.csv [-dry-run] [-tenant abrigo]
+//	petctl export -format ndjson -file pets.ndjson -target grpc -addr localhost:50051 -tenant abrigo
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	"github.com/LuizFJP/pet-ms/application"
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/LuizFJP/pet-ms/init/bootstrap"
+	pb "github.com/LuizFJP/pet-ms/proto"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+type options struct {
+	format  string
+	file    string
+	rejects string
+	dryRun  bool
+
+	target  string
+	addr    string
+	timeout time.Duration
+	tenant  string
+
+	guardian string
+	breed    string
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	command := os.Args[1]
+	opts, err := parseFlags(command, os.Args[2:])
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	switch command {
+	case "import":
+		err = runImportCommand(opts)
+	case "export":
+		err = runExportCommand(opts)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: petctl <import|export> [flags]")
+}
+
+func parseFlags(command string, args []string) (options, error) {
+	if command != "import" && command != "export" {
+		usage()
+		return options{}, fmt.Errorf("unknown command %q", command)
+	}
+
+	opts := options{}
+	fs := flag.NewFlagSet(command, flag.ContinueOnError)
+	fs.StringVar(&opts.format, "format", formatCSV, "file format: csv or ndjson")
+	fs.StringVar(&opts.file, "file", "-", "input/output file, - for stdin/stdout")
+	fs.StringVar(&opts.target, "target", "db", "db (wired like the server, from STORAGE_BACKEND, DB_* and the other env vars) or grpc")
+	fs.StringVar(&opts.addr, "addr", getEnv("GRPC_ADDR", "localhost:50051"), "pet-ms address when target is grpc")
+	fs.DurationVar(&opts.timeout, "timeout", 10*time.Minute, "timeout for the whole operation")
+	fs.StringVar(&opts.tenant, "tenant", "", "organization (x-tenant-id) whose pets are imported or exported")
+	if command == "import" {
+		fs.StringVar(&opts.rejects, "rejects", "", "file that receives the rejected rows and their errors")
+		fs.BoolVar(&opts.dryRun, "dry-run", false, "only validate the file, nothing is written")
+	} else {
+		fs.StringVar(&opts.guardian, "guardian", "", "export only pets of this guardian uuid")
+		fs.StringVar(&opts.breed, "breed", "", "export only pets of this breed")
+	}
+	return opts, fs.Parse(args)
+}
+
+func runImportCommand(opts options) error {
+	in, err := openInput(opts.file)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	header, records, err := readRecords(opts.format, in)
+	if err != nil {
+		return err
+	}
+
+	var t target
+	if !opts.dryRun {
+		var closeTarget func()
+		t, closeTarget, err = newTarget(opts)
+		if err != nil {
+			return err
+		}
+		defer closeTarget()
+	}
+
+	imported, rejected, err := importRecords(t, records, opts.dryRun)
+	if err != nil {
+		return err
+	}
+
+	if opts.rejects != "" && len(rejected) > 0 {
+		out, err := os.Create(opts.rejects)
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+		if err := writeRejects(opts.format, out, header, rejected); err != nil {
+			return err
+		}
+	}
+
+	verb := "imported"
+	if opts.dryRun {
+		verb = "valid"
+	}
+	log.Printf("%d records read, %d %s, %d rejected", len(records), imported, verb, len(rejected))
+	return nil
+}
+
+// importRecords valida os registros e envia os válidos para o target. Em dry-run
+// só a validação roda e a contagem devolvida é a de registros válidos.
+func importRecords(t target, records []*record, dryRun bool) (int, []*record, error) {
+	var rejected []*record
+	var pending []*record
+	for _, rec := range records {
+		if len(rec.errs) == 0 {
+			for k, v := range rec.pet.Validate("create") {
+				rec.errs[k] = v
+			}
+		}
+		if len(rec.errs) > 0 {
+			rejected = append(rejected, rec)
+			continue
+		}
+		pending = append(pending, rec)
+	}
+
+	if dryRun {
+		return len(pending), rejected, nil
+	}
+	if len(pending) == 0 {
+		return 0, rejected, nil
+	}
+
+	pets := make([]*entity.Pet, len(pending))
+	for i, rec := range pending {
+		pets[i] = rec.pet
+	}
+	imported, itemErrs, err := t.Import(pets)
+	if err != nil {
+		return 0, nil, err
+	}
+	for i, rec := range pending {
+		if errs, ok := itemErrs[i]; ok {
+			rec.errs = errs
+			rejected = append(rejected, rec)
+		}
+	}
+	return imported, rejected, nil
+}
+
+func runExportCommand(opts options) error {
+	filter := entity.PetFilter{Breed: opts.breed}
+	if opts.guardian != "" {
+		guardian, err := uuid.Parse(opts.guardian)
+		if err != nil {
+			return fmt.Errorf("invalid -guardian: %w", err)
+		}
+		filter.UuidGuardian = guardian
+	}
+
+	t, closeTarget, err := newTarget(opts)
+	if err != nil {
+		return err
+	}
+	defer closeTarget()
+
+	out, err := openOutput(opts.file)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	writer, err := newPetWriter(opts.format, out)
+	if err != nil {
+		return err
+	}
+
+	exported := 0
+	err = t.Export(filter, func(pet *entity.Pet) error {
+		exported++
+		return writer.Write(pet)
+	})
+	if err != nil {
+		return err
+	}
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+
+	log.Printf("%d pets exported", exported)
+	return nil
+}
+
+func newTarget(opts options) (target, func(), error) {
+	switch opts.target {
+	case "db":
+		cfg := bootstrap.LoadConfig()
+		// fora do docker-compose o banco costuma estar na máquina do operador
+		cfg.DBHost = getEnv("DB_HOST", "localhost")
+		cfg.DisableWorkers = true
+		app, cleanup, err := bootstrap.App(cfg)
+		if err != nil {
+			return nil, nil, err
+		}
+		ctx := application.ContextWithActor(context.Background(), cliActor())
+		if opts.tenant != "" {
+			ctx = application.ContextWithTenant(ctx, opts.tenant)
+		}
+		return &dbTarget{ctx: ctx, app: *app}, cleanup, nil
+	case "grpc":
+		conn, err := grpc.NewClient(opts.addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			return nil, nil, err
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), opts.timeout)
+		ctx = metadata.AppendToOutgoingContext(ctx, "x-principal", cliActor().Principal)
+		if opts.tenant != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, "x-tenant-id", opts.tenant)
+		}
+		closeTarget := func() {
+			cancel()
+			conn.Close()
+		}
+		return &grpcTarget{ctx: ctx, client: pb.NewPetServiceClient(conn)}, closeTarget, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown target %q", opts.target)
+	}
+}
+
+// cliActor identifica o operador nas entradas de auditoria.
+func cliActor() application.Actor {
+	return application.Actor{Principal: "petctl:" + getEnv("USER", "unknown"), RequestID: uuid.NewString()}
+}
+
+func openInput(path string) (io.ReadCloser, error) {
+	if path == "-" {
+		return io.NopCloser(os.Stdin), nil
+	}
+	return os.Open(path)
+}
+
+func openOutput(path string) (io.WriteCloser, error) {
+	if path == "-" {
+		return nopWriteCloser{os.Stdout}, nil
+	}
+	return os.Create(path)
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
+
+func getEnv(key, def string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return def
+}
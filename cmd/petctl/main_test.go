@@ -0,0 +1,146 @@
+package main
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/LuizFJP/pet-ms/application"
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/LuizFJP/pet-ms/infrastructure/persistence"
+)
+
+type targetMock struct {
+	imported []*entity.Pet
+	itemErrs map[int]map[string]string
+}
+
+func (m *targetMock) Import(pets []*entity.Pet) (int, map[int]map[string]string, error) {
+	m.imported = pets
+	return len(pets) - len(m.itemErrs), m.itemErrs, nil
+}
+
+func (m *targetMock) Export(filter entity.PetFilter, send func(*entity.Pet) error) error {
+	return nil
+}
+
+func newRecord(line int, name string) *record {
+	return &record{
+		line: line,
+		errs: map[string]string{},
+		pet:  &entity.Pet{UuidGuardian: uuid.New(), Name: name, BirthYear: 2020, Breed: "SRD"},
+	}
+}
+
+func TestImportRecords_DryRunOnlyValidates(t *testing.T) {
+	records := []*record{newRecord(2, "Rex"), newRecord(3, "")}
+
+	valid, rejected, err := importRecords(nil, records, true)
+	require.NoError(t, err)
+	assert.Equal(t, 1, valid)
+	require.Len(t, rejected, 1)
+	assert.Equal(t, 3, rejected[0].line)
+	assert.Contains(t, rejected[0].errs, "pet name is required")
+}
+
+func TestImportRecords_SendsValidRecordsAndCollectsTargetErrors(t *testing.T) {
+	parseFailure := newRecord(4, "Bad")
+	parseFailure.errs["birth_year"] = "not a number"
+	records := []*record{newRecord(2, "Rex"), newRecord(3, "Thor"), parseFailure}
+	target := &targetMock{itemErrs: map[int]map[string]string{1: {"db_error": "boom"}}}
+
+	imported, rejected, err := importRecords(target, records, false)
+	require.NoError(t, err)
+	assert.Equal(t, 1, imported)
+	require.Len(t, target.imported, 2, "records that failed parsing must not be sent")
+	require.Len(t, rejected, 2)
+	assert.Equal(t, 4, rejected[0].line)
+	assert.Equal(t, 3, rejected[1].line)
+	assert.Equal(t, "boom", rejected[1].errs["db_error"])
+}
+
+func TestDBTarget_ImportAndExport(t *testing.T) {
+	db, err := gorm.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+	db.LogMode(false)
+	db.DB().SetMaxOpenConns(1)
+	require.NoError(t, db.AutoMigrate(&entity.Pet{}).Error)
+
+	target := &dbTarget{ctx: context.Background(), app: application.NewPetApplication(persistence.NewPetRepository(db))}
+	guardian := uuid.New()
+	pets := []*entity.Pet{
+		{UuidGuardian: guardian, Name: "Rex", BirthYear: 2020, Breed: "SRD"},
+		{UuidGuardian: uuid.New(), Name: "Mia", BirthYear: 2021, Breed: "SRD", Specie: entity.Cat},
+	}
+
+	imported, itemErrs, err := target.Import(pets)
+	require.NoError(t, err)
+	assert.Equal(t, 2, imported)
+	assert.Empty(t, itemErrs)
+	assert.NotEqual(t, uuid.Nil, pets[0].Uuid, "missing uuids are generated on import")
+
+	var exported []*entity.Pet
+	err = target.Export(entity.PetFilter{UuidGuardian: guardian}, func(pet *entity.Pet) error {
+		exported = append(exported, pet)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, exported, 1)
+	assert.Equal(t, "Rex", exported[0].Name)
+}
+
+func TestNewTarget_DBUsesServerWiringAndTenant(t *testing.T) {
+	t.Setenv("STORAGE_BACKEND", "sqlite")
+	t.Setenv("SQLITE_PATH", filepath.Join(t.TempDir(), "pets.db"))
+
+	shelter, closeShelter, err := newTarget(options{target: "db", tenant: "shelter"})
+	require.NoError(t, err)
+	pets := []*entity.Pet{
+		{UuidGuardian: uuid.New(), Name: "Rex", BirthYear: 2020, Breed: "SRD", Specie: entity.Dog},
+		{UuidGuardian: uuid.New(), Name: "Nemo", BirthYear: 2020, Breed: "SRD", Specie: entity.PetType(99)},
+	}
+	imported, itemErrs, err := shelter.Import(pets)
+	require.NoError(t, err)
+	assert.Equal(t, 1, imported)
+	assert.Contains(t, itemErrs[1], "specie", "the species catalog must be checked")
+
+	var exported []*entity.Pet
+	require.NoError(t, shelter.Export(entity.PetFilter{}, func(pet *entity.Pet) error {
+		exported = append(exported, pet)
+		return nil
+	}))
+	require.Len(t, exported, 1)
+	assert.Equal(t, "shelter", exported[0].TenantID)
+	closeShelter()
+
+	clinic, closeClinic, err := newTarget(options{target: "db", tenant: "clinic"})
+	require.NoError(t, err)
+	defer closeClinic()
+	require.NoError(t, clinic.Export(entity.PetFilter{}, func(pet *entity.Pet) error {
+		t.Fatalf("another tenant must not export %s", pet.Name)
+		return nil
+	}))
+}
+
+func TestNewTarget_GRPCSendsTenant(t *testing.T) {
+	target, closeTarget, err := newTarget(options{target: "grpc", addr: "localhost:0", timeout: time.Minute, tenant: "shelter"})
+	require.NoError(t, err)
+	defer closeTarget()
+
+	md, _ := metadata.FromOutgoingContext(target.(*grpcTarget).ctx)
+	assert.Equal(t, []string{"shelter"}, md.Get("x-tenant-id"))
+}
+
+func TestParseFlags_RejectsUnknownCommand(t *testing.T) {
+	_, err := parseFlags("purge", nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/LuizFJP/pet-ms/application"
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	pb "github.com/LuizFJP/pet-ms/proto"
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	accuracyEnumPrefix = "BIRTH_DATE_ACCURACY_"
+	sexEnumPrefix      = "PET_SEX_"
+)
+
+// target é o destino do import/export: o banco direto ou um servidor pet-ms.
+type target interface {
+	Import(pets []*entity.Pet) (int, map[int]map[string]string, error)
+	Export(filter entity.PetFilter, send func(*entity.Pet) error) error
+}
+
+type dbTarget struct {
+	ctx context.Context
+	app application.PetApplicationInterface
+}
+
+func (d *dbTarget) Import(pets []*entity.Pet) (int, map[int]map[string]string, error) {
+	for _, pet := range pets {
+		if pet.Uuid == uuid.Nil {
+			pet.Uuid = uuid.New()
+		}
+	}
+	imported, itemErrs := d.app.ImportPets(d.ctx, pets)
+	return imported, itemErrs, nil
+}
+
+func (d *dbTarget) Export(filter entity.PetFilter, send func(*entity.Pet) error) error {
+	var sendErr error
+	errData := d.app.ExportPets(d.ctx, filter, 0, func(pet *entity.Pet) error {
+		sendErr = send(pet)
+		return sendErr
+	})
+	if sendErr != nil {
+		return sendErr
+	}
+	if errData != nil {
+		return errors.New(formatErrors(errData))
+	}
+	return nil
+}
+
+type grpcTarget struct {
+	ctx    context.Context
+	client pb.PetServiceClient
+}
+
+func (g *grpcTarget) Import(pets []*entity.Pet) (int, map[int]map[string]string, error) {
+	stream, err := g.client.ImportPets(g.ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	for start := 0; start < len(pets); start += application.ImportChunkSize {
+		end := start + application.ImportChunkSize
+		if end > len(pets) {
+			end = len(pets)
+		}
+		chunk := &pb.ImportPetsRequest{}
+		for _, pet := range pets[start:end] {
+			item := &pb.CreatePetRequest{
+				UuidGuardian:    pet.UuidGuardian.String(),
+				Name:            pet.Name,
+				BirthYear:       uint64(pet.BirthYear),
+				Breed:           pet.Breed,
+				Specie:          uint64(pet.Specie),
+				Sex:             pb.PetSex(pb.PetSex_value[sexEnumPrefix+strings.ToUpper(string(pet.Sex))]),
+				Color:           pet.Color,
+				Markings:        pet.Markings,
+				MicrochipNumber: pet.MicrochipNumber,
+				PhotoUrls:       pet.Photos,
+				Notes:           pet.Notes,
+			}
+			if pet.Neutered != nil {
+				item.Neutered = wrapperspb.Bool(*pet.Neutered)
+			}
+			if latest := pet.WeightHistory.Latest(); latest != nil {
+				// o servidor só recebe o peso atual; o histórico não é importado
+				item.WeightGrams = uint32(latest.Grams)
+			}
+			if pet.BirthDate != nil {
+				item.BirthDate = &date.Date{
+					Year:  int32(pet.BirthDate.Year()),
+					Month: int32(pet.BirthDate.Month()),
+					Day:   int32(pet.BirthDate.Day()),
+				}
+				item.BirthDateAccuracy = pb.BirthDateAccuracy(pb.BirthDateAccuracy_value[accuracyEnumPrefix+strings.ToUpper(string(pet.BirthDateAccuracy))])
+			}
+			chunk.Pets = append(chunk.Pets, item)
+		}
+		if err := stream.Send(chunk); err != nil {
+			return 0, nil, err
+		}
+	}
+
+	summary, err := stream.CloseAndRecv()
+	if err != nil {
+		return 0, nil, err
+	}
+	itemErrs := map[int]map[string]string{}
+	for _, item := range summary.Errors {
+		itemErrs[int(item.Record)] = item.Errors
+	}
+	return int(summary.Imported), itemErrs, nil
+}
+
+func (g *grpcTarget) Export(filter entity.PetFilter, send func(*entity.Pet) error) error {
+	req := &pb.ExportPetsRequest{
+		Breed:         filter.Breed,
+		BirthYearFrom: uint64(filter.BirthYearFrom),
+		BirthYearTo:   uint64(filter.BirthYearTo),
+	}
+	if filter.UuidGuardian != uuid.Nil {
+		req.UuidGuardian = filter.UuidGuardian.String()
+	}
+	for _, specie := range filter.Species {
+		req.Species = append(req.Species, uint64(specie))
+	}
+
+	stream, err := g.client.ExportPets(g.ctx, req)
+	if err != nil {
+		return err
+	}
+	for {
+		res, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := send(petFromResponse(res)); err != nil {
+			return err
+		}
+	}
+}
+
+func petFromResponse(res *pb.GetPetResponse) *entity.Pet {
+	specie, _ := strconv.Atoi(res.Specie)
+	petUuid, _ := uuid.Parse(res.Uuid)
+	guardian, _ := uuid.Parse(res.UuidGuardian)
+	pet := &entity.Pet{
+		NIdentification: uint(res.NIdentification),
+		Uuid:            petUuid,
+		UuidGuardian:    guardian,
+		Name:            res.Name,
+		BirthYear:       int(res.BirthYear),
+		Breed:           res.Breed,
+		Specie:          entity.PetType(specie),
+		Color:           res.Color,
+		Markings:        res.Markings,
+		MicrochipNumber: res.MicrochipNumber,
+		Photos:          res.PhotoUrls,
+		Notes:           res.Notes,
+	}
+	if res.Sex != pb.PetSex_PET_SEX_UNSPECIFIED {
+		pet.Sex = entity.PetSex(strings.ToLower(strings.TrimPrefix(res.Sex.String(), sexEnumPrefix)))
+	}
+	if res.Neutered != nil {
+		neutered := res.Neutered.Value
+		pet.Neutered = &neutered
+	}
+	for _, m := range res.WeightHistory {
+		pet.WeightHistory = append(pet.WeightHistory, entity.WeightMeasurement{Grams: int(m.Grams), MeasuredAt: m.MeasuredAt.AsTime()})
+	}
+	if d := res.BirthDate; d != nil {
+		// a resposta zera dia e mês além da precisão; no arquivo eles voltam como 1
+		born := time.Date(int(d.Year), time.Month(max(d.Month, 1)), int(max(d.Day, 1)), 0, 0, 0, 0, time.UTC)
+		pet.BirthDate = &born
+		pet.BirthDateAccuracy = entity.BirthDateAccuracy(strings.ToLower(strings.TrimPrefix(res.BirthDateAccuracy.String(), accuracyEnumPrefix)))
+	}
+	return pet
+}
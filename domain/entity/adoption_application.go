@@ -0,0 +1,121 @@
+package entity
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ApplicationStatus string
+
+const (
+	ApplicationPending   ApplicationStatus = "pending"
+	ApplicationApproved  ApplicationStatus = "approved"
+	ApplicationRejected  ApplicationStatus = "rejected"
+	ApplicationWithdrawn ApplicationStatus = "withdrawn"
+)
+
+const (
+	MaxApplicationMessageLength = 2000
+	MaxDecisionReasonLength     = 500
+)
+
+// CompetingApplicationReason é o motivo gravado nos pedidos recusados porque outro
+// pedido do mesmo pet foi aprovado.
+const CompetingApplicationReason = "another application for this pet was approved"
+
+// AdoptionApplication é o pedido de um guardião para adotar um pet disponível. Só
+// pedidos pendentes mudam de status, e cada guardião tem no máximo um pedido pendente
+// por pet.
+type AdoptionApplication struct {
+	ID            uint              `gorm:"primary_key" json:"id"`
+	Uuid          uuid.UUID         `gorm:"unique_index" json:"uuid"`
+	PetUuid       uuid.UUID         `gorm:"index" json:"pet_uuid"`
+	ApplicantUuid uuid.UUID         `gorm:"index" json:"applicant_uuid"`
+	TenantID      string            `gorm:"type:varchar(64);index" json:"tenant_id,omitempty"`
+	Status        ApplicationStatus `gorm:"type:varchar(16)" json:"status"`
+	Message       string            `gorm:"type:text" json:"message,omitempty"`
+	// DecisionReason, DecidedBy e DecidedAt ficam vazios enquanto o pedido está pendente.
+	DecisionReason string     `gorm:"type:text" json:"decision_reason,omitempty"`
+	DecidedBy      string     `json:"decided_by,omitempty"`
+	DecidedAt      *time.Time `json:"decided_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+// Validate checa um pedido novo e o deixa pendente.
+func (a *AdoptionApplication) Validate() map[string]string {
+	errorMessages := make(map[string]string)
+
+	if a.PetUuid == uuid.Nil {
+		errorMessages["pet_uuid"] = "pet uuid is missing or invalid"
+	}
+	if a.ApplicantUuid == uuid.Nil {
+		errorMessages["applicant_uuid"] = "applicant uuid is missing or invalid"
+	}
+	a.Message = strings.TrimSpace(a.Message)
+	checkLength(errorMessages, "message", a.Message, MaxApplicationMessageLength)
+
+	a.Status = ApplicationPending
+	return errorMessages
+}
+
+// ApplicationDecision encerra um pedido pendente. Aprovação e recusa são da equipe do
+// abrigo; a desistência é do próprio guardião.
+type ApplicationDecision struct {
+	Status    ApplicationStatus
+	Reason    string
+	DecidedBy string
+	DecidedAt time.Time
+}
+
+func (d *ApplicationDecision) Validate() map[string]string {
+	errorMessages := make(map[string]string)
+
+	switch d.Status {
+	case ApplicationApproved, ApplicationRejected, ApplicationWithdrawn:
+	default:
+		errorMessages["status"] = "decision must be approved, rejected or withdrawn"
+	}
+	d.Reason = strings.TrimSpace(d.Reason)
+	checkLength(errorMessages, "reason", d.Reason, MaxDecisionReasonLength)
+	return errorMessages
+}
+
+// Apply grava a decisão no pedido.
+func (d ApplicationDecision) Apply(application *AdoptionApplication) {
+	application.Status = d.Status
+	application.DecisionReason = d.Reason
+	application.DecidedBy = d.DecidedBy
+	decidedAt := d.DecidedAt
+	application.DecidedAt = &decidedAt
+}
+
+// Competing é a recusa aplicada aos outros pedidos pendentes do pet quando um é aprovado.
+func (d ApplicationDecision) Competing() ApplicationDecision {
+	return ApplicationDecision{
+		Status:    ApplicationRejected,
+		Reason:    CompetingApplicationReason,
+		DecidedBy: d.DecidedBy,
+		DecidedAt: d.DecidedAt,
+	}
+}
+
+// Reservation é a transição que a aprovação do pedido aplica ao pet.
+func (d ApplicationDecision) Reservation(application *AdoptionApplication) StatusChange {
+	return StatusChange{
+		From:      StatusAvailable,
+		To:        StatusReserved,
+		Reason:    "reserved by adoption application " + application.Uuid.String(),
+		ChangedAt: d.DecidedAt,
+	}
+}
+
+// ApplicationApproval é o resultado da aprovação: o pedido aprovado, o pet reservado
+// e os pedidos concorrentes recusados.
+type ApplicationApproval struct {
+	Application *AdoptionApplication
+	Pet         *Pet
+	Rejected    []*AdoptionApplication
+}
@@ -0,0 +1,53 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestAdoptionApplication_Validate(t *testing.T) {
+	application := &AdoptionApplication{PetUuid: uuid.New(), ApplicantUuid: uuid.New(), Message: "  I have a garden  ", Status: ApplicationApproved}
+	if errs := application.Validate(); len(errs) != 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if application.Status != ApplicationPending || application.Message != "I have a garden" {
+		t.Fatalf("expected a trimmed pending application, got %+v", application)
+	}
+
+	errs := (&AdoptionApplication{Message: strings.Repeat("a", MaxApplicationMessageLength+1)}).Validate()
+	for _, field := range []string{"pet_uuid", "applicant_uuid", "message"} {
+		if errs[field] == "" {
+			t.Fatalf("expected %s error, got %v", field, errs)
+		}
+	}
+}
+
+func TestApplicationDecision(t *testing.T) {
+	if errs := (&ApplicationDecision{Status: ApplicationPending}).Validate(); errs["status"] == "" {
+		t.Fatalf("expected status error, got %v", errs)
+	}
+
+	at := time.Date(2024, time.May, 2, 10, 0, 0, 0, time.UTC)
+	approval := ApplicationDecision{Status: ApplicationApproved, DecidedBy: "staff", DecidedAt: at}
+	if errs := approval.Validate(); len(errs) != 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+
+	competing := approval.Competing()
+	if competing.Status != ApplicationRejected || competing.Reason != CompetingApplicationReason || competing.DecidedBy != "staff" {
+		t.Fatalf("unexpected competing decision: %+v", competing)
+	}
+	application := &AdoptionApplication{Uuid: uuid.New()}
+	competing.Apply(application)
+	if application.Status != ApplicationRejected || application.DecidedAt == nil || !application.DecidedAt.Equal(at) {
+		t.Fatalf("unexpected application after decision: %+v", application)
+	}
+
+	reservation := approval.Reservation(application)
+	if reservation.From != StatusAvailable || reservation.To != StatusReserved || !CanTransition(reservation.From, reservation.To) {
+		t.Fatalf("unexpected reservation: %+v", reservation)
+	}
+}
@@ -0,0 +1,104 @@
+package entity
+
+import (
+	"fmt"
+	"path"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+type AttachmentKind string
+
+const (
+	AttachmentPhoto    AttachmentKind = "photo"
+	AttachmentDocument AttachmentKind = "document"
+)
+
+const (
+	MaxPhotoBytes          = 10 << 20
+	MaxDocumentBytes       = 20 << 20
+	MaxAttachmentNameBytes = 255
+)
+
+// attachmentContentTypes lista os formatos aceitos por tipo de anexo. Os documentos
+// também podem ser digitalizações em imagem.
+var attachmentContentTypes = map[AttachmentKind][]string{
+	AttachmentPhoto:    {"image/jpeg", "image/png", "image/webp"},
+	AttachmentDocument: {"application/pdf", "image/jpeg", "image/png"},
+}
+
+// MaxBytes devolve o tamanho máximo do conteúdo para o tipo de anexo.
+func (k AttachmentKind) MaxBytes() int64 {
+	if k == AttachmentDocument {
+		return MaxDocumentBytes
+	}
+	return MaxPhotoBytes
+}
+
+// AllowsContentType indica se o formato é aceito para o tipo de anexo.
+func (k AttachmentKind) AllowsContentType(contentType string) bool {
+	for _, allowed := range attachmentContentTypes[k] {
+		if allowed == contentType {
+			return true
+		}
+	}
+	return false
+}
+
+// Attachment são os metadados de um arquivo ligado ao pet; o conteúdo fica no
+// BlobStore, em StorageKey.
+type Attachment struct {
+	ID          uint           `gorm:"primary_key" json:"id"`
+	Uuid        uuid.UUID      `gorm:"unique_index" json:"uuid"`
+	PetUuid     uuid.UUID      `gorm:"index" json:"pet_uuid"`
+	Kind        AttachmentKind `json:"kind"`
+	FileName    string         `json:"file_name"`
+	ContentType string         `json:"content_type"`
+	SizeBytes   int64          `json:"size_bytes"`
+	Checksum    string         `json:"checksum"`
+	StorageKey  string         `json:"-"`
+	CreatedAt   time.Time      `json:"created_at"`
+}
+
+// Validate checa os metadados declarados antes do envio. FileName é reduzido ao nome
+// base, sem diretórios.
+func (a *Attachment) Validate() map[string]string {
+	errorMessages := make(map[string]string)
+
+	if a.PetUuid == uuid.Nil {
+		errorMessages["pet_uuid"] = "pet uuid is missing or invalid"
+	}
+
+	switch a.Kind {
+	case AttachmentPhoto, AttachmentDocument:
+		a.ContentType = strings.ToLower(strings.TrimSpace(a.ContentType))
+		if !a.Kind.AllowsContentType(a.ContentType) {
+			errorMessages["content_type"] = fmt.Sprintf("%s must be one of %s", a.Kind, strings.Join(attachmentContentTypes[a.Kind], ", "))
+		}
+		if a.SizeBytes <= 0 || a.SizeBytes > a.Kind.MaxBytes() {
+			errorMessages["size_bytes"] = fmt.Sprintf("size must be between 1 and %d bytes", a.Kind.MaxBytes())
+		}
+	default:
+		errorMessages["kind"] = "kind must be photo or document"
+	}
+
+	a.FileName = path.Base(strings.ReplaceAll(strings.TrimSpace(a.FileName), "\\", "/"))
+	if a.FileName == "." || a.FileName == "/" || a.FileName == ".." {
+		a.FileName = ""
+	}
+	if a.FileName == "" {
+		errorMessages["file_name"] = "file name is empty"
+	} else if len(a.FileName) > MaxAttachmentNameBytes || !utf8.ValidString(a.FileName) {
+		errorMessages["file_name"] = fmt.Sprintf("file name must be valid utf-8 up to %d bytes", MaxAttachmentNameBytes)
+	}
+
+	return errorMessages
+}
+
+// AttachmentStorageKey agrupa os arquivos por pet para facilitar a limpeza.
+func AttachmentStorageKey(petUuid, attachmentUuid uuid.UUID) string {
+	return "pets/" + petUuid.String() + "/" + attachmentUuid.String()
+}
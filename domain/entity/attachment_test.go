@@ -0,0 +1,37 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestAttachmentValidate(t *testing.T) {
+	a := &Attachment{
+		PetUuid:     uuid.New(),
+		Kind:        AttachmentDocument,
+		FileName:    `C:\scans\..\termo de adoção.pdf`,
+		ContentType: " Application/PDF ",
+		SizeBytes:   1024,
+	}
+	if errs := a.Validate(); len(errs) != 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if a.FileName != "termo de adoção.pdf" || a.ContentType != "application/pdf" {
+		t.Fatalf("expected base name and normalized content type, got %q / %q", a.FileName, a.ContentType)
+	}
+
+	photo := &Attachment{PetUuid: uuid.New(), Kind: AttachmentPhoto, FileName: "rex.pdf", ContentType: "application/pdf", SizeBytes: MaxPhotoBytes + 1}
+	errs := photo.Validate()
+	if errs["content_type"] == "" || errs["size_bytes"] == "" {
+		t.Fatalf("expected content type and size errors, got %v", errs)
+	}
+
+	invalid := &Attachment{Kind: "video", FileName: ".."}
+	errs = invalid.Validate()
+	for _, field := range []string{"pet_uuid", "kind", "file_name"} {
+		if errs[field] == "" {
+			t.Errorf("expected error on %s, got %v", field, errs)
+		}
+	}
+}
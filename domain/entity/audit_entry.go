@@ -0,0 +1,142 @@
+package entity
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AuditAction string
+
+const (
+	AuditCreate       AuditAction = "create"
+	AuditUpdate       AuditAction = "update"
+	AuditDelete       AuditAction = "delete"
+	AuditTransfer     AuditAction = "transfer"
+	AuditStatusChange AuditAction = "status_change"
+)
+
+// ErrAuditEntryImmutable é devolvido ao tentar alterar ou apagar uma entrada de auditoria.
+var ErrAuditEntryImmutable = errors.New("audit entries are append-only")
+
+// AuditEntry registra quem alterou um pet, quando e o que mudou. PreviousGuardian
+// só é preenchido quando o pet troca de dono (transferência, adoção ou devolução), para
+// que o guardião anterior também veja a entrada.
+type AuditEntry struct {
+	ID               uint        `gorm:"primary_key" json:"id"`
+	PetUuid          uuid.UUID   `gorm:"index" json:"pet_uuid"`
+	UuidGuardian     uuid.UUID   `gorm:"index" json:"uuid_guardian"`
+	PreviousGuardian uuid.UUID   `gorm:"index" json:"previous_guardian"`
+	TenantID         string      `gorm:"type:varchar(64);index" json:"tenant_id,omitempty"`
+	Action           AuditAction `json:"action"`
+	Principal        string      `json:"principal"`
+	RequestID        string      `json:"request_id"`
+	OccurredAt       time.Time   `gorm:"index" json:"occurred_at"`
+	Changes          string      `gorm:"type:text" json:"changes"`
+}
+
+// FieldChange é a diferença de um campo do pet entre o antes e o depois.
+type FieldChange struct {
+	Field  string `json:"field"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+func (a *AuditEntry) BeforeUpdate() error {
+	return ErrAuditEntryImmutable
+}
+
+func (a *AuditEntry) BeforeDelete() error {
+	return ErrAuditEntryImmutable
+}
+
+// SetChanges guarda o diff serializado em Changes.
+func (a *AuditEntry) SetChanges(changes []FieldChange) error {
+	raw, err := json.Marshal(changes)
+	if err != nil {
+		return err
+	}
+	a.Changes = string(raw)
+	return nil
+}
+
+func (a *AuditEntry) FieldChanges() ([]FieldChange, error) {
+	if a.Changes == "" {
+		return nil, nil
+	}
+	var changes []FieldChange
+	err := json.Unmarshal([]byte(a.Changes), &changes)
+	return changes, err
+}
+
+// DiffPets compara campo a campo; before nil representa uma criação e after nil uma remoção.
+func DiffPets(before, after *Pet) []FieldChange {
+	var zero Pet
+	if before == nil {
+		before = &zero
+	}
+	if after == nil {
+		after = &zero
+	}
+
+	b := reflect.ValueOf(*before)
+	a := reflect.ValueOf(*after)
+	t := b.Type()
+
+	var changes []FieldChange
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name := auditFieldName(field)
+		if name == "" {
+			continue
+		}
+		beforeValue := auditValue(b.Field(i))
+		afterValue := auditValue(a.Field(i))
+		if beforeValue != afterValue {
+			changes = append(changes, FieldChange{Field: name, Before: beforeValue, After: afterValue})
+		}
+	}
+	return changes
+}
+
+// auditValue segue ponteiros, para que campos opcionais apareçam pelo valor e não pelo
+// endereço, e serializa listas em JSON.
+func auditValue(v reflect.Value) string {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+	if t, ok := v.Interface().(time.Time); ok {
+		return t.Format(time.RFC3339)
+	}
+	if v.Kind() == reflect.Slice {
+		if v.Len() == 0 {
+			return ""
+		}
+		raw, _ := json.Marshal(v.Interface())
+		return string(raw)
+	}
+	return fmt.Sprint(v.Interface())
+}
+
+func auditFieldName(field reflect.StructField) string {
+	tag := strings.Split(field.Tag.Get("json"), ",")[0]
+	switch tag {
+	case "-":
+		return ""
+	case "":
+		return field.Name
+	default:
+		return tag
+	}
+}
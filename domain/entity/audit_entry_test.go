@@ -0,0 +1,56 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestDiffPets(t *testing.T) {
+	before := &Pet{Uuid: uuid.New(), Name: "Rex", Breed: "Labrador", BirthYear: 2020}
+	after := *before
+	after.Breed = "Golden"
+
+	changes := DiffPets(before, &after)
+	if len(changes) != 1 {
+		t.Fatalf("expected 1 change, got %+v", changes)
+	}
+	if changes[0] != (FieldChange{Field: "breed", Before: "Labrador", After: "Golden"}) {
+		t.Fatalf("unexpected change: %+v", changes[0])
+	}
+}
+
+func TestDiffPets_CreateAndDelete(t *testing.T) {
+	pet := &Pet{Uuid: uuid.New(), Name: "Rex", Breed: "Labrador"}
+
+	created := DiffPets(nil, pet)
+	deleted := DiffPets(pet, nil)
+	if len(created) != 3 || len(deleted) != 3 {
+		t.Fatalf("expected uuid, name and breed changes, got %+v and %+v", created, deleted)
+	}
+	if created[1].Field != "name" || created[1].Before != "" || created[1].After != "Rex" {
+		t.Fatalf("unexpected create change: %+v", created[1])
+	}
+	if deleted[1].Before != "Rex" || deleted[1].After != "" {
+		t.Fatalf("unexpected delete change: %+v", deleted[1])
+	}
+}
+
+func TestAuditEntry_ChangesRoundTrip(t *testing.T) {
+	entry := &AuditEntry{}
+	in := []FieldChange{{Field: "name", Before: "A", After: "B"}}
+	if err := entry.SetChanges(in); err != nil {
+		t.Fatal(err)
+	}
+	out, err := entry.FieldChanges()
+	if err != nil || len(out) != 1 || out[0] != in[0] {
+		t.Fatalf("round trip failed: %+v, %v", out, err)
+	}
+}
+
+func TestAuditEntry_IsAppendOnly(t *testing.T) {
+	entry := &AuditEntry{}
+	if entry.BeforeUpdate() != ErrAuditEntryImmutable || entry.BeforeDelete() != ErrAuditEntryImmutable {
+		t.Fatal("audit entries must reject updates and deletes")
+	}
+}
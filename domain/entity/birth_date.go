@@ -0,0 +1,112 @@
+package entity
+
+import "time"
+
+// BirthDateAccuracy indica o quanto de BirthDate é confiável. Sem BirthDate o pet só
+// tem BirthYear, que é tratado como precisão de ano.
+type BirthDateAccuracy string
+
+const (
+	BirthDateExact     BirthDateAccuracy = "exact"
+	BirthDateMonth     BirthDateAccuracy = "month"
+	BirthDateYear      BirthDateAccuracy = "year"
+	BirthDateEstimated BirthDateAccuracy = "estimated"
+)
+
+// MinBirthYear é o limite inferior aceito; BirthYear 0 significa "não informado".
+const MinBirthYear = 1900
+
+func (a BirthDateAccuracy) Valid() bool {
+	switch a {
+	case BirthDateExact, BirthDateMonth, BirthDateYear, BirthDateEstimated:
+		return true
+	}
+	return false
+}
+
+// ValidateBirth checa BirthYear e BirthDate e normaliza a data para a precisão
+// informada (dia 1 para mês, 1º de janeiro para ano). Também preenche BirthYear a
+// partir da data, para manter o campo legado coerente.
+func (p *Pet) ValidateBirth(now time.Time) map[string]string {
+	errorMessages := make(map[string]string)
+
+	if p.BirthYear != 0 && (p.BirthYear < MinBirthYear || p.BirthYear > now.Year()) {
+		errorMessages["birth_year"] = "year out of range"
+	}
+
+	if p.BirthDate == nil {
+		if p.BirthDateAccuracy != "" {
+			errorMessages["birth_date_accuracy"] = "accuracy requires a birth date"
+		}
+		return errorMessages
+	}
+
+	if p.BirthDateAccuracy == "" {
+		p.BirthDateAccuracy = BirthDateExact
+	}
+	if !p.BirthDateAccuracy.Valid() {
+		errorMessages["birth_date_accuracy"] = "accuracy must be exact, month, year or estimated"
+		return errorMessages
+	}
+
+	date := truncateBirthDate(*p.BirthDate, p.BirthDateAccuracy)
+	p.BirthDate = &date
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if date.Year() < MinBirthYear || date.After(today) {
+		errorMessages["birth_date"] = "date out of range"
+		return errorMessages
+	}
+	if p.BirthYear != 0 && p.BirthYear != date.Year() {
+		errorMessages["birth_year"] = "birth year does not match birth date"
+		return errorMessages
+	}
+	p.BirthYear = date.Year()
+
+	return errorMessages
+}
+
+func truncateBirthDate(date time.Time, accuracy BirthDateAccuracy) time.Time {
+	year, month, day := date.Date()
+	switch accuracy {
+	case BirthDateMonth:
+		day = 1
+	case BirthDateYear:
+		month, day = time.January, 1
+	}
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// Age calcula a idade em anos e meses completos. Sem BirthDate usa 1º de janeiro de
+// BirthYear; ok é falso quando o pet não tem nenhuma das duas informações.
+func (p *Pet) Age(now time.Time) (years, months int, ok bool) {
+	var born time.Time
+	switch {
+	case p.BirthDate != nil:
+		born = *p.BirthDate
+	case p.BirthYear != 0:
+		born = time.Date(p.BirthYear, time.January, 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return 0, 0, false
+	}
+
+	total := (now.Year()-born.Year())*12 + int(now.Month()) - int(born.Month())
+	if now.Day() < born.Day() {
+		total--
+	}
+	if total < 0 {
+		total = 0
+	}
+	return total / 12, total % 12, true
+}
+
+// EffectiveBirthDateAccuracy devolve a precisão considerando o fallback para BirthYear.
+func (p *Pet) EffectiveBirthDateAccuracy() BirthDateAccuracy {
+	if p.BirthDate != nil {
+		return p.BirthDateAccuracy
+	}
+	if p.BirthYear != 0 {
+		return BirthDateYear
+	}
+	return ""
+}
@@ -0,0 +1,82 @@
+package entity
+
+import (
+	"testing"
+	"time"
+)
+
+var birthTestNow = time.Date(2024, time.June, 15, 10, 0, 0, 0, time.UTC)
+
+func birthDate(year int, month time.Month, day int) *time.Time {
+	d := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	return &d
+}
+
+func TestPetValidateBirth_RejectsOutOfRangeYears(t *testing.T) {
+	for _, year := range []int{-5, 1850, 2025} {
+		pet := &Pet{BirthYear: year}
+		if errs := pet.ValidateBirth(birthTestNow); errs["birth_year"] == "" {
+			t.Errorf("expected birth_year error for %d, got %v", year, errs)
+		}
+	}
+
+	pet := &Pet{}
+	if errs := pet.ValidateBirth(birthTestNow); len(errs) != 0 {
+		t.Fatalf("birth year 0 means unknown and must be accepted, got %v", errs)
+	}
+}
+
+func TestPetValidateBirth_NormalizesByAccuracy(t *testing.T) {
+	pet := &Pet{BirthDate: birthDate(2020, time.March, 17), BirthDateAccuracy: BirthDateMonth}
+	if errs := pet.ValidateBirth(birthTestNow); len(errs) != 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if !pet.BirthDate.Equal(*birthDate(2020, time.March, 1)) || pet.BirthYear != 2020 {
+		t.Fatalf("expected date truncated to the month and birth year filled, got %v / %d", pet.BirthDate, pet.BirthYear)
+	}
+
+	exact := &Pet{BirthDate: birthDate(2021, time.May, 2)}
+	exact.ValidateBirth(birthTestNow)
+	if exact.BirthDateAccuracy != BirthDateExact {
+		t.Fatalf("expected exact accuracy by default, got %q", exact.BirthDateAccuracy)
+	}
+}
+
+func TestPetValidateBirth_RejectsInconsistentData(t *testing.T) {
+	cases := map[string]*Pet{
+		"birth_date":          {BirthDate: birthDate(2024, time.June, 16)},
+		"birth_year":          {BirthYear: 2019, BirthDate: birthDate(2020, time.January, 1)},
+		"birth_date_accuracy": {BirthDateAccuracy: BirthDateMonth},
+	}
+	for field, pet := range cases {
+		if errs := pet.ValidateBirth(birthTestNow); errs[field] == "" {
+			t.Errorf("expected %s error, got %v", field, errs)
+		}
+	}
+
+	invalid := &Pet{BirthDate: birthDate(2020, time.January, 1), BirthDateAccuracy: "roughly"}
+	if errs := invalid.ValidateBirth(birthTestNow); errs["birth_date_accuracy"] == "" {
+		t.Fatalf("expected accuracy error, got %v", errs)
+	}
+}
+
+func TestPetAge(t *testing.T) {
+	pet := &Pet{BirthDate: birthDate(2022, time.June, 16)}
+	years, months, ok := pet.Age(birthTestNow)
+	if !ok || years != 1 || months != 11 {
+		t.Fatalf("expected 1y11m, got %dy%dm ok=%v", years, months, ok)
+	}
+
+	legacy := &Pet{BirthYear: 2020}
+	years, months, _ = legacy.Age(birthTestNow)
+	if years != 4 || months != 5 {
+		t.Fatalf("expected 4y5m from birth year, got %dy%dm", years, months)
+	}
+	if legacy.EffectiveBirthDateAccuracy() != BirthDateYear {
+		t.Fatalf("expected year accuracy for birth year only, got %q", legacy.EffectiveBirthDateAccuracy())
+	}
+
+	if _, _, ok := (&Pet{}).Age(birthTestNow); ok {
+		t.Fatal("pet without birth data has no age")
+	}
+}
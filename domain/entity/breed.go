@@ -0,0 +1,97 @@
+package entity
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// MixedBreed é o nome canônico de "sem raça definida", aceito para qualquer espécie.
+const MixedBreed = "SRD"
+
+var mixedBreedAliases = []string{"Sem raça definida", "Vira-lata", "Mestiço", "Mixed", "Mixed breed", "Unknown", "Desconhecida"}
+
+// Breed é uma raça do catálogo, sempre ligada a uma espécie. Aliases guarda em JSON
+// os outros nomes pelos quais a raça é conhecida ("Lab", "Labrador", ...).
+type Breed struct {
+	ID        uint      `gorm:"primary_key" json:"id"`
+	Specie    PetType   `gorm:"unique_index:idx_breed_specie_name" json:"specie"`
+	Name      string    `gorm:"unique_index:idx_breed_specie_name" json:"name"`
+	Aliases   string    `gorm:"type:text" json:"aliases"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (b *Breed) SetAliases(aliases []string) error {
+	raw, err := json.Marshal(aliases)
+	if err != nil {
+		return err
+	}
+	b.Aliases = string(raw)
+	return nil
+}
+
+func (b *Breed) AliasList() []string {
+	if b.Aliases == "" {
+		return nil
+	}
+	var aliases []string
+	if err := json.Unmarshal([]byte(b.Aliases), &aliases); err != nil {
+		return nil
+	}
+	return aliases
+}
+
+// Keys devolve o nome e os aliases já normalizados, sem repetições.
+func (b *Breed) Keys() []string {
+	seen := map[string]bool{}
+	var keys []string
+	for _, name := range append([]string{b.Name}, b.AliasList()...) {
+		key := NormalizeBreedName(name)
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// NewMixedBreed monta a entrada "sem raça definida" da espécie; ela não é gravada no catálogo.
+func NewMixedBreed(specie PetType) *Breed {
+	breed := &Breed{Specie: specie, Name: MixedBreed}
+	_ = breed.SetAliases(mixedBreedAliases)
+	return breed
+}
+
+// IsMixedBreed indica se o nome informado significa raça indefinida ou mista.
+func IsMixedBreed(name string) bool {
+	key := NormalizeBreedName(name)
+	for _, k := range NewMixedBreed(0).Keys() {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+// NormalizeBreedName deixa o nome em minúsculas, sem acentos e sem pontuação,
+// para que "Pastor-Alemão" e "pastor alemao" sejam a mesma chave.
+func NormalizeBreedName(name string) string {
+	// o Chain guarda estado entre chamadas, por isso não pode ser compartilhado
+	stripAccents := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	plain, _, err := transform.String(stripAccents, name)
+	if err != nil {
+		plain = name
+	}
+
+	words := strings.FieldsFunc(strings.ToLower(plain), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.Join(words, " ")
+}
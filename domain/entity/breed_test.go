@@ -0,0 +1,42 @@
+package entity
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeBreedName(t *testing.T) {
+	cases := map[string]string{
+		"  Pastor-Alemão ":    "pastor alemao",
+		"LABRADOR  retriever": "labrador retriever",
+		"--":                  "",
+	}
+	for input, want := range cases {
+		if got := NormalizeBreedName(input); got != want {
+			t.Errorf("NormalizeBreedName(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestBreed_KeysIncludeAliases(t *testing.T) {
+	breed := Breed{Name: "Labrador Retriever"}
+	if err := breed.SetAliases([]string{"Labrador", "Lab", "labrador"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{"labrador retriever", "labrador", "lab"}
+	if got := breed.Keys(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+}
+
+func TestIsMixedBreed(t *testing.T) {
+	for _, name := range []string{"SRD", "Vira-lata", "sem raça definida"} {
+		if !IsMixedBreed(name) {
+			t.Errorf("expected %q to be a mixed breed", name)
+		}
+	}
+	if IsMixedBreed("Beagle") {
+		t.Error("Beagle is not a mixed breed")
+	}
+}
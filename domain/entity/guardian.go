@@ -0,0 +1,118 @@
+package entity
+
+import (
+	"net/mail"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	MaxGuardianNameLength = 200
+	MaxEmailLength        = 254
+	MaxAddressLineLength  = 200
+	MaxAddressPartLength  = 100
+	MaxPostalCodeLength   = 20
+)
+
+// Telefones em E.164 ou no formato local, com espaços, hífens e parênteses.
+var phonePattern = regexp.MustCompile(`^\+?[0-9 ()-]{8,20}$`)
+
+var countryCodePattern = regexp.MustCompile(`^[A-Z]{2}$`)
+
+// Address é o endereço do guardião. Country é o código ISO 3166-1 alfa-2.
+type Address struct {
+	Street     string `json:"street,omitempty"`
+	Number     string `json:"number,omitempty"`
+	Complement string `json:"complement,omitempty"`
+	District   string `json:"district,omitempty"`
+	City       string `json:"city,omitempty"`
+	State      string `json:"state,omitempty"`
+	PostalCode string `json:"postal_code,omitempty"`
+	Country    string `json:"country,omitempty"`
+}
+
+// Consent guarda o que o guardião autorizou. Sem consentimento de contato o serviço
+// não envia lembretes; sem o de compartilhamento os dados não saem da organização.
+type Consent struct {
+	Contact     bool `json:"contact"`
+	Marketing   bool `json:"marketing"`
+	DataSharing bool `json:"data_sharing"`
+}
+
+// Guardian é o responsável pelos pets; Pet.UuidGuardian aponta para Uuid.
+type Guardian struct {
+	ID       uint      `gorm:"primary_key" json:"id"`
+	Uuid     uuid.UUID `gorm:"unique_index" json:"uuid"`
+	TenantID string    `gorm:"type:varchar(64);index" json:"tenant_id,omitempty"`
+	Name     string    `json:"name"`
+	Email    string    `json:"email,omitempty"`
+	Phone    string    `json:"phone,omitempty"`
+	Address  Address   `gorm:"embedded;embedded_prefix:address_" json:"address"`
+	Consent  Consent   `gorm:"embedded;embedded_prefix:consent_" json:"consent"`
+	// ConsentUpdatedAt muda só quando algum consentimento muda.
+	ConsentUpdatedAt *time.Time `json:"consent_updated_at,omitempty"`
+	CreatedAt        time.Time  `json:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at"`
+}
+
+// Validate checa o cadastro e normaliza os campos de texto. O guardião precisa de ao
+// menos um meio de contato.
+func (g *Guardian) Validate() map[string]string {
+	errorMessages := make(map[string]string)
+
+	g.Name = strings.TrimSpace(g.Name)
+	if g.Name == "" {
+		errorMessages["name"] = "name is empty"
+	}
+	checkLength(errorMessages, "name", g.Name, MaxGuardianNameLength)
+
+	g.Email = strings.TrimSpace(g.Email)
+	if g.Email != "" {
+		if address, err := mail.ParseAddress(g.Email); err != nil || address.Address != g.Email {
+			errorMessages["email"] = "email is not a valid address"
+		}
+		checkLength(errorMessages, "email", g.Email, MaxEmailLength)
+	}
+
+	g.Phone = strings.TrimSpace(g.Phone)
+	if g.Phone != "" && !phonePattern.MatchString(g.Phone) {
+		errorMessages["phone"] = "phone must have 8 to 20 digits, spaces, hyphens or parentheses"
+	}
+	if g.Email == "" && g.Phone == "" {
+		errorMessages["contact"] = "email or phone is required"
+	}
+
+	g.Address.validate(errorMessages)
+	return errorMessages
+}
+
+func (a *Address) validate(errorMessages map[string]string) {
+	for _, part := range []*string{&a.Street, &a.Number, &a.Complement, &a.District, &a.City, &a.State, &a.PostalCode} {
+		*part = strings.TrimSpace(*part)
+	}
+	checkLength(errorMessages, "address.street", a.Street, MaxAddressLineLength)
+	checkLength(errorMessages, "address.number", a.Number, MaxAddressPartLength)
+	checkLength(errorMessages, "address.complement", a.Complement, MaxAddressLineLength)
+	checkLength(errorMessages, "address.district", a.District, MaxAddressPartLength)
+	checkLength(errorMessages, "address.city", a.City, MaxAddressPartLength)
+	checkLength(errorMessages, "address.state", a.State, MaxAddressPartLength)
+	checkLength(errorMessages, "address.postal_code", a.PostalCode, MaxPostalCodeLength)
+
+	a.Country = strings.ToUpper(strings.TrimSpace(a.Country))
+	if a.Country != "" && !countryCodePattern.MatchString(a.Country) {
+		errorMessages["address.country"] = "country must be an ISO 3166-1 alpha-2 code"
+	}
+}
+
+// TouchConsent marca now em ConsentUpdatedAt quando o consentimento difere de before,
+// ou sempre no cadastro (before nil).
+func (g *Guardian) TouchConsent(before *Guardian, now time.Time) {
+	if before != nil && before.Consent == g.Consent {
+		g.ConsentUpdatedAt = before.ConsentUpdatedAt
+		return
+	}
+	g.ConsentUpdatedAt = &now
+}
@@ -0,0 +1,56 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGuardian_Validate(t *testing.T) {
+	g := &Guardian{
+		Name:    " Ana Souza ",
+		Email:   "ana@example.com",
+		Phone:   "+55 (11) 91234-5678",
+		Address: Address{City: " Campinas ", Country: "br"},
+	}
+	if errs := g.Validate(); len(errs) != 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if g.Name != "Ana Souza" || g.Address.City != "Campinas" || g.Address.Country != "BR" {
+		t.Fatalf("expected normalized fields, got %+v", g)
+	}
+
+	invalid := &Guardian{Email: "Ana <ana@example.com>", Phone: "ligar", Address: Address{Country: "Brasil", Street: strings.Repeat("a", MaxAddressLineLength+1)}}
+	errs := invalid.Validate()
+	for _, field := range []string{"name", "email", "phone", "address.country", "address.street"} {
+		if errs[field] == "" {
+			t.Fatalf("expected %s error, got %v", field, errs)
+		}
+	}
+
+	if errs := (&Guardian{Name: "Sem contato"}).Validate(); errs["contact"] == "" {
+		t.Fatalf("expected contact error, got %v", errs)
+	}
+}
+
+func TestGuardian_TouchConsent(t *testing.T) {
+	created := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	g := &Guardian{Consent: Consent{Contact: true}}
+	g.TouchConsent(nil, created)
+	if g.ConsentUpdatedAt == nil || !g.ConsentUpdatedAt.Equal(created) {
+		t.Fatalf("expected consent stamped on creation, got %v", g.ConsentUpdatedAt)
+	}
+
+	later := created.AddDate(0, 1, 0)
+	same := &Guardian{Name: "outro nome", Consent: g.Consent}
+	same.TouchConsent(g, later)
+	if !same.ConsentUpdatedAt.Equal(created) {
+		t.Fatalf("expected unchanged consent to keep its date, got %v", same.ConsentUpdatedAt)
+	}
+
+	changed := &Guardian{Consent: Consent{Contact: true, Marketing: true}}
+	changed.TouchConsent(g, later)
+	if !changed.ConsentUpdatedAt.Equal(later) {
+		t.Fatalf("expected changed consent stamped with now, got %v", changed.ConsentUpdatedAt)
+	}
+}
@@ -0,0 +1,19 @@
+package entity
+
+import "time"
+
+// IdempotencyKey guarda o resultado de um Create feito com chave de idempotência,
+// para que retentativas do cliente devolvam o mesmo pet em vez de criar outro. A chave
+// vale dentro do tenant: organizações diferentes podem usar a mesma.
+type IdempotencyKey struct {
+	TenantID    string    `gorm:"primary_key;type:varchar(64);default:''" json:"tenant_id,omitempty"`
+	Key         string    `gorm:"primary_key;column:idempotency_key" json:"idempotency_key"`
+	RequestHash string    `json:"request_hash"`
+	Response    string    `gorm:"type:text" json:"response"`
+	CreatedAt   time.Time `json:"created_at"`
+	ExpiresAt   time.Time `gorm:"index" json:"expires_at"`
+}
+
+func (k *IdempotencyKey) Expired(now time.Time) bool {
+	return !now.Before(k.ExpiresAt)
+}
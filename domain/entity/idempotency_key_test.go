@@ -0,0 +1,21 @@
+package entity
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIdempotencyKey_Expired(t *testing.T) {
+	now := time.Now()
+	key := &IdempotencyKey{Key: "abc", ExpiresAt: now.Add(time.Minute)}
+
+	if key.Expired(now) {
+		t.Fatalf("key should not be expired before ExpiresAt")
+	}
+	if !key.Expired(now.Add(time.Minute)) {
+		t.Fatalf("key should be expired at ExpiresAt")
+	}
+	if !key.Expired(now.Add(time.Hour)) {
+		t.Fatalf("key should be expired after ExpiresAt")
+	}
+}
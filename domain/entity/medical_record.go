@@ -0,0 +1,91 @@
+package entity
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type MedicalRecordKind string
+
+const (
+	MedicalConsultation MedicalRecordKind = "consultation"
+	MedicalTreatment    MedicalRecordKind = "treatment"
+	MedicalSurgery      MedicalRecordKind = "surgery"
+	MedicalExam         MedicalRecordKind = "exam"
+	MedicalOther        MedicalRecordKind = "other"
+)
+
+const (
+	MaxMedicalTitleLength       = 200
+	MaxMedicalDescriptionLength = 10000
+)
+
+func (k MedicalRecordKind) Valid() bool {
+	switch k {
+	case MedicalConsultation, MedicalTreatment, MedicalSurgery, MedicalExam, MedicalOther:
+		return true
+	}
+	return false
+}
+
+// MedicalRecord é um atendimento ou tratamento. FollowUpAt, quando informado, é a
+// data do retorno.
+type MedicalRecord struct {
+	ID           uint              `gorm:"primary_key" json:"id"`
+	Uuid         uuid.UUID         `gorm:"unique_index" json:"uuid"`
+	PetUuid      uuid.UUID         `gorm:"index" json:"pet_uuid"`
+	Kind         MedicalRecordKind `json:"kind"`
+	Title        string            `json:"title"`
+	Description  string            `gorm:"type:text" json:"description,omitempty"`
+	Veterinarian string            `json:"veterinarian,omitempty"`
+	OccurredAt   time.Time         `gorm:"type:date" json:"occurred_at"`
+	FollowUpAt   *time.Time        `gorm:"type:date" json:"follow_up_at,omitempty"`
+	CreatedAt    time.Time         `json:"created_at"`
+	UpdatedAt    time.Time         `json:"updated_at"`
+}
+
+// Validate checa o registro e normaliza as datas para o dia. Sem tipo, o registro é
+// tratado como other.
+func (m *MedicalRecord) Validate(now time.Time) map[string]string {
+	errorMessages := make(map[string]string)
+
+	if m.PetUuid == uuid.Nil {
+		errorMessages["pet_uuid"] = "pet uuid is missing or invalid"
+	}
+
+	if m.Kind == "" {
+		m.Kind = MedicalOther
+	}
+	if !m.Kind.Valid() {
+		errorMessages["kind"] = "kind must be consultation, treatment, surgery, exam or other"
+	}
+
+	m.Title = strings.TrimSpace(m.Title)
+	if m.Title == "" {
+		errorMessages["title"] = "title is empty"
+	}
+	checkLength(errorMessages, "title", m.Title, MaxMedicalTitleLength)
+	checkLength(errorMessages, "description", m.Description, MaxMedicalDescriptionLength)
+	checkLength(errorMessages, "veterinarian", m.Veterinarian, MaxVeterinarianLength)
+
+	if m.OccurredAt.IsZero() {
+		errorMessages["occurred_at"] = "date is missing"
+		return errorMessages
+	}
+	m.OccurredAt = dateOnly(m.OccurredAt)
+	if m.OccurredAt.Year() < MinBirthYear || m.OccurredAt.After(dateOnly(now)) {
+		errorMessages["occurred_at"] = "date out of range"
+	}
+
+	if m.FollowUpAt != nil {
+		followUp := dateOnly(*m.FollowUpAt)
+		m.FollowUpAt = &followUp
+		if followUp.Before(m.OccurredAt) {
+			errorMessages["follow_up_at"] = "follow-up is before the record date"
+		}
+	}
+
+	return errorMessages
+}
@@ -0,0 +1,30 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestMedicalRecordValidate(t *testing.T) {
+	record := &MedicalRecord{PetUuid: uuid.New(), Title: " Castração ", OccurredAt: *birthDate(2024, time.May, 2)}
+	if errs := record.Validate(birthTestNow); len(errs) != 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if record.Kind != MedicalOther || record.Title != "Castração" {
+		t.Fatalf("expected default kind and trimmed title, got %q / %q", record.Kind, record.Title)
+	}
+
+	invalid := &MedicalRecord{
+		Kind:       "vaccine",
+		OccurredAt: *birthDate(2024, time.May, 2),
+		FollowUpAt: birthDate(2024, time.April, 1),
+	}
+	errs := invalid.Validate(birthTestNow)
+	for _, field := range []string{"pet_uuid", "kind", "title", "follow_up_at"} {
+		if errs[field] == "" {
+			t.Errorf("expected error on %s, got %v", field, errs)
+		}
+	}
+}
@@ -0,0 +1,21 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OutboxMessage é um evento gravado na mesma transação da escrita do pet e
+// publicado depois no broker pelo relay.
+type OutboxMessage struct {
+	ID          uint       `gorm:"primary_key" json:"id"`
+	EventID     uuid.UUID  `gorm:"unique_index" json:"event_id"`
+	EventType   string     `json:"event_type"`
+	AggregateID uuid.UUID  `gorm:"index" json:"aggregate_id"`
+	Payload     []byte     `json:"payload"`
+	CreatedAt   time.Time  `json:"created_at"`
+	PublishedAt *time.Time `gorm:"index" json:"published_at"`
+	Attempts    int        `json:"attempts"`
+	LastError   string     `json:"last_error"`
+}
@@ -8,19 +8,43 @@ import (
 
 type PetType int
 
+// Os valores são gravados em pets.specie e não podem mudar; espécies novas
+// entram pelo catálogo (Species) com valores a partir de FirstCustomPetType.
 const (
 	Dog PetType = iota
 	Cat
+	Bird
+	Rabbit
+	Reptile
+	Rodent
+	Fish
+	Horse
 )
 
+const FirstCustomPetType PetType = 100
+
 type Pet struct {
-	NIdentification uint      `gorm:"AUTO_INCREMENT"`
-	Uuid            uuid.UUID `gorm:"primaryKey" json:"uuid"`
-	UuidGuardian    uuid.UUID `json:"uuid_guardian"`
-	Name            string    `json:"name"`
-	BirthYear       int       `json:"birth_year"`
-	Breed           string    `json:"breed"`
-	Specie          PetType   `json:"specie"`
+	NIdentification   uint              `gorm:"AUTO_INCREMENT"`
+	Uuid              uuid.UUID         `gorm:"primaryKey" json:"uuid"`
+	UuidGuardian      uuid.UUID         `json:"uuid_guardian"`
+	TenantID          string            `gorm:"type:varchar(64)" json:"tenant_id,omitempty"`
+	Name              string            `json:"name"`
+	BirthYear         int               `json:"birth_year"`
+	BirthDate         *time.Time        `gorm:"type:date" json:"birth_date,omitempty"`
+	BirthDateAccuracy BirthDateAccuracy `json:"birth_date_accuracy,omitempty"`
+	Breed             string            `json:"breed"`
+	Specie            PetType           `json:"specie"`
+	Sex               PetSex            `json:"sex,omitempty"`
+	Neutered          *bool             `json:"neutered,omitempty"`
+	Color             string            `json:"color,omitempty"`
+	Markings          string            `json:"markings,omitempty"`
+	WeightHistory     WeightHistory     `gorm:"type:text" json:"weight_history,omitempty"`
+	MicrochipNumber   string            `json:"microchip_number,omitempty"`
+	Photos            StringList        `gorm:"type:text" json:"photos,omitempty"`
+	Notes             string            `gorm:"type:text" json:"notes,omitempty"`
+	Status            PetStatus         `gorm:"type:varchar(16)" json:"status,omitempty"`
+	StatusReason      string            `gorm:"type:text" json:"status_reason,omitempty"`
+	StatusChangedAt   *time.Time        `json:"status_changed_at,omitempty"`
 }
 
 func (p *Pet) Validate(action string) map[string]string {
@@ -28,6 +52,19 @@ func (p *Pet) Validate(action string) map[string]string {
 
 	switch strings.ToLower(action) {
 	case "":
+	case "create":
+		p.validateDefault(errorMessages)
+		if p.UuidGuardian == uuid.Nil {
+			errorMessages["uuid_guardian"] = "guardian uuid is missing or invalid"
+		}
+		for field, msg := range p.ValidateNewStatus() {
+			errorMessages[field] = msg
+		}
+	case "update":
+		p.validateDefault(errorMessages)
+		if p.Uuid == uuid.Nil {
+			errorMessages["uuid"] = "pet uuid is missing or invalid"
+		}
 	default:
 		p.validateDefault(errorMessages)
 	}
@@ -44,7 +81,10 @@ func (p *Pet) validateDefault(errorMessages map[string]string) {
 		errorMessages["pet breed is required"] = "pet breed is empty"
 	}
 
-	if p.BirthYear > time.Now().Year() {
-		errorMessages["birth_year"] = "year out of range"
+	for field, msg := range p.ValidateBirth(time.Now()) {
+		errorMessages[field] = msg
+	}
+	for field, msg := range p.ValidateProfile(time.Now()) {
+		errorMessages[field] = msg
 	}
 }
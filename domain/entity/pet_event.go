@@ -0,0 +1,43 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PetEventType int
+
+const (
+	PetCreated PetEventType = iota + 1
+	PetUpdated
+	PetDeleted
+	PetTransferred
+)
+
+// PetEvent é publicado depois de cada escrita bem-sucedida em um pet.
+// Sequence é atribuído pelo barramento e cresce monotonicamente; ID identifica
+// o evento na outbox para que consumidores possam descartar duplicatas.
+type PetEvent struct {
+	ID               uuid.UUID
+	Sequence         uint64
+	Type             PetEventType
+	Pet              Pet
+	PreviousGuardian uuid.UUID
+	OccurredAt       time.Time
+}
+
+func (t PetEventType) String() string {
+	switch t {
+	case PetCreated:
+		return "created"
+	case PetUpdated:
+		return "updated"
+	case PetDeleted:
+		return "deleted"
+	case PetTransferred:
+		return "transferred"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,18 @@
+package entity
+
+import "testing"
+
+func TestPetEventType_String(t *testing.T) {
+	cases := map[PetEventType]string{
+		PetCreated:      "created",
+		PetUpdated:      "updated",
+		PetDeleted:      "deleted",
+		PetTransferred:  "transferred",
+		PetEventType(0): "unknown",
+	}
+	for eventType, want := range cases {
+		if got := eventType.String(); got != want {
+			t.Errorf("expected %q, got %q", want, got)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package entity
+
+import "github.com/google/uuid"
+
+// PetFilter restringe consultas de listagem; campos zerados não filtram.
+//
+// Sem GuardianRoles, UuidGuardian casa só com o dono. Com GuardianRoles, casa com os
+// pets em que o guardião tem um desses papéis: owner pelo UuidGuardian do pet e os
+// demais por LinkedPets, que a aplicação preenche a partir das ligações do guardião.
+type PetFilter struct {
+	UuidGuardian  uuid.UUID
+	GuardianRoles []GuardianRole
+	LinkedPets    []uuid.UUID
+	Species       []PetType
+	Statuses      []PetStatus
+	Breed         string
+	BirthYearFrom int
+	BirthYearTo   int
+}
+
+// IncludesOwner diz se o filtro por guardião considera o dono do pet.
+func (f PetFilter) IncludesOwner() bool {
+	if len(f.GuardianRoles) == 0 {
+		return true
+	}
+	for _, role := range f.GuardianRoles {
+		if role == RoleOwner {
+			return true
+		}
+	}
+	return false
+}
+
+func (f PetFilter) Matches(pet *Pet) bool {
+	if f.UuidGuardian != uuid.Nil && !f.matchesGuardian(pet) {
+		return false
+	}
+	if len(f.Species) > 0 && !containsPetType(f.Species, pet.Specie) {
+		return false
+	}
+	if len(f.Statuses) > 0 && !containsStatus(f.Statuses, pet.CurrentStatus()) {
+		return false
+	}
+	if f.Breed != "" && pet.Breed != f.Breed {
+		return false
+	}
+	if f.BirthYearFrom != 0 && pet.BirthYear < f.BirthYearFrom {
+		return false
+	}
+	if f.BirthYearTo != 0 && pet.BirthYear > f.BirthYearTo {
+		return false
+	}
+	return true
+}
+
+func (f PetFilter) matchesGuardian(pet *Pet) bool {
+	if f.IncludesOwner() && pet.UuidGuardian == f.UuidGuardian {
+		return true
+	}
+	for _, id := range f.LinkedPets {
+		if id == pet.Uuid {
+			return true
+		}
+	}
+	return false
+}
+
+func containsPetType(types []PetType, t PetType) bool {
+	for _, candidate := range types {
+		if candidate == t {
+			return true
+		}
+	}
+	return false
+}
+
+func containsStatus(statuses []PetStatus, s PetStatus) bool {
+	for _, candidate := range statuses {
+		if candidate == s {
+			return true
+		}
+	}
+	return false
+}
+
+// StoredStatuses devolve os valores de status a consultar no banco: intake inclui os
+// pets gravados sem status.
+func (f PetFilter) StoredStatuses() []PetStatus {
+	if !containsStatus(f.Statuses, StatusIntake) {
+		return f.Statuses
+	}
+	return append(append([]PetStatus{}, f.Statuses...), "")
+}
@@ -0,0 +1,57 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestPetFilter_Matches(t *testing.T) {
+	guardian := uuid.New()
+	pet := &Pet{Uuid: uuid.New(), UuidGuardian: guardian, Name: "Rex", BirthYear: 2020, Breed: "SRD", Specie: Dog}
+
+	cases := []struct {
+		name   string
+		filter PetFilter
+		want   bool
+	}{
+		{"empty filter", PetFilter{}, true},
+		{"same guardian", PetFilter{UuidGuardian: guardian}, true},
+		{"other guardian", PetFilter{UuidGuardian: uuid.New()}, false},
+		{"owner role", PetFilter{UuidGuardian: guardian, GuardianRoles: []GuardianRole{RoleOwner, RoleFoster}}, true},
+		{"owner without owner role", PetFilter{UuidGuardian: guardian, GuardianRoles: []GuardianRole{RoleFoster}}, false},
+		{"linked pet", PetFilter{UuidGuardian: uuid.New(), GuardianRoles: []GuardianRole{RoleFoster}, LinkedPets: []uuid.UUID{pet.Uuid}}, true},
+		{"specie in list", PetFilter{Species: []PetType{Cat, Dog}}, true},
+		{"specie not in list", PetFilter{Species: []PetType{Cat}}, false},
+		{"same breed", PetFilter{Breed: "SRD"}, true},
+		{"other breed", PetFilter{Breed: "Beagle"}, false},
+		{"inside year range", PetFilter{BirthYearFrom: 2019, BirthYearTo: 2021}, true},
+		{"before range", PetFilter{BirthYearFrom: 2021}, false},
+		{"after range", PetFilter{BirthYearTo: 2019}, false},
+		{"legacy pet is in intake", PetFilter{Statuses: []PetStatus{StatusIntake}}, true},
+		{"other status", PetFilter{Statuses: []PetStatus{StatusAvailable, StatusAdopted}}, false},
+	}
+
+	for _, tc := range cases {
+		if got := tc.filter.Matches(pet); got != tc.want {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.want, got)
+		}
+	}
+}
+
+func TestPetGuardian_Validate(t *testing.T) {
+	link := &PetGuardian{PetUuid: uuid.New(), GuardianUuid: uuid.New(), Role: RoleFoster}
+	if errs := link.Validate(); len(errs) != 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+
+	for _, role := range []GuardianRole{RoleOwner, "", "neighbor"} {
+		link.Role = role
+		if errs := link.Validate(); errs["role"] == "" {
+			t.Fatalf("expected role error for %q, got %v", role, errs)
+		}
+	}
+	if errs := (&PetGuardian{Role: RoleCoOwner}).Validate(); errs["pet_uuid"] == "" || errs["uuid_guardian"] == "" {
+		t.Fatalf("expected uuid errors, got %v", errs)
+	}
+}
@@ -0,0 +1,54 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type GuardianRole string
+
+const (
+	// RoleOwner é o guardião principal, o UuidGuardian do pet; muda só por TransferPet.
+	RoleOwner            GuardianRole = "owner"
+	RoleCoOwner          GuardianRole = "co_owner"
+	RoleFoster           GuardianRole = "foster"
+	RoleEmergencyContact GuardianRole = "emergency_contact"
+)
+
+func (r GuardianRole) Valid() bool {
+	switch r {
+	case RoleOwner, RoleCoOwner, RoleFoster, RoleEmergencyContact:
+		return true
+	}
+	return false
+}
+
+// PetGuardian liga um guardião a um pet com um papel diferente de owner. Cada
+// guardião tem um único papel por pet.
+type PetGuardian struct {
+	ID           uint         `gorm:"primary_key" json:"id"`
+	PetUuid      uuid.UUID    `gorm:"unique_index:idx_pet_guardian" json:"pet_uuid"`
+	GuardianUuid uuid.UUID    `gorm:"unique_index:idx_pet_guardian;index" json:"uuid_guardian"`
+	Role         GuardianRole `gorm:"type:varchar(32)" json:"role"`
+	TenantID     string       `gorm:"type:varchar(64);index" json:"tenant_id,omitempty"`
+	CreatedAt    time.Time    `json:"created_at"`
+}
+
+func (l *PetGuardian) Validate() map[string]string {
+	errorMessages := make(map[string]string)
+
+	if l.PetUuid == uuid.Nil {
+		errorMessages["pet_uuid"] = "pet uuid is missing or invalid"
+	}
+	if l.GuardianUuid == uuid.Nil {
+		errorMessages["uuid_guardian"] = "guardian uuid is missing or invalid"
+	}
+	switch {
+	case l.Role == RoleOwner:
+		errorMessages["role"] = "the owner is changed with TransferPet"
+	case !l.Role.Valid():
+		errorMessages["role"] = "role must be co_owner, foster or emergency_contact"
+	}
+	return errorMessages
+}
@@ -0,0 +1,229 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+type PetSex string
+
+const (
+	SexMale   PetSex = "male"
+	SexFemale PetSex = "female"
+)
+
+const (
+	MaxColorLength    = 50
+	MaxMarkingsLength = 200
+	MaxNotesLength    = 2000
+	MaxPhotos         = 20
+	// MaxWeightGrams cobre até cavalos de tração com folga.
+	MaxWeightGrams = 2000000
+)
+
+// Microchips ISO 11784/11785 têm 15 dígitos: 3 do país (ISO 3166) ou do fabricante
+// (900 a 998) e 12 de identificação. O prefixo 999 é reservado a chips de teste.
+var isoMicrochipPattern = regexp.MustCompile(`^[0-9]{15}$`)
+
+// WeightMeasurement é uma pesagem; o histórico é mantido em ordem de inclusão.
+type WeightMeasurement struct {
+	Grams      int       `json:"grams"`
+	MeasuredAt time.Time `json:"measured_at"`
+}
+
+// WeightHistory é gravado como JSON numa coluna de texto.
+type WeightHistory []WeightMeasurement
+
+func (w WeightHistory) Value() (driver.Value, error) {
+	return jsonValue(w)
+}
+
+func (w *WeightHistory) Scan(src interface{}) error {
+	return jsonScan(src, w)
+}
+
+// Latest devolve a pesagem mais recente, ou nil se não houver nenhuma.
+func (w WeightHistory) Latest() *WeightMeasurement {
+	var latest *WeightMeasurement
+	for i := range w {
+		if latest == nil || !w[i].MeasuredAt.Before(latest.MeasuredAt) {
+			latest = &w[i]
+		}
+	}
+	return latest
+}
+
+// StringList é gravada como JSON numa coluna de texto.
+type StringList []string
+
+func (s StringList) Value() (driver.Value, error) {
+	return jsonValue(s)
+}
+
+func (s *StringList) Scan(src interface{}) error {
+	return jsonScan(src, s)
+}
+
+func jsonValue(v interface{}) (driver.Value, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return string(raw), nil
+}
+
+func jsonScan(src interface{}, dst interface{}) error {
+	var raw []byte
+	switch v := src.(type) {
+	case nil:
+		return nil
+	case string:
+		raw = []byte(v)
+	case []byte:
+		raw = v
+	default:
+		return fmt.Errorf("cannot scan %T into %T", src, dst)
+	}
+	if len(raw) == 0 {
+		return nil
+	}
+	return json.Unmarshal(raw, dst)
+}
+
+// NormalizeMicrochip remove espaços, hífens e pontos que os leitores costumam exibir.
+func NormalizeMicrochip(number string) string {
+	return strings.NewReplacer(" ", "", "-", "", ".", "").Replace(strings.TrimSpace(number))
+}
+
+// ValidMicrochip checa o formato ISO 11784/11785 de um número já normalizado.
+func ValidMicrochip(number string) bool {
+	if !isoMicrochipPattern.MatchString(number) {
+		return false
+	}
+	prefix := number[:3]
+	return prefix != "000" && prefix != "999"
+}
+
+// ValidateProfile checa os campos de perfil. Normaliza o microchip e data as
+// pesagens sem MeasuredAt com now.
+func (p *Pet) ValidateProfile(now time.Time) map[string]string {
+	errorMessages := make(map[string]string)
+
+	switch p.Sex {
+	case "", SexMale, SexFemale:
+	default:
+		errorMessages["sex"] = "sex must be male or female"
+	}
+
+	checkLength(errorMessages, "color", p.Color, MaxColorLength)
+	checkLength(errorMessages, "markings", p.Markings, MaxMarkingsLength)
+	checkLength(errorMessages, "notes", p.Notes, MaxNotesLength)
+
+	for i := range p.WeightHistory {
+		m := &p.WeightHistory[i]
+		if m.MeasuredAt.IsZero() {
+			m.MeasuredAt = now
+		}
+		if m.Grams <= 0 || m.Grams > MaxWeightGrams {
+			errorMessages["weight_history"] = fmt.Sprintf("weight must be between 1 and %d grams", MaxWeightGrams)
+		} else if m.MeasuredAt.After(now) {
+			errorMessages["weight_history"] = "measurement date is in the future"
+		}
+	}
+
+	if p.MicrochipNumber != "" {
+		p.MicrochipNumber = NormalizeMicrochip(p.MicrochipNumber)
+		if !ValidMicrochip(p.MicrochipNumber) {
+			errorMessages["microchip_number"] = "microchip must be an ISO 11784/11785 number with 15 digits"
+		}
+	}
+
+	if len(p.Photos) > MaxPhotos {
+		errorMessages["photos"] = fmt.Sprintf("at most %d photos", MaxPhotos)
+	}
+	for _, photo := range p.Photos {
+		if err := validatePhotoURL(photo); err != nil {
+			errorMessages["photos"] = err.Error()
+			break
+		}
+	}
+
+	return errorMessages
+}
+
+func checkLength(errorMessages map[string]string, field, value string, max int) {
+	if utf8.RuneCountInString(value) > max {
+		errorMessages[field] = fmt.Sprintf("%s exceeds %d characters", field, max)
+	}
+}
+
+func validatePhotoURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		return fmt.Errorf("photo %q is not an http(s) url", raw)
+	}
+	return nil
+}
+
+// CorePetFields são os campos substituídos pelo Update sem máscara.
+var CorePetFields = []string{"name", "birth_year", "birth_date", "birth_date_accuracy", "breed", "specie"}
+
+// ProfilePetFields só mudam quando listados explicitamente.
+var ProfilePetFields = []string{"sex", "neutered", "color", "markings", "weight_history", "microchip_number", "photos", "notes"}
+
+var errUnknownField = errors.New("unknown field")
+
+// CopyFields copia de from os campos informados, pelo nome json. weight_history é
+// acrescentado ao histórico atual em vez de substituí-lo.
+func (p *Pet) CopyFields(from *Pet, fields []string) map[string]string {
+	errorMessages := make(map[string]string)
+	for _, field := range fields {
+		if err := p.copyField(from, field); err != nil {
+			errorMessages["update_mask"] = fmt.Sprintf("%s: %q", err, field)
+		}
+	}
+	return errorMessages
+}
+
+func (p *Pet) copyField(from *Pet, field string) error {
+	switch field {
+	case "name":
+		p.Name = from.Name
+	case "birth_year":
+		p.BirthYear = from.BirthYear
+	case "birth_date":
+		p.BirthDate = from.BirthDate
+	case "birth_date_accuracy":
+		p.BirthDateAccuracy = from.BirthDateAccuracy
+	case "breed":
+		p.Breed = from.Breed
+	case "specie":
+		p.Specie = from.Specie
+	case "sex":
+		p.Sex = from.Sex
+	case "neutered":
+		p.Neutered = from.Neutered
+	case "color":
+		p.Color = from.Color
+	case "markings":
+		p.Markings = from.Markings
+	case "weight_history":
+		p.WeightHistory = append(append(WeightHistory{}, p.WeightHistory...), from.WeightHistory...)
+	case "microchip_number":
+		p.MicrochipNumber = from.MicrochipNumber
+	case "photos":
+		p.Photos = from.Photos
+	case "notes":
+		p.Notes = from.Notes
+	default:
+		return errUnknownField
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package entity
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPetValidateProfile(t *testing.T) {
+	now := time.Date(2024, time.June, 15, 10, 0, 0, 0, time.UTC)
+	pet := &Pet{
+		Sex:             SexFemale,
+		Color:           "Tricolor",
+		MicrochipNumber: "985 1120-0012 3456",
+		WeightHistory:   WeightHistory{{Grams: 8200}},
+		Photos:          StringList{"https://cdn.example.com/rex.jpg"},
+	}
+	if errs := pet.ValidateProfile(now); len(errs) != 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if pet.MicrochipNumber != "985112000123456" {
+		t.Fatalf("expected normalized microchip, got %q", pet.MicrochipNumber)
+	}
+	if !pet.WeightHistory[0].MeasuredAt.Equal(now) {
+		t.Fatalf("expected measurement stamped with now, got %v", pet.WeightHistory[0].MeasuredAt)
+	}
+
+	invalid := &Pet{
+		Sex:             "other",
+		Notes:           strings.Repeat("a", MaxNotesLength+1),
+		MicrochipNumber: "123",
+		WeightHistory:   WeightHistory{{Grams: 0, MeasuredAt: now}},
+		Photos:          StringList{"file:///etc/passwd"},
+	}
+	errs := invalid.ValidateProfile(now)
+	for _, field := range []string{"sex", "notes", "microchip_number", "weight_history", "photos"} {
+		if errs[field] == "" {
+			t.Errorf("expected %s error, got %v", field, errs)
+		}
+	}
+}
+
+func TestPetCopyFields(t *testing.T) {
+	measured := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	current := &Pet{Name: "Rex", Color: "Preto", WeightHistory: WeightHistory{{Grams: 8000, MeasuredAt: measured}}}
+	changes := &Pet{Name: "Ignored", Color: "Caramelo", WeightHistory: WeightHistory{{Grams: 8500}}}
+
+	if errs := current.CopyFields(changes, []string{"color", "weight_history"}); len(errs) != 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if current.Name != "Rex" || current.Color != "Caramelo" {
+		t.Fatalf("only masked fields must change, got %+v", current)
+	}
+	if len(current.WeightHistory) != 2 || current.WeightHistory[1].Grams != 8500 {
+		t.Fatalf("expected weight appended to history, got %+v", current.WeightHistory)
+	}
+
+	if errs := current.CopyFields(changes, []string{"uuid_guardian"}); errs["update_mask"] == "" {
+		t.Fatalf("expected update_mask error, got %v", errs)
+	}
+}
+
+func TestWeightHistory_ValueAndScan(t *testing.T) {
+	history := WeightHistory{{Grams: 1200, MeasuredAt: time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC)}}
+	raw, err := history.Value()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var scanned WeightHistory
+	if err := scanned.Scan([]byte(raw.(string))); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(history, scanned) {
+		t.Fatalf("expected %v, got %v", history, scanned)
+	}
+}
+
+func TestValidMicrochip(t *testing.T) {
+	cases := map[string]bool{
+		"985112000123456": true,
+		"076099000000001": true,
+		"000112000123456": false,
+		"999000000000001": false,
+		"98511200012345":  false,
+		"0A123B4567":      false,
+	}
+	for number, want := range cases {
+		if got := ValidMicrochip(number); got != want {
+			t.Errorf("ValidMicrochip(%q) = %v, want %v", number, got, want)
+		}
+	}
+}
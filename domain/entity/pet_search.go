@@ -0,0 +1,259 @@
+package entity
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Campos pesquisáveis do pet, do mais para o menos relevante.
+const (
+	SearchFieldName  = "name"
+	SearchFieldBreed = "breed"
+	SearchFieldNotes = "notes"
+)
+
+const (
+	// MaxSearchTerms limita os termos considerados; o excedente é ignorado.
+	MaxSearchTerms = 8
+	// WordSimilarityThreshold é o mesmo corte padrão do operador <% do pg_trgm.
+	WordSimilarityThreshold = 0.6
+	// HighlightStart e HighlightEnd cercam os termos encontrados; o texto não é escapado.
+	HighlightStart = "<mark>"
+	HighlightEnd   = "</mark>"
+	// notesSnippetWords é quantas palavras das observações entram no destaque.
+	notesSnippetWords = 20
+)
+
+// searchFieldWeights segue os pesos A, B e C usados no índice de texto do Postgres.
+var searchFieldWeights = map[string]float64{
+	SearchFieldName:  1,
+	SearchFieldBreed: 0.6,
+	SearchFieldNotes: 0.3,
+}
+
+// PetSearch é uma busca textual sobre nome, raça e observações. Todos os termos
+// precisam aparecer no pet, cada um em qualquer campo, por prefixo ou semelhança.
+type PetSearch struct {
+	Query  string
+	Filter PetFilter
+	Limit  int
+}
+
+// PetSearchHit é um pet encontrado. Rank só serve para ordenar; Highlights traz, por
+// campo, o texto com os termos marcados.
+type PetSearchHit struct {
+	Pet        *Pet
+	Rank       float64
+	Highlights map[string]string
+}
+
+// Terms quebra a consulta em palavras minúsculas, sem repetição. Sobram só letras e
+// dígitos, então os termos podem ir direto para um tsquery.
+func (s PetSearch) Terms() []string {
+	var terms []string
+	seen := map[string]bool{}
+	for _, word := range searchWords(s.Query) {
+		if seen[word] {
+			continue
+		}
+		seen[word] = true
+		terms = append(terms, word)
+		if len(terms) == MaxSearchTerms {
+			break
+		}
+	}
+	return terms
+}
+
+// Match aplica a busca a um pet já carregado, com o mesmo critério da consulta no
+// Postgres: prefixo de palavra ou semelhança por trigramas.
+func (s PetSearch) Match(pet *Pet) (*PetSearchHit, bool) {
+	terms := s.Terms()
+	if len(terms) == 0 || !s.Filter.Matches(pet) {
+		return nil, false
+	}
+
+	fields := searchFields(pet)
+	rank := 0.0
+	for _, term := range terms {
+		best := 0.0
+		for _, field := range fields {
+			if score := bestWordScore(term, field.words) * searchFieldWeights[field.name]; score > best {
+				best = score
+			}
+		}
+		if best == 0 {
+			return nil, false
+		}
+		rank += best
+	}
+	return &PetSearchHit{Pet: pet, Rank: rank}, true
+}
+
+// SortSearchHits ordena pelo rank e, no empate, pelo uuid, como a consulta no banco.
+func SortSearchHits(hits []*PetSearchHit) {
+	sort.SliceStable(hits, func(i, j int) bool {
+		if hits[i].Rank != hits[j].Rank {
+			return hits[i].Rank > hits[j].Rank
+		}
+		return hits[i].Pet.Uuid.String() < hits[j].Pet.Uuid.String()
+	})
+}
+
+// HighlightPet marca os termos em cada campo que teve correspondência. Das observações
+// vai só um trecho em volta da primeira palavra marcada.
+func HighlightPet(pet *Pet, terms []string) map[string]string {
+	highlights := map[string]string{}
+	for _, field := range searchFields(pet) {
+		text, ok := highlight(field.text, terms)
+		if !ok {
+			continue
+		}
+		if field.name == SearchFieldNotes {
+			text = snippet(text)
+		}
+		highlights[field.name] = text
+	}
+	return highlights
+}
+
+type searchField struct {
+	name  string
+	text  string
+	words []string
+}
+
+func searchFields(pet *Pet) []searchField {
+	return []searchField{
+		{SearchFieldName, pet.Name, searchWords(pet.Name)},
+		{SearchFieldBreed, pet.Breed, searchWords(pet.Breed)},
+		{SearchFieldNotes, pet.Notes, searchWords(pet.Notes)},
+	}
+}
+
+func isSearchRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func searchWords(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool { return !isSearchRune(r) })
+}
+
+// bestWordScore é 1 para a palavra idêntica ao termo. Prefixos sempre casam, valendo
+// ao menos o corte; as demais palavras valem a semelhança, se passarem do corte.
+func bestWordScore(term string, words []string) float64 {
+	best := 0.0
+	for _, word := range words {
+		if word == term {
+			return 1
+		}
+		sim := WordSimilarity(term, word)
+		if strings.HasPrefix(word, term) && sim < WordSimilarityThreshold {
+			sim = WordSimilarityThreshold
+		}
+		if sim >= WordSimilarityThreshold && sim > best {
+			best = sim
+		}
+	}
+	return best
+}
+
+// WordSimilarity é a fração dos trigramas do termo presentes na palavra, a mesma
+// ideia do word_similarity do pg_trgm aplicada a uma palavra só.
+func WordSimilarity(term, word string) float64 {
+	termTrigrams := trigrams(term)
+	if len(termTrigrams) == 0 {
+		return 0
+	}
+	wordTrigrams := trigrams(word)
+	common := 0
+	for trigram := range termTrigrams {
+		if wordTrigrams[trigram] {
+			common++
+		}
+	}
+	return float64(common) / float64(len(termTrigrams))
+}
+
+// trigrams segue o pg_trgm: a palavra ganha dois espaços antes e um depois.
+func trigrams(word string) map[string]bool {
+	runes := []rune("  " + strings.ToLower(word) + " ")
+	set := map[string]bool{}
+	for i := 0; i+3 <= len(runes); i++ {
+		set[string(runes[i:i+3])] = true
+	}
+	return set
+}
+
+// highlight cerca as palavras que casam com algum termo; ok indica se houve alguma.
+func highlight(text string, terms []string) (string, bool) {
+	var b strings.Builder
+	ok := false
+	for len(text) > 0 {
+		start := strings.IndexFunc(text, isSearchRune)
+		if start < 0 {
+			b.WriteString(text)
+			break
+		}
+		end := strings.IndexFunc(text[start:], func(r rune) bool { return !isSearchRune(r) })
+		if end < 0 {
+			end = len(text)
+		} else {
+			end += start
+		}
+
+		word := text[start:end]
+		b.WriteString(text[:start])
+		if matchesAnyTerm(strings.ToLower(word), terms) {
+			ok = true
+			b.WriteString(HighlightStart + word + HighlightEnd)
+		} else {
+			b.WriteString(word)
+		}
+		text = text[end:]
+	}
+	return b.String(), ok
+}
+
+func matchesAnyTerm(word string, terms []string) bool {
+	for _, term := range terms {
+		if bestWordScore(term, []string{word}) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// snippet corta o texto já marcado em notesSnippetWords palavras, começando um pouco
+// antes da primeira marcação.
+func snippet(text string) string {
+	words := strings.Fields(text)
+	if len(words) <= notesSnippetWords {
+		return text
+	}
+
+	first := 0
+	for i, word := range words {
+		if strings.Contains(word, HighlightStart) {
+			first = i
+			break
+		}
+	}
+	start := first - notesSnippetWords/4
+	if start < 0 {
+		start = 0
+	}
+	if start > len(words)-notesSnippetWords {
+		start = len(words) - notesSnippetWords
+	}
+
+	out := strings.Join(words[start:start+notesSnippetWords], " ")
+	if start > 0 {
+		out = "… " + out
+	}
+	if start+notesSnippetWords < len(words) {
+		out += " …"
+	}
+	return out
+}
@@ -0,0 +1,93 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestPetSearch_Terms(t *testing.T) {
+	terms := PetSearch{Query: "  Rex, GOLDEN rex! 'ç&' "}.Terms()
+	if strings.Join(terms, "|") != "rex|golden|ç" {
+		t.Fatalf("unexpected terms: %v", terms)
+	}
+
+	many := PetSearch{Query: strings.Repeat("a b c d e f g h i j ", 2)}.Terms()
+	if len(many) != MaxSearchTerms {
+		t.Fatalf("expected %d terms, got %d", MaxSearchTerms, len(many))
+	}
+}
+
+func TestPetSearch_MatchRequiresEveryTerm(t *testing.T) {
+	rex := &Pet{Uuid: uuid.New(), Name: "Rex", Breed: "Golden Retriever"}
+	mel := &Pet{Uuid: uuid.New(), Name: "Mel", Breed: "Golden Retriever", Notes: "Vive com o Rex"}
+
+	hitRex, ok := PetSearch{Query: "rex golden"}.Match(rex)
+	if !ok {
+		t.Fatal("expected rex to match")
+	}
+	hitMel, ok := PetSearch{Query: "rex golden"}.Match(mel)
+	if !ok {
+		t.Fatal("expected mel to match through notes")
+	}
+	if hitRex.Rank <= hitMel.Rank {
+		t.Fatalf("a match on name should rank higher: %v <= %v", hitRex.Rank, hitMel.Rank)
+	}
+
+	if _, ok := (PetSearch{Query: "rex poodle"}).Match(rex); ok {
+		t.Fatal("every term must match")
+	}
+	if _, ok := (PetSearch{Query: "rex", Filter: PetFilter{Species: []PetType{Cat}}}).Match(rex); ok {
+		t.Fatal("filter must apply")
+	}
+}
+
+func TestPetSearch_MatchIsFuzzy(t *testing.T) {
+	pet := &Pet{Name: "Rex", Breed: "Golden Retriever"}
+	for _, query := range []string{"rexx", "goldn", "retr"} {
+		if _, ok := (PetSearch{Query: query}).Match(pet); !ok {
+			t.Fatalf("expected %q to match", query)
+		}
+	}
+	if _, ok := (PetSearch{Query: "bolt"}).Match(pet); ok {
+		t.Fatal("unrelated term must not match")
+	}
+}
+
+func TestWordSimilarity(t *testing.T) {
+	if got := WordSimilarity("rexx", "rex"); got != 0.6 {
+		t.Fatalf("expected 0.6, got %v", got)
+	}
+	if got := WordSimilarity("rex", "rex"); got != 1 {
+		t.Fatalf("expected 1, got %v", got)
+	}
+	if got := WordSimilarity("", "rex"); got != 0 {
+		t.Fatalf("expected 0, got %v", got)
+	}
+}
+
+func TestHighlightPet(t *testing.T) {
+	pet := &Pet{
+		Name:  "Rex",
+		Breed: "Golden Retriever",
+		Notes: "um dois três quatro cinco seis sete oito nove dez onze doze treze catorze quinze dezesseis dezessete dezoito dezenove vinte " +
+			"vinte-e-um gosta de brincar com o rex no quintal depois do almoço todos os dias da semana inclusive aos sábados e domingos quando chove",
+	}
+	got := HighlightPet(pet, PetSearch{Query: "rexx golden"}.Terms())
+
+	if got[SearchFieldName] != "<mark>Rex</mark>" {
+		t.Fatalf("unexpected name highlight: %q", got[SearchFieldName])
+	}
+	if got[SearchFieldBreed] != "<mark>Golden</mark> Retriever" {
+		t.Fatalf("unexpected breed highlight: %q", got[SearchFieldBreed])
+	}
+	notes := got[SearchFieldNotes]
+	if !strings.HasPrefix(notes, "… ") || !strings.HasSuffix(notes, " …") || !strings.Contains(notes, "o <mark>rex</mark> no") {
+		t.Fatalf("unexpected notes snippet: %q", notes)
+	}
+
+	if _, ok := HighlightPet(&Pet{Name: "Mel"}, []string{"rex"})[SearchFieldName]; ok {
+		t.Fatal("fields without matches must be left out")
+	}
+}
@@ -0,0 +1,135 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PetStatus é a etapa do pet no fluxo de adoção do abrigo.
+type PetStatus string
+
+const (
+	StatusIntake    PetStatus = "intake"
+	StatusAvailable PetStatus = "available"
+	StatusReserved  PetStatus = "reserved"
+	StatusAdopted   PetStatus = "adopted"
+	StatusReturned  PetStatus = "returned"
+)
+
+const MaxStatusReasonLength = 500
+
+func (s PetStatus) Valid() bool {
+	_, ok := petStatusTransitions[s]
+	return ok
+}
+
+// petStatusTransitions lista, para cada status, os status que podem vir em seguida.
+// Um pet devolvido volta para triagem ou direto para adoção.
+var petStatusTransitions = map[PetStatus][]PetStatus{
+	StatusIntake:    {StatusAvailable},
+	StatusAvailable: {StatusReserved, StatusIntake},
+	StatusReserved:  {StatusAdopted, StatusAvailable},
+	StatusAdopted:   {StatusReturned},
+	StatusReturned:  {StatusIntake, StatusAvailable},
+}
+
+// CanTransition diz se o pet pode ir de from para to.
+func CanTransition(from, to PetStatus) bool {
+	for _, next := range petStatusTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// CurrentStatus trata como intake os pets gravados antes do status existir.
+func (p *Pet) CurrentStatus() PetStatus {
+	if p.Status == "" {
+		return StatusIntake
+	}
+	return p.Status
+}
+
+// ValidateNewStatus põe os pets novos em intake; os demais status só se alcançam por
+// transição.
+func (p *Pet) ValidateNewStatus() map[string]string {
+	if p.Status == "" {
+		p.Status = StatusIntake
+	}
+	if p.Status != StatusIntake {
+		return map[string]string{"status": "new pets start in intake"}
+	}
+	return map[string]string{}
+}
+
+// StatusTransition é o pedido de mudança de status. Guardian é obrigatório na adoção,
+// onde é o adotante, e opcional na devolução, onde é quem recebe o pet de volta; nos
+// dois casos ele passa a ser o dono. Reason é obrigatório na devolução.
+type StatusTransition struct {
+	To       PetStatus
+	Guardian uuid.UUID
+	Reason   string
+}
+
+// Validate checa os dados exigidos pelo status de destino; se a transição é permitida a
+// partir do status atual é decidido por CheckFrom.
+func (t *StatusTransition) Validate() map[string]string {
+	errorMessages := make(map[string]string)
+
+	if !t.To.Valid() {
+		errorMessages["status"] = "status must be intake, available, reserved, adopted or returned"
+	}
+
+	switch t.To {
+	case StatusAdopted:
+		if t.Guardian == uuid.Nil {
+			errorMessages["uuid_guardian"] = "the adopter guardian is required to adopt"
+		}
+	case StatusReturned:
+	default:
+		if t.Guardian != uuid.Nil {
+			errorMessages["uuid_guardian"] = "uuid_guardian is only accepted when adopting or returning"
+		}
+	}
+
+	t.Reason = strings.TrimSpace(t.Reason)
+	if t.To == StatusReturned && t.Reason == "" {
+		errorMessages["reason"] = "a reason is required to return a pet"
+	}
+	checkLength(errorMessages, "reason", t.Reason, MaxStatusReasonLength)
+	return errorMessages
+}
+
+// CheckFrom devolve o motivo da recusa quando o pet não pode sair de from para t.To.
+func (t *StatusTransition) CheckFrom(from PetStatus) string {
+	if CanTransition(from, t.To) {
+		return ""
+	}
+	return fmt.Sprintf("cannot move pet from %s to %s", from, t.To)
+}
+
+// StatusChange é a transição já aceita, como o repositório a grava. From é o status lido
+// antes; se ele mudou nesse meio tempo a escrita é recusada. Guardian zerado mantém o
+// dono atual.
+type StatusChange struct {
+	From      PetStatus
+	To        PetStatus
+	Reason    string
+	Guardian  uuid.UUID
+	ChangedAt time.Time
+}
+
+// Apply grava a mudança no pet.
+func (c StatusChange) Apply(pet *Pet) {
+	pet.Status = c.To
+	pet.StatusReason = c.Reason
+	changedAt := c.ChangedAt
+	pet.StatusChangedAt = &changedAt
+	if c.Guardian != uuid.Nil {
+		pet.UuidGuardian = c.Guardian
+	}
+}
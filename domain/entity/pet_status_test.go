@@ -0,0 +1,95 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestCanTransition(t *testing.T) {
+	cases := []struct {
+		from, to PetStatus
+		want     bool
+	}{
+		{StatusIntake, StatusAvailable, true},
+		{StatusIntake, StatusAdopted, false},
+		{StatusAvailable, StatusReserved, true},
+		{StatusAvailable, StatusAdopted, false},
+		{StatusReserved, StatusAdopted, true},
+		{StatusReserved, StatusAvailable, true},
+		{StatusAdopted, StatusReturned, true},
+		{StatusAdopted, StatusAvailable, false},
+		{StatusReturned, StatusIntake, true},
+		{StatusReturned, StatusReturned, false},
+	}
+	for _, tc := range cases {
+		if got := CanTransition(tc.from, tc.to); got != tc.want {
+			t.Errorf("%s -> %s: expected %v, got %v", tc.from, tc.to, tc.want, got)
+		}
+	}
+}
+
+func TestStatusTransition_Validate(t *testing.T) {
+	adopter := uuid.New()
+	cases := []struct {
+		name       string
+		transition StatusTransition
+		wantField  string
+	}{
+		{"available", StatusTransition{To: StatusAvailable}, ""},
+		{"unknown status", StatusTransition{To: "lost"}, "status"},
+		{"adoption without adopter", StatusTransition{To: StatusAdopted}, "uuid_guardian"},
+		{"adoption", StatusTransition{To: StatusAdopted, Guardian: adopter}, ""},
+		{"guardian on reservation", StatusTransition{To: StatusReserved, Guardian: adopter}, "uuid_guardian"},
+		{"return without reason", StatusTransition{To: StatusReturned, Reason: "  "}, "reason"},
+		{"return", StatusTransition{To: StatusReturned, Reason: "allergy", Guardian: adopter}, ""},
+		{"reason too long", StatusTransition{To: StatusAvailable, Reason: strings.Repeat("a", MaxStatusReasonLength+1)}, "reason"},
+	}
+	for _, tc := range cases {
+		errs := tc.transition.Validate()
+		if tc.wantField == "" && len(errs) != 0 {
+			t.Errorf("%s: unexpected errors %v", tc.name, errs)
+		}
+		if tc.wantField != "" && errs[tc.wantField] == "" {
+			t.Errorf("%s: expected %s error, got %v", tc.name, tc.wantField, errs)
+		}
+	}
+}
+
+func TestStatusTransition_CheckFrom(t *testing.T) {
+	transition := StatusTransition{To: StatusAdopted, Guardian: uuid.New()}
+	if msg := transition.CheckFrom(StatusReserved); msg != "" {
+		t.Fatalf("unexpected refusal: %s", msg)
+	}
+	if msg := transition.CheckFrom(StatusIntake); msg != "cannot move pet from intake to adopted" {
+		t.Fatalf("unexpected message: %q", msg)
+	}
+}
+
+func TestPet_ValidateNewStatus(t *testing.T) {
+	pet := &Pet{}
+	if errs := pet.ValidateNewStatus(); len(errs) != 0 || pet.Status != StatusIntake {
+		t.Fatalf("expected intake, got %q %v", pet.Status, errs)
+	}
+	pet.Status = StatusAdopted
+	if errs := pet.ValidateNewStatus(); errs["status"] == "" {
+		t.Fatalf("expected status error, got %v", errs)
+	}
+}
+
+func TestStatusChange_Apply(t *testing.T) {
+	owner, adopter := uuid.New(), uuid.New()
+	at := time.Date(2024, time.May, 2, 10, 0, 0, 0, time.UTC)
+	pet := &Pet{UuidGuardian: owner}
+
+	StatusChange{From: StatusIntake, To: StatusAvailable, ChangedAt: at}.Apply(pet)
+	if pet.Status != StatusAvailable || pet.UuidGuardian != owner || pet.StatusChangedAt == nil || !pet.StatusChangedAt.Equal(at) {
+		t.Fatalf("unexpected pet after change: %+v", pet)
+	}
+	StatusChange{From: StatusReserved, To: StatusAdopted, Guardian: adopter, ChangedAt: at}.Apply(pet)
+	if pet.UuidGuardian != adopter {
+		t.Fatalf("expected the adopter as owner, got %s", pet.UuidGuardian)
+	}
+}
@@ -72,3 +72,35 @@ func TestPetValidate_WithValidPetAndAction_NoErrors(t *testing.T) {
 		t.Fatalf("expected no errors for valid pet, got: %v", errs)
 	}
 }
+
+func TestPetValidate_Create_RequiresGuardian(t *testing.T) {
+	pet := &Pet{
+		Uuid:      uuid.New(),
+		Name:      "Rex",
+		BirthYear: 2020,
+		Breed:     "SRD",
+		Specie:    Dog,
+	}
+
+	errs := pet.Validate("create")
+
+	if len(errs) != 1 || errs["uuid_guardian"] == "" {
+		t.Fatalf("expected only the uuid_guardian error, got: %v", errs)
+	}
+}
+
+func TestPetValidate_Update_RequiresUuid(t *testing.T) {
+	pet := &Pet{
+		UuidGuardian: uuid.New(),
+		Name:         "Rex",
+		BirthYear:    2020,
+		Breed:        "SRD",
+		Specie:       Dog,
+	}
+
+	errs := pet.Validate("update")
+
+	if len(errs) != 1 || errs["uuid"] == "" {
+		t.Fatalf("expected only the uuid error, got: %v", errs)
+	}
+}
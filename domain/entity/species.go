@@ -0,0 +1,50 @@
+package entity
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+var speciesCodePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{1,31}$`)
+
+// Species é uma entrada do catálogo de espécies. Value é o número gravado em
+// Pet.Specie; Code é o identificador estável exposto na API.
+type Species struct {
+	ID          uint      `gorm:"primary_key" json:"id"`
+	Value       PetType   `gorm:"unique_index" json:"value"`
+	Code        string    `gorm:"unique_index" json:"code"`
+	DisplayName string    `json:"display_name"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// DefaultSpecies é o catálogo inicial, gravado pelas migrações.
+func DefaultSpecies() []Species {
+	return []Species{
+		{Value: Dog, Code: "dog", DisplayName: "Cachorro"},
+		{Value: Cat, Code: "cat", DisplayName: "Gato"},
+		{Value: Bird, Code: "bird", DisplayName: "Ave"},
+		{Value: Rabbit, Code: "rabbit", DisplayName: "Coelho"},
+		{Value: Reptile, Code: "reptile", DisplayName: "Réptil"},
+		{Value: Rodent, Code: "rodent", DisplayName: "Roedor"},
+		{Value: Fish, Code: "fish", DisplayName: "Peixe"},
+		{Value: Horse, Code: "horse", DisplayName: "Cavalo"},
+	}
+}
+
+func (s *Species) Validate() map[string]string {
+	errorMessages := make(map[string]string)
+
+	s.Code = strings.ToLower(strings.TrimSpace(s.Code))
+	if !speciesCodePattern.MatchString(s.Code) {
+		errorMessages["code"] = "code must be 2-32 lowercase letters, digits or underscores"
+	}
+
+	s.DisplayName = strings.TrimSpace(s.DisplayName)
+	if s.DisplayName == "" {
+		errorMessages["display_name"] = "display name is empty"
+	}
+
+	return errorMessages
+}
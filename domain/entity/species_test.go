@@ -0,0 +1,37 @@
+package entity
+
+import "testing"
+
+func TestSpecies_Validate(t *testing.T) {
+	s := &Species{Code: " Guinea_Pig ", DisplayName: " Porquinho-da-índia "}
+	if errs := s.Validate(); len(errs) != 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if s.Code != "guinea_pig" || s.DisplayName != "Porquinho-da-índia" {
+		t.Fatalf("expected normalized fields, got %+v", s)
+	}
+
+	invalid := &Species{Code: "1-bad"}
+	errs := invalid.Validate()
+	if errs["code"] == "" || errs["display_name"] == "" {
+		t.Fatalf("expected code and display_name errors, got %v", errs)
+	}
+}
+
+func TestDefaultSpecies_AreValidAndUnique(t *testing.T) {
+	codes := map[string]bool{}
+	values := map[PetType]bool{}
+	for _, s := range DefaultSpecies() {
+		if errs := s.Validate(); len(errs) != 0 {
+			t.Fatalf("default species %q is invalid: %v", s.Code, errs)
+		}
+		if codes[s.Code] || values[s.Value] {
+			t.Fatalf("duplicate default species %+v", s)
+		}
+		if s.Value >= FirstCustomPetType {
+			t.Fatalf("built-in species %q must stay below FirstCustomPetType", s.Code)
+		}
+		codes[s.Code] = true
+		values[s.Value] = true
+	}
+}
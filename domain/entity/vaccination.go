@@ -0,0 +1,98 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	MaxVaccineNameLength  = 100
+	MaxLotNumberLength    = 50
+	MaxVeterinarianLength = 100
+	// MaxBoosterIntervalDays cobre as vacinas com reforço de até três anos, com folga.
+	MaxBoosterIntervalDays = 3650
+)
+
+// Vaccination é uma dose aplicada num pet. NextDueAt é a data do reforço, calculada
+// por ScheduleBooster a partir de AdministeredAt e BoosterIntervalDays.
+type Vaccination struct {
+	ID                  uint       `gorm:"primary_key" json:"id"`
+	Uuid                uuid.UUID  `gorm:"unique_index" json:"uuid"`
+	PetUuid             uuid.UUID  `gorm:"index" json:"pet_uuid"`
+	Vaccine             string     `json:"vaccine"`
+	Dose                int        `json:"dose"`
+	AdministeredAt      time.Time  `gorm:"type:date" json:"administered_at"`
+	BoosterIntervalDays int        `json:"booster_interval_days,omitempty"`
+	NextDueAt           *time.Time `gorm:"type:date;index" json:"next_due_at,omitempty"`
+	LotNumber           string     `json:"lot_number,omitempty"`
+	Veterinarian        string     `json:"veterinarian,omitempty"`
+	Notes               string     `gorm:"type:text" json:"notes,omitempty"`
+	CreatedAt           time.Time  `json:"created_at"`
+	UpdatedAt           time.Time  `json:"updated_at"`
+}
+
+// Validate checa a dose e normaliza o nome da vacina e a data de aplicação. Dose 0
+// vira 1, a primeira dose.
+func (v *Vaccination) Validate(now time.Time) map[string]string {
+	errorMessages := make(map[string]string)
+
+	if v.PetUuid == uuid.Nil {
+		errorMessages["pet_uuid"] = "pet uuid is missing or invalid"
+	}
+
+	v.Vaccine = strings.Join(strings.Fields(v.Vaccine), " ")
+	if v.Vaccine == "" {
+		errorMessages["vaccine"] = "vaccine is empty"
+	}
+	checkLength(errorMessages, "vaccine", v.Vaccine, MaxVaccineNameLength)
+	checkLength(errorMessages, "lot_number", v.LotNumber, MaxLotNumberLength)
+	checkLength(errorMessages, "veterinarian", v.Veterinarian, MaxVeterinarianLength)
+	checkLength(errorMessages, "notes", v.Notes, MaxNotesLength)
+
+	if v.Dose == 0 {
+		v.Dose = 1
+	}
+	if v.Dose < 0 {
+		errorMessages["dose"] = "dose must be positive"
+	}
+
+	if v.BoosterIntervalDays < 0 || v.BoosterIntervalDays > MaxBoosterIntervalDays {
+		errorMessages["booster_interval_days"] = fmt.Sprintf("booster interval must be between 0 and %d days", MaxBoosterIntervalDays)
+	}
+
+	if v.AdministeredAt.IsZero() {
+		errorMessages["administered_at"] = "administration date is missing"
+	} else {
+		v.AdministeredAt = dateOnly(v.AdministeredAt)
+		if v.AdministeredAt.Year() < MinBirthYear || v.AdministeredAt.After(dateOnly(now)) {
+			errorMessages["administered_at"] = "date out of range"
+		}
+	}
+
+	return errorMessages
+}
+
+// ScheduleBooster calcula NextDueAt. Sem intervalo a vacina não tem reforço.
+func (v *Vaccination) ScheduleBooster() {
+	if v.BoosterIntervalDays <= 0 {
+		v.NextDueAt = nil
+		return
+	}
+	due := dateOnly(v.AdministeredAt).AddDate(0, 0, v.BoosterIntervalDays)
+	v.NextDueAt = &due
+}
+
+// Overdue indica que o reforço venceu antes de now. O próprio dia do vencimento
+// ainda não conta como atraso.
+func (v *Vaccination) Overdue(now time.Time) bool {
+	return v.NextDueAt != nil && dateOnly(*v.NextDueAt).Before(dateOnly(now))
+}
+
+// dateOnly descarta o horário, mantendo o dia do calendário em UTC.
+func dateOnly(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
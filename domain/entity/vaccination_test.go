@@ -0,0 +1,53 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestVaccinationValidate(t *testing.T) {
+	v := &Vaccination{
+		PetUuid:        uuid.New(),
+		Vaccine:        "  V10   polivalente ",
+		AdministeredAt: time.Date(2024, time.March, 10, 15, 30, 0, 0, time.UTC),
+	}
+	if errs := v.Validate(birthTestNow); len(errs) != 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if v.Vaccine != "V10 polivalente" || v.Dose != 1 {
+		t.Fatalf("expected normalized vaccine and first dose, got %q / %d", v.Vaccine, v.Dose)
+	}
+	if !v.AdministeredAt.Equal(*birthDate(2024, time.March, 10)) {
+		t.Fatalf("expected date without time, got %v", v.AdministeredAt)
+	}
+
+	invalid := &Vaccination{Dose: -1, BoosterIntervalDays: MaxBoosterIntervalDays + 1, AdministeredAt: birthTestNow.AddDate(0, 0, 1)}
+	errs := invalid.Validate(birthTestNow)
+	for _, field := range []string{"pet_uuid", "vaccine", "dose", "booster_interval_days", "administered_at"} {
+		if errs[field] == "" {
+			t.Errorf("expected error on %s, got %v", field, errs)
+		}
+	}
+}
+
+func TestVaccinationScheduleBoosterAndOverdue(t *testing.T) {
+	v := &Vaccination{AdministeredAt: *birthDate(2023, time.June, 1), BoosterIntervalDays: 365}
+	v.ScheduleBooster()
+	if v.NextDueAt == nil || !v.NextDueAt.Equal(*birthDate(2024, time.May, 31)) {
+		t.Fatalf("expected booster on 2024-05-31, got %v", v.NextDueAt)
+	}
+	if !v.Overdue(birthTestNow) {
+		t.Fatal("expected booster to be overdue")
+	}
+	if v.Overdue(*birthDate(2024, time.May, 31)) {
+		t.Fatal("the due date itself must not be overdue")
+	}
+
+	v.BoosterIntervalDays = 0
+	v.ScheduleBooster()
+	if v.NextDueAt != nil || v.Overdue(birthTestNow) {
+		t.Fatalf("expected no booster, got %v", v.NextDueAt)
+	}
+}
@@ -0,0 +1,30 @@
+package event
+
+import (
+	"errors"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+)
+
+var (
+	// ErrResumeExpired indica que os eventos após a posição pedida já não estão retidos.
+	ErrResumeExpired = errors.New("resume position is no longer available")
+	// ErrSubscriberLagging indica que o assinante não consumiu os eventos a tempo e foi desconectado.
+	ErrSubscriberLagging = errors.New("subscriber fell behind the event stream")
+)
+
+type Bus interface {
+	Publish(events ...entity.PetEvent)
+	// Subscribe entrega os eventos com Sequence maior que afterSequence; zero começa do próximo evento.
+	Subscribe(afterSequence uint64) (Subscription, error)
+}
+
+type Subscription interface {
+	// Events é fechado quando a assinatura termina; Err diz o motivo.
+	Events() <-chan entity.PetEvent
+	Err() error
+	Close()
+}
+
+// Encoder serializa um evento para a outbox, devolvendo o tipo publicado e o payload.
+type Encoder func(ev entity.PetEvent) (eventType string, payload []byte, err error)
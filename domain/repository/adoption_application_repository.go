@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/google/uuid"
+)
+
+// AdoptionApplicationRepository guarda os pedidos de adoção. tenant vazio enxerga todos
+// os tenants; com tenant, pedidos de outra organização não são encontrados.
+type AdoptionApplicationRepository interface {
+	// SubmitApplication devolve conflict quando o guardião já tem pedido pendente
+	// para o pet.
+	SubmitApplication(application *entity.AdoptionApplication) (*entity.AdoptionApplication, map[string]string)
+	GetApplication(tenant string, uuid uuid.UUID) (*entity.AdoptionApplication, map[string]string)
+	// DecideApplication grava a recusa ou a desistência de um pedido; devolve
+	// failed_precondition quando ele não está mais pendente.
+	DecideApplication(tenant string, uuid uuid.UUID, decision entity.ApplicationDecision) (*entity.AdoptionApplication, map[string]string)
+	// ApproveApplication aprova o pedido pendente, reserva o pet, que precisa estar
+	// disponível, e recusa os outros pedidos pendentes dele, tudo na mesma transação.
+	ApproveApplication(tenant string, uuid uuid.UUID, decision entity.ApplicationDecision) (*entity.ApplicationApproval, map[string]string)
+	// As listagens paginam por id, do pedido mais antigo para o mais novo.
+	ListApplicationsByPet(tenant string, petUuid uuid.UUID, afterID uint, limit int) ([]*entity.AdoptionApplication, map[string]string)
+	ListApplicationsByApplicant(tenant string, applicant uuid.UUID, afterID uint, limit int) ([]*entity.AdoptionApplication, map[string]string)
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/google/uuid"
+)
+
+type AttachmentRepository interface {
+	CreateAttachment(attachment *entity.Attachment) (*entity.Attachment, map[string]string)
+	GetAttachment(uuid uuid.UUID) (*entity.Attachment, map[string]string)
+	ListAttachments(petUuid uuid.UUID) ([]*entity.Attachment, map[string]string)
+	DeleteAttachment(uuid uuid.UUID) map[string]string
+}
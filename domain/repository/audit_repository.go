@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/google/uuid"
+)
+
+// AuditRepository é só de inclusão: entradas nunca são alteradas nem removidas. As
+// listagens ficam restritas às entradas de tenant; vazio lista todas.
+type AuditRepository interface {
+	AppendAuditEntries(entries []*entity.AuditEntry) map[string]string
+	ListAuditEntriesByPet(tenant string, petUuid uuid.UUID, afterID uint, limit int) ([]*entity.AuditEntry, map[string]string)
+	ListAuditEntriesByGuardian(tenant string, uuidGuardian uuid.UUID, afterID uint, limit int) ([]*entity.AuditEntry, map[string]string)
+}
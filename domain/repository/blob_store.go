@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrBlobNotFound é devolvido por Get quando a chave não existe.
+var ErrBlobNotFound = errors.New("blob not found")
+
+// BlobStore guarda o conteúdo dos anexos. Put recebe o tamanho exato do conteúdo,
+// exigido por armazenamentos como o S3 para enviar sem bufferizar. Delete de uma
+// chave inexistente não é erro.
+type BlobStore interface {
+	Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
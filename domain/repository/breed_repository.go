@@ -0,0 +1,7 @@
+package repository
+
+import "github.com/LuizFJP/pet-ms/domain/entity"
+
+type BreedRepository interface {
+	ListBreeds() ([]*entity.Breed, map[string]string)
+}
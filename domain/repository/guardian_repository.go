@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/google/uuid"
+)
+
+// GuardianRepository guarda o cadastro de guardiões. tenant vazio enxerga todos os
+// tenants; com tenant, guardiões de outra organização não são encontrados.
+type GuardianRepository interface {
+	CreateGuardian(guardian *entity.Guardian) (*entity.Guardian, map[string]string)
+	GetGuardian(tenant string, uuid uuid.UUID) (*entity.Guardian, map[string]string)
+	// UpdateGuardian substitui o cadastro; o uuid e o tenant não mudam.
+	UpdateGuardian(guardian *entity.Guardian) (*entity.Guardian, map[string]string)
+	DeleteGuardian(tenant string, uuid uuid.UUID) map[string]string
+	// ListGuardians pagina por id, do cadastro mais antigo para o mais novo.
+	ListGuardians(tenant string, afterID uint, limit int) ([]*entity.Guardian, map[string]string)
+}
+
+// GuardianDirectory confirma que um guardião existe antes de um pet apontar para ele.
+// É implementado pelo cadastro local e pelo cliente do serviço externo de guardiões;
+// o erro indica que a resposta não pôde ser obtida.
+type GuardianDirectory interface {
+	GuardianExists(ctx context.Context, tenant string, uuid uuid.UUID) (bool, error)
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+)
+
+// IdempotencyRepository guarda as chaves por tenant; tenant vazio é o das instalações
+// sem tenancy. As chaves são gravadas junto com o pet pelo IdempotentWriter do
+// repositório de pets.
+type IdempotencyRepository interface {
+	GetIdempotencyKey(tenant, key string) (*entity.IdempotencyKey, map[string]string)
+	DeleteExpiredIdempotencyKeys(now time.Time) (int64, map[string]string)
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/google/uuid"
+)
+
+type MedicalRecordRepository interface {
+	CreateMedicalRecord(record *entity.MedicalRecord) (*entity.MedicalRecord, map[string]string)
+	GetMedicalRecord(uuid uuid.UUID) (*entity.MedicalRecord, map[string]string)
+	UpdateMedicalRecord(record *entity.MedicalRecord) (*entity.MedicalRecord, map[string]string)
+	ListMedicalRecords(petUuid uuid.UUID) ([]*entity.MedicalRecord, map[string]string)
+}
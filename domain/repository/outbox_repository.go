@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+)
+
+type OutboxRepository interface {
+	PendingOutboxMessages(limit int) ([]*entity.OutboxMessage, map[string]string)
+	MarkOutboxMessagePublished(id uint, at time.Time) map[string]string
+	MarkOutboxMessageFailed(id uint, reason string) map[string]string
+}
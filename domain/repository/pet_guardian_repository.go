@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/google/uuid"
+)
+
+// PetGuardianRepository guarda os guardiões de um pet além do dono, que continua em
+// entity.Pet.UuidGuardian.
+type PetGuardianRepository interface {
+	AddPetGuardian(link *entity.PetGuardian) (*entity.PetGuardian, map[string]string)
+	ListPetGuardians(petUuid uuid.UUID) ([]*entity.PetGuardian, map[string]string)
+	RemovePetGuardian(petUuid, guardian uuid.UUID) map[string]string
+	// PetsOfGuardian devolve os pets em que guardian tem um dos papéis; tenant vazio
+	// não filtra.
+	PetsOfGuardian(tenant string, guardian uuid.UUID, roles []entity.GuardianRole) ([]uuid.UUID, map[string]string)
+	DeletePetLinks(petUuids []uuid.UUID) map[string]string
+	DeleteGuardianLinks(tenant string, guardian uuid.UUID) map[string]string
+}
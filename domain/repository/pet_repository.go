@@ -1,10 +1,68 @@
 package repository
 
-import "github.com/LuizFJP/pet-ms/domain/entity"
+import (
+	"context"
+	"time"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/google/uuid"
+)
 
 type PetRepository interface {
 	SavePet(pet *entity.Pet) (*entity.Pet, map[string]string)
 	GetPet(uuid string) (*entity.Pet, map[string]string)
+	// GetPetByMicrochip devolve not_found quando nenhum pet tem o chip.
+	GetPetByMicrochip(number string) (*entity.Pet, map[string]string)
+	// UpdatePet e UpdatePets mantêm o guardião gravado quando pet.UuidGuardian é nulo.
 	UpdatePet(pet *entity.Pet) (*entity.Pet, map[string]string)
+	// UpdatePetFields grava só as colunas dos campos informados (nomes json do Pet).
+	UpdatePetFields(pet *entity.Pet, fields []string) (*entity.Pet, map[string]string)
 	DeletePet(uuid string) (map[string]string, map[string]string)
+	TransferPet(uuid string, uuidGuardian string) (*entity.Pet, map[string]string)
+	// ChangePetStatus devolve aborted quando o status do pet não é mais change.From.
+	ChangePetStatus(uuid string, change entity.StatusChange) (*entity.Pet, map[string]string)
+	SavePets(pets []*entity.Pet, allOrNothing bool) ([]*entity.Pet, []map[string]string)
+	GetPets(uuids []string) ([]*entity.Pet, map[string]string)
+	UpdatePets(pets []*entity.Pet, allOrNothing bool) ([]*entity.Pet, []map[string]string)
+	InsertPets(pets []*entity.Pet) map[string]string
+	ListPets(filter entity.PetFilter, afterUuid string, limit int) ([]*entity.Pet, map[string]string)
+	// SearchPets devolve até search.Limit pets, do mais para o menos relevante, sem Highlights.
+	SearchPets(search entity.PetSearch) ([]*entity.PetSearchHit, map[string]string)
+}
+
+// ReplicaRouter é implementado pelos repositórios com réplicas de leitura. As leituras
+// do próprio repositório vão ao primário; ReadReplica devolve um repositório, só para
+// leitura, que consulta as réplicas, a menos que lastWrite seja recente demais para
+// elas já terem recebido a escrita.
+type ReplicaRouter interface {
+	ReadReplica(lastWrite time.Time) PetRepository
+}
+
+// ContextBinder é implementado pelos repositórios que limitam seu trabalho (novas
+// tentativas, esperas) ao prazo da requisição. WithContext devolve o repositório preso
+// a ctx; sem ele as operações seguem só os limites do próprio repositório.
+type ContextBinder interface {
+	WithContext(ctx context.Context) PetRepository
+}
+
+// TenantScoper é implementado pelos repositórios que separam os pets por organização.
+// ForTenant devolve o repositório restrito a tenant: as leituras só enxergam os pets
+// dele, as escritas só alcançam os pets dele e os pets gravados passam a ser dele. O
+// repositório sem escopo enxerga todos os tenants e fica para as tarefas internas.
+type TenantScoper interface {
+	ForTenant(tenant string) PetRepository
+}
+
+// PetCache é implementado pelos repositórios que guardam os pets lidos. Forget descarta
+// pets alterados por fora do repositório, como o pet que a aprovação de um pedido de
+// adoção reserva.
+type PetCache interface {
+	Forget(petUuids ...uuid.UUID)
+}
+
+// IdempotentWriter é implementado pelos repositórios que criam o pet e gravam a chave
+// de idempotência na mesma transação, pelo mesmo caminho de SavePet. Uma chave expirada
+// é substituída; com a chave ainda ativa nada é gravado e a chamada falha.
+type IdempotentWriter interface {
+	SavePetWithIdempotencyKey(pet *entity.Pet, key *entity.IdempotencyKey) (*entity.Pet, map[string]string)
 }
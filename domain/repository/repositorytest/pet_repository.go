@@ -0,0 +1,532 @@
+// Package repositorytest reúne os testes de conformidade que toda implementação dos
+// repositórios precisa passar, seja em banco ou em memória.
+package repositorytest
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/LuizFJP/pet-ms/domain/repository"
+)
+
+// NewPetRepository devolve um repositório vazio, isolado dos outros subtestes.
+type NewPetRepository func(t *testing.T) repository.PetRepository
+
+// TestPetRepository roda a suíte de conformidade de repository.PetRepository.
+func TestPetRepository(t *testing.T, newRepo NewPetRepository) {
+	tests := []struct {
+		name string
+		run  func(t *testing.T, repo repository.PetRepository)
+	}{
+		{"SaveAndGet", testSaveAndGet},
+		{"SaveDuplicateUuid", testSaveDuplicateUuid},
+		{"ReturnedPetsAreCopies", testReturnedPetsAreCopies},
+		{"UpdatePet", testUpdatePet},
+		{"UpdateMissingPet", testUpdateMissingPet},
+		{"UpdatePetFields", testUpdatePetFields},
+		{"DeleteByGuardian", testDeleteByGuardian},
+		{"TransferPet", testTransferPet},
+		{"ChangePetStatus", testChangePetStatus},
+		{"Microchip", testMicrochip},
+		{"SavePetsAllOrNothing", testSavePetsAllOrNothing},
+		{"UpdatePetsPerItem", testUpdatePetsPerItem},
+		{"GetPets", testGetPets},
+		{"InsertPets", testInsertPets},
+		{"ListPets", testListPets},
+		{"ListPetsByGuardianRole", testListPetsByGuardianRole},
+		{"SearchPets", testSearchPets},
+		{"TenantIsolation", testTenantIsolation},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.run(t, newRepo(t))
+		})
+	}
+}
+
+func newPet(name string, guardian uuid.UUID) *entity.Pet {
+	return &entity.Pet{
+		Uuid:         uuid.New(),
+		UuidGuardian: guardian,
+		Name:         name,
+		BirthYear:    2020,
+		Breed:        "SRD",
+		Specie:       entity.Dog,
+	}
+}
+
+func save(t *testing.T, repo repository.PetRepository, pets ...*entity.Pet) {
+	t.Helper()
+	for _, pet := range pets {
+		_, errData := repo.SavePet(pet)
+		require.Nil(t, errData, "saving %s", pet.Name)
+	}
+}
+
+func testSaveAndGet(t *testing.T, repo repository.PetRepository) {
+	born := time.Date(2020, time.March, 1, 0, 0, 0, 0, time.UTC)
+	neutered := true
+	pet := newPet("Luna", uuid.New())
+	pet.BirthDate = &born
+	pet.BirthDateAccuracy = entity.BirthDateMonth
+	pet.Neutered = &neutered
+	pet.Color = "Preto"
+	pet.WeightHistory = entity.WeightHistory{{Grams: 4000, MeasuredAt: time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC)}}
+	pet.Photos = entity.StringList{"https://cdn.example.com/luna.jpg"}
+	pet.Notes = "Alérgica a frango"
+
+	saved, errData := repo.SavePet(pet)
+	require.Nil(t, errData)
+	assert.Equal(t, pet.Uuid, saved.Uuid)
+
+	got, errData := repo.GetPet(pet.Uuid.String())
+	require.Nil(t, errData)
+	assert.Equal(t, "Luna", got.Name)
+	assert.Equal(t, pet.UuidGuardian, got.UuidGuardian)
+	require.NotNil(t, got.BirthDate)
+	assert.True(t, born.Equal(*got.BirthDate), "expected %v, got %v", born, got.BirthDate)
+	assert.Equal(t, entity.BirthDateMonth, got.BirthDateAccuracy)
+	require.NotNil(t, got.Neutered)
+	assert.True(t, *got.Neutered)
+	assert.Equal(t, "Preto", got.Color)
+	require.Len(t, got.WeightHistory, 1)
+	assert.Equal(t, 4000, got.WeightHistory[0].Grams)
+	assert.Equal(t, pet.Photos, got.Photos)
+	assert.Equal(t, "Alérgica a frango", got.Notes)
+
+	_, errData = repo.GetPet(uuid.New().String())
+	assert.Contains(t, errData, "db_error", "a missing pet is reported like gorm's First()")
+}
+
+func testSaveDuplicateUuid(t *testing.T, repo repository.PetRepository) {
+	pet := newPet("Rex", uuid.New())
+	save(t, repo, pet)
+
+	again := newPet("Rex II", uuid.New())
+	again.Uuid = pet.Uuid
+	saved, errData := repo.SavePet(again)
+	assert.Nil(t, saved)
+	assert.Contains(t, errData, "db_error")
+
+	got, _ := repo.GetPet(pet.Uuid.String())
+	assert.Equal(t, "Rex", got.Name)
+}
+
+func testReturnedPetsAreCopies(t *testing.T, repo repository.PetRepository) {
+	pet := newPet("Rex", uuid.New())
+	pet.Photos = entity.StringList{"a.jpg"}
+	save(t, repo, pet)
+	pet.Name = "changed after save"
+
+	got, _ := repo.GetPet(pet.Uuid.String())
+	got.Name = "changed after get"
+	got.Photos[0] = "b.jpg"
+
+	again, _ := repo.GetPet(pet.Uuid.String())
+	assert.Equal(t, "Rex", again.Name)
+	assert.Equal(t, entity.StringList{"a.jpg"}, again.Photos)
+}
+
+func testUpdatePet(t *testing.T, repo repository.PetRepository) {
+	pet := newPet("Luna", uuid.New())
+	pet.Color = "Preto"
+	save(t, repo, pet)
+
+	// o Update sem máscara troca os campos básicos e mantém os de perfil
+	changes := newPet("Luna II", uuid.New())
+	changes.Uuid = pet.Uuid
+	changes.NIdentification = pet.NIdentification
+	changes.BirthYear = 2021
+	updated, errData := repo.UpdatePet(changes)
+	require.Nil(t, errData)
+	assert.Equal(t, "Luna II", updated.Name)
+	assert.Equal(t, 2021, updated.BirthYear)
+	assert.Equal(t, changes.UuidGuardian, updated.UuidGuardian)
+	assert.Equal(t, "Preto", updated.Color)
+
+	got, _ := repo.GetPet(pet.Uuid.String())
+	assert.Equal(t, "Luna II", got.Name)
+
+	// sem guardião no Update o gravado continua
+	changes.UuidGuardian = uuid.Nil
+	changes.Name = "Luna III"
+	updated, errData = repo.UpdatePet(changes)
+	require.Nil(t, errData)
+	assert.Equal(t, "Luna III", updated.Name)
+	assert.NotEqual(t, uuid.Nil, updated.UuidGuardian)
+	assert.Equal(t, got.UuidGuardian, updated.UuidGuardian)
+}
+
+func testUpdateMissingPet(t *testing.T, repo repository.PetRepository) {
+	updated, errData := repo.UpdatePet(newPet("Ghost", uuid.New()))
+	assert.Nil(t, updated)
+	assert.Equal(t, "pet not found", errData["not_found"])
+
+	_, errData = repo.UpdatePetFields(newPet("Ghost", uuid.New()), []string{"notes"})
+	assert.Equal(t, "pet not found", errData["not_found"])
+}
+
+func testUpdatePetFields(t *testing.T, repo repository.PetRepository) {
+	pet := newPet("Luna", uuid.New())
+	pet.Color = "Preto"
+	save(t, repo, pet)
+
+	changes := *pet
+	changes.Name = "Not persisted"
+	changes.Notes = "Castrada em 2021"
+	updated, errData := repo.UpdatePetFields(&changes, []string{"notes"})
+	require.Nil(t, errData)
+	assert.Equal(t, "Luna", updated.Name)
+	assert.Equal(t, "Castrada em 2021", updated.Notes)
+	assert.Equal(t, "Preto", updated.Color)
+
+	_, errData = repo.UpdatePetFields(&changes, []string{"uuid_guardian"})
+	assert.Contains(t, errData, "invalid_argument")
+
+	unchanged, errData := repo.UpdatePetFields(&changes, nil)
+	require.Nil(t, errData)
+	assert.Equal(t, "Luna", unchanged.Name)
+}
+
+func testDeleteByGuardian(t *testing.T, repo repository.PetRepository) {
+	guardian := uuid.New()
+	other := newPet("Bidu", uuid.New())
+	save(t, repo, newPet("Pingo", guardian), newPet("Nina", guardian), other)
+
+	msg, errData := repo.DeletePet(guardian.String())
+	require.Nil(t, errData)
+	assert.Equal(t, "2 pet(s) deletados!", msg["message"])
+
+	left, _ := repo.ListPets(entity.PetFilter{}, "", 10)
+	require.Len(t, left, 1)
+	assert.Equal(t, other.Uuid, left[0].Uuid)
+
+	_, errData = repo.DeletePet(guardian.String())
+	assert.Equal(t, "nenhum pet encontrado para esse guardião", errData["not_found"])
+}
+
+func testTransferPet(t *testing.T, repo repository.PetRepository) {
+	pet := newPet("Rex", uuid.New())
+	save(t, repo, pet)
+
+	guardian := uuid.New()
+	transferred, errData := repo.TransferPet(pet.Uuid.String(), guardian.String())
+	require.Nil(t, errData)
+	assert.Equal(t, guardian, transferred.UuidGuardian)
+
+	got, _ := repo.GetPet(pet.Uuid.String())
+	assert.Equal(t, guardian, got.UuidGuardian)
+
+	_, errData = repo.TransferPet(uuid.New().String(), guardian.String())
+	assert.Equal(t, "pet not found", errData["not_found"])
+}
+
+func testChangePetStatus(t *testing.T, repo repository.PetRepository) {
+	owner, adopter := uuid.New(), uuid.New()
+	pet, other := newPet("Rex", owner), newPet("Mia", owner)
+	save(t, repo, pet, other)
+	at := time.Date(2024, time.May, 2, 10, 0, 0, 0, time.UTC)
+
+	change := func(from, to entity.PetStatus, guardian uuid.UUID) (*entity.Pet, map[string]string) {
+		return repo.ChangePetStatus(pet.Uuid.String(), entity.StatusChange{From: from, To: to, Reason: "ok", Guardian: guardian, ChangedAt: at})
+	}
+
+	changed, errData := change(entity.StatusIntake, entity.StatusAvailable, uuid.Nil)
+	require.Nil(t, errData)
+	assert.Equal(t, entity.StatusAvailable, changed.Status)
+	assert.Equal(t, "ok", changed.StatusReason)
+	require.NotNil(t, changed.StatusChangedAt)
+	assert.True(t, at.Equal(*changed.StatusChangedAt))
+	assert.Equal(t, owner, changed.UuidGuardian)
+
+	// a leitura de antes ficou velha
+	_, errData = change(entity.StatusIntake, entity.StatusAvailable, uuid.Nil)
+	assert.NotEmpty(t, errData["aborted"])
+
+	_, errData = change(entity.StatusAvailable, entity.StatusReserved, uuid.Nil)
+	require.Nil(t, errData)
+	changed, errData = change(entity.StatusReserved, entity.StatusAdopted, adopter)
+	require.Nil(t, errData)
+	assert.Equal(t, adopter, changed.UuidGuardian)
+
+	got, _ := repo.GetPet(pet.Uuid.String())
+	assert.Equal(t, entity.StatusAdopted, got.Status)
+	assert.Equal(t, adopter, got.UuidGuardian)
+
+	list := func(statuses ...entity.PetStatus) []uuid.UUID {
+		pets, errData := repo.ListPets(entity.PetFilter{Statuses: statuses}, "", 10)
+		require.Nil(t, errData)
+		var ids []uuid.UUID
+		for _, pet := range pets {
+			ids = append(ids, pet.Uuid)
+		}
+		return ids
+	}
+	assert.Equal(t, []uuid.UUID{pet.Uuid}, list(entity.StatusAdopted))
+	assert.Equal(t, []uuid.UUID{other.Uuid}, list(entity.StatusIntake))
+	assert.Empty(t, list(entity.StatusAvailable, entity.StatusReserved))
+
+	_, errData = repo.ChangePetStatus(uuid.New().String(), entity.StatusChange{From: entity.StatusIntake, To: entity.StatusAvailable, ChangedAt: at})
+	assert.Equal(t, "pet not found", errData["not_found"])
+}
+
+func testMicrochip(t *testing.T, repo repository.PetRepository) {
+	// pets sem chip não colidem entre si
+	save(t, repo, newPet("A", uuid.New()), newPet("B", uuid.New()))
+
+	chipped := newPet("Rex", uuid.New())
+	chipped.MicrochipNumber = "985112000123456"
+	save(t, repo, chipped)
+
+	got, errData := repo.GetPetByMicrochip("985112000123456")
+	require.Nil(t, errData)
+	assert.Equal(t, chipped.Uuid, got.Uuid)
+	_, errData = repo.GetPetByMicrochip("985112000654321")
+	assert.Contains(t, errData, "not_found")
+
+	duplicate := newPet("Mel", uuid.New())
+	duplicate.MicrochipNumber = chipped.MicrochipNumber
+	_, errData = repo.SavePet(duplicate)
+	assert.Contains(t, errData, "conflict")
+
+	other := newPet("Bolt", uuid.New())
+	save(t, repo, other)
+	other.MicrochipNumber = chipped.MicrochipNumber
+	_, errData = repo.UpdatePetFields(other, []string{"microchip_number"})
+	assert.Contains(t, errData, "conflict")
+
+	// regravar o próprio chip não é conflito
+	_, errData = repo.UpdatePetFields(chipped, []string{"microchip_number"})
+	assert.Nil(t, errData)
+}
+
+func testSavePetsAllOrNothing(t *testing.T, repo repository.PetRepository) {
+	existing := newPet("Rex", uuid.New())
+	save(t, repo, existing)
+
+	fresh := newPet("Mia", uuid.New())
+	duplicate := newPet("Rex again", uuid.New())
+	duplicate.Uuid = existing.Uuid
+
+	saved, itemErrs := repo.SavePets([]*entity.Pet{fresh, duplicate}, true)
+	assert.Nil(t, saved[0])
+	assert.Nil(t, saved[1])
+	assert.Contains(t, itemErrs[0], "aborted")
+	assert.Contains(t, itemErrs[1], "db_error")
+
+	_, errData := repo.GetPet(fresh.Uuid.String())
+	assert.NotNil(t, errData, "the whole batch must be rolled back")
+}
+
+func testUpdatePetsPerItem(t *testing.T, repo repository.PetRepository) {
+	existing := newPet("Luna", uuid.New())
+	save(t, repo, existing)
+
+	changed := *existing
+	changed.Name = "Luna Updated"
+	ghost := newPet("Ghost", uuid.New())
+
+	updated, itemErrs := repo.UpdatePets([]*entity.Pet{ghost, &changed}, false)
+	assert.Nil(t, updated[0])
+	assert.Contains(t, itemErrs[0], "not_found")
+	require.NotNil(t, updated[1])
+	assert.Nil(t, itemErrs[1])
+
+	got, _ := repo.GetPet(existing.Uuid.String())
+	assert.Equal(t, "Luna Updated", got.Name)
+
+	changed.Name = "Rolled back"
+	updated, itemErrs = repo.UpdatePets([]*entity.Pet{&changed, ghost}, true)
+	assert.Nil(t, updated[0])
+	assert.Contains(t, itemErrs[0], "aborted")
+	assert.Contains(t, itemErrs[1], "not_found")
+
+	got, _ = repo.GetPet(existing.Uuid.String())
+	assert.Equal(t, "Luna Updated", got.Name)
+}
+
+func testGetPets(t *testing.T, repo repository.PetRepository) {
+	a, b := newPet("A", uuid.New()), newPet("B", uuid.New())
+	save(t, repo, a, b)
+
+	got, errData := repo.GetPets([]string{a.Uuid.String(), uuid.New().String()})
+	require.Nil(t, errData)
+	require.Len(t, got, 1)
+	assert.Equal(t, a.Uuid, got[0].Uuid)
+}
+
+func testInsertPets(t *testing.T, repo repository.PetRepository) {
+	guardian := uuid.New()
+	pets := []*entity.Pet{newPet("Rex", guardian), newPet("Mia", guardian)}
+	require.Nil(t, repo.InsertPets(pets))
+
+	got, _ := repo.ListPets(entity.PetFilter{UuidGuardian: guardian}, "", 10)
+	assert.Len(t, got, 2)
+
+	// um INSERT só: uma linha inválida descarta todas
+	chipped := newPet("Bolt", guardian)
+	chipped.MicrochipNumber = "985112000123456"
+	twin := newPet("Bolt II", guardian)
+	twin.MicrochipNumber = chipped.MicrochipNumber
+	assert.NotNil(t, repo.InsertPets([]*entity.Pet{chipped, twin}))
+
+	got, _ = repo.ListPets(entity.PetFilter{UuidGuardian: guardian}, "", 10)
+	assert.Len(t, got, 2)
+}
+
+func testListPets(t *testing.T, repo repository.PetRepository) {
+	guardian := uuid.New()
+	for i := 0; i < 5; i++ {
+		pet := newPet(fmt.Sprintf("Dog %d", i), guardian)
+		pet.BirthYear = 2015 + i
+		save(t, repo, pet)
+	}
+	cat := newPet("Cat", guardian)
+	cat.Specie = entity.Cat
+	save(t, repo, cat, newPet("Other", uuid.New()))
+
+	filter := entity.PetFilter{UuidGuardian: guardian, Species: []entity.PetType{entity.Dog}, BirthYearFrom: 2016}
+	var all []*entity.Pet
+	after := ""
+	for {
+		page, errData := repo.ListPets(filter, after, 2)
+		require.Nil(t, errData)
+		if len(page) == 0 {
+			break
+		}
+		assert.LessOrEqual(t, len(page), 2)
+		all = append(all, page...)
+		after = page[len(page)-1].Uuid.String()
+	}
+
+	require.Len(t, all, 4)
+	for i := 1; i < len(all); i++ {
+		assert.Less(t, all[i-1].Uuid.String(), all[i].Uuid.String(), "pages must follow uuid order")
+	}
+	for _, pet := range all {
+		assert.True(t, filter.Matches(pet))
+	}
+}
+
+func testListPetsByGuardianRole(t *testing.T, repo repository.PetRepository) {
+	guardian := uuid.New()
+	owned, fostered, other := newPet("Rex", guardian), newPet("Mia", uuid.New()), newPet("Bolt", uuid.New())
+	save(t, repo, owned, fostered, other)
+
+	list := func(filter entity.PetFilter) []uuid.UUID {
+		pets, errData := repo.ListPets(filter, "", 10)
+		require.Nil(t, errData)
+		var ids []uuid.UUID
+		for _, pet := range pets {
+			ids = append(ids, pet.Uuid)
+		}
+		return ids
+	}
+
+	linked := []uuid.UUID{fostered.Uuid}
+	assert.Equal(t, []uuid.UUID{owned.Uuid}, list(entity.PetFilter{UuidGuardian: guardian}))
+	assert.Equal(t, []uuid.UUID{fostered.Uuid}, list(entity.PetFilter{UuidGuardian: guardian,
+		GuardianRoles: []entity.GuardianRole{entity.RoleFoster}, LinkedPets: linked}))
+	assert.ElementsMatch(t, []uuid.UUID{owned.Uuid, fostered.Uuid}, list(entity.PetFilter{UuidGuardian: guardian,
+		GuardianRoles: []entity.GuardianRole{entity.RoleOwner, entity.RoleFoster}, LinkedPets: linked}))
+	assert.Empty(t, list(entity.PetFilter{UuidGuardian: guardian, GuardianRoles: []entity.GuardianRole{entity.RoleCoOwner}}))
+}
+
+func testSearchPets(t *testing.T, repo repository.PetRepository) {
+	guardian := uuid.New()
+	rex := newPet("Rex", guardian)
+	rex.Breed = "Golden Retriever"
+	mel := newPet("Mel", guardian)
+	mel.Breed = "Golden Retriever"
+	mel.Notes = "Irmã do Rex"
+	tom := newPet("Tom", guardian)
+	tom.Breed = "Siamês"
+	tom.Specie = entity.Cat
+	save(t, repo, rex, mel, tom)
+
+	hits, errData := repo.SearchPets(entity.PetSearch{Query: "rex golden", Limit: 10})
+	require.Nil(t, errData)
+	require.Len(t, hits, 2)
+	assert.Equal(t, rex.Uuid, hits[0].Pet.Uuid)
+	assert.Equal(t, mel.Uuid, hits[1].Pet.Uuid)
+
+	hits, errData = repo.SearchPets(entity.PetSearch{Query: "golden", Filter: entity.PetFilter{Species: []entity.PetType{entity.Cat}}, Limit: 10})
+	require.Nil(t, errData)
+	assert.Empty(t, hits)
+
+	hits, errData = repo.SearchPets(entity.PetSearch{Query: "golden", Limit: 1})
+	require.Nil(t, errData)
+	assert.Len(t, hits, 1)
+}
+
+// testTenantIsolation só roda nos repositórios que separam tenants.
+func testTenantIsolation(t *testing.T, repo repository.PetRepository) {
+	scoper, ok := repo.(repository.TenantScoper)
+	if !ok {
+		t.Skip("repository is not a TenantScoper")
+	}
+	shelter := scoper.ForTenant("shelter")
+	clinic := scoper.ForTenant("clinic")
+
+	guardian := uuid.New()
+	rex := newPet("Rex", guardian)
+	rex.MicrochipNumber = "985112000123456"
+	save(t, shelter, rex)
+	assert.Equal(t, "shelter", rex.TenantID)
+
+	// o mesmo chip pode existir em outra organização
+	mel := newPet("Mel", guardian)
+	mel.MicrochipNumber = rex.MicrochipNumber
+	mel.TenantID = "shelter" // o tenant da visão prevalece sobre o do pet
+	save(t, clinic, mel)
+	assert.Equal(t, "clinic", mel.TenantID)
+
+	got, errData := shelter.GetPet(rex.Uuid.String())
+	require.Nil(t, errData)
+	assert.Equal(t, "shelter", got.TenantID)
+	_, errData = clinic.GetPet(rex.Uuid.String())
+	assert.NotNil(t, errData)
+
+	got, errData = clinic.GetPetByMicrochip(rex.MicrochipNumber)
+	require.Nil(t, errData)
+	assert.Equal(t, mel.Uuid, got.Uuid)
+
+	found, errData := clinic.GetPets([]string{rex.Uuid.String(), mel.Uuid.String()})
+	require.Nil(t, errData)
+	require.Len(t, found, 1)
+	assert.Equal(t, mel.Uuid, found[0].Uuid)
+
+	listed, errData := shelter.ListPets(entity.PetFilter{UuidGuardian: guardian}, "", 10)
+	require.Nil(t, errData)
+	require.Len(t, listed, 1)
+	assert.Equal(t, rex.Uuid, listed[0].Uuid)
+
+	hits, errData := clinic.SearchPets(entity.PetSearch{Query: "rex", Limit: 10})
+	require.Nil(t, errData)
+	assert.Empty(t, hits)
+
+	// escritas não alcançam os pets de outro tenant
+	intruder := *rex
+	intruder.Name = "Hacked"
+	_, errData = clinic.UpdatePet(&intruder)
+	assert.Equal(t, "pet not found", errData["not_found"])
+	_, errData = clinic.UpdatePetFields(&intruder, []string{"name"})
+	assert.Equal(t, "pet not found", errData["not_found"])
+	_, errData = clinic.TransferPet(rex.Uuid.String(), uuid.New().String())
+	assert.Equal(t, "pet not found", errData["not_found"])
+
+	msg, errData := clinic.DeletePet(guardian.String())
+	require.Nil(t, errData)
+	assert.Equal(t, "1 pet(s) deletados!", msg["message"])
+
+	got, errData = repo.GetPet(rex.Uuid.String())
+	require.Nil(t, errData)
+	assert.Equal(t, "Rex", got.Name)
+	assert.Equal(t, guardian, got.UuidGuardian)
+}
@@ -0,0 +1,11 @@
+package repository
+
+import "github.com/LuizFJP/pet-ms/domain/entity"
+
+type SpeciesRepository interface {
+	ListSpecies() ([]*entity.Species, map[string]string)
+	GetSpeciesByCode(code string) (*entity.Species, map[string]string)
+	CreateSpecies(species *entity.Species) (*entity.Species, map[string]string)
+	UpdateSpecies(species *entity.Species) (*entity.Species, map[string]string)
+	DeleteSpecies(code string) map[string]string
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/google/uuid"
+)
+
+type VaccinationRepository interface {
+	CreateVaccination(vaccination *entity.Vaccination) (*entity.Vaccination, map[string]string)
+	GetVaccination(uuid uuid.UUID) (*entity.Vaccination, map[string]string)
+	UpdateVaccination(vaccination *entity.Vaccination) (*entity.Vaccination, map[string]string)
+	ListVaccinations(petUuid uuid.UUID) ([]*entity.Vaccination, map[string]string)
+	// ListOverdueVaccinations considera só a dose mais recente de cada vacina do pet:
+	// um reforço já aplicado encerra o vencimento das doses anteriores. Só entram as
+	// doses de pets de tenant; vazio considera todos.
+	ListOverdueVaccinations(tenant string, asOf time.Time, afterID uint, limit int) ([]*entity.Vaccination, map[string]string)
+}
@@ -0,0 +1,50 @@
+package blobstore
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/LuizFJP/pet-ms/domain/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testBlobStore é o comportamento comum esperado de qualquer BlobStore.
+func testBlobStore(t *testing.T, store repository.BlobStore) {
+	t.Helper()
+	ctx := context.Background()
+	key := "pets/0b6f/photo-1"
+	content := bytes.Repeat([]byte("rex"), 50000)
+
+	require.NoError(t, store.Put(ctx, key, bytes.NewReader(content), int64(len(content)), "image/jpeg"))
+
+	r, err := store.Get(ctx, key)
+	require.NoError(t, err)
+	got, err := io.ReadAll(r)
+	require.NoError(t, r.Close())
+	require.NoError(t, err)
+	assert.Equal(t, content, got)
+
+	err = store.Put(ctx, "pets/0b6f/short", strings.NewReader("abc"), 10, "image/jpeg")
+	assert.Error(t, err, "content shorter than size must fail")
+	_, err = store.Get(ctx, "pets/0b6f/short")
+	assert.ErrorIs(t, err, repository.ErrBlobNotFound, "a failed upload must not be visible")
+
+	require.NoError(t, store.Delete(ctx, key))
+	require.NoError(t, store.Delete(ctx, key), "deleting a missing key is not an error")
+	_, err = store.Get(ctx, key)
+	assert.ErrorIs(t, err, repository.ErrBlobNotFound)
+
+	for _, bad := range []string{"", "../etc/passwd", "pets//x", "pets/./x", "/abs"} {
+		assert.Error(t, store.Put(ctx, bad, strings.NewReader("x"), 1, "image/jpeg"), "key %q", bad)
+	}
+}
+
+func TestLocalStore(t *testing.T) {
+	store, err := NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+	testBlobStore(t, store)
+}
@@ -0,0 +1,23 @@
+package blobstore
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var keySegmentPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+// validateKey aceita só chaves relativas com segmentos simples, para que nenhuma
+// chave escape do diretório ou do bucket.
+func validateKey(key string) error {
+	if key == "" {
+		return fmt.Errorf("blob key is empty")
+	}
+	for _, segment := range strings.Split(key, "/") {
+		if !keySegmentPattern.MatchString(segment) {
+			return fmt.Errorf("invalid blob key %q", key)
+		}
+	}
+	return nil
+}
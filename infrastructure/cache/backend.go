@@ -0,0 +1,15 @@
+package cache
+
+import (
+	"context"
+	"time"
+)
+
+// Backend guarda valores já serializados com prazo de validade. Um erro do backend
+// nunca é fatal para quem usa o cache: a leitura cai no repositório.
+type Backend interface {
+	// Get devolve ok=false quando a chave não existe ou expirou.
+	Get(ctx context.Context, key string) (value []byte, ok bool, err error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	Delete(ctx context.Context, keys ...string) error
+}
@@ -0,0 +1,346 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"sync/atomic"
+	"time"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/LuizFJP/pet-ms/domain/repository"
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	// DefaultTTL é por quanto tempo um pet lido fica no cache.
+	DefaultTTL = 5 * time.Minute
+	// DefaultNegativeTTL é por quanto tempo um pet inexistente fica marcado como tal;
+	// é curto porque um SavePet em outra instância não invalida este cache.
+	DefaultNegativeTTL = 30 * time.Second
+)
+
+// Resultados contados em pet_cache_lookups_total.
+const (
+	resultHit         = "hit"
+	resultNegativeHit = "negative_hit"
+	resultMiss        = "miss"
+	resultError       = "error"
+)
+
+// PetRepository é um read-through de GetPet na frente de outro repositório. As
+// escritas passam direto e invalidam as chaves dos pets afetados; o resto do
+// repositório não usa o cache.
+type PetRepository struct {
+	repository.PetRepository
+	*petCache
+	// tenant marca a visão de ForTenant; vazio enxerga todos os tenants.
+	tenant string
+}
+
+// petCache é o estado dividido entre o repositório e as visões de ForTenant. As faltas
+// carregam pelo repositório sem escopo, e a mesma entrada serve a qualquer visão.
+type petCache struct {
+	loader      repository.PetRepository
+	backend     Backend
+	ttl         time.Duration
+	negativeTTL time.Duration
+	lookups     *prometheus.CounterVec
+	flights     singleflight.Group
+	// invalidations conta as invalidações: uma leitura que cruzou com alguma não grava
+	// no cache, senão poderia guardar o pet de antes da escrita.
+	invalidations atomic.Uint64
+}
+
+type Option func(*PetRepository)
+
+// WithTTL muda por quanto tempo ficam os pets encontrados e os não encontrados.
+func WithTTL(ttl, negativeTTL time.Duration) Option {
+	return func(r *PetRepository) {
+		r.ttl = ttl
+		r.negativeTTL = negativeTTL
+	}
+}
+
+// WithMetrics registra o contador de acertos e faltas em reg. Se ele já estiver
+// registrado (outro cache no mesmo processo), reaproveita o existente.
+func WithMetrics(reg prometheus.Registerer) Option {
+	return func(r *PetRepository) {
+		if err := reg.Register(r.lookups); err != nil {
+			var already prometheus.AlreadyRegisteredError
+			if errors.As(err, &already) {
+				r.lookups = already.ExistingCollector.(*prometheus.CounterVec)
+				return
+			}
+			log.Printf("failed to register pet cache metrics: %v", err)
+		}
+	}
+}
+
+func NewPetRepository(next repository.PetRepository, backend Backend, opts ...Option) *PetRepository {
+	r := &PetRepository{
+		PetRepository: next,
+		petCache: &petCache{
+			loader:      next,
+			backend:     backend,
+			ttl:         DefaultTTL,
+			negativeTTL: DefaultNegativeTTL,
+			lookups: prometheus.NewCounterVec(prometheus.CounterOpts{
+				Name: "pet_cache_lookups_total",
+				Help: "GetPet lookups in the pet cache by result (hit, negative_hit, miss, error).",
+			}, []string{"result"}),
+		},
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+var _ repository.PetRepository = &PetRepository{}
+var _ repository.TenantScoper = &PetRepository{}
+var _ repository.IdempotentWriter = &PetRepository{}
+
+// ForTenant divide o cache com o repositório: um pet em cache de outro tenant é
+// devolvido à visão como não encontrado.
+func (r *PetRepository) ForTenant(tenant string) repository.PetRepository {
+	view := *r
+	view.tenant = tenant
+	if scoper, ok := r.PetRepository.(repository.TenantScoper); ok {
+		view.PetRepository = scoper.ForTenant(tenant)
+	}
+	return &view
+}
+
+// petEntry é o que vai para o backend: o pet ou o erro de "não encontrado" devolvido
+// pelo repositório, que volta igual nas leituras seguintes.
+type petEntry struct {
+	Pet      *entity.Pet       `json:"pet,omitempty"`
+	NotFound map[string]string `json:"not_found,omitempty"`
+}
+
+func petKey(id uuid.UUID) string {
+	return "pet:" + id.String()
+}
+
+// GetPet consulta o cache e, na falta, o repositório. Faltas simultâneas da mesma
+// chave viram uma consulta só; cada chamador recebe a própria cópia do pet.
+func (r *PetRepository) GetPet(id string) (*entity.Pet, map[string]string) {
+	pet, errData := r.getPet(id)
+	if errData == nil && r.tenant != "" && pet.TenantID != r.tenant {
+		// mesmo retorno do First() do gorm
+		return nil, map[string]string{"db_error": "record not found"}
+	}
+	return pet, errData
+}
+
+func (r *PetRepository) getPet(id string) (*entity.Pet, map[string]string) {
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return r.PetRepository.GetPet(id)
+	}
+	key := petKey(parsed)
+	ctx := context.Background()
+
+	cached, ok, err := r.backend.Get(ctx, key)
+	if err != nil {
+		r.lookups.WithLabelValues(resultError).Inc()
+		log.Printf("pet cache get %s: %v", key, err)
+	}
+	if ok {
+		if pet, errData, decoded := decodePetEntry(cached); decoded {
+			if errData != nil {
+				r.lookups.WithLabelValues(resultNegativeHit).Inc()
+			} else {
+				r.lookups.WithLabelValues(resultHit).Inc()
+			}
+			return pet, errData
+		}
+	}
+	r.lookups.WithLabelValues(resultMiss).Inc()
+
+	loaded, _, _ := r.flights.Do(key, func() (interface{}, error) {
+		return r.load(ctx, id, key), nil
+	})
+	result := loaded.(loadResult)
+	if result.errData != nil {
+		return nil, result.errData
+	}
+	pet, errData, _ := decodePetEntry(result.encoded)
+	return pet, errData
+}
+
+// loadResult é o que a consulta compartilhada devolve: o pet serializado, para cada
+// chamador decodificar a sua cópia, ou um erro que não vai para o cache.
+type loadResult struct {
+	encoded []byte
+	errData map[string]string
+}
+
+func (r *PetRepository) load(ctx context.Context, id, key string) loadResult {
+	generation := r.invalidations.Load()
+	pet, errData := r.loader.GetPet(id)
+
+	entry, ttl := petEntry{Pet: pet}, r.ttl
+	if errData != nil {
+		if !isNotFound(errData) {
+			return loadResult{errData: errData}
+		}
+		entry, ttl = petEntry{NotFound: errData}, r.negativeTTL
+	}
+	encoded, err := json.Marshal(entry)
+	if err != nil {
+		return loadResult{errData: map[string]string{"db_error": err.Error()}}
+	}
+
+	if ttl > 0 && r.invalidations.Load() == generation {
+		if err := r.backend.Set(ctx, key, encoded, ttl); err != nil {
+			log.Printf("pet cache set %s: %v", key, err)
+		}
+	}
+	return loadResult{encoded: encoded}
+}
+
+// isNotFound reconhece o "não encontrado" do gorm e o not_found dos outros repositórios.
+func isNotFound(errData map[string]string) bool {
+	return errData["db_error"] == "record not found" || errData["not_found"] != ""
+}
+
+func decodePetEntry(encoded []byte) (*entity.Pet, map[string]string, bool) {
+	var entry petEntry
+	if err := json.Unmarshal(encoded, &entry); err != nil {
+		log.Printf("pet cache decode: %v", err)
+		return nil, nil, false
+	}
+	if entry.NotFound != nil {
+		return nil, entry.NotFound, true
+	}
+	if entry.Pet == nil {
+		return nil, nil, false
+	}
+	return entry.Pet, nil, true
+}
+
+// invalidate apaga as chaves dos pets. Roda depois da escrita, com ou sem erro: apagar
+// uma chave a mais só custa uma leitura no repositório.
+func (r *PetRepository) invalidate(ids ...uuid.UUID) {
+	r.invalidations.Add(1)
+	keys := make([]string, 0, len(ids))
+	for _, id := range ids {
+		keys = append(keys, petKey(id))
+	}
+	if err := r.backend.Delete(context.Background(), keys...); err != nil {
+		log.Printf("pet cache delete %v: %v", keys, err)
+	}
+}
+
+var _ repository.PetCache = &PetRepository{}
+
+// Forget invalida os pets gravados por outros repositórios.
+func (r *PetRepository) Forget(ids ...uuid.UUID) {
+	r.invalidate(ids...)
+}
+
+func petUuids(pets []*entity.Pet) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(pets))
+	for _, pet := range pets {
+		if pet != nil {
+			ids = append(ids, pet.Uuid)
+		}
+	}
+	return ids
+}
+
+// SavePet invalida para apagar uma marca de "não encontrado" do mesmo uuid.
+func (r *PetRepository) SavePet(pet *entity.Pet) (*entity.Pet, map[string]string) {
+	defer r.invalidate(pet.Uuid)
+	return r.PetRepository.SavePet(pet)
+}
+
+func (r *PetRepository) SavePetWithIdempotencyKey(pet *entity.Pet, key *entity.IdempotencyKey) (*entity.Pet, map[string]string) {
+	writer, ok := r.PetRepository.(repository.IdempotentWriter)
+	if !ok {
+		return nil, map[string]string{"unavailable": "idempotency keys are not supported by the pet repository"}
+	}
+	defer r.invalidate(pet.Uuid)
+	return writer.SavePetWithIdempotencyKey(pet, key)
+}
+
+func (r *PetRepository) UpdatePet(pet *entity.Pet) (*entity.Pet, map[string]string) {
+	defer r.invalidate(pet.Uuid)
+	return r.PetRepository.UpdatePet(pet)
+}
+
+func (r *PetRepository) UpdatePetFields(pet *entity.Pet, fields []string) (*entity.Pet, map[string]string) {
+	defer r.invalidate(pet.Uuid)
+	return r.PetRepository.UpdatePetFields(pet, fields)
+}
+
+// DeletePet apaga pelo guardião, então os pets afetados são listados antes.
+func (r *PetRepository) DeletePet(uuidGuardian string) (map[string]string, map[string]string) {
+	guardian, err := uuid.Parse(uuidGuardian)
+	if err != nil {
+		return r.PetRepository.DeletePet(uuidGuardian)
+	}
+	pets, errData := r.PetRepository.ListPets(entity.PetFilter{UuidGuardian: guardian}, "", -1)
+	if errData != nil {
+		return nil, errData
+	}
+	defer r.invalidate(petUuids(pets)...)
+	return r.PetRepository.DeletePet(uuidGuardian)
+}
+
+func (r *PetRepository) TransferPet(petUuid string, uuidGuardian string) (*entity.Pet, map[string]string) {
+	if parsed, err := uuid.Parse(petUuid); err == nil {
+		defer r.invalidate(parsed)
+	}
+	return r.PetRepository.TransferPet(petUuid, uuidGuardian)
+}
+
+func (r *PetRepository) ChangePetStatus(petUuid string, change entity.StatusChange) (*entity.Pet, map[string]string) {
+	if parsed, err := uuid.Parse(petUuid); err == nil {
+		defer r.invalidate(parsed)
+	}
+	return r.PetRepository.ChangePetStatus(petUuid, change)
+}
+
+func (r *PetRepository) SavePets(pets []*entity.Pet, allOrNothing bool) ([]*entity.Pet, []map[string]string) {
+	defer r.invalidate(petUuids(pets)...)
+	return r.PetRepository.SavePets(pets, allOrNothing)
+}
+
+func (r *PetRepository) UpdatePets(pets []*entity.Pet, allOrNothing bool) ([]*entity.Pet, []map[string]string) {
+	defer r.invalidate(petUuids(pets)...)
+	return r.PetRepository.UpdatePets(pets, allOrNothing)
+}
+
+func (r *PetRepository) InsertPets(pets []*entity.Pet) map[string]string {
+	defer r.invalidate(petUuids(pets)...)
+	return r.PetRepository.InsertPets(pets)
+}
+
+var _ repository.ReplicaRouter = &PetRepository{}
+
+// ReadReplica mantém GetPet no cache e manda as outras leituras às réplicas. As faltas
+// do cache carregam do primário: uma réplica atrasada guardaria o pet de antes da
+// escrita até o TTL vencer.
+func (r *PetRepository) ReadReplica(lastWrite time.Time) repository.PetRepository {
+	router, ok := r.PetRepository.(repository.ReplicaRouter)
+	if !ok {
+		return r
+	}
+	return &replicaReads{PetRepository: router.ReadReplica(lastWrite), cache: r}
+}
+
+// replicaReads é a visão de ReadReplica; só serve para leituras.
+type replicaReads struct {
+	repository.PetRepository
+	cache *PetRepository
+}
+
+func (v *replicaReads) GetPet(id string) (*entity.Pet, map[string]string) {
+	return v.cache.GetPet(id)
+}
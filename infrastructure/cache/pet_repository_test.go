@@ -0,0 +1,349 @@
+package cache
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/LuizFJP/pet-ms/domain/repository"
+	"github.com/LuizFJP/pet-ms/domain/repository/repositorytest"
+	"github.com/LuizFJP/pet-ms/infrastructure/memory"
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingRepo conta as leituras que chegam ao repositório; gate, se definido,
+// segura cada GetPet até ser fechado.
+type countingRepo struct {
+	repository.PetRepository
+	gets atomic.Int32
+	gate chan struct{}
+}
+
+func (r *countingRepo) GetPet(id string) (*entity.Pet, map[string]string) {
+	r.gets.Add(1)
+	if r.gate != nil {
+		<-r.gate
+	}
+	return r.PetRepository.GetPet(id)
+}
+
+// ForTenant expõe as visões do repositório em memória; as faltas do cache continuam
+// passando pela contagem, que carrega sem escopo.
+func (r *countingRepo) ForTenant(tenant string) repository.PetRepository {
+	return r.PetRepository.(repository.TenantScoper).ForTenant(tenant)
+}
+
+func newCachedRepo(t *testing.T, opts ...Option) (*PetRepository, *countingRepo) {
+	t.Helper()
+	next := &countingRepo{PetRepository: memory.NewPetRepository()}
+	return NewPetRepository(next, NewLRU(100), opts...), next
+}
+
+func newCachePet() *entity.Pet {
+	return &entity.Pet{Uuid: uuid.New(), UuidGuardian: uuid.New(), Name: "Rex", BirthYear: 2020, Breed: "SRD", Specie: entity.Dog}
+}
+
+func TestPetRepository_Conformance(t *testing.T) {
+	repositorytest.TestPetRepository(t, func(t *testing.T) repository.PetRepository {
+		repo, _ := newCachedRepo(t)
+		return repo
+	})
+}
+
+func TestPetRepository_GetPetReadsThrough(t *testing.T) {
+	repo, next := newCachedRepo(t)
+	pet := newCachePet()
+	_, errData := repo.SavePet(pet)
+	require.Nil(t, errData)
+
+	first, errData := repo.GetPet(pet.Uuid.String())
+	require.Nil(t, errData)
+	second, errData := repo.GetPet(pet.Uuid.String())
+	require.Nil(t, errData)
+
+	assert.Equal(t, int32(1), next.gets.Load())
+	assert.Equal(t, first, second)
+	assert.NotSame(t, first, second, "each caller gets its own copy")
+	assert.Equal(t, float64(1), testutil.ToFloat64(repo.lookups.WithLabelValues(resultMiss)))
+	assert.Equal(t, float64(1), testutil.ToFloat64(repo.lookups.WithLabelValues(resultHit)))
+}
+
+func TestPetRepository_NegativeCaching(t *testing.T) {
+	repo, next := newCachedRepo(t)
+	pet := newCachePet()
+
+	_, errData := repo.GetPet(pet.Uuid.String())
+	assert.Equal(t, map[string]string{"db_error": "record not found"}, errData)
+	_, errData = repo.GetPet(pet.Uuid.String())
+	assert.Equal(t, map[string]string{"db_error": "record not found"}, errData)
+	assert.Equal(t, int32(1), next.gets.Load())
+	assert.Equal(t, float64(1), testutil.ToFloat64(repo.lookups.WithLabelValues(resultNegativeHit)))
+
+	// criar o pet apaga a marca de inexistente
+	_, errData = repo.SavePet(pet)
+	require.Nil(t, errData)
+	got, errData := repo.GetPet(pet.Uuid.String())
+	require.Nil(t, errData)
+	assert.Equal(t, "Rex", got.Name)
+}
+
+func TestPetRepository_NegativeCachingDisabled(t *testing.T) {
+	repo, next := newCachedRepo(t, WithTTL(time.Minute, 0))
+	id := uuid.NewString()
+
+	repo.GetPet(id)
+	repo.GetPet(id)
+	assert.Equal(t, int32(2), next.gets.Load())
+}
+
+func TestPetRepository_WritesInvalidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		write func(t *testing.T, repo *PetRepository, pet *entity.Pet)
+		check func(t *testing.T, got *entity.Pet, errData map[string]string)
+	}{
+		{
+			name: "UpdatePet",
+			write: func(t *testing.T, repo *PetRepository, pet *entity.Pet) {
+				pet.Name = "Thor"
+				_, errData := repo.UpdatePet(pet)
+				require.Nil(t, errData)
+			},
+			check: func(t *testing.T, got *entity.Pet, errData map[string]string) {
+				require.Nil(t, errData)
+				assert.Equal(t, "Thor", got.Name)
+			},
+		},
+		{
+			name: "UpdatePetFields",
+			write: func(t *testing.T, repo *PetRepository, pet *entity.Pet) {
+				_, errData := repo.UpdatePetFields(&entity.Pet{Uuid: pet.Uuid, Color: "preto"}, []string{"color"})
+				require.Nil(t, errData)
+			},
+			check: func(t *testing.T, got *entity.Pet, errData map[string]string) {
+				require.Nil(t, errData)
+				assert.Equal(t, "preto", got.Color)
+			},
+		},
+		{
+			name: "UpdatePets",
+			write: func(t *testing.T, repo *PetRepository, pet *entity.Pet) {
+				pet.Name = "Thor"
+				_, itemErrs := repo.UpdatePets([]*entity.Pet{pet}, true)
+				require.Nil(t, itemErrs[0])
+			},
+			check: func(t *testing.T, got *entity.Pet, errData map[string]string) {
+				require.Nil(t, errData)
+				assert.Equal(t, "Thor", got.Name)
+			},
+		},
+		{
+			name: "TransferPet",
+			write: func(t *testing.T, repo *PetRepository, pet *entity.Pet) {
+				pet.UuidGuardian = uuid.New()
+				_, errData := repo.TransferPet(pet.Uuid.String(), pet.UuidGuardian.String())
+				require.Nil(t, errData)
+			},
+			check: func(t *testing.T, got *entity.Pet, errData map[string]string) {
+				require.Nil(t, errData)
+				assert.NotEqual(t, uuid.Nil, got.UuidGuardian)
+			},
+		},
+		{
+			name: "DeletePet",
+			write: func(t *testing.T, repo *PetRepository, pet *entity.Pet) {
+				_, errData := repo.DeletePet(pet.UuidGuardian.String())
+				require.Nil(t, errData)
+			},
+			check: func(t *testing.T, got *entity.Pet, errData map[string]string) {
+				assert.Nil(t, got)
+				assert.Equal(t, map[string]string{"db_error": "record not found"}, errData)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, next := newCachedRepo(t)
+			pet := newCachePet()
+			_, errData := repo.SavePet(pet)
+			require.Nil(t, errData)
+			_, errData = repo.GetPet(pet.Uuid.String())
+			require.Nil(t, errData)
+
+			tt.write(t, repo, pet)
+			got, errData := repo.GetPet(pet.Uuid.String())
+			tt.check(t, got, errData)
+			if got != nil {
+				assert.Equal(t, pet.UuidGuardian, got.UuidGuardian)
+			}
+			assert.Equal(t, int32(2), next.gets.Load(), "the write dropped the cached pet")
+		})
+	}
+}
+
+func TestPetRepository_SingleflightCollapsesMisses(t *testing.T) {
+	repo, next := newCachedRepo(t)
+	pet := newCachePet()
+	_, errData := repo.SavePet(pet)
+	require.Nil(t, errData)
+	next.gate = make(chan struct{})
+
+	const callers = 10
+	var wg sync.WaitGroup
+	results := make([]*entity.Pet, callers)
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], _ = repo.GetPet(pet.Uuid.String())
+		}(i)
+	}
+	// espera todos estarem na falta antes de liberar a leitura
+	require.Eventually(t, func() bool {
+		return testutil.ToFloat64(repo.lookups.WithLabelValues(resultMiss)) == callers
+	}, time.Second, time.Millisecond)
+	close(next.gate)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), next.gets.Load())
+	for _, got := range results {
+		require.NotNil(t, got)
+		assert.Equal(t, "Rex", got.Name)
+	}
+}
+
+func TestPetRepository_ReadRacingWriteIsNotCached(t *testing.T) {
+	repo, next := newCachedRepo(t)
+	pet := newCachePet()
+	_, errData := repo.SavePet(pet)
+	require.Nil(t, errData)
+	next.gate = make(chan struct{})
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		repo.GetPet(pet.Uuid.String())
+	}()
+	require.Eventually(t, func() bool { return next.gets.Load() == 1 }, time.Second, time.Millisecond)
+
+	// a escrita acontece enquanto a leitura está no repositório
+	pet.Name = "Thor"
+	_, errData = repo.UpdatePet(pet)
+	require.Nil(t, errData)
+	close(next.gate)
+	<-done
+
+	got, errData := repo.GetPet(pet.Uuid.String())
+	require.Nil(t, errData)
+	assert.Equal(t, "Thor", got.Name)
+}
+
+func TestPetRepository_BackendErrorFallsBack(t *testing.T) {
+	backend, server := newTestRedis(t)
+	next := &countingRepo{PetRepository: memory.NewPetRepository()}
+	repo := NewPetRepository(next, backend)
+	pet := newCachePet()
+	_, errData := repo.SavePet(pet)
+	require.Nil(t, errData)
+	server.Close()
+
+	got, errData := repo.GetPet(pet.Uuid.String())
+	require.Nil(t, errData)
+	assert.Equal(t, "Rex", got.Name)
+	assert.Equal(t, float64(1), testutil.ToFloat64(repo.lookups.WithLabelValues(resultError)))
+}
+
+func TestPetRepository_RedisSharedBetweenInstances(t *testing.T) {
+	backend, _ := newTestRedis(t)
+	next := &countingRepo{PetRepository: memory.NewPetRepository()}
+	pet := newCachePet()
+	_, errData := next.SavePet(pet)
+	require.Nil(t, errData)
+
+	first := NewPetRepository(next, backend)
+	second := NewPetRepository(next, backend)
+	_, errData = first.GetPet(pet.Uuid.String())
+	require.Nil(t, errData)
+	got, errData := second.GetPet(pet.Uuid.String())
+	require.Nil(t, errData)
+	assert.Equal(t, "Rex", got.Name)
+	assert.Equal(t, int32(1), next.gets.Load())
+}
+
+func TestWithMetrics_ReusesRegisteredCounter(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	first, _ := newCachedRepo(t, WithMetrics(reg))
+	second, _ := newCachedRepo(t, WithMetrics(reg))
+
+	first.GetPet(uuid.NewString())
+	second.GetPet(uuid.NewString())
+	assert.Equal(t, float64(2), testutil.ToFloat64(second.lookups.WithLabelValues(resultMiss)))
+}
+
+// replicatedRepo lê do primário e, em ReadReplica, de replica.
+type replicatedRepo struct {
+	*countingRepo
+	replica repository.PetRepository
+}
+
+func (r *replicatedRepo) ReadReplica(time.Time) repository.PetRepository {
+	return r.replica
+}
+
+func TestPetRepository_ReadReplica(t *testing.T) {
+	primary := &countingRepo{PetRepository: memory.NewPetRepository()}
+	replica := memory.NewPetRepository()
+	repo := NewPetRepository(&replicatedRepo{countingRepo: primary, replica: replica}, NewLRU(100))
+	pet := newCachePet()
+	_, errData := repo.SavePet(pet)
+	require.Nil(t, errData)
+
+	reads := repo.ReadReplica(time.Time{})
+	// GetPet passa pelo cache, que carrega do primário
+	got, errData := reads.GetPet(pet.Uuid.String())
+	require.Nil(t, errData)
+	assert.Equal(t, "Rex", got.Name)
+	assert.Equal(t, int32(1), primary.gets.Load())
+
+	// as outras leituras vão à réplica, que aqui ainda não tem o pet
+	pets, errData := reads.ListPets(entity.PetFilter{}, "", -1)
+	require.Nil(t, errData)
+	assert.Empty(t, pets)
+
+	plain, _ := newCachedRepo(t)
+	assert.Same(t, plain, plain.ReadReplica(time.Time{}), "without replicas there is nothing to route")
+}
+
+// keyedRepo grava com chave de idempotência pelo SavePet do repositório por baixo.
+type keyedRepo struct {
+	*countingRepo
+}
+
+func (r *keyedRepo) SavePetWithIdempotencyKey(pet *entity.Pet, key *entity.IdempotencyKey) (*entity.Pet, map[string]string) {
+	return r.SavePet(pet)
+}
+
+func TestPetRepository_SavePetWithIdempotencyKey(t *testing.T) {
+	repo := NewPetRepository(&keyedRepo{&countingRepo{PetRepository: memory.NewPetRepository()}}, NewLRU(100))
+	pet := newCachePet()
+	// a leitura antes da criação deixa o pet marcado como inexistente
+	_, errData := repo.GetPet(pet.Uuid.String())
+	require.NotNil(t, errData)
+
+	_, errData = repo.SavePetWithIdempotencyKey(pet, &entity.IdempotencyKey{Key: "k-1"})
+	require.Nil(t, errData)
+	got, errData := repo.GetPet(pet.Uuid.String())
+	require.Nil(t, errData, "the create must invalidate the negative entry")
+	assert.Equal(t, "Rex", got.Name)
+
+	plain, _ := newCachedRepo(t)
+	_, errData = plain.SavePetWithIdempotencyKey(newCachePet(), &entity.IdempotencyKey{Key: "k-1"})
+	assert.NotEmpty(t, errData["unavailable"])
+}
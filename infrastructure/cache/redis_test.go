@@ -0,0 +1,59 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRedis(t *testing.T) (*Redis, *miniredis.Miniredis) {
+	t.Helper()
+	server := miniredis.RunT(t)
+	backend := NewRedis(redis.NewClient(&redis.Options{Addr: server.Addr()}), "pet-ms:")
+	t.Cleanup(func() { backend.Close() })
+	return backend, server
+}
+
+func TestRedis_SetGetDelete(t *testing.T) {
+	ctx := context.Background()
+	backend, server := newTestRedis(t)
+
+	_, ok, err := backend.Get(ctx, "a")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	require.NoError(t, backend.Set(ctx, "a", []byte("1"), time.Minute))
+	assert.True(t, server.Exists("pet-ms:a"), "keys carry the prefix")
+	value, ok, err := backend.Get(ctx, "a")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []byte("1"), value)
+
+	require.NoError(t, backend.Delete(ctx, "a"))
+	_, ok, _ = backend.Get(ctx, "a")
+	assert.False(t, ok)
+}
+
+func TestRedis_Expires(t *testing.T) {
+	ctx := context.Background()
+	backend, server := newTestRedis(t)
+
+	require.NoError(t, backend.Set(ctx, "a", []byte("1"), time.Minute))
+	server.FastForward(time.Minute)
+	_, ok, err := backend.Get(ctx, "a")
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestRedis_ServerDown(t *testing.T) {
+	backend, server := newTestRedis(t)
+	server.Close()
+
+	_, _, err := backend.Get(context.Background(), "a")
+	assert.Error(t, err)
+}
@@ -0,0 +1,121 @@
+package eventbus
+
+import (
+	"sync"
+	"time"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/LuizFJP/pet-ms/domain/event"
+)
+
+const (
+	DefaultRetention        = 10000
+	DefaultSubscriberBuffer = 256
+)
+
+// MemoryBus é um barramento em processo que retém os últimos eventos para que
+// assinantes possam retomar a partir de uma posição sem perder eventos.
+type MemoryBus struct {
+	mu        sync.Mutex
+	retention int
+	buffer    int
+	next      uint64
+	events    []entity.PetEvent
+	subs      map[*subscription]struct{}
+	now       func() time.Time
+}
+
+var _ event.Bus = &MemoryBus{}
+
+func NewMemoryBus(retention int) *MemoryBus {
+	if retention <= 0 {
+		retention = DefaultRetention
+	}
+	return &MemoryBus{
+		retention: retention,
+		buffer:    DefaultSubscriberBuffer,
+		// começar do relógio evita que posições de uma execução anterior sejam aceitas
+		next: uint64(time.Now().UnixNano()),
+		subs: map[*subscription]struct{}{},
+		now:  time.Now,
+	}
+}
+
+func (b *MemoryBus) Publish(events ...entity.PetEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, ev := range events {
+		ev.Sequence = b.next
+		b.next++
+		if ev.OccurredAt.IsZero() {
+			ev.OccurredAt = b.now()
+		}
+
+		b.events = append(b.events, ev)
+		if len(b.events) > b.retention {
+			b.events = b.events[len(b.events)-b.retention:]
+		}
+
+		for sub := range b.subs {
+			select {
+			case sub.ch <- ev:
+			default:
+				b.drop(sub, event.ErrSubscriberLagging)
+			}
+		}
+	}
+}
+
+func (b *MemoryBus) Subscribe(afterSequence uint64) (event.Subscription, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var replay []entity.PetEvent
+	if afterSequence != 0 {
+		oldest := b.next - uint64(len(b.events))
+		if afterSequence+1 < oldest || afterSequence >= b.next {
+			return nil, event.ErrResumeExpired
+		}
+		replay = b.events[len(b.events)-int(b.next-afterSequence-1):]
+	}
+
+	sub := &subscription{bus: b, ch: make(chan entity.PetEvent, len(replay)+b.buffer)}
+	for _, ev := range replay {
+		sub.ch <- ev
+	}
+	b.subs[sub] = struct{}{}
+	return sub, nil
+}
+
+// drop precisa ser chamado com o lock do barramento.
+func (b *MemoryBus) drop(sub *subscription, err error) {
+	if _, ok := b.subs[sub]; !ok {
+		return
+	}
+	delete(b.subs, sub)
+	sub.err = err
+	close(sub.ch)
+}
+
+type subscription struct {
+	bus *MemoryBus
+	ch  chan entity.PetEvent
+	err error
+}
+
+func (s *subscription) Events() <-chan entity.PetEvent {
+	return s.ch
+}
+
+func (s *subscription) Err() error {
+	s.bus.mu.Lock()
+	defer s.bus.mu.Unlock()
+	return s.err
+}
+
+func (s *subscription) Close() {
+	s.bus.mu.Lock()
+	defer s.bus.mu.Unlock()
+	s.bus.drop(s, nil)
+}
@@ -0,0 +1,112 @@
+package eventbus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/LuizFJP/pet-ms/domain/event"
+)
+
+func publishNamed(bus *MemoryBus, names ...string) {
+	for _, name := range names {
+		bus.Publish(entity.PetEvent{Type: entity.PetCreated, Pet: entity.Pet{Name: name}})
+	}
+}
+
+func receive(t *testing.T, sub event.Subscription, n int) []entity.PetEvent {
+	t.Helper()
+	var got []entity.PetEvent
+	for i := 0; i < n; i++ {
+		select {
+		case ev := <-sub.Events():
+			got = append(got, ev)
+		default:
+			t.Fatalf("expected %d events, got %d", n, len(got))
+		}
+	}
+	return got
+}
+
+func TestMemoryBus_SubscribeFromNowReceivesOnlyNewEvents(t *testing.T) {
+	bus := NewMemoryBus(10)
+	publishNamed(bus, "old")
+
+	sub, err := bus.Subscribe(0)
+	require.NoError(t, err)
+	defer sub.Close()
+
+	publishNamed(bus, "new")
+
+	got := receive(t, sub, 1)
+	assert.Equal(t, "new", got[0].Pet.Name)
+	assert.False(t, got[0].OccurredAt.IsZero())
+}
+
+func TestMemoryBus_ResumeReplaysRetainedEvents(t *testing.T) {
+	bus := NewMemoryBus(10)
+	publishNamed(bus, "a", "b", "c")
+
+	first, err := bus.Subscribe(0)
+	require.NoError(t, err)
+	publishNamed(bus, "d")
+	last := receive(t, first, 1)[0]
+	first.Close()
+
+	publishNamed(bus, "e", "f")
+
+	resumed, err := bus.Subscribe(last.Sequence)
+	require.NoError(t, err)
+	defer resumed.Close()
+
+	got := receive(t, resumed, 2)
+	assert.Equal(t, "e", got[0].Pet.Name)
+	assert.Equal(t, "f", got[1].Pet.Name)
+	assert.Equal(t, got[0].Sequence+1, got[1].Sequence)
+}
+
+func TestMemoryBus_ResumeFromEvictedPositionFails(t *testing.T) {
+	bus := NewMemoryBus(2)
+	sub, err := bus.Subscribe(0)
+	require.NoError(t, err)
+	publishNamed(bus, "a")
+	first := receive(t, sub, 1)[0]
+	sub.Close()
+
+	publishNamed(bus, "b", "c", "d")
+
+	_, err = bus.Subscribe(first.Sequence)
+	assert.ErrorIs(t, err, event.ErrResumeExpired)
+
+	_, err = bus.Subscribe(1)
+	assert.ErrorIs(t, err, event.ErrResumeExpired, "positions from a previous process must be rejected")
+}
+
+func TestMemoryBus_SlowSubscriberIsDropped(t *testing.T) {
+	bus := NewMemoryBus(10)
+	bus.buffer = 1
+
+	sub, err := bus.Subscribe(0)
+	require.NoError(t, err)
+
+	publishNamed(bus, "a", "b")
+
+	_, ok := <-sub.Events()
+	assert.True(t, ok, "buffered event is still delivered")
+	_, ok = <-sub.Events()
+	assert.False(t, ok, "channel is closed once the subscriber lags")
+	assert.ErrorIs(t, sub.Err(), event.ErrSubscriberLagging)
+}
+
+func TestMemoryBus_CloseIsIdempotent(t *testing.T) {
+	bus := NewMemoryBus(10)
+	sub, err := bus.Subscribe(0)
+	require.NoError(t, err)
+
+	sub.Close()
+	sub.Close()
+	assert.NoError(t, sub.Err())
+	publishNamed(bus, "after close")
+}
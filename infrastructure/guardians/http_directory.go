@@ -0,0 +1,80 @@
+// Package guardians fala com o serviço externo de guardiões, usado quando o cadastro
+// não fica neste serviço.
+package guardians
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/LuizFJP/pet-ms/domain/repository"
+	"github.com/google/uuid"
+)
+
+const DefaultTimeout = 2 * time.Second
+
+// HTTPConfig aponta para o serviço de guardiões. Token, se informado, vai como Bearer.
+type HTTPConfig struct {
+	BaseURL string
+	Token   string
+	Timeout time.Duration
+}
+
+// HTTPDirectory consulta GET {BaseURL}/guardians/{uuid}: 200 é guardião existente,
+// 404 é inexistente e o resto é erro. O tenant vai no header X-Tenant-ID, para o
+// serviço responder 404 aos guardiões de outra organização.
+type HTTPDirectory struct {
+	cfg     HTTPConfig
+	baseURL *url.URL
+	client  *http.Client
+}
+
+func NewHTTPDirectory(cfg HTTPConfig) (*HTTPDirectory, error) {
+	baseURL, err := url.Parse(cfg.BaseURL)
+	if err != nil || baseURL.Host == "" || (baseURL.Scheme != "http" && baseURL.Scheme != "https") {
+		return nil, fmt.Errorf("invalid guardian service url %q", cfg.BaseURL)
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = DefaultTimeout
+	}
+	return &HTTPDirectory{cfg: cfg, baseURL: baseURL, client: &http.Client{Timeout: cfg.Timeout}}, nil
+}
+
+var _ repository.GuardianDirectory = &HTTPDirectory{}
+
+func (d *HTTPDirectory) GuardianExists(ctx context.Context, tenant string, id uuid.UUID) (bool, error) {
+	u := *d.baseURL
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/guardians/" + id.String()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if tenant != "" {
+		req.Header.Set("X-Tenant-ID", tenant)
+	}
+	if d.cfg.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+d.cfg.Token)
+	}
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("guardian service: %w", err)
+	}
+	defer resp.Body.Close()
+	// esvazia o corpo para a conexão voltar ao pool
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		return false, fmt.Errorf("guardian service: unexpected status %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,51 @@
+package guardians
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPDirectory_GuardianExists(t *testing.T) {
+	known := uuid.New()
+	var tenant, auth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tenant, auth = r.Header.Get("X-Tenant-ID"), r.Header.Get("Authorization")
+		switch r.URL.Path {
+		case "/v1/guardians/" + known.String():
+			w.Write([]byte(`{"uuid":"` + known.String() + `"}`))
+		case "/v1/guardians/" + uuid.Nil.String():
+			http.Error(w, "boom", http.StatusInternalServerError)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	directory, err := NewHTTPDirectory(HTTPConfig{BaseURL: srv.URL + "/v1/", Token: "secret"})
+	require.NoError(t, err)
+
+	ok, err := directory.GuardianExists(context.Background(), "shelter", known)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "shelter", tenant)
+	assert.Equal(t, "Bearer secret", auth)
+
+	ok, err = directory.GuardianExists(context.Background(), "", uuid.New())
+	require.NoError(t, err)
+	assert.False(t, ok)
+	assert.Empty(t, tenant)
+
+	_, err = directory.GuardianExists(context.Background(), "", uuid.Nil)
+	assert.Error(t, err)
+}
+
+func TestNewHTTPDirectory_RejectsInvalidURL(t *testing.T) {
+	_, err := NewHTTPDirectory(HTTPConfig{BaseURL: "guardians:8080"})
+	assert.Error(t, err)
+}
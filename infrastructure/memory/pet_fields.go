@@ -0,0 +1,81 @@
+package memory
+
+import (
+	"time"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+)
+
+// petFields são os campos atualizáveis, pelo nome json, como no PetRepo do Postgres.
+var petFields = func() map[string]bool {
+	fields := map[string]bool{}
+	for _, field := range append(append([]string{}, entity.CorePetFields...), entity.ProfilePetFields...) {
+		fields[field] = true
+	}
+	return fields
+}()
+
+// copyPetField copia um campo de src para dst, pelo nome json.
+func copyPetField(dst, src *entity.Pet, field string) {
+	switch field {
+	case "name":
+		dst.Name = src.Name
+	case "birth_year":
+		dst.BirthYear = src.BirthYear
+	case "birth_date":
+		dst.BirthDate = cloneTime(src.BirthDate)
+	case "birth_date_accuracy":
+		dst.BirthDateAccuracy = src.BirthDateAccuracy
+	case "breed":
+		dst.Breed = src.Breed
+	case "specie":
+		dst.Specie = src.Specie
+	case "sex":
+		dst.Sex = src.Sex
+	case "neutered":
+		dst.Neutered = cloneBool(src.Neutered)
+	case "color":
+		dst.Color = src.Color
+	case "markings":
+		dst.Markings = src.Markings
+	case "weight_history":
+		dst.WeightHistory = append(entity.WeightHistory(nil), src.WeightHistory...)
+	case "microchip_number":
+		dst.MicrochipNumber = src.MicrochipNumber
+	case "photos":
+		dst.Photos = append(entity.StringList(nil), src.Photos...)
+	case "notes":
+		dst.Notes = src.Notes
+	}
+}
+
+// clonePet copia também o que o pet referencia, para que quem recebe a cópia não
+// altere o que está guardado.
+func clonePet(pet *entity.Pet) *entity.Pet {
+	cloned := *pet
+	cloned.BirthDate = cloneTime(pet.BirthDate)
+	cloned.Neutered = cloneBool(pet.Neutered)
+	if pet.WeightHistory != nil {
+		cloned.WeightHistory = append(entity.WeightHistory(nil), pet.WeightHistory...)
+	}
+	if pet.Photos != nil {
+		cloned.Photos = append(entity.StringList(nil), pet.Photos...)
+	}
+	return &cloned
+}
+
+func cloneTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	copied := *t
+	return &copied
+}
+
+func cloneBool(b *bool) *bool {
+	if b == nil {
+		return nil
+	}
+	copied := *b
+	return &copied
+}
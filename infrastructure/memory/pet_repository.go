@@ -0,0 +1,379 @@
+package memory
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/LuizFJP/pet-ms/domain/repository"
+	"github.com/google/uuid"
+)
+
+// PetRepository guarda os pets num mapa protegido por mutex. Segue a semântica do
+// PetRepo do Postgres, inclusive nas mensagens de erro; serve para testes e para
+// desenvolvimento local. Os pets entram e saem como cópias.
+type PetRepository struct {
+	store *petStore
+	// tenant marca a visão de ForTenant; vazio enxerga todos os tenants.
+	tenant string
+}
+
+// petStore é compartilhado entre o repositório e as visões de ForTenant.
+type petStore struct {
+	mu    sync.RWMutex
+	table *petTable
+}
+
+func NewPetRepository() *PetRepository {
+	return &PetRepository{store: &petStore{table: &petTable{pets: map[uuid.UUID]*entity.Pet{}}}}
+}
+
+var _ repository.PetRepository = &PetRepository{}
+var _ repository.TenantScoper = &PetRepository{}
+
+func (r *PetRepository) ForTenant(tenant string) repository.PetRepository {
+	return &PetRepository{store: r.store, tenant: tenant}
+}
+
+// visible diz se o pet pertence ao tenant da visão.
+func (r *PetRepository) visible(pet *entity.Pet) bool {
+	return inTenant(pet, r.tenant)
+}
+
+func inTenant(pet *entity.Pet, tenant string) bool {
+	return tenant == "" || pet.TenantID == tenant
+}
+
+// stamp passa os pets novos para o tenant da visão.
+func (r *PetRepository) stamp(pets ...*entity.Pet) {
+	if r.tenant == "" {
+		return
+	}
+	for _, pet := range pets {
+		pet.TenantID = r.tenant
+	}
+}
+
+func (r *PetRepository) SavePet(pet *entity.Pet) (*entity.Pet, map[string]string) {
+	r.stamp(pet)
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	if errData := r.store.table.insert(pet); errData != nil {
+		return nil, errData
+	}
+	return pet, nil
+}
+
+func (r *PetRepository) GetPet(id string) (*entity.Pet, map[string]string) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+	pet, ok := r.store.table.get(id)
+	if !ok || !r.visible(pet) {
+		// mesmo retorno do First() do gorm
+		return nil, map[string]string{"db_error": "record not found"}
+	}
+	return pet, nil
+}
+
+func (r *PetRepository) GetPetByMicrochip(number string) (*entity.Pet, map[string]string) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+	for _, pet := range r.store.table.pets {
+		if pet.MicrochipNumber == number && r.visible(pet) {
+			return clonePet(pet), nil
+		}
+	}
+	return nil, map[string]string{"not_found": "no pet with this microchip"}
+}
+
+func (r *PetRepository) UpdatePet(pet *entity.Pet) (*entity.Pet, map[string]string) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	return r.store.table.update(pet, r.tenant)
+}
+
+func (r *PetRepository) UpdatePetFields(pet *entity.Pet, fields []string) (*entity.Pet, map[string]string) {
+	for _, field := range fields {
+		if !petFields[field] {
+			return nil, map[string]string{"invalid_argument": fmt.Sprintf("unknown field %q", field)}
+		}
+	}
+	if len(fields) == 0 {
+		return r.GetPet(pet.Uuid.String())
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	return r.store.table.updateFields(pet, fields, r.tenant)
+}
+
+func (r *PetRepository) DeletePet(uuidGuardian string) (map[string]string, map[string]string) {
+	guardian, err := uuid.Parse(uuidGuardian)
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	deleted := 0
+	if err == nil {
+		for id, pet := range r.store.table.pets {
+			if pet.UuidGuardian == guardian && r.visible(pet) {
+				delete(r.store.table.pets, id)
+				deleted++
+			}
+		}
+	}
+	if deleted == 0 {
+		return nil, map[string]string{"not_found": "nenhum pet encontrado para esse guardião"}
+	}
+	return map[string]string{
+		"message": fmt.Sprintf("%d pet(s) deletados!", deleted),
+	}, nil
+}
+
+func (r *PetRepository) TransferPet(petUuid string, uuidGuardian string) (*entity.Pet, map[string]string) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	pet, ok := r.store.table.get(petUuid)
+	if !ok || !r.visible(pet) {
+		return nil, map[string]string{"not_found": "pet not found"}
+	}
+	guardian, err := uuid.Parse(uuidGuardian)
+	if err != nil {
+		return nil, map[string]string{"db_error": err.Error()}
+	}
+	pet.UuidGuardian = guardian
+	r.store.table.pets[pet.Uuid] = pet
+	return clonePet(pet), nil
+}
+
+func (r *PetRepository) ChangePetStatus(petUuid string, change entity.StatusChange) (*entity.Pet, map[string]string) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	pet, ok := r.store.table.get(petUuid)
+	if !ok || !r.visible(pet) {
+		return nil, map[string]string{"not_found": "pet not found"}
+	}
+	if pet.CurrentStatus() != change.From {
+		return nil, map[string]string{"aborted": "pet status changed concurrently, reload and try again"}
+	}
+	change.Apply(pet)
+	r.store.table.pets[pet.Uuid] = pet
+	return clonePet(pet), nil
+}
+
+func (r *PetRepository) SavePets(pets []*entity.Pet, allOrNothing bool) ([]*entity.Pet, []map[string]string) {
+	r.stamp(pets...)
+	return r.runBatch(pets, allOrNothing, func(t *petTable, pet *entity.Pet) (*entity.Pet, map[string]string) {
+		if errData := t.insert(pet); errData != nil {
+			return nil, errData
+		}
+		return pet, nil
+	})
+}
+
+func (r *PetRepository) GetPets(uuids []string) ([]*entity.Pet, map[string]string) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+	var pets []*entity.Pet
+	seen := map[string]bool{}
+	for _, id := range uuids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		if pet, ok := r.store.table.get(id); ok && r.visible(pet) {
+			pets = append(pets, pet)
+		}
+	}
+	return pets, nil
+}
+
+func (r *PetRepository) UpdatePets(pets []*entity.Pet, allOrNothing bool) ([]*entity.Pet, []map[string]string) {
+	return r.runBatch(pets, allOrNothing, func(t *petTable, pet *entity.Pet) (*entity.Pet, map[string]string) {
+		return t.update(pet, r.tenant)
+	})
+}
+
+// runBatch trabalha numa cópia da tabela e só a publica no fim, o que faz o papel da
+// transação; no modo por item cada falha simplesmente não altera a cópia.
+func (r *PetRepository) runBatch(pets []*entity.Pet, allOrNothing bool, op func(*petTable, *entity.Pet) (*entity.Pet, map[string]string)) ([]*entity.Pet, []map[string]string) {
+	results := make([]*entity.Pet, len(pets))
+	itemErrs := make([]map[string]string, len(pets))
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	working := r.store.table.clone()
+	for i, pet := range pets {
+		res, errData := op(working, pet)
+		if errData == nil {
+			results[i] = res
+			continue
+		}
+		itemErrs[i] = errData
+		if allOrNothing {
+			for j := range pets {
+				results[j] = nil
+				if itemErrs[j] == nil {
+					itemErrs[j] = map[string]string{"aborted": "batch rolled back"}
+				}
+			}
+			return results, itemErrs
+		}
+	}
+	r.store.table = working
+	return results, itemErrs
+}
+
+func (r *PetRepository) InsertPets(pets []*entity.Pet) map[string]string {
+	r.stamp(pets...)
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	working := r.store.table.clone()
+	for _, pet := range pets {
+		if errData := working.insert(pet); errData != nil {
+			return errData
+		}
+	}
+	r.store.table = working
+	return nil
+}
+
+// ListPets pagina por uuid (keyset), na mesma ordem textual do banco. limit negativo
+// não limita, como no gorm.
+func (r *PetRepository) ListPets(filter entity.PetFilter, afterUuid string, limit int) ([]*entity.Pet, map[string]string) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+	pets := r.store.table.sorted(func(pet *entity.Pet) bool {
+		return r.visible(pet) && filter.Matches(pet) && pet.Uuid.String() > afterUuid
+	})
+	if limit >= 0 && len(pets) > limit {
+		pets = pets[:limit]
+	}
+	return pets, nil
+}
+
+func (r *PetRepository) SearchPets(search entity.PetSearch) ([]*entity.PetSearchHit, map[string]string) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+	var hits []*entity.PetSearchHit
+	for _, pet := range r.store.table.sorted(r.visible) {
+		if hit, ok := search.Match(pet); ok {
+			hits = append(hits, hit)
+		}
+	}
+
+	entity.SortSearchHits(hits)
+	if search.Limit >= 0 && len(hits) > search.Limit {
+		hits = hits[:search.Limit]
+	}
+	return hits, nil
+}
+
+// petTable é o estado do repositório. Os pets guardados nunca são alterados no lugar:
+// cada escrita grava uma cópia nova, então clone só precisa copiar o mapa.
+type petTable struct {
+	pets   map[uuid.UUID]*entity.Pet
+	nextID uint
+}
+
+func (t *petTable) clone() *petTable {
+	pets := make(map[uuid.UUID]*entity.Pet, len(t.pets))
+	for id, pet := range t.pets {
+		pets[id] = pet
+	}
+	return &petTable{pets: pets, nextID: t.nextID}
+}
+
+// get devolve uma cópia que pode ser alterada e gravada de volta.
+func (t *petTable) get(id string) (*entity.Pet, bool) {
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return nil, false
+	}
+	pet, ok := t.pets[parsed]
+	if !ok {
+		return nil, false
+	}
+	return clonePet(pet), true
+}
+
+func (t *petTable) sorted(keep func(*entity.Pet) bool) []*entity.Pet {
+	var pets []*entity.Pet
+	for _, pet := range t.pets {
+		if keep == nil || keep(pet) {
+			pets = append(pets, clonePet(pet))
+		}
+	}
+	sort.Slice(pets, func(i, j int) bool { return pets[i].Uuid.String() < pets[j].Uuid.String() })
+	return pets
+}
+
+// insert preenche NIdentification como a sequência do banco quando vier zerado.
+func (t *petTable) insert(pet *entity.Pet) map[string]string {
+	if _, exists := t.pets[pet.Uuid]; exists {
+		return map[string]string{"db_error": `pq: duplicate key value violates unique constraint "pets_pkey"`}
+	}
+	if errData := t.checkMicrochip(pet); errData != nil {
+		return errData
+	}
+	if pet.NIdentification == 0 {
+		t.nextID++
+		pet.NIdentification = t.nextID
+	} else if pet.NIdentification > t.nextID {
+		t.nextID = pet.NIdentification
+	}
+	t.pets[pet.Uuid] = clonePet(pet)
+	return nil
+}
+
+// update substitui os campos básicos; os de perfil só mudam por updateFields. Pets de
+// outro tenant não são encontrados.
+func (t *petTable) update(pet *entity.Pet, tenant string) (*entity.Pet, map[string]string) {
+	current, ok := t.pets[pet.Uuid]
+	if !ok || !inTenant(current, tenant) {
+		return nil, map[string]string{"not_found": "pet not found"}
+	}
+	updated := clonePet(current)
+	updated.NIdentification = pet.NIdentification
+	if pet.UuidGuardian != uuid.Nil {
+		updated.UuidGuardian = pet.UuidGuardian
+	}
+	for _, field := range entity.CorePetFields {
+		copyPetField(updated, pet, field)
+	}
+	return t.store(updated)
+}
+
+func (t *petTable) updateFields(pet *entity.Pet, fields []string, tenant string) (*entity.Pet, map[string]string) {
+	current, ok := t.pets[pet.Uuid]
+	if !ok || !inTenant(current, tenant) {
+		return nil, map[string]string{"not_found": "pet not found"}
+	}
+	updated := clonePet(current)
+	for _, field := range fields {
+		copyPetField(updated, pet, field)
+	}
+	return t.store(updated)
+}
+
+func (t *petTable) store(pet *entity.Pet) (*entity.Pet, map[string]string) {
+	if errData := t.checkMicrochip(pet); errData != nil {
+		return nil, errData
+	}
+	t.pets[pet.Uuid] = pet
+	return clonePet(pet), nil
+}
+
+// checkMicrochip faz o papel do índice único parcial por tenant: chips vazios não
+// colidem.
+func (t *petTable) checkMicrochip(pet *entity.Pet) map[string]string {
+	if pet.MicrochipNumber == "" {
+		return nil
+	}
+	for id, other := range t.pets {
+		if id != pet.Uuid && other.TenantID == pet.TenantID && other.MicrochipNumber == pet.MicrochipNumber {
+			return map[string]string{"conflict": "microchip already registered to another pet"}
+		}
+	}
+	return nil
+}
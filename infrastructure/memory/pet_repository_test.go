@@ -0,0 +1,58 @@
+package memory
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/LuizFJP/pet-ms/domain/repository"
+	"github.com/LuizFJP/pet-ms/domain/repository/repositorytest"
+)
+
+func TestPetRepository_Conformance(t *testing.T) {
+	repositorytest.TestPetRepository(t, func(t *testing.T) repository.PetRepository {
+		return NewPetRepository()
+	})
+}
+
+func TestPetRepository_AssignsNIdentification(t *testing.T) {
+	repo := NewPetRepository()
+
+	first, _ := repo.SavePet(&entity.Pet{Uuid: uuid.New(), Name: "A"})
+	explicit, _ := repo.SavePet(&entity.Pet{Uuid: uuid.New(), Name: "B", NIdentification: 10})
+	next, _ := repo.SavePet(&entity.Pet{Uuid: uuid.New(), Name: "C"})
+
+	assert.Equal(t, uint(1), first.NIdentification)
+	assert.Equal(t, uint(10), explicit.NIdentification)
+	assert.Equal(t, uint(11), next.NIdentification)
+}
+
+func TestPetRepository_ConcurrentWrites(t *testing.T) {
+	repo := NewPetRepository()
+	guardian := uuid.New()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			pet := &entity.Pet{Uuid: uuid.New(), UuidGuardian: guardian, Name: fmt.Sprintf("Pet %d", i)}
+			_, errData := repo.SavePet(pet)
+			assert.Nil(t, errData)
+			pet.Name = "renamed"
+			_, errData = repo.UpdatePetFields(pet, []string{"name"})
+			assert.Nil(t, errData)
+			repo.ListPets(entity.PetFilter{UuidGuardian: guardian}, "", -1)
+		}(i)
+	}
+	wg.Wait()
+
+	pets, errData := repo.ListPets(entity.PetFilter{UuidGuardian: guardian}, "", -1)
+	require.Nil(t, errData)
+	assert.Len(t, pets, 50)
+}
@@ -0,0 +1,23 @@
+package outbox
+
+import (
+	"context"
+	"time"
+)
+
+// Message é o que o relay entrega ao broker. Key é o uuid do pet, para que
+// brokers particionados mantenham a ordem dos eventos de um mesmo pet.
+type Message struct {
+	ID         string
+	Type       string
+	Key        string
+	Payload    []byte
+	OccurredAt time.Time
+}
+
+// Broker publica mensagens da outbox. Publish só deve retornar nil depois que
+// o broker confirmou o recebimento; caso contrário a mensagem é reenviada.
+type Broker interface {
+	Publish(ctx context.Context, msg Message) error
+	Close() error
+}
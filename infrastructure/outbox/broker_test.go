@@ -0,0 +1,57 @@
+package outbox
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testMessage() Message {
+	return Message{
+		ID:         "evt-1",
+		Type:       "pet.created",
+		Key:        "pet-1",
+		Payload:    []byte("payload"),
+		OccurredAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+}
+
+func TestMemoryBroker_PublishAndFail(t *testing.T) {
+	broker := NewMemoryBroker()
+	require.NoError(t, broker.Publish(context.Background(), testMessage()))
+
+	broker.FailWith(assert.AnError)
+	assert.ErrorIs(t, broker.Publish(context.Background(), testMessage()), assert.AnError)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	broker.FailWith(nil)
+	assert.Error(t, broker.Publish(ctx, testMessage()))
+
+	assert.Len(t, broker.Messages(), 1)
+}
+
+func TestToKafkaMessage(t *testing.T) {
+	km := toKafkaMessage(testMessage())
+
+	assert.Equal(t, []byte("pet-1"), km.Key)
+	assert.Equal(t, []byte("payload"), km.Value)
+	assert.Equal(t, testMessage().OccurredAt, km.Time)
+	require.Len(t, km.Headers, 2)
+	assert.Equal(t, "event-id", km.Headers[0].Key)
+	assert.Equal(t, []byte("evt-1"), km.Headers[0].Value)
+	assert.Equal(t, []byte("pet.created"), km.Headers[1].Value)
+}
+
+func TestToNatsMsg(t *testing.T) {
+	msg := toNatsMsg("pets", testMessage())
+
+	assert.Equal(t, "pets.pet.created", msg.Subject)
+	assert.Equal(t, []byte("payload"), msg.Data)
+	assert.Equal(t, "evt-1", msg.Header.Get(jetstream.MsgIDHeader))
+	assert.Equal(t, "pet-1", msg.Header.Get("Pet-Uuid"))
+}
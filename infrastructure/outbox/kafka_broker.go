@@ -0,0 +1,43 @@
+package outbox
+
+import (
+	"context"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// KafkaBroker publica todos os eventos num único tópico, particionado pelo uuid do pet.
+type KafkaBroker struct {
+	writer *kafka.Writer
+}
+
+func NewKafkaBroker(brokers []string, topic string) *KafkaBroker {
+	return &KafkaBroker{writer: &kafka.Writer{
+		Addr:         kafka.TCP(brokers...),
+		Topic:        topic,
+		Balancer:     &kafka.Hash{},
+		RequiredAcks: kafka.RequireAll,
+	}}
+}
+
+var _ Broker = &KafkaBroker{}
+
+func (b *KafkaBroker) Publish(ctx context.Context, msg Message) error {
+	return b.writer.WriteMessages(ctx, toKafkaMessage(msg))
+}
+
+func toKafkaMessage(msg Message) kafka.Message {
+	return kafka.Message{
+		Key:   []byte(msg.Key),
+		Value: msg.Payload,
+		Time:  msg.OccurredAt,
+		Headers: []kafka.Header{
+			{Key: "event-id", Value: []byte(msg.ID)},
+			{Key: "event-type", Value: []byte(msg.Type)},
+		},
+	}
+}
+
+func (b *KafkaBroker) Close() error {
+	return b.writer.Close()
+}
@@ -0,0 +1,50 @@
+package outbox
+
+import (
+	"context"
+	"sync"
+)
+
+// MemoryBroker guarda as mensagens publicadas em memória; usado em testes e desenvolvimento local.
+type MemoryBroker struct {
+	mu       sync.Mutex
+	messages []Message
+	fail     error
+}
+
+func NewMemoryBroker() *MemoryBroker {
+	return &MemoryBroker{}
+}
+
+var _ Broker = &MemoryBroker{}
+
+func (b *MemoryBroker) Publish(ctx context.Context, msg Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.fail != nil {
+		return b.fail
+	}
+	b.messages = append(b.messages, msg)
+	return nil
+}
+
+// FailWith faz os próximos Publish falharem com err; nil volta ao normal.
+func (b *MemoryBroker) FailWith(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.fail = err
+}
+
+// Messages devolve uma cópia das mensagens publicadas, na ordem de publicação.
+func (b *MemoryBroker) Messages() []Message {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]Message(nil), b.messages...)
+}
+
+func (b *MemoryBroker) Close() error {
+	return nil
+}
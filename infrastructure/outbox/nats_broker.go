@@ -0,0 +1,50 @@
+package outbox
+
+import (
+	"context"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// NatsBroker publica no JetStream em "<prefixo>.<tipo do evento>". O id do evento
+// vai em Nats-Msg-Id, então reenvios dentro da janela de duplicatas do stream são descartados.
+type NatsBroker struct {
+	conn          *nats.Conn
+	js            jetstream.JetStream
+	subjectPrefix string
+}
+
+func NewNatsBroker(url, subjectPrefix string) (*NatsBroker, error) {
+	conn, err := nats.Connect(url)
+	if err != nil {
+		return nil, err
+	}
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return &NatsBroker{conn: conn, js: js, subjectPrefix: subjectPrefix}, nil
+}
+
+var _ Broker = &NatsBroker{}
+
+func (b *NatsBroker) Publish(ctx context.Context, msg Message) error {
+	_, err := b.js.PublishMsg(ctx, toNatsMsg(b.subjectPrefix, msg))
+	return err
+}
+
+func toNatsMsg(subjectPrefix string, msg Message) *nats.Msg {
+	natsMsg := nats.NewMsg(subjectPrefix + "." + msg.Type)
+	natsMsg.Data = msg.Payload
+	natsMsg.Header.Set(jetstream.MsgIDHeader, msg.ID)
+	natsMsg.Header.Set("Event-Type", msg.Type)
+	natsMsg.Header.Set("Pet-Uuid", msg.Key)
+	return natsMsg
+}
+
+func (b *NatsBroker) Close() error {
+	b.conn.Close()
+	return nil
+}
@@ -0,0 +1,93 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/LuizFJP/pet-ms/domain/repository"
+)
+
+const (
+	DefaultRelayInterval  = time.Second
+	DefaultRelayBatchSize = 100
+)
+
+// Relay lê a outbox e publica as mensagens pendentes no broker. Uma mensagem só é
+// marcada como publicada depois da confirmação do broker, então a entrega é
+// at-least-once: uma queda entre a publicação e a marcação gera reenvio.
+type Relay struct {
+	repo      repository.OutboxRepository
+	broker    Broker
+	interval  time.Duration
+	batchSize int
+	now       func() time.Time
+}
+
+func NewRelay(repo repository.OutboxRepository, broker Broker, interval time.Duration, batchSize int) *Relay {
+	if interval <= 0 {
+		interval = DefaultRelayInterval
+	}
+	if batchSize <= 0 {
+		batchSize = DefaultRelayBatchSize
+	}
+	return &Relay{repo: repo, broker: broker, interval: interval, batchSize: batchSize, now: time.Now}
+}
+
+// Run publica em lotes até ctx ser cancelado.
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		// esvazia o backlog antes de voltar a esperar o próximo tick
+		for {
+			published, err := r.RelayOnce(ctx)
+			if err != nil {
+				log.Printf("outbox relay: %v", err)
+			}
+			if err != nil || published < r.batchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RelayOnce publica um lote de mensagens pendentes e devolve quantas foram publicadas.
+// Na primeira falha o lote é interrompido para não publicar eventos fora de ordem;
+// a mensagem é tentada de novo no próximo ciclo.
+func (r *Relay) RelayOnce(ctx context.Context) (int, error) {
+	messages, errData := r.repo.PendingOutboxMessages(r.batchSize)
+	if errData != nil {
+		return 0, fmt.Errorf("load pending messages: %v", errData)
+	}
+
+	published := 0
+	for _, m := range messages {
+		msg := Message{
+			ID:         m.EventID.String(),
+			Type:       m.EventType,
+			Key:        m.AggregateID.String(),
+			Payload:    m.Payload,
+			OccurredAt: m.CreatedAt,
+		}
+		if err := r.broker.Publish(ctx, msg); err != nil {
+			if errData := r.repo.MarkOutboxMessageFailed(m.ID, err.Error()); errData != nil {
+				log.Printf("outbox relay: mark message %d failed: %v", m.ID, errData)
+			}
+			return published, fmt.Errorf("publish message %d: %w", m.ID, err)
+		}
+		if errData := r.repo.MarkOutboxMessagePublished(m.ID, r.now()); errData != nil {
+			return published, fmt.Errorf("mark message %d published: %v", m.ID, errData)
+		}
+		published++
+	}
+	return published, nil
+}
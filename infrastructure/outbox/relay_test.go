@@ -0,0 +1,118 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeOutboxRepo struct {
+	messages []*entity.OutboxMessage
+}
+
+func (r *fakeOutboxRepo) add(eventType string) *entity.OutboxMessage {
+	m := &entity.OutboxMessage{
+		ID:          uint(len(r.messages) + 1),
+		EventID:     uuid.New(),
+		EventType:   eventType,
+		AggregateID: uuid.New(),
+		Payload:     []byte(eventType),
+	}
+	r.messages = append(r.messages, m)
+	return m
+}
+
+func (r *fakeOutboxRepo) PendingOutboxMessages(limit int) ([]*entity.OutboxMessage, map[string]string) {
+	var pending []*entity.OutboxMessage
+	for _, m := range r.messages {
+		if m.PublishedAt == nil && len(pending) < limit {
+			pending = append(pending, m)
+		}
+	}
+	return pending, nil
+}
+
+func (r *fakeOutboxRepo) MarkOutboxMessagePublished(id uint, at time.Time) map[string]string {
+	m := r.messages[id-1]
+	m.PublishedAt = &at
+	m.Attempts++
+	return nil
+}
+
+func (r *fakeOutboxRepo) MarkOutboxMessageFailed(id uint, reason string) map[string]string {
+	m := r.messages[id-1]
+	m.Attempts++
+	m.LastError = reason
+	return nil
+}
+
+func TestRelay_RelayOnce_PublishesInOrder(t *testing.T) {
+	repo := &fakeOutboxRepo{}
+	first := repo.add("pet.created")
+	repo.add("pet.updated")
+	broker := NewMemoryBroker()
+	relay := NewRelay(repo, broker, time.Second, 10)
+
+	published, err := relay.RelayOnce(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, published)
+
+	msgs := broker.Messages()
+	require.Len(t, msgs, 2)
+	assert.Equal(t, first.EventID.String(), msgs[0].ID)
+	assert.Equal(t, "pet.created", msgs[0].Type)
+	assert.Equal(t, first.AggregateID.String(), msgs[0].Key)
+	assert.Equal(t, "pet.updated", msgs[1].Type)
+
+	published, err = relay.RelayOnce(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, published, "published messages must not be sent again")
+}
+
+func TestRelay_RelayOnce_FailureStopsBatchAndRetries(t *testing.T) {
+	repo := &fakeOutboxRepo{}
+	first := repo.add("pet.created")
+	repo.add("pet.updated")
+	broker := NewMemoryBroker()
+	broker.FailWith(errors.New("broker down"))
+	relay := NewRelay(repo, broker, time.Second, 10)
+
+	published, err := relay.RelayOnce(context.Background())
+	require.Error(t, err)
+	assert.Zero(t, published)
+	assert.Equal(t, 1, first.Attempts)
+	assert.Equal(t, "broker down", first.LastError)
+	assert.Zero(t, repo.messages[1].Attempts, "later messages wait for the failed one")
+
+	broker.FailWith(nil)
+	published, err = relay.RelayOnce(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, published)
+	assert.Equal(t, 2, first.Attempts)
+}
+
+func TestRelay_Run_DrainsUntilCancelled(t *testing.T) {
+	repo := &fakeOutboxRepo{}
+	for i := 0; i < 5; i++ {
+		repo.add("pet.created")
+	}
+	broker := NewMemoryBroker()
+	relay := NewRelay(repo, broker, time.Hour, 2)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		relay.Run(ctx)
+	}()
+
+	require.Eventually(t, func() bool { return len(broker.Messages()) == 5 }, time.Second, 10*time.Millisecond)
+	cancel()
+	<-done
+}
@@ -0,0 +1,188 @@
+package persistence
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/LuizFJP/pet-ms/domain/repository"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"github.com/lib/pq"
+)
+
+// pendingApplicationIndex impede dois pedidos pendentes do mesmo guardião para o
+// mesmo pet; pedidos encerrados não contam.
+const pendingApplicationIndex = "ux_adoption_applications_pending"
+
+// AdoptionApplicationRepo grava os pedidos de adoção. A aprovação também reserva o pet
+// e, com a outbox habilitada, grava o evento dele na mesma transação. Com row-level
+// security a transação da aprovação define o tenant, como as escritas do PetRepo.
+type AdoptionApplicationRepo struct {
+	db          *gorm.DB
+	outbox      *OutboxWriter
+	rowSecurity bool
+}
+
+func NewAdoptionApplicationRepository(db *gorm.DB, opts ...RepoOption) *AdoptionApplicationRepo {
+	o := newRepoOptions(opts)
+	return &AdoptionApplicationRepo{db: db, outbox: o.outbox, rowSecurity: o.rowSecurity}
+}
+
+var _ repository.AdoptionApplicationRepository = &AdoptionApplicationRepo{}
+
+func createAdoptionIndexes(db *gorm.DB) error {
+	return db.Exec("CREATE UNIQUE INDEX IF NOT EXISTS " + pendingApplicationIndex +
+		" ON adoption_applications (pet_uuid, applicant_uuid) WHERE status = 'pending'").Error
+}
+
+func scopeTenant(db *gorm.DB, tenant string) *gorm.DB {
+	if tenant == "" {
+		return db
+	}
+	return db.Where("tenant_id = ?", tenant)
+}
+
+func (r *AdoptionApplicationRepo) SubmitApplication(application *entity.AdoptionApplication) (*entity.AdoptionApplication, map[string]string) {
+	if err := r.db.Create(application).Error; err != nil {
+		if isPendingApplicationConflict(err) {
+			return nil, map[string]string{"conflict": "applicant already has a pending application for this pet"}
+		}
+		return nil, dbError(err)
+	}
+	return application, nil
+}
+
+func (r *AdoptionApplicationRepo) GetApplication(tenant string, id uuid.UUID) (*entity.AdoptionApplication, map[string]string) {
+	return getApplication(r.db, tenant, id)
+}
+
+func getApplication(db *gorm.DB, tenant string, id uuid.UUID) (*entity.AdoptionApplication, map[string]string) {
+	application := &entity.AdoptionApplication{}
+	err := scopeTenant(db, tenant).Where("uuid = ?", id).First(application).Error
+	if gorm.IsRecordNotFoundError(err) {
+		return nil, map[string]string{"not_found": "adoption application not found"}
+	}
+	if err != nil {
+		return nil, dbError(err)
+	}
+	return application, nil
+}
+
+func (r *AdoptionApplicationRepo) DecideApplication(tenant string, id uuid.UUID, decision entity.ApplicationDecision) (*entity.AdoptionApplication, map[string]string) {
+	return decideApplication(r.db, tenant, id, decision)
+}
+
+// decideApplication encerra o pedido só se ele ainda estiver pendente.
+func decideApplication(db *gorm.DB, tenant string, id uuid.UUID, decision entity.ApplicationDecision) (*entity.AdoptionApplication, map[string]string) {
+	application, errData := getApplication(db, tenant, id)
+	if errData != nil {
+		return nil, errData
+	}
+	if application.Status != entity.ApplicationPending {
+		return nil, map[string]string{"failed_precondition": "adoption application is already " + string(application.Status)}
+	}
+
+	res := db.Model(&entity.AdoptionApplication{}).Where("id = ? AND status = ?", application.ID, entity.ApplicationPending).
+		Updates(decisionColumns(decision))
+	if res.Error != nil {
+		return nil, dbError(res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return nil, map[string]string{"failed_precondition": "adoption application is no longer pending"}
+	}
+	return getApplication(db, "", id)
+}
+
+func (r *AdoptionApplicationRepo) ApproveApplication(tenant string, id uuid.UUID, decision entity.ApplicationDecision) (*entity.ApplicationApproval, map[string]string) {
+	approval := &entity.ApplicationApproval{}
+	errData := transaction(r.db, func(tx *gorm.DB) map[string]string {
+		if r.rowSecurity && tenant != "" {
+			if err := setTenant(tx, tenant); err != nil {
+				return dbError(err)
+			}
+		}
+		application, errData := decideApplication(tx, tenant, id, decision)
+		if errData != nil {
+			return errData
+		}
+		approval.Application = application
+
+		reservation := decision.Reservation(application)
+		from := entity.PetFilter{Statuses: []entity.PetStatus{reservation.From}}.StoredStatuses()
+		res := scopeTenant(tx, tenant).Model(&entity.Pet{}).Where("uuid = ? AND status IN (?)", application.PetUuid, from).
+			Updates(map[string]interface{}{
+				"status":            reservation.To,
+				"status_reason":     reservation.Reason,
+				"status_changed_at": reservation.ChangedAt,
+			})
+		if res.Error != nil {
+			return dbError(res.Error)
+		}
+		if res.RowsAffected == 0 {
+			return map[string]string{"failed_precondition": "pet is not available for adoption"}
+		}
+		approval.Pet = &entity.Pet{}
+		if err := tx.Where("uuid = ?", application.PetUuid).First(approval.Pet).Error; err != nil {
+			return dbError(err)
+		}
+
+		if err := tx.Where("pet_uuid = ? AND status = ?", application.PetUuid, entity.ApplicationPending).
+			Order("id").Find(&approval.Rejected).Error; err != nil {
+			return dbError(err)
+		}
+		if len(approval.Rejected) > 0 {
+			rejection := decision.Competing()
+			ids := make([]uint, 0, len(approval.Rejected))
+			for _, competing := range approval.Rejected {
+				ids = append(ids, competing.ID)
+				rejection.Apply(competing)
+			}
+			if err := tx.Model(&entity.AdoptionApplication{}).Where("id IN (?)", ids).
+				Updates(decisionColumns(rejection)).Error; err != nil {
+				return dbError(err)
+			}
+		}
+		return r.outbox.enqueue(tx, petEvents(entity.PetUpdated, approval.Pet)...)
+	})
+	if errData != nil {
+		return nil, errData
+	}
+	return approval, nil
+}
+
+func decisionColumns(decision entity.ApplicationDecision) map[string]interface{} {
+	return map[string]interface{}{
+		"status":          decision.Status,
+		"decision_reason": decision.Reason,
+		"decided_by":      decision.DecidedBy,
+		"decided_at":      decision.DecidedAt,
+	}
+}
+
+func (r *AdoptionApplicationRepo) ListApplicationsByPet(tenant string, petUuid uuid.UUID, afterID uint, limit int) ([]*entity.AdoptionApplication, map[string]string) {
+	return r.list(scopeTenant(r.db, tenant).Where("pet_uuid = ?", petUuid), afterID, limit)
+}
+
+func (r *AdoptionApplicationRepo) ListApplicationsByApplicant(tenant string, applicant uuid.UUID, afterID uint, limit int) ([]*entity.AdoptionApplication, map[string]string) {
+	return r.list(scopeTenant(r.db, tenant).Where("applicant_uuid = ?", applicant), afterID, limit)
+}
+
+func (r *AdoptionApplicationRepo) list(query *gorm.DB, afterID uint, limit int) ([]*entity.AdoptionApplication, map[string]string) {
+	if afterID > 0 {
+		query = query.Where("id > ?", afterID)
+	}
+	var applications []*entity.AdoptionApplication
+	if err := query.Order("id").Limit(limit).Find(&applications).Error; err != nil {
+		return nil, dbError(err)
+	}
+	return applications, nil
+}
+
+func isPendingApplicationConflict(err error) bool {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return pqErr.Code == "23505" && pqErr.Constraint == pendingApplicationIndex
+	}
+	return strings.Contains(err.Error(), "UNIQUE constraint failed: adoption_applications.pet_uuid")
+}
@@ -0,0 +1,124 @@
+package persistence
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+)
+
+func submitApplication(t *testing.T, repos *Repositories, pet uuid.UUID, tenant string) *entity.AdoptionApplication {
+	t.Helper()
+	application := &entity.AdoptionApplication{Uuid: uuid.New(), PetUuid: pet, ApplicantUuid: uuid.New(), TenantID: tenant, Status: entity.ApplicationPending}
+	saved, errData := repos.Adoption.SubmitApplication(application)
+	require.Nil(t, errData)
+	return saved
+}
+
+func TestAdoptionApplicationRepository_Approve(t *testing.T) {
+	repos := newSQLiteTestRepos(t, filepath.Join(t.TempDir(), "pets.db"))
+	pet := &entity.Pet{Uuid: uuid.New(), UuidGuardian: uuid.New(), Name: "Rex", TenantID: "shelter", Status: entity.StatusAvailable}
+	_, errData := repos.Pet.SavePet(pet)
+	require.Nil(t, errData)
+
+	chosen := submitApplication(t, repos, pet.Uuid, "shelter")
+	competing := submitApplication(t, repos, pet.Uuid, "shelter")
+	other := submitApplication(t, repos, uuid.New(), "shelter")
+
+	duplicate := *chosen
+	duplicate.ID, duplicate.Uuid = 0, uuid.New()
+	_, errData = repos.Adoption.SubmitApplication(&duplicate)
+	assert.Equal(t, "applicant already has a pending application for this pet", errData["conflict"])
+
+	decision := entity.ApplicationDecision{Status: entity.ApplicationApproved, DecidedBy: "staff", DecidedAt: time.Now()}
+	_, errData = repos.Adoption.ApproveApplication("clinic", chosen.Uuid, decision)
+	assert.NotEmpty(t, errData["not_found"])
+
+	approval, errData := repos.Adoption.ApproveApplication("shelter", chosen.Uuid, decision)
+	require.Nil(t, errData)
+	assert.Equal(t, entity.ApplicationApproved, approval.Application.Status)
+	assert.Equal(t, "staff", approval.Application.DecidedBy)
+	assert.Equal(t, entity.StatusReserved, approval.Pet.Status)
+	require.Len(t, approval.Rejected, 1)
+	assert.Equal(t, competing.Uuid, approval.Rejected[0].Uuid)
+
+	got, _ := repos.Adoption.GetApplication("", competing.Uuid)
+	assert.Equal(t, entity.ApplicationRejected, got.Status)
+	assert.Equal(t, entity.CompetingApplicationReason, got.DecisionReason)
+	got, _ = repos.Adoption.GetApplication("", other.Uuid)
+	assert.Equal(t, entity.ApplicationPending, got.Status)
+	reserved, _ := repos.Pet.GetPet(pet.Uuid.String())
+	assert.Equal(t, entity.StatusReserved, reserved.Status)
+
+	_, errData = repos.Adoption.ApproveApplication("", chosen.Uuid, decision)
+	assert.Equal(t, "adoption application is already approved", errData["failed_precondition"])
+}
+
+func TestAdoptionApplicationRepository_ApproveRollsBackWhenPetUnavailable(t *testing.T) {
+	repos := newSQLiteTestRepos(t, filepath.Join(t.TempDir(), "pets.db"))
+	pet := &entity.Pet{Uuid: uuid.New(), UuidGuardian: uuid.New(), Name: "Rex", Status: entity.StatusReserved}
+	_, errData := repos.Pet.SavePet(pet)
+	require.Nil(t, errData)
+	first, second := submitApplication(t, repos, pet.Uuid, ""), submitApplication(t, repos, pet.Uuid, "")
+
+	_, errData = repos.Adoption.ApproveApplication("", first.Uuid, entity.ApplicationDecision{Status: entity.ApplicationApproved, DecidedAt: time.Now()})
+	assert.Equal(t, "pet is not available for adoption", errData["failed_precondition"])
+
+	for _, id := range []uuid.UUID{first.Uuid, second.Uuid} {
+		got, _ := repos.Adoption.GetApplication("", id)
+		assert.Equal(t, entity.ApplicationPending, got.Status, "the approval must be undone")
+	}
+}
+
+func TestAdoptionApplicationRepository_ApproveOnlyReservesPetsOfTheTenant(t *testing.T) {
+	repos := newSQLiteTestRepos(t, filepath.Join(t.TempDir(), "pets.db"))
+	pet := &entity.Pet{Uuid: uuid.New(), UuidGuardian: uuid.New(), Name: "Rex", TenantID: "clinic", Status: entity.StatusAvailable}
+	_, errData := repos.Pet.SavePet(pet)
+	require.Nil(t, errData)
+	application := submitApplication(t, repos, pet.Uuid, "shelter")
+
+	_, errData = repos.Adoption.ApproveApplication("shelter", application.Uuid, entity.ApplicationDecision{Status: entity.ApplicationApproved, DecidedAt: time.Now()})
+	assert.Equal(t, "pet is not available for adoption", errData["failed_precondition"])
+
+	got, _ := repos.Pet.GetPet(pet.Uuid.String())
+	assert.Equal(t, entity.StatusAvailable, got.Status, "another tenant's pet must not be reserved")
+}
+
+func TestAdoptionApplicationRepository_DecideAndList(t *testing.T) {
+	repos := newSQLiteTestRepos(t, filepath.Join(t.TempDir(), "pets.db"))
+	pet := uuid.New()
+	first, second := submitApplication(t, repos, pet, "shelter"), submitApplication(t, repos, pet, "shelter")
+
+	withdrawn, errData := repos.Adoption.DecideApplication("shelter", first.Uuid, entity.ApplicationDecision{Status: entity.ApplicationWithdrawn, Reason: "moved", DecidedAt: time.Now()})
+	require.Nil(t, errData)
+	assert.Equal(t, entity.ApplicationWithdrawn, withdrawn.Status)
+	require.NotNil(t, withdrawn.DecidedAt)
+	_, errData = repos.Adoption.DecideApplication("shelter", first.Uuid, entity.ApplicationDecision{Status: entity.ApplicationRejected, DecidedAt: time.Now()})
+	assert.NotEmpty(t, errData["failed_precondition"])
+
+	// o guardião que desistiu pode pedir de novo
+	again := *first
+	again.ID, again.Uuid, again.Status = 0, uuid.New(), entity.ApplicationPending
+	_, errData = repos.Adoption.SubmitApplication(&again)
+	require.Nil(t, errData)
+
+	page, errData := repos.Adoption.ListApplicationsByPet("shelter", pet, 0, 2)
+	require.Nil(t, errData)
+	require.Len(t, page, 2)
+	assert.Equal(t, first.Uuid, page[0].Uuid)
+	assert.Equal(t, second.Uuid, page[1].Uuid)
+	page, _ = repos.Adoption.ListApplicationsByPet("shelter", pet, page[1].ID, 2)
+	require.Len(t, page, 1)
+	assert.Equal(t, again.Uuid, page[0].Uuid)
+	page, _ = repos.Adoption.ListApplicationsByPet("clinic", pet, 0, 10)
+	assert.Empty(t, page)
+
+	byApplicant, errData := repos.Adoption.ListApplicationsByApplicant("", first.ApplicantUuid, 0, 10)
+	require.Nil(t, errData)
+	assert.Len(t, byApplicant, 2)
+}
@@ -0,0 +1,56 @@
+package persistence
+
+import (
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/LuizFJP/pet-ms/domain/repository"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+)
+
+type AttachmentRepo struct {
+	db *gorm.DB
+}
+
+func NewAttachmentRepository(db *gorm.DB) *AttachmentRepo {
+	return &AttachmentRepo{db}
+}
+
+var _ repository.AttachmentRepository = &AttachmentRepo{}
+
+func (r *AttachmentRepo) CreateAttachment(attachment *entity.Attachment) (*entity.Attachment, map[string]string) {
+	if err := r.db.Create(attachment).Error; err != nil {
+		return nil, map[string]string{"db_error": err.Error()}
+	}
+	return attachment, nil
+}
+
+func (r *AttachmentRepo) GetAttachment(id uuid.UUID) (*entity.Attachment, map[string]string) {
+	attachment := &entity.Attachment{}
+	err := r.db.Where("uuid = ?", id).First(attachment).Error
+	if gorm.IsRecordNotFoundError(err) {
+		return nil, map[string]string{"not_found": "attachment not found"}
+	}
+	if err != nil {
+		return nil, map[string]string{"db_error": err.Error()}
+	}
+	return attachment, nil
+}
+
+func (r *AttachmentRepo) ListAttachments(petUuid uuid.UUID) ([]*entity.Attachment, map[string]string) {
+	var attachments []*entity.Attachment
+	if err := r.db.Where("pet_uuid = ?", petUuid).Order("id").Find(&attachments).Error; err != nil {
+		return nil, map[string]string{"db_error": err.Error()}
+	}
+	return attachments, nil
+}
+
+func (r *AttachmentRepo) DeleteAttachment(id uuid.UUID) map[string]string {
+	tx := r.db.Where("uuid = ?", id).Delete(&entity.Attachment{})
+	if tx.Error != nil {
+		return map[string]string{"db_error": tx.Error.Error()}
+	}
+	if tx.RowsAffected == 0 {
+		return map[string]string{"not_found": "attachment not found"}
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package persistence
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+)
+
+func TestAttachmentRepository_CRUD(t *testing.T) {
+	db := newTestDB(t)
+	defer db.Close()
+	require.NoError(t, db.AutoMigrate(&entity.Attachment{}).Error)
+	repo := NewAttachmentRepository(db)
+
+	petUuid := uuid.New()
+	id := uuid.New()
+	_, errMap := repo.CreateAttachment(&entity.Attachment{
+		Uuid:        id,
+		PetUuid:     petUuid,
+		Kind:        entity.AttachmentPhoto,
+		FileName:    "rex.jpg",
+		ContentType: "image/jpeg",
+		SizeBytes:   42,
+		StorageKey:  entity.AttachmentStorageKey(petUuid, id),
+	})
+	require.Nil(t, errMap)
+
+	found, errMap := repo.GetAttachment(id)
+	require.Nil(t, errMap)
+	assert.Equal(t, entity.AttachmentStorageKey(petUuid, id), found.StorageKey)
+
+	list, errMap := repo.ListAttachments(petUuid)
+	require.Nil(t, errMap)
+	assert.Len(t, list, 1)
+
+	require.Nil(t, repo.DeleteAttachment(id))
+	assert.NotEmpty(t, repo.DeleteAttachment(id)["not_found"])
+	_, errMap = repo.GetAttachment(id)
+	assert.NotEmpty(t, errMap["not_found"])
+}
+
+func createTestAttachment(t *testing.T, repo *AttachmentRepo, petUuid uuid.UUID) uuid.UUID {
+	t.Helper()
+	id := uuid.New()
+	_, errMap := repo.CreateAttachment(&entity.Attachment{
+		Uuid:        id,
+		PetUuid:     petUuid,
+		Kind:        entity.AttachmentPhoto,
+		FileName:    "rex.jpg",
+		ContentType: "image/jpeg",
+		SizeBytes:   42,
+		StorageKey:  entity.AttachmentStorageKey(petUuid, id),
+	})
+	require.Nil(t, errMap)
+	return id
+}
+
+func TestPetRepository_DeletePet_RemovesAttachmentRows(t *testing.T) {
+	db := newTestDB(t)
+	defer db.Close()
+	repo := NewPetRepository(db)
+	attachments := NewAttachmentRepository(db)
+
+	pet := newIdempotentPet()
+	other := newIdempotentPet()
+	for _, p := range []*entity.Pet{pet, other} {
+		_, errMap := repo.SavePet(p)
+		require.Nil(t, errMap)
+	}
+	deleted := createTestAttachment(t, attachments, pet.Uuid)
+	kept := createTestAttachment(t, attachments, other.Uuid)
+
+	_, errMap := repo.DeletePet(pet.UuidGuardian.String())
+	require.Nil(t, errMap)
+
+	_, errMap = attachments.GetAttachment(deleted)
+	assert.NotEmpty(t, errMap["not_found"], "attachment rows must go with the pet")
+	_, errMap = attachments.GetAttachment(kept)
+	assert.Nil(t, errMap, "attachments of other guardians' pets must stay")
+}
+
+func TestPetRepository_DeletePet_KeepsAttachmentRowsOnRollback(t *testing.T) {
+	db := newOutboxTestDB(t)
+	defer db.Close()
+	attachments := NewAttachmentRepository(db)
+
+	pet := newIdempotentPet()
+	_, errMap := NewPetRepository(db).SavePet(pet)
+	require.Nil(t, errMap)
+	id := createTestAttachment(t, attachments, pet.Uuid)
+
+	failing := func(entity.PetEvent) (string, []byte, error) { return "", nil, errors.New("boom") }
+	_, errMap = NewPetRepository(db, WithOutbox(NewOutboxWriter(failing))).DeletePet(pet.UuidGuardian.String())
+	require.NotNil(t, errMap)
+
+	_, errMap = attachments.GetAttachment(id)
+	assert.Nil(t, errMap, "a failed pet delete must keep the attachment rows")
+}
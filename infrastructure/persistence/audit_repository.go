@@ -0,0 +1,57 @@
+package persistence
+
+import (
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/LuizFJP/pet-ms/domain/repository"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+)
+
+type AuditRepo struct {
+	db *gorm.DB
+}
+
+func NewAuditRepository(db *gorm.DB) *AuditRepo {
+	return &AuditRepo{db}
+}
+
+var _ repository.AuditRepository = &AuditRepo{}
+
+func (r *AuditRepo) AppendAuditEntries(entries []*entity.AuditEntry) map[string]string {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		for _, entry := range entries {
+			if err := tx.Create(entry).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return map[string]string{"db_error": err.Error()}
+	}
+	return nil
+}
+
+func (r *AuditRepo) ListAuditEntriesByPet(tenant string, petUuid uuid.UUID, afterID uint, limit int) ([]*entity.AuditEntry, map[string]string) {
+	return r.list(tenant, r.db.Where("pet_uuid = ?", petUuid), afterID, limit)
+}
+
+// ListAuditEntriesByGuardian inclui as transferências em que o guardião era o anterior.
+func (r *AuditRepo) ListAuditEntriesByGuardian(tenant string, uuidGuardian uuid.UUID, afterID uint, limit int) ([]*entity.AuditEntry, map[string]string) {
+	return r.list(tenant, r.db.Where("uuid_guardian = ? OR previous_guardian = ?", uuidGuardian, uuidGuardian), afterID, limit)
+}
+
+func (r *AuditRepo) list(tenant string, query *gorm.DB, afterID uint, limit int) ([]*entity.AuditEntry, map[string]string) {
+	if tenant != "" {
+		query = query.Where("tenant_id = ?", tenant)
+	}
+	if afterID > 0 {
+		query = query.Where("id > ?", afterID)
+	}
+
+	var entries []*entity.AuditEntry
+	if err := query.Order("id").Limit(limit).Find(&entries).Error; err != nil {
+		return nil, map[string]string{"db_error": err.Error()}
+	}
+	return entries, nil
+}
@@ -0,0 +1,75 @@
+package persistence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+)
+
+func newAuditTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db := newTestDB(t)
+	require.NoError(t, db.AutoMigrate(&entity.AuditEntry{}).Error, "failed to automigrate AuditEntry")
+	return db
+}
+
+func TestAuditRepository_ListByPetAndGuardian(t *testing.T) {
+	db := newAuditTestDB(t)
+	defer db.Close()
+	repo := NewAuditRepository(db)
+
+	pet := uuid.New()
+	oldGuardian := uuid.New()
+	newGuardian := uuid.New()
+	entries := []*entity.AuditEntry{
+		{PetUuid: pet, UuidGuardian: oldGuardian, Action: entity.AuditCreate, OccurredAt: time.Now()},
+		{PetUuid: pet, UuidGuardian: oldGuardian, Action: entity.AuditUpdate, OccurredAt: time.Now()},
+		{PetUuid: pet, UuidGuardian: newGuardian, PreviousGuardian: oldGuardian, Action: entity.AuditTransfer, OccurredAt: time.Now()},
+		{PetUuid: uuid.New(), UuidGuardian: newGuardian, Action: entity.AuditCreate, OccurredAt: time.Now()},
+	}
+	require.Nil(t, repo.AppendAuditEntries(entries))
+
+	byPet, errMap := repo.ListAuditEntriesByPet("", pet, 0, 2)
+	require.Nil(t, errMap)
+	require.Len(t, byPet, 2)
+	assert.Equal(t, entity.AuditCreate, byPet[0].Action)
+
+	byPet, errMap = repo.ListAuditEntriesByPet("", pet, byPet[1].ID, 10)
+	require.Nil(t, errMap)
+	require.Len(t, byPet, 1)
+	assert.Equal(t, entity.AuditTransfer, byPet[0].Action)
+
+	byOld, errMap := repo.ListAuditEntriesByGuardian("", oldGuardian, 0, 10)
+	require.Nil(t, errMap)
+	assert.Len(t, byOld, 3, "previous guardian still sees the transfer")
+
+	byNew, errMap := repo.ListAuditEntriesByGuardian("", newGuardian, 0, 10)
+	require.Nil(t, errMap)
+	assert.Len(t, byNew, 2)
+}
+
+func TestAuditRepository_EntriesAreAppendOnly(t *testing.T) {
+	db := newAuditTestDB(t)
+	defer db.Close()
+	repo := NewAuditRepository(db)
+
+	entry := &entity.AuditEntry{PetUuid: uuid.New(), Action: entity.AuditCreate, Principal: "alice"}
+	require.Nil(t, repo.AppendAuditEntries([]*entity.AuditEntry{entry}))
+
+	err := db.Model(entry).Update("principal", "mallory").Error
+	assert.ErrorIs(t, err, entity.ErrAuditEntryImmutable)
+	err = db.Delete(entry).Error
+	assert.ErrorIs(t, err, entity.ErrAuditEntryImmutable)
+
+	stored, errMap := repo.ListAuditEntriesByPet("", entry.PetUuid, 0, 10)
+	require.Nil(t, errMap)
+	require.Len(t, stored, 1)
+	assert.Equal(t, "alice", stored[0].Principal)
+}
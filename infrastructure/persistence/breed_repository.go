@@ -0,0 +1,69 @@
+package persistence
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/LuizFJP/pet-ms/domain/repository"
+	"github.com/jinzhu/gorm"
+)
+
+// breedSeed é o catálogo inicial de raças, agrupado pelo código da espécie.
+//
+//go:embed seed/breeds.json
+var breedSeed []byte
+
+type BreedRepo struct {
+	db *gorm.DB
+}
+
+func NewBreedRepository(db *gorm.DB) *BreedRepo {
+	return &BreedRepo{db}
+}
+
+var _ repository.BreedRepository = &BreedRepo{}
+
+func (r *BreedRepo) ListBreeds() ([]*entity.Breed, map[string]string) {
+	var breeds []*entity.Breed
+	if err := r.db.Order("specie, name").Find(&breeds).Error; err != nil {
+		return nil, map[string]string{"db_error": err.Error()}
+	}
+	return breeds, nil
+}
+
+// defaultBreeds lê o seed, descartando as espécies que não estão entre as informadas.
+func defaultBreeds(species []entity.Species) ([]entity.Breed, error) {
+	var seed map[string][]struct {
+		Name    string   `json:"name"`
+		Aliases []string `json:"aliases"`
+	}
+	if err := json.Unmarshal(breedSeed, &seed); err != nil {
+		return nil, fmt.Errorf("invalid breed seed: %w", err)
+	}
+
+	var breeds []entity.Breed
+	for _, s := range species {
+		for _, item := range seed[s.Code] {
+			breed := entity.Breed{Specie: s.Value, Name: item.Name}
+			if err := breed.SetAliases(item.Aliases); err != nil {
+				return nil, err
+			}
+			breeds = append(breeds, breed)
+		}
+	}
+	return breeds, nil
+}
+
+// seedBreeds grava as raças padrão que ainda não existem, sem tocar nas já cadastradas.
+func seedBreeds(db *gorm.DB, defaults []entity.Breed) error {
+	for _, breed := range defaults {
+		breed := breed
+		// Dog vale 0, então o filtro por struct ignoraria a espécie
+		if err := db.Where("specie = ? AND name = ?", breed.Specie, breed.Name).FirstOrCreate(&breed).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
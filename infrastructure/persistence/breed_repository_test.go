@@ -0,0 +1,50 @@
+package persistence
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+)
+
+func TestBreedRepository_SeedIsIdempotent(t *testing.T) {
+	db := newTestDB(t)
+	defer db.Close()
+	require.NoError(t, db.AutoMigrate(&entity.Breed{}).Error, "failed to automigrate Breed")
+
+	breeds, err := defaultBreeds(entity.DefaultSpecies())
+	require.NoError(t, err)
+	require.NotEmpty(t, breeds)
+	require.NoError(t, seedBreeds(db, breeds))
+	require.NoError(t, seedBreeds(db, breeds))
+
+	repo := NewBreedRepository(db)
+	list, errMap := repo.ListBreeds()
+	require.Nil(t, errMap)
+	assert.Len(t, list, len(breeds))
+
+	var labrador *entity.Breed
+	for _, breed := range list {
+		if breed.Name == "Labrador Retriever" {
+			labrador = breed
+		}
+	}
+	require.NotNil(t, labrador)
+	assert.Equal(t, entity.Dog, labrador.Specie)
+	assert.Contains(t, labrador.AliasList(), "Lab")
+}
+
+func TestDefaultBreeds_SameNameInDifferentSpecies(t *testing.T) {
+	breeds, err := defaultBreeds(entity.DefaultSpecies())
+	require.NoError(t, err)
+
+	var angora []entity.PetType
+	for _, breed := range breeds {
+		if breed.Name == "Angorá" {
+			angora = append(angora, breed.Specie)
+		}
+	}
+	assert.ElementsMatch(t, []entity.PetType{entity.Cat, entity.Rabbit}, angora)
+}
@@ -2,7 +2,10 @@ package persistence
 
 import (
 	"fmt"
+	"time"
+
 	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/LuizFJP/pet-ms/domain/event"
 	"github.com/LuizFJP/pet-ms/domain/repository"
 	"github.com/jinzhu/gorm"
 	_ "github.com/lib/pq"
@@ -10,8 +13,21 @@ import (
 )
 
 type Repositories struct {
-	Pet repository.PetRepository
-	db  *gorm.DB
+	Pet         repository.PetRepository
+	Idempotency repository.IdempotencyRepository
+	Outbox      repository.OutboxRepository
+	Audit       repository.AuditRepository
+	Species     repository.SpeciesRepository
+	Breed       repository.BreedRepository
+	Vaccination repository.VaccinationRepository
+	Medical     repository.MedicalRecordRepository
+	Attachment  repository.AttachmentRepository
+	Guardian    *GuardianRepo
+	PetGuardian repository.PetGuardianRepository
+	Adoption    repository.AdoptionApplicationRepository
+	db          *gorm.DB
+	petOptions  []RepoOption
+	replicas    *ReplicaSet
 }
 
 func NewPetRepo(Dbdriver, DbUser, DbPassword, DbPort, DbHost, DbName string) (*Repositories, error) {
@@ -22,16 +38,138 @@ func NewPetRepo(Dbdriver, DbUser, DbPassword, DbPort, DbHost, DbName string) (*R
 	}
 	db.LogMode(true)
 
+	return newRepositories(db), nil
+}
+
+func newRepositories(db *gorm.DB) *Repositories {
 	return &Repositories{
-		Pet: NewPetRepository(db),
-		db:  db,
-	}, nil
+		Pet:         NewPetRepository(db),
+		Idempotency: NewIdempotencyRepository(db),
+		Outbox:      NewOutboxRepository(db),
+		Audit:       NewAuditRepository(db),
+		Species:     NewSpeciesRepository(db),
+		Breed:       NewBreedRepository(db),
+		Vaccination: NewVaccinationRepository(db),
+		Medical:     NewMedicalRecordRepository(db),
+		Attachment:  NewAttachmentRepository(db),
+		Guardian:    NewGuardianRepository(db),
+		PetGuardian: NewPetGuardianRepository(db),
+		Adoption:    NewAdoptionApplicationRepository(db),
+		db:          db,
+	}
+}
+
+// EnableOutbox recria os repositórios de escrita para gravarem eventos na outbox.
+func (s *Repositories) EnableOutbox(encode event.Encoder) {
+	w := NewOutboxWriter(encode)
+	s.petOptions = append(s.petOptions, WithOutbox(w))
+	s.Pet = NewPetRepository(s.db, s.petOptions...)
+	s.Adoption = NewAdoptionApplicationRepository(s.db, s.petOptions...)
+}
+
+// EnableReplicas abre as réplicas de leitura e recria o repositório de pets para ler
+// delas. Réplicas que atrasarem mais que maxLag saem de circulação até alcançarem o
+// primário; a saúde é medida a cada interval.
+func (s *Repositories) EnableReplicas(driver string, dsns []string, maxLag, interval time.Duration) error {
+	dbs := make([]*gorm.DB, 0, len(dsns))
+	for _, dsn := range dsns {
+		db, err := gorm.Open(driver, dsn)
+		if err != nil {
+			for _, opened := range dbs {
+				opened.Close()
+			}
+			return fmt.Errorf("open read replica %d: %w", len(dbs)+1, err)
+		}
+		dbs = append(dbs, db)
+	}
+
+	s.replicas = NewReplicaSet(dbs, maxLag, interval, PostgresLag)
+	s.replicas.Start()
+	s.petOptions = append(s.petOptions, WithReplicas(s.replicas))
+	s.Pet = NewPetRepository(s.db, s.petOptions...)
+	return nil
+}
+
+// EnableRowLevelSecurity liga as políticas de tenant na tabela pets e recria os
+// repositórios que gravam pets (pets e pedidos de adoção) para definirem o tenant em
+// cada transação. Só existe no Postgres; deve rodar depois do Automigrate, que cria a
+// coluna tenant_id.
+func (s *Repositories) EnableRowLevelSecurity() error {
+	if s.db.Dialect().GetName() != "postgres" {
+		return fmt.Errorf("row-level security requires postgres, not %s", s.db.Dialect().GetName())
+	}
+	for _, statement := range petsRowSecurity {
+		if err := s.db.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+	s.petOptions = append(s.petOptions, WithRowLevelSecurity())
+	s.Pet = NewPetRepository(s.db, s.petOptions...)
+	s.Adoption = NewAdoptionApplicationRepository(s.db, s.petOptions...)
+	return nil
+}
+
+// EnableGuardianForeignKey passa a exigir, no banco, que o guardião dos pets gravados
+// daqui em diante esteja no cadastro, no mesmo tenant do pet. Deve rodar depois do
+// Automigrate.
+func (s *Repositories) EnableGuardianForeignKey() error {
+	statements, ok := guardianForeignKeys[s.db.Dialect().GetName()]
+	if !ok {
+		return fmt.Errorf("guardian foreign key is not supported on %s", s.db.Dialect().GetName())
+	}
+	for _, statement := range statements {
+		if err := s.db.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Repositories) migratePets() error {
+	if s.db.Dialect().GetName() == "sqlite3" {
+		return migrateSQLite(s.db)
+	}
+	if err := s.db.AutoMigrate(&entity.Pet{}).Error; err != nil {
+		return err
+	}
+	if err := createPetIndexes(s.db); err != nil {
+		return err
+	}
+	return createSearchIndexes(s.db)
 }
 
 func (s *Repositories) Close() error {
+	if s.replicas != nil {
+		s.replicas.Close()
+	}
 	return s.db.Close()
 }
 
+// Automigrate cria as tabelas e os dados iniciais. No SQLite a tabela pets vem das
+// migrações próprias (sqliteMigrations); as demais seguem o AutoMigrate nos dois bancos.
 func (s *Repositories) Automigrate() error {
-	return s.db.AutoMigrate(&entity.Pet{}).Error
+	err := s.db.AutoMigrate(&entity.IdempotencyKey{}, &entity.OutboxMessage{}, &entity.AuditEntry{}, &entity.Species{}, &entity.Breed{},
+		&entity.Vaccination{}, &entity.MedicalRecord{}, &entity.Attachment{}, &entity.Guardian{},
+		&entity.PetGuardian{}, &entity.AdoptionApplication{}).Error
+	if err != nil {
+		return err
+	}
+	if err := migrateIdempotencyTenancy(s.db); err != nil {
+		return err
+	}
+	if err := createAdoptionIndexes(s.db); err != nil {
+		return err
+	}
+	if err := s.migratePets(); err != nil {
+		return err
+	}
+	if err := seedSpecies(s.db, entity.DefaultSpecies()); err != nil {
+		return err
+	}
+
+	breeds, err := defaultBreeds(entity.DefaultSpecies())
+	if err != nil {
+		return err
+	}
+	return seedBreeds(s.db, breeds)
 }
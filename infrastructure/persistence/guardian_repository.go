@@ -0,0 +1,167 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/LuizFJP/pet-ms/domain/repository"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"github.com/lib/pq"
+)
+
+// guardianForeignKey liga pets.uuid_guardian a guardians.uuid dentro do tenant.
+const guardianForeignKey = "fk_pets_guardian"
+
+// guardianTenantIndex é a chave única que a guardianForeignKey referencia no Postgres.
+const guardianTenantIndex = "ux_guardians_tenant_uuid"
+
+type GuardianRepo struct {
+	db *gorm.DB
+}
+
+func NewGuardianRepository(db *gorm.DB) *GuardianRepo {
+	return &GuardianRepo{db}
+}
+
+var (
+	_ repository.GuardianRepository = &GuardianRepo{}
+	_ repository.GuardianDirectory  = &GuardianRepo{}
+)
+
+func (r *GuardianRepo) scope(tenant string) *gorm.DB {
+	if tenant == "" {
+		return r.db
+	}
+	return r.db.Where("tenant_id = ?", tenant)
+}
+
+func (r *GuardianRepo) CreateGuardian(guardian *entity.Guardian) (*entity.Guardian, map[string]string) {
+	if err := r.db.Create(guardian).Error; err != nil {
+		return nil, dbError(err)
+	}
+	return guardian, nil
+}
+
+func (r *GuardianRepo) GetGuardian(tenant string, id uuid.UUID) (*entity.Guardian, map[string]string) {
+	guardian := &entity.Guardian{}
+	err := r.scope(tenant).Where("uuid = ?", id).First(guardian).Error
+	if gorm.IsRecordNotFoundError(err) {
+		return nil, map[string]string{"not_found": "guardian not found"}
+	}
+	if err != nil {
+		return nil, dbError(err)
+	}
+	return guardian, nil
+}
+
+func (r *GuardianRepo) UpdateGuardian(guardian *entity.Guardian) (*entity.Guardian, map[string]string) {
+	tx := r.scope(guardian.TenantID).Model(&entity.Guardian{}).Where("uuid = ?", guardian.Uuid).Updates(map[string]interface{}{
+		"name":                 guardian.Name,
+		"email":                guardian.Email,
+		"phone":                guardian.Phone,
+		"address_street":       guardian.Address.Street,
+		"address_number":       guardian.Address.Number,
+		"address_complement":   guardian.Address.Complement,
+		"address_district":     guardian.Address.District,
+		"address_city":         guardian.Address.City,
+		"address_state":        guardian.Address.State,
+		"address_postal_code":  guardian.Address.PostalCode,
+		"address_country":      guardian.Address.Country,
+		"consent_contact":      guardian.Consent.Contact,
+		"consent_marketing":    guardian.Consent.Marketing,
+		"consent_data_sharing": guardian.Consent.DataSharing,
+		"consent_updated_at":   guardian.ConsentUpdatedAt,
+	})
+	if tx.Error != nil {
+		return nil, dbError(tx.Error)
+	}
+	if tx.RowsAffected == 0 {
+		return nil, map[string]string{"not_found": "guardian not found"}
+	}
+	return r.GetGuardian(guardian.TenantID, guardian.Uuid)
+}
+
+// DeleteGuardian recusa guardiões com pets quando a chave estrangeira está ligada.
+func (r *GuardianRepo) DeleteGuardian(tenant string, id uuid.UUID) map[string]string {
+	tx := r.scope(tenant).Where("uuid = ?", id).Delete(&entity.Guardian{})
+	if tx.Error != nil {
+		if isForeignKeyViolation(tx.Error) {
+			return map[string]string{"failed_precondition": "guardian still has pets"}
+		}
+		return dbError(tx.Error)
+	}
+	if tx.RowsAffected == 0 {
+		return map[string]string{"not_found": "guardian not found"}
+	}
+	return nil
+}
+
+func (r *GuardianRepo) ListGuardians(tenant string, afterID uint, limit int) ([]*entity.Guardian, map[string]string) {
+	query := r.scope(tenant)
+	if afterID > 0 {
+		query = query.Where("id > ?", afterID)
+	}
+
+	var guardians []*entity.Guardian
+	if err := query.Order("id").Limit(limit).Find(&guardians).Error; err != nil {
+		return nil, dbError(err)
+	}
+	return guardians, nil
+}
+
+// GuardianExists faz do cadastro local o GuardianDirectory do modo interno.
+func (r *GuardianRepo) GuardianExists(_ context.Context, tenant string, id uuid.UUID) (bool, error) {
+	var count int
+	if err := r.scope(tenant).Model(&entity.Guardian{}).Where("uuid = ?", id).Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// guardianForeignKeys amarra (pets.tenant_id, pets.uuid_guardian) ao cadastro do mesmo
+// tenant: um pet não aponta para o guardião de outra organização. No Postgres a chave
+// é NOT VALID: vale para as escritas novas sem exigir que os pets antigos, de antes do
+// cadastro, tenham guardião cadastrado. O SQLite não adiciona chaves a tabelas
+// existentes, então os gatilhos fazem o mesmo papel. A chave de antes dos tenants, só
+// no uuid, é trocada. Uma vez criadas, desligar o cadastro não as remove.
+var guardianForeignKeys = map[string][]string{
+	"postgres": {
+		"UPDATE guardians SET tenant_id = '' WHERE tenant_id IS NULL",
+		"CREATE UNIQUE INDEX IF NOT EXISTS " + guardianTenantIndex + " ON guardians (tenant_id, uuid)",
+		`DO $$ BEGIN
+			IF EXISTS (SELECT 1 FROM pg_constraint WHERE conname = '` + guardianForeignKey + `' AND array_length(conkey, 1) = 1) THEN
+				ALTER TABLE pets DROP CONSTRAINT ` + guardianForeignKey + `;
+			END IF;
+			IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = '` + guardianForeignKey + `') THEN
+				ALTER TABLE pets ADD CONSTRAINT ` + guardianForeignKey + ` FOREIGN KEY (tenant_id, uuid_guardian)
+					REFERENCES guardians (tenant_id, uuid) ON DELETE RESTRICT NOT VALID;
+			END IF;
+		END $$`,
+	},
+	"sqlite3": {
+		"UPDATE guardians SET tenant_id = '' WHERE tenant_id IS NULL",
+		`DROP TRIGGER IF EXISTS ` + guardianForeignKey + `_insert`,
+		`CREATE TRIGGER ` + guardianForeignKey + `_insert BEFORE INSERT ON pets
+		WHEN NOT EXISTS (SELECT 1 FROM guardians WHERE uuid = NEW.uuid_guardian AND tenant_id = NEW.tenant_id)
+		BEGIN SELECT RAISE(ABORT, 'FOREIGN KEY constraint failed'); END`,
+		`DROP TRIGGER IF EXISTS ` + guardianForeignKey + `_update`,
+		`CREATE TRIGGER ` + guardianForeignKey + `_update BEFORE UPDATE OF tenant_id, uuid_guardian ON pets
+		WHEN NOT EXISTS (SELECT 1 FROM guardians WHERE uuid = NEW.uuid_guardian AND tenant_id = NEW.tenant_id)
+		BEGIN SELECT RAISE(ABORT, 'FOREIGN KEY constraint failed'); END`,
+		`DROP TRIGGER IF EXISTS ` + guardianForeignKey + `_delete`,
+		`CREATE TRIGGER ` + guardianForeignKey + `_delete BEFORE DELETE ON guardians
+		WHEN EXISTS (SELECT 1 FROM pets WHERE uuid_guardian = OLD.uuid AND tenant_id = OLD.tenant_id)
+		BEGIN SELECT RAISE(ABORT, 'FOREIGN KEY constraint failed'); END`,
+	},
+}
+
+func isForeignKeyViolation(err error) bool {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return pqErr.Code == "23503"
+	}
+	return strings.Contains(err.Error(), "FOREIGN KEY constraint failed")
+}
@@ -0,0 +1,127 @@
+package persistence
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/LuizFJP/pet-ms/domain/repository"
+)
+
+func newTestGuardian(tenant string) *entity.Guardian {
+	return &entity.Guardian{Uuid: uuid.New(), TenantID: tenant, Name: "Ana", Email: "ana@example.com",
+		Address: entity.Address{City: "Campinas", Country: "BR"}, Consent: entity.Consent{Contact: true}}
+}
+
+func TestGuardianRepository_CRUDByTenant(t *testing.T) {
+	repos := newSQLiteTestRepos(t, filepath.Join(t.TempDir(), "pets.db"))
+	repo := repos.Guardian
+
+	ana := newTestGuardian("shelter")
+	_, errData := repo.CreateGuardian(ana)
+	require.Nil(t, errData)
+	_, errData = repo.CreateGuardian(newTestGuardian("clinic"))
+	require.Nil(t, errData)
+
+	got, errData := repo.GetGuardian("shelter", ana.Uuid)
+	require.Nil(t, errData)
+	assert.Equal(t, "Campinas", got.Address.City)
+	assert.True(t, got.Consent.Contact)
+	_, errData = repo.GetGuardian("clinic", ana.Uuid)
+	assert.Equal(t, "guardian not found", errData["not_found"])
+
+	ana.Phone = "11 91234-5678"
+	ana.Consent.Marketing = true
+	updated, errData := repo.UpdateGuardian(ana)
+	require.Nil(t, errData)
+	assert.Equal(t, "11 91234-5678", updated.Phone)
+	assert.True(t, updated.Consent.Marketing)
+
+	list, errData := repo.ListGuardians("shelter", 0, 10)
+	require.Nil(t, errData)
+	require.Len(t, list, 1)
+	all, _ := repo.ListGuardians("", 0, 10)
+	assert.Len(t, all, 2)
+	page, _ := repo.ListGuardians("", all[0].ID, 10)
+	assert.Len(t, page, 1)
+
+	ok, err := repo.GuardianExists(context.Background(), "clinic", ana.Uuid)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	assert.Equal(t, "guardian not found", repo.DeleteGuardian("clinic", ana.Uuid)["not_found"])
+	require.Nil(t, repo.DeleteGuardian("shelter", ana.Uuid))
+	ok, _ = repo.GuardianExists(context.Background(), "", ana.Uuid)
+	assert.False(t, ok)
+}
+
+func TestGuardianForeignKey_SQLite(t *testing.T) {
+	repos := newSQLiteTestRepos(t, filepath.Join(t.TempDir(), "pets.db"))
+
+	// pets de antes da chave continuam válidos
+	legacy := &entity.Pet{Uuid: uuid.New(), UuidGuardian: uuid.New(), Name: "Rex", BirthYear: 2020, Breed: "SRD"}
+	_, errData := repos.Pet.SavePet(legacy)
+	require.Nil(t, errData)
+
+	require.NoError(t, repos.EnableGuardianForeignKey())
+	require.NoError(t, repos.EnableGuardianForeignKey(), "enabling twice is a no-op")
+
+	orphan := &entity.Pet{Uuid: uuid.New(), UuidGuardian: uuid.New(), Name: "Mel", BirthYear: 2020, Breed: "SRD"}
+	_, errData = repos.Pet.SavePet(orphan)
+	assert.Equal(t, "guardian not found", errData["failed_precondition"])
+
+	ana := newTestGuardian("")
+	_, errData = repos.Guardian.CreateGuardian(ana)
+	require.Nil(t, errData)
+	orphan.UuidGuardian = ana.Uuid
+	_, errData = repos.Pet.SavePet(orphan)
+	require.Nil(t, errData)
+
+	_, errData = repos.Pet.TransferPet(orphan.Uuid.String(), uuid.New().String())
+	assert.Equal(t, "guardian not found", errData["failed_precondition"])
+
+	legacy.Name = "Rex II"
+	_, errData = repos.Pet.UpdatePetFields(legacy, []string{"name"})
+	assert.Nil(t, errData, "updates that keep the guardian are not checked")
+
+	// o Update sem máscara não traz o guardião e mantém o gravado
+	stored, errData := repos.Pet.GetPet(orphan.Uuid.String())
+	require.Nil(t, errData)
+	update := &entity.Pet{Uuid: stored.Uuid, NIdentification: stored.NIdentification, Name: "Mel II", BirthYear: 2021, Breed: "SRD"}
+	updated, errData := repos.Pet.UpdatePet(update)
+	require.Nil(t, errData)
+	assert.Equal(t, ana.Uuid, updated.UuidGuardian)
+	_, itemErrs := repos.Pet.UpdatePets([]*entity.Pet{{Uuid: legacy.Uuid, NIdentification: legacy.NIdentification, Name: "Rex III", BirthYear: 2020, Breed: "SRD"}}, true)
+	assert.Nil(t, itemErrs[0])
+
+	assert.Equal(t, "guardian still has pets", repos.Guardian.DeleteGuardian("", ana.Uuid)["failed_precondition"])
+}
+
+func TestGuardianForeignKey_SQLite_MatchesTenant(t *testing.T) {
+	repos := newSQLiteTestRepos(t, filepath.Join(t.TempDir(), "pets.db"))
+	require.NoError(t, repos.EnableGuardianForeignKey())
+	ana := newTestGuardian("shelter")
+	_, errData := repos.Guardian.CreateGuardian(ana)
+	require.Nil(t, errData)
+
+	shelter := repos.Pet.(repository.TenantScoper).ForTenant("shelter")
+	clinic := repos.Pet.(repository.TenantScoper).ForTenant("clinic")
+	_, errData = clinic.SavePet(&entity.Pet{Uuid: uuid.New(), UuidGuardian: ana.Uuid, Name: "Mel", BirthYear: 2020, Breed: "SRD"})
+	assert.Equal(t, "guardian not found", errData["failed_precondition"], "a pet must not point to another tenant's guardian")
+
+	rex := &entity.Pet{Uuid: uuid.New(), UuidGuardian: ana.Uuid, Name: "Rex", BirthYear: 2020, Breed: "SRD"}
+	_, errData = shelter.SavePet(rex)
+	require.Nil(t, errData)
+
+	bob := newTestGuardian("clinic")
+	_, errData = repos.Guardian.CreateGuardian(bob)
+	require.Nil(t, errData)
+	_, errData = shelter.TransferPet(rex.Uuid.String(), bob.Uuid.String())
+	assert.Equal(t, "guardian not found", errData["failed_precondition"])
+	require.Nil(t, repos.Guardian.DeleteGuardian("clinic", bob.Uuid), "pets of another tenant do not hold the guardian")
+}
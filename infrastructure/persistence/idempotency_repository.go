@@ -0,0 +1,41 @@
+package persistence
+
+import (
+	"time"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/LuizFJP/pet-ms/domain/repository"
+	"github.com/jinzhu/gorm"
+)
+
+// IdempotencyRepo consulta e expira as chaves; elas são gravadas com o pet por
+// PetRepo.SavePetWithIdempotencyKey.
+type IdempotencyRepo struct {
+	db *gorm.DB
+}
+
+func NewIdempotencyRepository(db *gorm.DB) *IdempotencyRepo {
+	return &IdempotencyRepo{db}
+}
+
+var _ repository.IdempotencyRepository = &IdempotencyRepo{}
+
+func (r *IdempotencyRepo) GetIdempotencyKey(tenant, key string) (*entity.IdempotencyKey, map[string]string) {
+	record := &entity.IdempotencyKey{}
+	err := r.db.Where("tenant_id = ? AND idempotency_key = ?", tenant, key).First(record).Error
+	if gorm.IsRecordNotFoundError(err) {
+		return nil, map[string]string{"not_found": "idempotency key not found"}
+	}
+	if err != nil {
+		return nil, map[string]string{"db_error": err.Error()}
+	}
+	return record, nil
+}
+
+func (r *IdempotencyRepo) DeleteExpiredIdempotencyKeys(now time.Time) (int64, map[string]string) {
+	tx := r.db.Where("expires_at <= ?", now).Delete(&entity.IdempotencyKey{})
+	if tx.Error != nil {
+		return 0, map[string]string{"db_error": tx.Error.Error()}
+	}
+	return tx.RowsAffected, nil
+}
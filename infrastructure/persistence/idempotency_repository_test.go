@@ -0,0 +1,150 @@
+package persistence
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+)
+
+func newIdempotencyTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db := newTestDB(t)
+	require.NoError(t, db.AutoMigrate(&entity.IdempotencyKey{}).Error, "failed to automigrate IdempotencyKey")
+	return db
+}
+
+func newIdempotentPet() *entity.Pet {
+	return &entity.Pet{
+		Uuid:         uuid.New(),
+		UuidGuardian: uuid.New(),
+		Name:         "Mingau",
+		BirthYear:    2020,
+		Breed:        "SRD",
+		Specie:       entity.Cat,
+	}
+}
+
+func TestPetRepository_SavePetWithIdempotencyKey_Success(t *testing.T) {
+	db := newIdempotencyTestDB(t)
+	defer db.Close()
+	repo := NewIdempotencyRepository(db)
+	pets := NewPetRepository(db)
+
+	pet := newIdempotentPet()
+	key := &entity.IdempotencyKey{Key: "k-1", RequestHash: "h-1", ExpiresAt: time.Now().Add(time.Hour)}
+
+	saved, errMap := pets.SavePetWithIdempotencyKey(pet, key)
+	require.Nil(t, errMap)
+	require.NotNil(t, saved)
+
+	stored, errMap := repo.GetIdempotencyKey("", "k-1")
+	require.Nil(t, errMap)
+	assert.Equal(t, "h-1", stored.RequestHash)
+
+	var replay entity.Pet
+	require.NoError(t, json.Unmarshal([]byte(stored.Response), &replay))
+	assert.Equal(t, pet.Uuid, replay.Uuid)
+	assert.Equal(t, pet.Name, replay.Name)
+}
+
+func TestPetRepository_SavePetWithIdempotencyKey_DuplicateRollsBack(t *testing.T) {
+	db := newIdempotencyTestDB(t)
+	defer db.Close()
+	pets := NewPetRepository(db)
+
+	first := newIdempotentPet()
+	_, errMap := pets.SavePetWithIdempotencyKey(first, &entity.IdempotencyKey{Key: "dup", RequestHash: "h", ExpiresAt: time.Now().Add(time.Hour)})
+	require.Nil(t, errMap)
+
+	second := newIdempotentPet()
+	saved, errMap := pets.SavePetWithIdempotencyKey(second, &entity.IdempotencyKey{Key: "dup", RequestHash: "h", ExpiresAt: time.Now().Add(time.Hour)})
+	require.Nil(t, saved)
+	assert.Contains(t, errMap, "db_error")
+
+	var count int
+	require.NoError(t, db.Model(&entity.Pet{}).Count(&count).Error)
+	assert.Equal(t, 1, count, "the second pet must be rolled back together with the key")
+}
+
+func TestPetRepository_SavePetWithIdempotencyKey_ReplacesExpiredKey(t *testing.T) {
+	db := newIdempotencyTestDB(t)
+	defer db.Close()
+	repo := NewIdempotencyRepository(db)
+	pets := NewPetRepository(db)
+
+	require.NoError(t, db.Create(&entity.IdempotencyKey{Key: "old", RequestHash: "h-old", ExpiresAt: time.Now().Add(-time.Minute)}).Error)
+
+	saved, errMap := pets.SavePetWithIdempotencyKey(newIdempotentPet(), &entity.IdempotencyKey{Key: "old", RequestHash: "h-new", ExpiresAt: time.Now().Add(time.Hour)})
+	require.Nil(t, errMap)
+	require.NotNil(t, saved)
+
+	stored, errMap := repo.GetIdempotencyKey("", "old")
+	require.Nil(t, errMap)
+	assert.Equal(t, "h-new", stored.RequestHash)
+}
+
+func TestIdempotencyRepository_GetIdempotencyKey_NotFound(t *testing.T) {
+	db := newIdempotencyTestDB(t)
+	defer db.Close()
+	repo := NewIdempotencyRepository(db)
+
+	got, errMap := repo.GetIdempotencyKey("", "missing")
+	require.Nil(t, got)
+	assert.Contains(t, errMap, "not_found")
+}
+
+func TestIdempotencyRepository_DeleteExpiredIdempotencyKeys(t *testing.T) {
+	db := newIdempotencyTestDB(t)
+	defer db.Close()
+	repo := NewIdempotencyRepository(db)
+
+	now := time.Now()
+	require.NoError(t, db.Create(&entity.IdempotencyKey{Key: "expired", ExpiresAt: now.Add(-time.Minute)}).Error)
+	require.NoError(t, db.Create(&entity.IdempotencyKey{Key: "alive", ExpiresAt: now.Add(time.Hour)}).Error)
+
+	deleted, errMap := repo.DeleteExpiredIdempotencyKeys(now)
+	require.Nil(t, errMap)
+	assert.Equal(t, int64(1), deleted)
+
+	_, errMap = repo.GetIdempotencyKey("", "alive")
+	assert.Nil(t, errMap)
+}
+
+func TestIdempotencyRepository_KeysAreScopedToTenant(t *testing.T) {
+	db := newIdempotencyTestDB(t)
+	defer db.Close()
+	repo := NewIdempotencyRepository(db)
+	pets := NewPetRepository(db)
+
+	rex := newIdempotentPet()
+	_, errMap := pets.ForTenant("shelter").(*PetRepo).SavePetWithIdempotencyKey(rex, &entity.IdempotencyKey{TenantID: "shelter", Key: "k-1", RequestHash: "h", ExpiresAt: time.Now().Add(time.Hour)})
+	require.Nil(t, errMap)
+	assert.Equal(t, "shelter", rex.TenantID)
+
+	got, errMap := pets.ForTenant("shelter").GetPet(rex.Uuid.String())
+	require.Nil(t, errMap, "the tenant that created the pet must find it")
+	assert.Equal(t, rex.Uuid, got.Uuid)
+
+	// a mesma chave em outro tenant é outra chave
+	_, errMap = repo.GetIdempotencyKey("clinic", "k-1")
+	assert.Contains(t, errMap, "not_found")
+	mel := newIdempotentPet()
+	_, errMap = pets.ForTenant("clinic").(*PetRepo).SavePetWithIdempotencyKey(mel, &entity.IdempotencyKey{TenantID: "clinic", Key: "k-1", RequestHash: "h", ExpiresAt: time.Now().Add(time.Hour)})
+	require.Nil(t, errMap)
+
+	stored, errMap := repo.GetIdempotencyKey("clinic", "k-1")
+	require.Nil(t, errMap)
+	var replay entity.Pet
+	require.NoError(t, json.Unmarshal([]byte(stored.Response), &replay))
+	assert.Equal(t, mel.Uuid, replay.Uuid)
+	_, errMap = pets.ForTenant("clinic").GetPet(rex.Uuid.String())
+	assert.NotNil(t, errMap)
+}
@@ -0,0 +1,65 @@
+package persistence
+
+import (
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/LuizFJP/pet-ms/domain/repository"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+)
+
+type MedicalRecordRepo struct {
+	db *gorm.DB
+}
+
+func NewMedicalRecordRepository(db *gorm.DB) *MedicalRecordRepo {
+	return &MedicalRecordRepo{db}
+}
+
+var _ repository.MedicalRecordRepository = &MedicalRecordRepo{}
+
+func (r *MedicalRecordRepo) CreateMedicalRecord(record *entity.MedicalRecord) (*entity.MedicalRecord, map[string]string) {
+	if err := r.db.Create(record).Error; err != nil {
+		return nil, map[string]string{"db_error": err.Error()}
+	}
+	return record, nil
+}
+
+func (r *MedicalRecordRepo) GetMedicalRecord(id uuid.UUID) (*entity.MedicalRecord, map[string]string) {
+	record := &entity.MedicalRecord{}
+	err := r.db.Where("uuid = ?", id).First(record).Error
+	if gorm.IsRecordNotFoundError(err) {
+		return nil, map[string]string{"not_found": "medical record not found"}
+	}
+	if err != nil {
+		return nil, map[string]string{"db_error": err.Error()}
+	}
+	return record, nil
+}
+
+// UpdateMedicalRecord substitui o conteúdo do registro; o pet não muda.
+func (r *MedicalRecordRepo) UpdateMedicalRecord(record *entity.MedicalRecord) (*entity.MedicalRecord, map[string]string) {
+	tx := r.db.Model(&entity.MedicalRecord{}).Where("uuid = ?", record.Uuid).Updates(map[string]interface{}{
+		"kind":         record.Kind,
+		"title":        record.Title,
+		"description":  record.Description,
+		"veterinarian": record.Veterinarian,
+		"occurred_at":  record.OccurredAt,
+		"follow_up_at": record.FollowUpAt,
+	})
+	if tx.Error != nil {
+		return nil, map[string]string{"db_error": tx.Error.Error()}
+	}
+	if tx.RowsAffected == 0 {
+		return nil, map[string]string{"not_found": "medical record not found"}
+	}
+	return r.GetMedicalRecord(record.Uuid)
+}
+
+// ListMedicalRecords devolve o histórico do pet do mais recente para o mais antigo.
+func (r *MedicalRecordRepo) ListMedicalRecords(petUuid uuid.UUID) ([]*entity.MedicalRecord, map[string]string) {
+	var records []*entity.MedicalRecord
+	if err := r.db.Where("pet_uuid = ?", petUuid).Order("occurred_at DESC, id DESC").Find(&records).Error; err != nil {
+		return nil, map[string]string{"db_error": err.Error()}
+	}
+	return records, nil
+}
@@ -0,0 +1,44 @@
+package persistence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+)
+
+func TestMedicalRecordRepository_CRUD(t *testing.T) {
+	db := newHealthTestDB(t)
+	defer db.Close()
+	repo := NewMedicalRecordRepository(db)
+
+	petUuid := uuid.New()
+	older := &entity.MedicalRecord{Uuid: uuid.New(), PetUuid: petUuid, Kind: entity.MedicalConsultation, Title: "Check-up", OccurredAt: day(2024, time.January, 5)}
+	newer := &entity.MedicalRecord{Uuid: uuid.New(), PetUuid: petUuid, Kind: entity.MedicalSurgery, Title: "Castração", OccurredAt: day(2024, time.March, 2)}
+	for _, record := range []*entity.MedicalRecord{older, newer} {
+		_, errMap := repo.CreateMedicalRecord(record)
+		require.Nil(t, errMap)
+	}
+
+	followUp := day(2024, time.March, 12)
+	newer.FollowUpAt = &followUp
+	newer.Description = "Retorno para retirar os pontos"
+	updated, errMap := repo.UpdateMedicalRecord(newer)
+	require.Nil(t, errMap)
+	require.NotNil(t, updated.FollowUpAt)
+	assert.True(t, updated.FollowUpAt.Equal(followUp))
+	assert.Equal(t, "Retorno para retirar os pontos", updated.Description)
+
+	records, errMap := repo.ListMedicalRecords(petUuid)
+	require.Nil(t, errMap)
+	require.Len(t, records, 2)
+	assert.Equal(t, newer.Uuid, records[0].Uuid, "most recent first")
+
+	_, errMap = repo.GetMedicalRecord(uuid.New())
+	assert.NotEmpty(t, errMap["not_found"])
+}
@@ -0,0 +1,155 @@
+package persistence
+
+import (
+	"errors"
+	"time"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/LuizFJP/pet-ms/domain/event"
+	"github.com/LuizFJP/pet-ms/domain/repository"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+)
+
+// OutboxWriter grava eventos na tabela outbox usando a transação da escrita do pet.
+type OutboxWriter struct {
+	encode event.Encoder
+	now    func() time.Time
+}
+
+func NewOutboxWriter(encode event.Encoder) *OutboxWriter {
+	return &OutboxWriter{encode: encode, now: time.Now}
+}
+
+// RepoOption configura os repositórios que escrevem pets.
+type RepoOption func(*repoOptions)
+
+type repoOptions struct {
+	outbox      *OutboxWriter
+	replicas    *ReplicaSet
+	rowSecurity bool
+}
+
+// WithOutbox faz cada escrita de pet gravar também o evento correspondente na outbox.
+func WithOutbox(w *OutboxWriter) RepoOption {
+	return func(o *repoOptions) {
+		o.outbox = w
+	}
+}
+
+func newRepoOptions(opts []RepoOption) repoOptions {
+	var o repoOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+var errWriteFailed = errors.New("write failed")
+
+// enqueue é um no-op quando a outbox não está habilitada.
+func (w *OutboxWriter) enqueue(tx *gorm.DB, events ...entity.PetEvent) map[string]string {
+	if w == nil {
+		return nil
+	}
+	for _, ev := range events {
+		ev.ID = uuid.New()
+		if ev.OccurredAt.IsZero() {
+			ev.OccurredAt = w.now()
+		}
+		eventType, payload, err := w.encode(ev)
+		if err != nil {
+			return map[string]string{"outbox_error": err.Error()}
+		}
+		message := &entity.OutboxMessage{
+			EventID:     ev.ID,
+			EventType:   eventType,
+			AggregateID: ev.Pet.Uuid,
+			Payload:     payload,
+			CreatedAt:   ev.OccurredAt,
+		}
+		if err := tx.Create(message).Error; err != nil {
+			return dbError(err)
+		}
+	}
+	return nil
+}
+
+func petEvents(eventType entity.PetEventType, pets ...*entity.Pet) []entity.PetEvent {
+	events := make([]entity.PetEvent, 0, len(pets))
+	for _, pet := range pets {
+		events = append(events, entity.PetEvent{Type: eventType, Pet: *pet})
+	}
+	return events
+}
+
+// write roda fn numa transação quando a outbox está habilitada, para que pet e
+// evento sejam gravados juntos; sem outbox mantém o comportamento sem transação.
+func write(db *gorm.DB, outbox *OutboxWriter, fn func(tx *gorm.DB) map[string]string) map[string]string {
+	if outbox == nil {
+		return fn(db)
+	}
+	return transaction(db, fn)
+}
+
+// transaction roda fn numa transação, desfeita quando fn devolve erro.
+func transaction(db *gorm.DB, fn func(tx *gorm.DB) map[string]string) map[string]string {
+	var errData map[string]string
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if errData = fn(tx); errData != nil {
+			return errWriteFailed
+		}
+		return nil
+	})
+	if errData != nil {
+		return errData
+	}
+	if err != nil {
+		return dbError(err)
+	}
+	return nil
+}
+
+type OutboxRepo struct {
+	db *gorm.DB
+}
+
+func NewOutboxRepository(db *gorm.DB) *OutboxRepo {
+	return &OutboxRepo{db}
+}
+
+var _ repository.OutboxRepository = &OutboxRepo{}
+
+// PendingOutboxMessages devolve as mensagens ainda não publicadas na ordem em que foram gravadas.
+func (r *OutboxRepo) PendingOutboxMessages(limit int) ([]*entity.OutboxMessage, map[string]string) {
+	var messages []*entity.OutboxMessage
+	err := r.db.Where("published_at IS NULL").Order("id").Limit(limit).Find(&messages).Error
+	if err != nil {
+		return nil, map[string]string{"db_error": err.Error()}
+	}
+	return messages, nil
+}
+
+func (r *OutboxRepo) MarkOutboxMessagePublished(id uint, at time.Time) map[string]string {
+	tx := r.db.Model(&entity.OutboxMessage{}).Where("id = ?", id).
+		Updates(map[string]interface{}{"published_at": at, "attempts": gorm.Expr("attempts + 1"), "last_error": ""})
+	if tx.Error != nil {
+		return map[string]string{"db_error": tx.Error.Error()}
+	}
+	if tx.RowsAffected == 0 {
+		return map[string]string{"not_found": "outbox message not found"}
+	}
+	return nil
+}
+
+func (r *OutboxRepo) MarkOutboxMessageFailed(id uint, reason string) map[string]string {
+	tx := r.db.Model(&entity.OutboxMessage{}).Where("id = ?", id).
+		Updates(map[string]interface{}{"attempts": gorm.Expr("attempts + 1"), "last_error": reason})
+	if tx.Error != nil {
+		return map[string]string{"db_error": tx.Error.Error()}
+	}
+	if tx.RowsAffected == 0 {
+		return map[string]string{"not_found": "outbox message not found"}
+	}
+	return nil
+}
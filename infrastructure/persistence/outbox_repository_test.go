@@ -0,0 +1,164 @@
+package persistence
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+)
+
+func newOutboxTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db := newTestDB(t)
+	require.NoError(t, db.AutoMigrate(&entity.IdempotencyKey{}, &entity.OutboxMessage{}).Error, "failed to automigrate outbox")
+	return db
+}
+
+func fakeEncoder(ev entity.PetEvent) (string, []byte, error) {
+	return "pet." + ev.Type.String(), []byte(ev.Pet.Uuid.String() + "|" + ev.PreviousGuardian.String()), nil
+}
+
+func outboxMessages(t *testing.T, db *gorm.DB) []*entity.OutboxMessage {
+	t.Helper()
+	var messages []*entity.OutboxMessage
+	require.NoError(t, db.Order("id").Find(&messages).Error)
+	return messages
+}
+
+func TestPetRepository_Outbox_WritesEventPerMutation(t *testing.T) {
+	db := newOutboxTestDB(t)
+	defer db.Close()
+	repo := NewPetRepository(db, WithOutbox(NewOutboxWriter(fakeEncoder)))
+
+	pet := newIdempotentPet()
+	previousGuardian := pet.UuidGuardian
+	_, errMap := repo.SavePet(pet)
+	require.Nil(t, errMap)
+
+	pet.Name = "Mingau II"
+	_, errMap = repo.UpdatePet(pet)
+	require.Nil(t, errMap)
+
+	newGuardian := uuid.New()
+	transferred, errMap := repo.TransferPet(pet.Uuid.String(), newGuardian.String())
+	require.Nil(t, errMap)
+	assert.Equal(t, newGuardian, transferred.UuidGuardian)
+
+	_, errMap = repo.DeletePet(newGuardian.String())
+	require.Nil(t, errMap)
+
+	messages := outboxMessages(t, db)
+	require.Len(t, messages, 4)
+	assert.Equal(t, "pet.created", messages[0].EventType)
+	assert.Equal(t, "pet.updated", messages[1].EventType)
+	assert.Equal(t, "pet.transferred", messages[2].EventType)
+	assert.Equal(t, "pet.deleted", messages[3].EventType)
+	assert.Equal(t, pet.Uuid.String()+"|"+previousGuardian.String(), string(messages[2].Payload))
+	for _, m := range messages {
+		assert.Equal(t, pet.Uuid, m.AggregateID)
+		assert.NotEqual(t, uuid.Nil, m.EventID)
+		assert.Nil(t, m.PublishedAt)
+	}
+}
+
+func TestPetRepository_Outbox_EncoderErrorRollsBackWrite(t *testing.T) {
+	db := newOutboxTestDB(t)
+	defer db.Close()
+	failing := func(entity.PetEvent) (string, []byte, error) { return "", nil, errors.New("boom") }
+	repo := NewPetRepository(db, WithOutbox(NewOutboxWriter(failing)))
+
+	pet := newIdempotentPet()
+	_, errMap := repo.SavePet(pet)
+	require.NotNil(t, errMap)
+	assert.Contains(t, errMap, "outbox_error")
+
+	var count int
+	require.NoError(t, db.Model(&entity.Pet{}).Count(&count).Error)
+	assert.Zero(t, count, "pet must not be saved without its event")
+	assert.Empty(t, outboxMessages(t, db))
+}
+
+func TestPetRepository_Outbox_BatchPerItemSkipsFailedItems(t *testing.T) {
+	db := newOutboxTestDB(t)
+	defer db.Close()
+	repo := NewPetRepository(db, WithOutbox(NewOutboxWriter(fakeEncoder)))
+
+	existing := newIdempotentPet()
+	_, errMap := repo.SavePet(existing)
+	require.Nil(t, errMap)
+
+	missing := newIdempotentPet()
+	_, itemErrs := repo.UpdatePets([]*entity.Pet{existing, missing}, false)
+	assert.Nil(t, itemErrs[0])
+	assert.NotNil(t, itemErrs[1])
+
+	messages := outboxMessages(t, db)
+	require.Len(t, messages, 2)
+	assert.Equal(t, "pet.updated", messages[1].EventType)
+	assert.Equal(t, existing.Uuid, messages[1].AggregateID)
+}
+
+func TestPetRepository_TransferPet_NotFound(t *testing.T) {
+	db := newTestDB(t)
+	defer db.Close()
+	repo := NewPetRepository(db)
+
+	_, errMap := repo.TransferPet(uuid.New().String(), uuid.New().String())
+	require.NotNil(t, errMap)
+	assert.Contains(t, errMap, "not_found")
+}
+
+func TestPetRepository_SavePetWithIdempotencyKey_Outbox_WritesCreatedEvent(t *testing.T) {
+	db := newOutboxTestDB(t)
+	defer db.Close()
+	repo := NewPetRepository(db, WithOutbox(NewOutboxWriter(fakeEncoder)))
+
+	pet := newIdempotentPet()
+	key := &entity.IdempotencyKey{Key: "k-1", RequestHash: "h-1", ExpiresAt: time.Now().Add(time.Hour)}
+	_, errMap := repo.SavePetWithIdempotencyKey(pet, key)
+	require.Nil(t, errMap)
+
+	messages := outboxMessages(t, db)
+	require.Len(t, messages, 1)
+	assert.Equal(t, "pet.created", messages[0].EventType)
+}
+
+func TestOutboxRepository_PendingAndMark(t *testing.T) {
+	db := newOutboxTestDB(t)
+	defer db.Close()
+	petRepo := NewPetRepository(db, WithOutbox(NewOutboxWriter(fakeEncoder)))
+	repo := NewOutboxRepository(db)
+
+	for i := 0; i < 3; i++ {
+		_, errMap := petRepo.SavePet(newIdempotentPet())
+		require.Nil(t, errMap)
+	}
+
+	pending, errMap := repo.PendingOutboxMessages(2)
+	require.Nil(t, errMap)
+	require.Len(t, pending, 2)
+
+	require.Nil(t, repo.MarkOutboxMessageFailed(pending[0].ID, "broker down"))
+	require.Nil(t, repo.MarkOutboxMessagePublished(pending[0].ID, time.Now()))
+	require.Nil(t, repo.MarkOutboxMessagePublished(pending[1].ID, time.Now()))
+
+	pending, errMap = repo.PendingOutboxMessages(10)
+	require.Nil(t, errMap)
+	require.Len(t, pending, 1)
+
+	published := &entity.OutboxMessage{}
+	require.NoError(t, db.First(published, outboxMessages(t, db)[0].ID).Error)
+	assert.Equal(t, 2, published.Attempts)
+	assert.Empty(t, published.LastError)
+	assert.NotNil(t, published.PublishedAt)
+
+	errMap = repo.MarkOutboxMessagePublished(9999, time.Now())
+	assert.Contains(t, errMap, "not_found")
+}
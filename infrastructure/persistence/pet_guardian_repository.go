@@ -0,0 +1,101 @@
+package persistence
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/LuizFJP/pet-ms/domain/repository"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"github.com/lib/pq"
+)
+
+// petGuardianIndex impede dois papéis do mesmo guardião no mesmo pet.
+const petGuardianIndex = "idx_pet_guardian"
+
+type PetGuardianRepo struct {
+	db *gorm.DB
+}
+
+func NewPetGuardianRepository(db *gorm.DB) *PetGuardianRepo {
+	return &PetGuardianRepo{db}
+}
+
+var _ repository.PetGuardianRepository = &PetGuardianRepo{}
+
+func (r *PetGuardianRepo) AddPetGuardian(link *entity.PetGuardian) (*entity.PetGuardian, map[string]string) {
+	if err := r.db.Create(link).Error; err != nil {
+		if isPetGuardianConflict(err) {
+			return nil, map[string]string{"conflict": "guardian already linked to this pet"}
+		}
+		return nil, dbError(err)
+	}
+	return link, nil
+}
+
+func (r *PetGuardianRepo) ListPetGuardians(petUuid uuid.UUID) ([]*entity.PetGuardian, map[string]string) {
+	var links []*entity.PetGuardian
+	if err := r.db.Where("pet_uuid = ?", petUuid).Order("id").Find(&links).Error; err != nil {
+		return nil, dbError(err)
+	}
+	return links, nil
+}
+
+func (r *PetGuardianRepo) RemovePetGuardian(petUuid, guardian uuid.UUID) map[string]string {
+	tx := r.db.Where("pet_uuid = ? AND guardian_uuid = ?", petUuid, guardian).Delete(&entity.PetGuardian{})
+	if tx.Error != nil {
+		return dbError(tx.Error)
+	}
+	if tx.RowsAffected == 0 {
+		return map[string]string{"not_found": "guardian is not linked to this pet"}
+	}
+	return nil
+}
+
+func (r *PetGuardianRepo) PetsOfGuardian(tenant string, guardian uuid.UUID, roles []entity.GuardianRole) ([]uuid.UUID, map[string]string) {
+	query := r.db.Model(&entity.PetGuardian{}).Where("guardian_uuid = ? AND role IN (?)", guardian, roles)
+	if tenant != "" {
+		query = query.Where("tenant_id = ?", tenant)
+	}
+	var links []*entity.PetGuardian
+	if err := query.Select("pet_uuid").Order("pet_uuid").Find(&links).Error; err != nil {
+		return nil, dbError(err)
+	}
+	pets := make([]uuid.UUID, 0, len(links))
+	for _, link := range links {
+		pets = append(pets, link.PetUuid)
+	}
+	return pets, nil
+}
+
+// DeletePetLinks apaga as ligações dos pets removidos.
+func (r *PetGuardianRepo) DeletePetLinks(petUuids []uuid.UUID) map[string]string {
+	if len(petUuids) == 0 {
+		return nil
+	}
+	if err := r.db.Where("pet_uuid IN (?)", petUuids).Delete(&entity.PetGuardian{}).Error; err != nil {
+		return dbError(err)
+	}
+	return nil
+}
+
+// DeleteGuardianLinks apaga as ligações do guardião removido do cadastro.
+func (r *PetGuardianRepo) DeleteGuardianLinks(tenant string, guardian uuid.UUID) map[string]string {
+	query := r.db.Where("guardian_uuid = ?", guardian)
+	if tenant != "" {
+		query = query.Where("tenant_id = ?", tenant)
+	}
+	if err := query.Delete(&entity.PetGuardian{}).Error; err != nil {
+		return dbError(err)
+	}
+	return nil
+}
+
+func isPetGuardianConflict(err error) bool {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return pqErr.Code == "23505" && pqErr.Constraint == petGuardianIndex
+	}
+	return strings.Contains(err.Error(), "UNIQUE constraint failed: pet_guardians.pet_uuid")
+}
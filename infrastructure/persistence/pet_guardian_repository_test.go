@@ -0,0 +1,47 @@
+package persistence
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+)
+
+func TestPetGuardianRepository(t *testing.T) {
+	repo := newSQLiteTestRepos(t, filepath.Join(t.TempDir(), "pets.db")).PetGuardian
+	pet, other, guardian := uuid.New(), uuid.New(), uuid.New()
+
+	_, errData := repo.AddPetGuardian(&entity.PetGuardian{PetUuid: pet, GuardianUuid: guardian, Role: entity.RoleFoster, TenantID: "shelter"})
+	require.Nil(t, errData)
+	_, errData = repo.AddPetGuardian(&entity.PetGuardian{PetUuid: other, GuardianUuid: guardian, Role: entity.RoleEmergencyContact, TenantID: "shelter"})
+	require.Nil(t, errData)
+	_, errData = repo.AddPetGuardian(&entity.PetGuardian{PetUuid: pet, GuardianUuid: guardian, Role: entity.RoleCoOwner})
+	assert.Equal(t, "guardian already linked to this pet", errData["conflict"])
+
+	links, errData := repo.ListPetGuardians(pet)
+	require.Nil(t, errData)
+	require.Len(t, links, 1)
+	assert.Equal(t, entity.RoleFoster, links[0].Role)
+
+	pets, errData := repo.PetsOfGuardian("shelter", guardian, []entity.GuardianRole{entity.RoleFoster})
+	require.Nil(t, errData)
+	assert.Equal(t, []uuid.UUID{pet}, pets)
+	pets, _ = repo.PetsOfGuardian("", guardian, []entity.GuardianRole{entity.RoleFoster, entity.RoleEmergencyContact})
+	assert.Len(t, pets, 2)
+	pets, _ = repo.PetsOfGuardian("clinic", guardian, []entity.GuardianRole{entity.RoleFoster})
+	assert.Empty(t, pets)
+
+	require.Nil(t, repo.DeletePetLinks([]uuid.UUID{pet}))
+	links, _ = repo.ListPetGuardians(pet)
+	assert.Empty(t, links)
+	assert.Equal(t, "guardian is not linked to this pet", repo.RemovePetGuardian(pet, guardian)["not_found"])
+
+	require.Nil(t, repo.DeleteGuardianLinks("clinic", guardian))
+	links, _ = repo.ListPetGuardians(other)
+	assert.Len(t, links, 1, "links of another tenant are kept")
+	require.Nil(t, repo.RemovePetGuardian(other, guardian))
+}
@@ -1,61 +1,345 @@
 package persistence
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
+
 	"github.com/LuizFJP/pet-ms/domain/entity"
 	"github.com/LuizFJP/pet-ms/domain/repository"
+	"github.com/google/uuid"
 	"github.com/jinzhu/gorm"
+	"github.com/lib/pq"
 )
 
 type PetRepo struct {
-	db *gorm.DB
+	db       *gorm.DB
+	outbox   *OutboxWriter
+	replicas *ReplicaSet
+	// fromReplicas marca a visão de ReadReplica; o repositório em si lê do primário.
+	fromReplicas bool
+	// tenant marca a visão de ForTenant; vazio enxerga todos os tenants.
+	tenant      string
+	rowSecurity bool
 }
 
-func NewPetRepository(db *gorm.DB) *PetRepo {
-	return &PetRepo{db}
+func NewPetRepository(db *gorm.DB, opts ...RepoOption) *PetRepo {
+	o := newRepoOptions(opts)
+	return &PetRepo{db: db, outbox: o.outbox, replicas: o.replicas, rowSecurity: o.rowSecurity}
 }
 
 var _ repository.PetRepository = &PetRepo{}
+var _ repository.ReplicaRouter = &PetRepo{}
+var _ repository.TenantScoper = &PetRepo{}
+var _ repository.IdempotentWriter = &PetRepo{}
+
+// ForTenant acrescenta tenant_id = tenant a todas as consultas e grava os pets novos
+// com o tenant.
+func (p *PetRepo) ForTenant(tenant string) repository.PetRepository {
+	view := *p
+	view.tenant = tenant
+	return &view
+}
+
+// scope restringe a consulta ao tenant da visão.
+func (p *PetRepo) scope(db *gorm.DB) *gorm.DB {
+	if p.tenant == "" {
+		return db
+	}
+	return db.Where("tenant_id = ?", p.tenant)
+}
+
+// stamp passa os pets novos para o tenant da visão.
+func (p *PetRepo) stamp(pets ...*entity.Pet) {
+	if p.tenant == "" {
+		return
+	}
+	for _, pet := range pets {
+		pet.TenantID = p.tenant
+	}
+}
+
+// ReadReplica lê das réplicas quando a última escrita da sessão já saiu da janela em
+// que elas podem não tê-la recebido.
+func (p *PetRepo) ReadReplica(lastWrite time.Time) repository.PetRepository {
+	if p.replicas == nil || time.Since(lastWrite) < p.replicas.staleWindow() {
+		return p
+	}
+	view := *p
+	view.fromReplicas = true
+	return &view
+}
+
+// read roda a consulta numa réplica e, se ela falhar, tira a réplica de circulação e
+// repete no primário. "Não encontrado" é resposta, não falha.
+func (p *PetRepo) read(query func(db *gorm.DB) error) error {
+	if p.fromReplicas {
+		if r := p.replicas.pick(); r != nil {
+			err := p.withTenant(r.db.Debug(), query)
+			if err == nil || gorm.IsRecordNotFoundError(err) {
+				return err
+			}
+			r.setHealthy(false, "query failed: "+err.Error())
+		}
+	}
+	return p.withTenant(p.db.Debug(), query)
+}
+
+// withTenant roda query restrita ao tenant da visão. Com row-level security a consulta
+// vai numa transação com pet_ms.tenant_id definido, que é o que as políticas leem.
+func (p *PetRepo) withTenant(db *gorm.DB, query func(db *gorm.DB) error) error {
+	if !p.rowSecurity || p.tenant == "" {
+		return query(p.scope(db))
+	}
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := setTenant(tx, p.tenant); err != nil {
+			return err
+		}
+		return query(p.scope(tx))
+	})
+}
+
+// write é o write da outbox com o escopo do tenant. Com row-level security toda
+// escrita da visão roda numa transação, mesmo sem outbox.
+func (p *PetRepo) write(fn func(tx *gorm.DB) map[string]string) map[string]string {
+	if !p.rowSecurity || p.tenant == "" {
+		return write(p.db.Debug(), p.outbox, func(tx *gorm.DB) map[string]string {
+			return fn(p.scope(tx))
+		})
+	}
+	return transaction(p.db.Debug(), func(tx *gorm.DB) map[string]string {
+		if err := setTenant(tx, p.tenant); err != nil {
+			return dbError(err)
+		}
+		return fn(p.scope(tx))
+	})
+}
+
+// writeAll é o write que sempre abre uma transação, para remoções que também apagam
+// linhas de outras tabelas.
+func (p *PetRepo) writeAll(fn func(tx *gorm.DB) map[string]string) map[string]string {
+	if p.rowSecurity && p.tenant != "" {
+		return p.write(fn)
+	}
+	return transaction(p.db.Debug(), func(tx *gorm.DB) map[string]string {
+		return fn(p.scope(tx))
+	})
+}
 
 func (p *PetRepo) SavePet(pet *entity.Pet) (*entity.Pet, map[string]string) {
-	dbErr := map[string]string{}
-	err := p.db.Debug().Create(pet).Error
+	p.stamp(pet)
+	var saved *entity.Pet
+	errData := p.write(func(tx *gorm.DB) map[string]string {
+		var errData map[string]string
+		if saved, errData = savePet(tx, pet); errData != nil {
+			return errData
+		}
+		return p.outbox.enqueue(tx, petEvents(entity.PetCreated, saved)...)
+	})
+	if errData != nil {
+		return nil, errData
+	}
+	return saved, nil
+}
+
+// SavePetWithIdempotencyKey grava o pet e a chave na mesma transação, com o tenant, a
+// outbox e a row-level security de SavePet. A chave expirada é apagada antes; se ela
+// ainda estiver ativa o insert dela falha, nada é persistido e o chamador deve consultar
+// o registro existente para reaproveitar a resposta original.
+func (p *PetRepo) SavePetWithIdempotencyKey(pet *entity.Pet, key *entity.IdempotencyKey) (*entity.Pet, map[string]string) {
+	p.stamp(pet)
+	errData := transaction(p.db.Debug(), func(tx *gorm.DB) map[string]string {
+		if p.rowSecurity && p.tenant != "" {
+			if err := setTenant(tx, p.tenant); err != nil {
+				return dbError(err)
+			}
+		}
+		if err := tx.Where("tenant_id = ? AND idempotency_key = ? AND expires_at <= ?", key.TenantID, key.Key, time.Now()).
+			Delete(&entity.IdempotencyKey{}).Error; err != nil {
+			return dbError(err)
+		}
+		if _, errData := savePet(tx, pet); errData != nil {
+			return errData
+		}
+		if errData := p.outbox.enqueue(tx, petEvents(entity.PetCreated, pet)...); errData != nil {
+			return errData
+		}
+
+		response, err := json.Marshal(pet)
+		if err != nil {
+			return map[string]string{"message": err.Error()}
+		}
+		key.Response = string(response)
+		if err := tx.Create(key).Error; err != nil {
+			return dbError(err)
+		}
+		return nil
+	})
+	if errData != nil {
+		return nil, errData
+	}
+	return pet, nil
+}
+
+func savePet(db *gorm.DB, pet *entity.Pet) (*entity.Pet, map[string]string) {
+	err := db.Create(pet).Error
 	if err != nil {
-		dbErr["db_error"] = err.Error()
-		return nil, dbErr
+		return nil, writeError(err)
+	}
+	return pet, nil
+}
+
+// microchipIndex era o índice único global de microchip, de antes dos tenants; hoje
+// o chip é único por tenant (tenantMicrochipIndex). Os dois são parciais para que os
+// pets sem chip (coluna vazia) não colidam entre si.
+const microchipIndex = "uix_pets_microchip_number"
+
+// petUuidIndex faz o papel da chave primária: a tag primaryKey do Pet não é lida pelo
+// gorm v1, então a tabela foi criada sem ela.
+const petUuidIndex = "uix_pets_uuid"
+
+func createPetIndexes(db *gorm.DB) error {
+	if err := db.Exec("CREATE UNIQUE INDEX IF NOT EXISTS " + petUuidIndex + " ON pets (uuid)").Error; err != nil {
+		return err
+	}
+	if err := migratePetTenancy(db); err != nil {
+		return err
+	}
+	return migratePetStatus(db)
+}
+
+// writeError traduz a violação do índice de microchip em conflict; o resto segue
+// pelo dbError.
+func writeError(err error) map[string]string {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" && pqErr.Constraint == tenantMicrochipIndex ||
+		strings.Contains(err.Error(), "UNIQUE constraint failed: pets.tenant_id, pets.microchip_number") {
+		return map[string]string{"conflict": "microchip already registered to another pet"}
+	}
+	if isForeignKeyViolation(err) {
+		return map[string]string{"failed_precondition": "guardian not found"}
+	}
+	return dbError(err)
+}
+
+// GetPetByMicrochip busca o pet pelo número do chip já normalizado.
+func (p *PetRepo) GetPetByMicrochip(number string) (*entity.Pet, map[string]string) {
+	pet := &entity.Pet{}
+	err := p.read(func(db *gorm.DB) error {
+		return db.Where("microchip_number = ?", number).First(pet).Error
+	})
+	if gorm.IsRecordNotFoundError(err) {
+		return nil, map[string]string{"not_found": "no pet with this microchip"}
+	}
+	if err != nil {
+		return nil, dbError(err)
 	}
 	return pet, nil
 }
 
 func (p *PetRepo) GetPet(uuid string) (*entity.Pet, map[string]string) {
 	pet := &entity.Pet{}
-	dbErr := map[string]string{}
-	err := p.db.Debug().Where("uuid = ?", uuid).First(pet).Error
+	err := p.read(func(db *gorm.DB) error {
+		return db.Where("uuid = ?", uuid).First(pet).Error
+	})
 	if err != nil {
-		dbErr["db_error"] = err.Error()
-		return nil, dbErr
+		return nil, dbError(err)
 	}
 	return pet, nil
 }
 
 func (p *PetRepo) UpdatePet(pet *entity.Pet) (*entity.Pet, map[string]string) {
+	var updated *entity.Pet
+	errData := p.write(func(tx *gorm.DB) map[string]string {
+		var errData map[string]string
+		if updated, errData = updatePet(tx, pet); errData != nil {
+			return errData
+		}
+		return p.outbox.enqueue(tx, petEvents(entity.PetUpdated, updated)...)
+	})
+	if errData != nil {
+		return nil, errData
+	}
+	return updated, nil
+}
+
+// UpdatePetFields é o Update mascarado: colunas fora de fields não são tocadas.
+func (p *PetRepo) UpdatePetFields(pet *entity.Pet, fields []string) (*entity.Pet, map[string]string) {
+	all := petColumns(pet)
+	columns := make(map[string]interface{}, len(fields))
+	for _, field := range fields {
+		value, ok := all[field]
+		if !ok {
+			return nil, map[string]string{"invalid_argument": fmt.Sprintf("unknown field %q", field)}
+		}
+		columns[field] = value
+	}
+	if len(columns) == 0 {
+		return p.GetPet(pet.Uuid.String())
+	}
+
+	var updated *entity.Pet
+	errData := p.write(func(tx *gorm.DB) map[string]string {
+		var errData map[string]string
+		if updated, errData = updatePetColumns(tx, pet.Uuid, columns); errData != nil {
+			return errData
+		}
+		return p.outbox.enqueue(tx, petEvents(entity.PetUpdated, updated)...)
+	})
+	if errData != nil {
+		return nil, errData
+	}
+	return updated, nil
+}
+
+// petColumns mapeia os campos atualizáveis do pet, pelo nome json, para as colunas.
+func petColumns(pet *entity.Pet) map[string]interface{} {
+	return map[string]interface{}{
+		"name":                pet.Name,
+		"birth_year":          pet.BirthYear,
+		"birth_date":          pet.BirthDate,
+		"birth_date_accuracy": pet.BirthDateAccuracy,
+		"breed":               pet.Breed,
+		"specie":              pet.Specie,
+		"sex":                 pet.Sex,
+		"neutered":            pet.Neutered,
+		"color":               pet.Color,
+		"markings":            pet.Markings,
+		"weight_history":      pet.WeightHistory,
+		"microchip_number":    pet.MicrochipNumber,
+		"photos":              pet.Photos,
+		"notes":               pet.Notes,
+	}
+}
+
+// updatePet substitui os campos básicos; os de perfil só mudam por UpdatePetFields.
+func updatePet(db *gorm.DB, pet *entity.Pet) (*entity.Pet, map[string]string) {
+	all := petColumns(pet)
+	columns := map[string]interface{}{
+		"n_identification": pet.NIdentification,
+	}
+	// o Update do cliente não traz o guardião, que só muda por transferência
+	if pet.UuidGuardian != uuid.Nil {
+		columns["uuid_guardian"] = pet.UuidGuardian
+	}
+	for _, field := range entity.CorePetFields {
+		columns[field] = all[field]
+	}
+	return updatePetColumns(db, pet.Uuid, columns)
+}
+
+func updatePetColumns(db *gorm.DB, petUuid uuid.UUID, columns map[string]interface{}) (*entity.Pet, map[string]string) {
 	dbErr := map[string]string{}
 
-	tx := p.db.Debug().
+	tx := db.
 		Model(&entity.Pet{}).
-		Where("uuid = ?", pet.Uuid).
-		Updates(map[string]interface{}{
-			"n_identification": pet.NIdentification,
-			"uuid_guardian":    pet.UuidGuardian,
-			"name":             pet.Name,
-			"birth_year":       pet.BirthYear,
-			"breed":            pet.Breed,
-			"specie":           pet.Specie,
-		})
+		Where("uuid = ?", petUuid).
+		Updates(columns)
 
 	if tx.Error != nil {
-		dbErr["db_error"] = tx.Error.Error()
-		return nil, dbErr
+		return nil, writeError(tx.Error)
 	}
 	if tx.RowsAffected == 0 {
 		dbErr["not_found"] = "pet not found"
@@ -63,27 +347,255 @@ func (p *PetRepo) UpdatePet(pet *entity.Pet) (*entity.Pet, map[string]string) {
 	}
 
 	updated := &entity.Pet{}
-	if err := p.db.Where("uuid = ?", pet.Uuid).First(updated).Error; err != nil {
-		dbErr["db_error"] = err.Error()
-		return nil, dbErr
+	if err := db.Where("uuid = ?", petUuid).First(updated).Error; err != nil {
+		return nil, dbError(err)
 	}
 	return updated, nil
 }
+
+// DeletePet apaga os pets do guardião e, na mesma transação, os metadados dos anexos.
 func (p *PetRepo) DeletePet(uuidGuardian string) (map[string]string, map[string]string) {
-	dbErr := map[string]string{}
-	tx := p.db.Debug().
-		Where("uuid_guardian = ?", uuidGuardian).
-		Delete(&entity.Pet{})
+	var deleted int64
+	errData := p.writeAll(func(tx *gorm.DB) map[string]string {
+		// com outbox os pets são lidos antes para que cada remoção gere seu evento
+		var pets []*entity.Pet
+		if p.outbox != nil {
+			if err := tx.Where("uuid_guardian = ?", uuidGuardian).Find(&pets).Error; err != nil {
+				return dbError(err)
+			}
+		}
 
-	if tx.Error != nil {
-		dbErr["db_error"] = tx.Error.Error()
-		return nil, dbErr
-	}
-	if tx.RowsAffected == 0 {
-		return nil, map[string]string{"not_found": "nenhum pet encontrado para esse guardião"}
+		// os metadados dos anexos saem junto com os pets; os blobs são removidos depois
+		owned := tx.Model(&entity.Pet{}).Select("uuid").Where("uuid_guardian = ?", uuidGuardian).QueryExpr()
+		if err := tx.New().Where("pet_uuid IN (?)", owned).Delete(&entity.Attachment{}).Error; err != nil {
+			return dbError(err)
+		}
+
+		res := tx.Where("uuid_guardian = ?", uuidGuardian).Delete(&entity.Pet{})
+		if res.Error != nil {
+			return dbError(res.Error)
+		}
+		if res.RowsAffected == 0 {
+			return map[string]string{"not_found": "nenhum pet encontrado para esse guardião"}
+		}
+		deleted = res.RowsAffected
+		return p.outbox.enqueue(tx, petEvents(entity.PetDeleted, pets...)...)
+	})
+	if errData != nil {
+		return nil, errData
 	}
 
 	return map[string]string{
-		"message": fmt.Sprintf("%d pet(s) deletados!", tx.RowsAffected),
+		"message": fmt.Sprintf("%d pet(s) deletados!", deleted),
 	}, nil
 }
+
+// TransferPet troca o guardião do pet; o evento carrega o guardião anterior.
+func (p *PetRepo) TransferPet(petUuid string, uuidGuardian string) (*entity.Pet, map[string]string) {
+	var transferred *entity.Pet
+	errData := p.write(func(tx *gorm.DB) map[string]string {
+		current := &entity.Pet{}
+		err := tx.Where("uuid = ?", petUuid).First(current).Error
+		if gorm.IsRecordNotFoundError(err) {
+			return map[string]string{"not_found": "pet not found"}
+		}
+		if err != nil {
+			return dbError(err)
+		}
+
+		res := tx.Model(&entity.Pet{}).Where("uuid = ?", petUuid).Update("uuid_guardian", uuidGuardian)
+		if res.Error != nil {
+			return writeError(res.Error)
+		}
+
+		transferred = &entity.Pet{}
+		if err := tx.Where("uuid = ?", petUuid).First(transferred).Error; err != nil {
+			return dbError(err)
+		}
+		return p.outbox.enqueue(tx, entity.PetEvent{
+			Type:             entity.PetTransferred,
+			Pet:              *transferred,
+			PreviousGuardian: current.UuidGuardian,
+		})
+	})
+	if errData != nil {
+		return nil, errData
+	}
+	return transferred, nil
+}
+
+var errBatchAborted = errors.New("batch aborted")
+
+func (p *PetRepo) SavePets(pets []*entity.Pet, allOrNothing bool) ([]*entity.Pet, []map[string]string) {
+	p.stamp(pets...)
+	return p.runBatch(pets, allOrNothing, entity.PetCreated, savePet)
+}
+
+func (p *PetRepo) GetPets(uuids []string) ([]*entity.Pet, map[string]string) {
+	var pets []*entity.Pet
+	err := p.read(func(db *gorm.DB) error {
+		pets = nil
+		return db.Where("uuid IN (?)", uuids).Find(&pets).Error
+	})
+	if err != nil {
+		return nil, dbError(err)
+	}
+	return pets, nil
+}
+
+func (p *PetRepo) UpdatePets(pets []*entity.Pet, allOrNothing bool) ([]*entity.Pet, []map[string]string) {
+	return p.runBatch(pets, allOrNothing, entity.PetUpdated, updatePet)
+}
+
+// runBatch aplica op a cada pet numa única transação. Em allOrNothing o primeiro erro
+// desfaz tudo; caso contrário cada item roda num savepoint e só o item com erro é descartado.
+func (p *PetRepo) runBatch(pets []*entity.Pet, allOrNothing bool, eventType entity.PetEventType, op func(*gorm.DB, *entity.Pet) (*entity.Pet, map[string]string)) ([]*entity.Pet, []map[string]string) {
+	results := make([]*entity.Pet, len(pets))
+	itemErrs := make([]map[string]string, len(pets))
+
+	err := p.db.Debug().Transaction(func(tx *gorm.DB) error {
+		if p.rowSecurity && p.tenant != "" {
+			if err := setTenant(tx, p.tenant); err != nil {
+				return err
+			}
+		}
+		for i, pet := range pets {
+			if !allOrNothing {
+				if err := tx.Exec("SAVEPOINT batch_item").Error; err != nil {
+					return err
+				}
+			}
+
+			res, errData := op(p.scope(tx), pet)
+			if errData == nil {
+				errData = p.outbox.enqueue(tx, petEvents(eventType, res)...)
+			}
+			if errData != nil {
+				itemErrs[i] = errData
+				if allOrNothing {
+					return errBatchAborted
+				}
+				if err := tx.Exec("ROLLBACK TO SAVEPOINT batch_item").Error; err != nil {
+					return err
+				}
+				continue
+			}
+
+			if !allOrNothing {
+				if err := tx.Exec("RELEASE SAVEPOINT batch_item").Error; err != nil {
+					return err
+				}
+			}
+			results[i] = res
+		}
+		return nil
+	})
+
+	if err != nil {
+		for i := range pets {
+			results[i] = nil
+			if itemErrs[i] != nil {
+				continue
+			}
+			if errors.Is(err, errBatchAborted) {
+				itemErrs[i] = map[string]string{"aborted": "batch rolled back"}
+			} else {
+				itemErrs[i] = dbError(err)
+			}
+		}
+	}
+	return results, itemErrs
+}
+
+// InsertPets grava todos os pets com um único INSERT de várias linhas.
+func (p *PetRepo) InsertPets(pets []*entity.Pet) map[string]string {
+	if len(pets) == 0 {
+		return nil
+	}
+	p.stamp(pets...)
+
+	scope := p.db.NewScope(pets[0])
+	var columns []string
+	var fieldNames []string
+	for _, field := range scope.Fields() {
+		// colunas com default (ex.: AUTO_INCREMENT) ficam por conta do banco, como no Create
+		if field.IsIgnored || !field.IsNormal || field.HasDefaultValue {
+			continue
+		}
+		columns = append(columns, scope.Quote(field.DBName))
+		fieldNames = append(fieldNames, field.Name)
+	}
+
+	placeholders := "(" + strings.TrimSuffix(strings.Repeat("?,", len(columns)), ",") + ")"
+	rows := make([]string, 0, len(pets))
+	values := make([]interface{}, 0, len(pets)*len(columns))
+	for _, pet := range pets {
+		rowScope := p.db.NewScope(pet)
+		for _, name := range fieldNames {
+			field, _ := rowScope.FieldByName(name)
+			values = append(values, field.Field.Interface())
+		}
+		rows = append(rows, placeholders)
+	}
+
+	sql := fmt.Sprintf("INSERT INTO %s (%s) VALUES %s",
+		scope.QuotedTableName(), strings.Join(columns, ","), strings.Join(rows, ","))
+	return p.write(func(tx *gorm.DB) map[string]string {
+		if err := tx.Exec(sql, values...).Error; err != nil {
+			return writeError(err)
+		}
+		return p.outbox.enqueue(tx, petEvents(entity.PetCreated, pets...)...)
+	})
+}
+
+// ListPets pagina por uuid (keyset): a próxima página começa após o último uuid devolvido.
+func (p *PetRepo) ListPets(filter entity.PetFilter, afterUuid string, limit int) ([]*entity.Pet, map[string]string) {
+	var pets []*entity.Pet
+	err := p.read(func(db *gorm.DB) error {
+		query := applyPetFilter(db.Model(&entity.Pet{}), filter)
+		if afterUuid != "" {
+			query = query.Where("uuid > ?", afterUuid)
+		}
+		pets = nil
+		return query.Order("uuid").Limit(limit).Find(&pets).Error
+	})
+	if err != nil {
+		return nil, dbError(err)
+	}
+	return pets, nil
+}
+
+func applyGuardianFilter(query *gorm.DB, filter entity.PetFilter) *gorm.DB {
+	switch owner := filter.IncludesOwner(); {
+	case owner && len(filter.LinkedPets) > 0:
+		return query.Where("uuid_guardian = ? OR uuid IN (?)", filter.UuidGuardian, filter.LinkedPets)
+	case owner:
+		return query.Where("uuid_guardian = ?", filter.UuidGuardian)
+	case len(filter.LinkedPets) > 0:
+		return query.Where("uuid IN (?)", filter.LinkedPets)
+	default:
+		return query.Where("1 = 0")
+	}
+}
+
+func applyPetFilter(query *gorm.DB, filter entity.PetFilter) *gorm.DB {
+	if filter.UuidGuardian != uuid.Nil {
+		query = applyGuardianFilter(query, filter)
+	}
+	if len(filter.Species) > 0 {
+		query = query.Where("specie IN (?)", filter.Species)
+	}
+	if len(filter.Statuses) > 0 {
+		query = query.Where("status IN (?)", filter.StoredStatuses())
+	}
+	if filter.Breed != "" {
+		query = query.Where("breed = ?", filter.Breed)
+	}
+	if filter.BirthYearFrom != 0 {
+		query = query.Where("birth_year >= ?", filter.BirthYearFrom)
+	}
+	if filter.BirthYearTo != 0 {
+		query = query.Where("birth_year <= ?", filter.BirthYearTo)
+	}
+	return query
+}
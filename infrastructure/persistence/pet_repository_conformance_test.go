@@ -0,0 +1,22 @@
+package persistence
+
+import (
+	"testing"
+
+	"github.com/LuizFJP/pet-ms/domain/repository"
+	"github.com/LuizFJP/pet-ms/domain/repository/repositorytest"
+)
+
+func TestPetRepository_Conformance(t *testing.T) {
+	repositorytest.TestPetRepository(t, func(t *testing.T) repository.PetRepository {
+		db := newTestDB(t)
+		t.Cleanup(func() { db.Close() })
+		return NewPetRepository(db)
+	})
+}
+
+func TestPetRepository_Conformance_Postgres(t *testing.T) {
+	repositorytest.TestPetRepository(t, func(t *testing.T) repository.PetRepository {
+		return NewPetRepository(newPostgresTestDB(t))
+	})
+}
@@ -3,7 +3,9 @@ package persistence
 import (
 	"fmt"
 	"github.com/jinzhu/gorm"
+	"os"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	_ "github.com/jinzhu/gorm/dialects/sqlite"
@@ -19,8 +21,31 @@ func newTestDB(t *testing.T) *gorm.DB {
 	require.NoError(t, err, "failed to open sqlite in-memory for tests")
 
 	db.LogMode(false)
+	// sqlite em memória cria um banco novo por conexão; transações precisam da mesma.
+	db.DB().SetMaxOpenConns(1)
 
-	require.NoError(t, db.AutoMigrate(&entity.Pet{}).Error, "failed to automigrate Pet")
+	require.NoError(t, db.AutoMigrate(&entity.Pet{}, &entity.Attachment{}).Error, "failed to automigrate Pet")
+	require.NoError(t, createPetIndexes(db), "failed to create pet indexes")
+	return db
+}
+
+// newPostgresTestDB usa o banco de POSTGRES_TEST_DSN, que tem as tabelas pets e attachments esvaziadas.
+// Sem a variável o teste é pulado.
+func newPostgresTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	dsn := os.Getenv("POSTGRES_TEST_DSN")
+	if dsn == "" {
+		t.Skip("POSTGRES_TEST_DSN not set")
+	}
+	db, err := gorm.Open("postgres", dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	require.NoError(t, db.AutoMigrate(&entity.Pet{}, &entity.Attachment{}).Error)
+	require.NoError(t, createPetIndexes(db))
+	require.NoError(t, createSearchIndexes(db))
+	require.NoError(t, db.Exec("DELETE FROM attachments").Error)
+	require.NoError(t, db.Exec("DELETE FROM pets").Error)
 	return db
 }
 
@@ -108,6 +133,82 @@ func TestPetRepository_UpdatePet_Success(t *testing.T) {
 	assert.Equal(t, "Beagle Tricolor", updated.Breed)
 }
 
+func TestPetRepository_BirthDateRoundTrip(t *testing.T) {
+	db := newTestDB(t)
+	defer db.Close()
+	repo := NewPetRepository(db)
+
+	born := time.Date(2020, time.March, 1, 0, 0, 0, 0, time.UTC)
+	pet := &entity.Pet{
+		Uuid:              uuid.New(),
+		UuidGuardian:      uuid.New(),
+		Name:              "Luna",
+		BirthYear:         2020,
+		BirthDate:         &born,
+		BirthDateAccuracy: entity.BirthDateMonth,
+		Breed:             "SRD",
+	}
+	_, errMap := repo.SavePet(pet)
+	require.Nil(t, errMap)
+
+	got, errMap := repo.GetPet(pet.Uuid.String())
+	require.Nil(t, errMap)
+	require.NotNil(t, got.BirthDate)
+	assert.True(t, born.Equal(*got.BirthDate), "expected %v, got %v", born, got.BirthDate)
+	assert.Equal(t, entity.BirthDateMonth, got.BirthDateAccuracy)
+
+	got.BirthDate = nil
+	got.BirthDateAccuracy = ""
+	updated, errMap := repo.UpdatePet(got)
+	require.Nil(t, errMap)
+	assert.Nil(t, updated.BirthDate, "clearing the birth date must be persisted")
+}
+
+func TestPetRepository_UpdatePetFields_OnlyTouchesMaskedColumns(t *testing.T) {
+	db := newTestDB(t)
+	defer db.Close()
+	repo := NewPetRepository(db)
+
+	neutered := true
+	pet := &entity.Pet{
+		Uuid:          uuid.New(),
+		UuidGuardian:  uuid.New(),
+		Name:          "Luna",
+		BirthYear:     2020,
+		Breed:         "SRD",
+		Color:         "Preto",
+		Neutered:      &neutered,
+		WeightHistory: entity.WeightHistory{{Grams: 4000, MeasuredAt: time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC)}},
+		Photos:        entity.StringList{"https://cdn.example.com/luna.jpg"},
+	}
+	_, errMap := repo.SavePet(pet)
+	require.Nil(t, errMap)
+
+	changes := *pet
+	changes.Name = "Not persisted"
+	changes.Notes = "Alérgica a frango"
+	updated, errMap := repo.UpdatePetFields(&changes, []string{"notes"})
+	require.Nil(t, errMap)
+	assert.Equal(t, "Luna", updated.Name)
+	assert.Equal(t, "Alérgica a frango", updated.Notes)
+	assert.Equal(t, "Preto", updated.Color)
+	require.NotNil(t, updated.Neutered)
+	assert.True(t, *updated.Neutered)
+	assert.Equal(t, pet.WeightHistory, updated.WeightHistory)
+	assert.Equal(t, pet.Photos, updated.Photos)
+
+	_, errMap = repo.UpdatePetFields(&changes, []string{"uuid_guardian"})
+	assert.Contains(t, errMap, "invalid_argument")
+
+	// o Update sem máscara não apaga os campos de perfil
+	legacy := &entity.Pet{Uuid: pet.Uuid, UuidGuardian: pet.UuidGuardian, Name: "Luna II", BirthYear: 2020, Breed: "SRD"}
+	updated, errMap = repo.UpdatePet(legacy)
+	require.Nil(t, errMap)
+	assert.Equal(t, "Luna II", updated.Name)
+	assert.Equal(t, "Preto", updated.Color)
+	assert.Equal(t, "Alérgica a frango", updated.Notes)
+}
+
 func TestPetRepository_UpdatePet_NotFound(t *testing.T) {
 	db := newTestDB(t)
 	defer db.Close()
@@ -223,3 +324,180 @@ func TestPetRepository_SavePet_DBError(t *testing.T) {
 	assert.Contains(t, errMap, "db_error")
 	assert.Contains(t, fmt.Sprintf("%v", errMap["db_error"]), "closed", "expect error mentions closed DB")
 }
+
+func TestPetRepository_SavePets_Success(t *testing.T) {
+	db := newTestDB(t)
+	defer db.Close()
+	repo := NewPetRepository(db)
+
+	pets := []*entity.Pet{
+		{Uuid: uuid.New(), UuidGuardian: uuid.New(), Name: "Rex", BirthYear: 2020, Breed: "SRD", Specie: entity.Dog},
+		{Uuid: uuid.New(), UuidGuardian: uuid.New(), Name: "Mia", BirthYear: 2021, Breed: "SRD", Specie: entity.Cat},
+	}
+
+	saved, itemErrs := repo.SavePets(pets, true)
+	require.Len(t, saved, 2)
+	assert.Nil(t, itemErrs[0])
+	assert.Nil(t, itemErrs[1])
+
+	var count int
+	require.NoError(t, db.Model(&entity.Pet{}).Count(&count).Error)
+	assert.Equal(t, 2, count)
+}
+
+func TestPetRepository_GetPets(t *testing.T) {
+	db := newTestDB(t)
+	defer db.Close()
+	repo := NewPetRepository(db)
+
+	a := &entity.Pet{Uuid: uuid.New(), UuidGuardian: uuid.New(), Name: "A", Breed: "SRD"}
+	b := &entity.Pet{Uuid: uuid.New(), UuidGuardian: uuid.New(), Name: "B", Breed: "SRD"}
+	require.NoError(t, db.Create(a).Error)
+	require.NoError(t, db.Create(b).Error)
+
+	got, errMap := repo.GetPets([]string{a.Uuid.String(), uuid.New().String()})
+	require.Nil(t, errMap)
+	require.Len(t, got, 1)
+	assert.Equal(t, a.Uuid, got[0].Uuid)
+}
+
+func TestPetRepository_UpdatePets_AllOrNothingRollsBack(t *testing.T) {
+	db := newTestDB(t)
+	defer db.Close()
+	repo := NewPetRepository(db)
+
+	existing := &entity.Pet{Uuid: uuid.New(), UuidGuardian: uuid.New(), Name: "Luna", BirthYear: 2019, Breed: "Beagle"}
+	require.NoError(t, db.Create(existing).Error)
+
+	changed := *existing
+	changed.Name = "Luna Updated"
+	ghost := &entity.Pet{Uuid: uuid.New(), Name: "Ghost", Breed: "Unknown"}
+
+	updated, itemErrs := repo.UpdatePets([]*entity.Pet{&changed, ghost}, true)
+	assert.Nil(t, updated[0])
+	assert.Nil(t, updated[1])
+	assert.Contains(t, itemErrs[0], "aborted")
+	assert.Contains(t, itemErrs[1], "not_found")
+
+	var got entity.Pet
+	require.NoError(t, db.Where("uuid = ?", existing.Uuid).First(&got).Error)
+	assert.Equal(t, "Luna", got.Name, "the whole batch must be rolled back")
+}
+
+func TestPetRepository_UpdatePets_PerItemKeepsValidItems(t *testing.T) {
+	db := newTestDB(t)
+	defer db.Close()
+	repo := NewPetRepository(db)
+
+	existing := &entity.Pet{Uuid: uuid.New(), UuidGuardian: uuid.New(), Name: "Luna", BirthYear: 2019, Breed: "Beagle"}
+	require.NoError(t, db.Create(existing).Error)
+
+	changed := *existing
+	changed.Name = "Luna Updated"
+	ghost := &entity.Pet{Uuid: uuid.New(), Name: "Ghost", Breed: "Unknown"}
+
+	updated, itemErrs := repo.UpdatePets([]*entity.Pet{ghost, &changed}, false)
+	assert.Nil(t, updated[0])
+	assert.Contains(t, itemErrs[0], "not_found")
+	require.NotNil(t, updated[1])
+	assert.Nil(t, itemErrs[1])
+	assert.Equal(t, "Luna Updated", updated[1].Name)
+
+	var got entity.Pet
+	require.NoError(t, db.Where("uuid = ?", existing.Uuid).First(&got).Error)
+	assert.Equal(t, "Luna Updated", got.Name)
+}
+
+func TestPetRepository_InsertPets(t *testing.T) {
+	db := newTestDB(t)
+	defer db.Close()
+	repo := NewPetRepository(db)
+
+	guardian := uuid.New()
+	pets := []*entity.Pet{
+		{Uuid: uuid.New(), UuidGuardian: guardian, Name: "Rex", BirthYear: 2020, Breed: "SRD", Specie: entity.Dog},
+		{Uuid: uuid.New(), UuidGuardian: guardian, Name: "Mia", BirthYear: 2021, Breed: "Siamês", Specie: entity.Cat},
+	}
+
+	require.Nil(t, repo.InsertPets(pets))
+
+	var got []entity.Pet
+	require.NoError(t, db.Where("uuid_guardian = ?", guardian).Order("name").Find(&got).Error)
+	require.Len(t, got, 2)
+	assert.Equal(t, "Mia", got[0].Name)
+	assert.Equal(t, "Siamês", got[0].Breed)
+	assert.Equal(t, entity.Cat, got[0].Specie)
+	assert.Equal(t, "Rex", got[1].Name)
+}
+
+func TestPetRepository_ListPets_PagesWithFilter(t *testing.T) {
+	db := newTestDB(t)
+	defer db.Close()
+	repo := NewPetRepository(db)
+
+	guardian := uuid.New()
+	for i := 0; i < 5; i++ {
+		require.NoError(t, db.Create(&entity.Pet{Uuid: uuid.New(), UuidGuardian: guardian, Name: fmt.Sprintf("Dog %d", i), Breed: "SRD", Specie: entity.Dog, BirthYear: 2015 + i}).Error)
+	}
+	require.NoError(t, db.Create(&entity.Pet{Uuid: uuid.New(), UuidGuardian: guardian, Name: "Cat", Breed: "SRD", Specie: entity.Cat}).Error)
+	require.NoError(t, db.Create(&entity.Pet{Uuid: uuid.New(), UuidGuardian: uuid.New(), Name: "Other", Breed: "SRD", Specie: entity.Dog}).Error)
+
+	filter := entity.PetFilter{UuidGuardian: guardian, Species: []entity.PetType{entity.Dog}, BirthYearFrom: 2016}
+
+	var all []*entity.Pet
+	after := ""
+	for {
+		page, errMap := repo.ListPets(filter, after, 2)
+		require.Nil(t, errMap)
+		if len(page) == 0 {
+			break
+		}
+		all = append(all, page...)
+		after = page[len(page)-1].Uuid.String()
+	}
+
+	require.Len(t, all, 4)
+	for i := 1; i < len(all); i++ {
+		assert.Less(t, all[i-1].Uuid.String(), all[i].Uuid.String(), "pages must follow uuid order")
+	}
+	for _, pet := range all {
+		assert.True(t, filter.Matches(pet))
+	}
+}
+
+func TestPetRepository_MicrochipIsUnique(t *testing.T) {
+	db := newTestDB(t)
+	defer db.Close()
+	repo := NewPetRepository(db)
+
+	newPet := func(n uint, chip string) *entity.Pet {
+		return &entity.Pet{Uuid: uuid.New(), NIdentification: n, UuidGuardian: uuid.New(), Name: "Rex", MicrochipNumber: chip}
+	}
+
+	// pets sem chip não colidem no índice
+	_, errData := repo.SavePet(newPet(1, ""))
+	require.Nil(t, errData)
+	_, errData = repo.SavePet(newPet(2, ""))
+	require.Nil(t, errData)
+
+	chipped := newPet(3, "985112000123456")
+	_, errData = repo.SavePet(chipped)
+	require.Nil(t, errData)
+
+	_, errData = repo.SavePet(newPet(4, "985112000123456"))
+	assert.Equal(t, "microchip already registered to another pet", errData["conflict"])
+
+	other := newPet(5, "")
+	_, errData = repo.SavePet(other)
+	require.Nil(t, errData)
+	other.MicrochipNumber = chipped.MicrochipNumber
+	_, errData = repo.UpdatePetFields(other, []string{"microchip_number"})
+	assert.NotEmpty(t, errData["conflict"])
+
+	found, errData := repo.GetPetByMicrochip("985112000123456")
+	require.Nil(t, errData)
+	assert.Equal(t, chipped.Uuid, found.Uuid)
+
+	_, errData = repo.GetPetByMicrochip("985112000654321")
+	assert.NotEmpty(t, errData["not_found"])
+}
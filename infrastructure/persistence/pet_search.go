@@ -0,0 +1,117 @@
+package persistence
+
+import (
+	"strings"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/jinzhu/gorm"
+)
+
+// petSearchDocument é a expressão do índice ix_pets_search; a consulta precisa usar
+// exatamente o mesmo texto para o Postgres aproveitar o índice.
+const petSearchDocument = "(setweight(to_tsvector('simple', coalesce(name, '')), 'A') || " +
+	"setweight(to_tsvector('simple', coalesce(breed, '')), 'B') || " +
+	"setweight(to_tsvector('simple', coalesce(notes, '')), 'C'))"
+
+// searchScanPageSize é o tamanho dos lotes lidos quando a busca roda fora do Postgres.
+const searchScanPageSize = 500
+
+// createSearchIndexes cria o índice de texto e os de trigramas usados por SearchPets.
+// Só existem no Postgres; nos outros bancos a busca percorre os pets.
+func createSearchIndexes(db *gorm.DB) error {
+	if db.Dialect().GetName() != "postgres" {
+		return nil
+	}
+	statements := []string{
+		"CREATE EXTENSION IF NOT EXISTS pg_trgm",
+		"CREATE INDEX IF NOT EXISTS ix_pets_search ON pets USING GIN (" + petSearchDocument + ")",
+		"CREATE INDEX IF NOT EXISTS ix_pets_name_trgm ON pets USING GIN (name gin_trgm_ops)",
+		"CREATE INDEX IF NOT EXISTS ix_pets_breed_trgm ON pets USING GIN (breed gin_trgm_ops)",
+		"CREATE INDEX IF NOT EXISTS ix_pets_notes_trgm ON pets USING GIN (notes gin_trgm_ops)",
+	}
+	for _, statement := range statements {
+		if err := db.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type petSearchRow struct {
+	entity.Pet
+	SearchRank float64
+}
+
+// SearchPets usa busca textual por prefixo e similaridade do pg_trgm: cada termo
+// precisa casar com o nome, a raça ou as observações.
+func (p *PetRepo) SearchPets(search entity.PetSearch) ([]*entity.PetSearchHit, map[string]string) {
+	terms := search.Terms()
+	if len(terms) == 0 {
+		return nil, nil
+	}
+	if p.db.Dialect().GetName() != "postgres" {
+		return p.scanSearch(search)
+	}
+
+	prefixes := make([]string, len(terms))
+	for i, term := range terms {
+		prefixes[i] = term + ":*"
+	}
+
+	// o rank soma a relevância do texto com a melhor similaridade de cada termo, com os
+	// mesmos pesos do documento
+	rank := "ts_rank(" + petSearchDocument + ", to_tsquery('simple', ?))"
+	args := []interface{}{strings.Join(prefixes, " | ")}
+	for _, term := range terms {
+		rank += " + GREATEST(word_similarity(?, name), 0.6 * word_similarity(?, breed), 0.3 * word_similarity(?, coalesce(notes, '')))"
+		args = append(args, term, term, term)
+	}
+
+	var rows []petSearchRow
+	err := p.read(func(db *gorm.DB) error {
+		query := applyPetFilter(db.Table("pets").Select("pets.*, "+rank+" AS search_rank", args...), search.Filter)
+		for i, term := range terms {
+			query = query.Where(petSearchDocument+" @@ to_tsquery('simple', ?) OR ? <% name OR ? <% breed OR ? <% notes",
+				prefixes[i], term, term, term)
+		}
+		rows = nil
+		return query.Order("search_rank DESC, uuid").Limit(search.Limit).Scan(&rows).Error
+	})
+	if err != nil {
+		return nil, dbError(err)
+	}
+
+	hits := make([]*entity.PetSearchHit, len(rows))
+	for i := range rows {
+		hits[i] = &entity.PetSearchHit{Pet: &rows[i].Pet, Rank: rows[i].SearchRank}
+	}
+	return hits, nil
+}
+
+// scanSearch percorre os pets do filtro e aplica o mesmo critério em memória. Serve
+// aos bancos sem pg_trgm, como o sqlite dos testes.
+func (p *PetRepo) scanSearch(search entity.PetSearch) ([]*entity.PetSearchHit, map[string]string) {
+	var hits []*entity.PetSearchHit
+	after := ""
+	for {
+		pets, errData := p.ListPets(search.Filter, after, searchScanPageSize)
+		if errData != nil {
+			return nil, errData
+		}
+		for _, pet := range pets {
+			if hit, ok := search.Match(pet); ok {
+				hits = append(hits, hit)
+			}
+		}
+		if len(pets) < searchScanPageSize {
+			break
+		}
+		after = pets[len(pets)-1].Uuid.String()
+	}
+
+	entity.SortSearchHits(hits)
+	if search.Limit > 0 && len(hits) > search.Limit {
+		hits = hits[:search.Limit]
+	}
+	return hits, nil
+}
@@ -0,0 +1,64 @@
+package persistence
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+)
+
+func seedSearchPets(t *testing.T, db *gorm.DB, guardian uuid.UUID) map[string]*entity.Pet {
+	t.Helper()
+	pets := map[string]*entity.Pet{
+		"rex":   {Uuid: uuid.New(), UuidGuardian: guardian, Name: "Rex", Breed: "Golden Retriever", Specie: entity.Dog},
+		"mel":   {Uuid: uuid.New(), UuidGuardian: guardian, Name: "Mel", Breed: "Golden Retriever", Specie: entity.Dog, Notes: "Irmã do Rex"},
+		"rexie": {Uuid: uuid.New(), UuidGuardian: uuid.New(), Name: "Rexie", Breed: "Golden Retriever", Specie: entity.Dog},
+		"tom":   {Uuid: uuid.New(), UuidGuardian: guardian, Name: "Tom", Breed: "Siamês", Specie: entity.Cat},
+	}
+	for _, pet := range pets {
+		require.NoError(t, db.Create(pet).Error)
+	}
+	return pets
+}
+
+func testSearchPets(t *testing.T, db *gorm.DB) {
+	guardian := uuid.New()
+	pets := seedSearchPets(t, db, guardian)
+	repo := NewPetRepository(db)
+
+	hits, errData := repo.SearchPets(entity.PetSearch{Query: "rex golden", Limit: 10})
+	require.Nil(t, errData)
+	require.Len(t, hits, 3)
+	assert.Equal(t, pets["rex"].Uuid, hits[0].Pet.Uuid, "exact name match ranks first")
+	for i := 1; i < len(hits); i++ {
+		assert.GreaterOrEqual(t, hits[i-1].Rank, hits[i].Rank)
+	}
+
+	hits, errData = repo.SearchPets(entity.PetSearch{Query: "rexx", Filter: entity.PetFilter{UuidGuardian: guardian}, Limit: 10})
+	require.Nil(t, errData)
+	require.Len(t, hits, 2, "rexie belongs to another guardian")
+	assert.Equal(t, pets["rex"].Uuid, hits[0].Pet.Uuid, "typos match by similarity")
+	assert.Equal(t, pets["mel"].Uuid, hits[1].Pet.Uuid, "notes are searched too")
+
+	hits, errData = repo.SearchPets(entity.PetSearch{Query: "golden", Filter: entity.PetFilter{Species: []entity.PetType{entity.Cat}}, Limit: 10})
+	require.Nil(t, errData)
+	assert.Empty(t, hits)
+
+	hits, errData = repo.SearchPets(entity.PetSearch{Query: "golden", Limit: 2})
+	require.Nil(t, errData)
+	assert.Len(t, hits, 2)
+}
+
+func TestPetRepository_SearchPets(t *testing.T) {
+	db := newTestDB(t)
+	defer db.Close()
+	testSearchPets(t, db)
+}
+
+func TestPetRepository_SearchPets_Postgres(t *testing.T) {
+	testSearchPets(t, newPostgresTestDB(t))
+}
@@ -0,0 +1,72 @@
+package persistence
+
+import (
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+)
+
+// migratePetStatus completa a coluna status criada pelo AutoMigrate: no Postgres os pets
+// de antes dela ficam em intake.
+func migratePetStatus(db *gorm.DB) error {
+	var statements []string
+	if db.Dialect().GetName() == "postgres" {
+		statements = append(statements,
+			"UPDATE pets SET status = 'intake' WHERE status IS NULL",
+			"ALTER TABLE pets ALTER COLUMN status SET DEFAULT 'intake', ALTER COLUMN status SET NOT NULL")
+	}
+	statements = append(statements, "CREATE INDEX IF NOT EXISTS ix_pets_tenant_status ON pets (tenant_id, status)")
+	for _, statement := range statements {
+		if err := db.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ChangePetStatus grava a transição só se o status ainda for change.From; se outra
+// escrita chegou antes, devolve aborted. Com troca de dono o evento é de transferência.
+func (p *PetRepo) ChangePetStatus(petUuid string, change entity.StatusChange) (*entity.Pet, map[string]string) {
+	var changed *entity.Pet
+	errData := p.write(func(tx *gorm.DB) map[string]string {
+		current := &entity.Pet{}
+		err := tx.Where("uuid = ?", petUuid).First(current).Error
+		if gorm.IsRecordNotFoundError(err) {
+			return map[string]string{"not_found": "pet not found"}
+		}
+		if err != nil {
+			return dbError(err)
+		}
+
+		columns := map[string]interface{}{
+			"status":            change.To,
+			"status_reason":     change.Reason,
+			"status_changed_at": change.ChangedAt,
+		}
+		if change.Guardian != uuid.Nil {
+			columns["uuid_guardian"] = change.Guardian
+		}
+		from := entity.PetFilter{Statuses: []entity.PetStatus{change.From}}.StoredStatuses()
+		res := tx.Model(&entity.Pet{}).Where("uuid = ? AND status IN (?)", petUuid, from).Updates(columns)
+		if res.Error != nil {
+			return writeError(res.Error)
+		}
+		if res.RowsAffected == 0 {
+			return map[string]string{"aborted": "pet status changed concurrently, reload and try again"}
+		}
+
+		changed = &entity.Pet{}
+		if err := tx.Where("uuid = ?", petUuid).First(changed).Error; err != nil {
+			return dbError(err)
+		}
+		ev := entity.PetEvent{Type: entity.PetUpdated, Pet: *changed}
+		if changed.UuidGuardian != current.UuidGuardian {
+			ev.Type, ev.PreviousGuardian = entity.PetTransferred, current.UuidGuardian
+		}
+		return p.outbox.enqueue(tx, ev)
+	})
+	if errData != nil {
+		return nil, errData
+	}
+	return changed, nil
+}
@@ -0,0 +1,160 @@
+package persistence
+
+import (
+	"fmt"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jinzhu/gorm"
+)
+
+const (
+	// DefaultReplicaMaxLag é o atraso a partir do qual uma réplica deixa de receber leituras.
+	DefaultReplicaMaxLag = 2 * time.Second
+	// DefaultReplicaCheckInterval é de quanto em quanto tempo a saúde das réplicas é medida.
+	DefaultReplicaCheckInterval = 5 * time.Second
+)
+
+// postgresReplicaLag mede há quanto tempo a réplica não aplica WAL. Sem nada pendente
+// (recebido = aplicado) o atraso é zero, mesmo que o primário esteja parado.
+const postgresReplicaLag = `SELECT CASE
+	WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+	ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0)
+END`
+
+// LagProbe mede o atraso de replicação de uma réplica; um erro a tira de circulação.
+type LagProbe func(db *gorm.DB) (time.Duration, error)
+
+// PostgresLag é o LagProbe das réplicas de streaming do Postgres.
+func PostgresLag(db *gorm.DB) (time.Duration, error) {
+	var seconds float64
+	if err := db.Raw(postgresReplicaLag).Row().Scan(&seconds); err != nil {
+		return 0, err
+	}
+	return time.Duration(seconds * float64(time.Second)), nil
+}
+
+// ReplicaSet distribui as leituras, em rodízio, entre as réplicas saudáveis. Uma
+// réplica sai de circulação quando o probe falha, quando atrasa mais que maxLag ou
+// quando uma consulta nela falha, e volta na próxima checagem boa.
+type ReplicaSet struct {
+	replicas []*replica
+	maxLag   time.Duration
+	interval time.Duration
+	probe    LagProbe
+	next     atomic.Uint64
+
+	stopOnce sync.Once
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+type replica struct {
+	name    string
+	db      *gorm.DB
+	healthy atomic.Bool
+}
+
+// NewReplicaSet não consulta as réplicas: até Check ou Start rodarem, todas as
+// leituras vão ao primário.
+func NewReplicaSet(dbs []*gorm.DB, maxLag, interval time.Duration, probe LagProbe) *ReplicaSet {
+	set := &ReplicaSet{maxLag: maxLag, interval: interval, probe: probe}
+	for i, db := range dbs {
+		set.replicas = append(set.replicas, &replica{name: fmt.Sprintf("#%d", i+1), db: db})
+	}
+	return set
+}
+
+// WithReplicas permite ao PetRepo ler das réplicas, pela visão de ReadReplica.
+func WithReplicas(set *ReplicaSet) RepoOption {
+	return func(o *repoOptions) {
+		o.replicas = set
+	}
+}
+
+// Check mede todas as réplicas uma vez.
+func (s *ReplicaSet) Check() {
+	for _, r := range s.replicas {
+		lag, err := s.probe(r.db)
+		switch {
+		case err != nil:
+			r.setHealthy(false, "probe failed: "+err.Error())
+		case lag > s.maxLag:
+			r.setHealthy(false, "lagging "+lag.String())
+		default:
+			r.setHealthy(true, "")
+		}
+	}
+}
+
+// Start roda Check agora e depois a cada interval, até Close.
+func (s *ReplicaSet) Start() {
+	s.Check()
+	s.stop = make(chan struct{})
+	s.done = make(chan struct{})
+	go func() {
+		defer close(s.done)
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				s.Check()
+			case <-s.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Close para as checagens e fecha as conexões das réplicas.
+func (s *ReplicaSet) Close() error {
+	s.stopOnce.Do(func() {
+		if s.stop != nil {
+			close(s.stop)
+			<-s.done
+		}
+	})
+	var firstErr error
+	for _, r := range s.replicas {
+		if err := r.db.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// staleWindow é por quanto tempo uma escrita pode ainda não ter chegado a uma réplica
+// em circulação: o atraso máximo mais o que ele pode crescer entre duas checagens.
+func (s *ReplicaSet) staleWindow() time.Duration {
+	return s.maxLag + s.interval
+}
+
+// pick devolve a próxima réplica saudável, ou nil para ler do primário.
+func (s *ReplicaSet) pick() *replica {
+	if s == nil {
+		return nil
+	}
+	n := len(s.replicas)
+	start := int(s.next.Add(1))
+	for i := 0; i < n; i++ {
+		r := s.replicas[(start+i)%n]
+		if r.healthy.Load() {
+			return r
+		}
+	}
+	return nil
+}
+
+func (r *replica) setHealthy(healthy bool, reason string) {
+	if r.healthy.Swap(healthy) == healthy {
+		return
+	}
+	if healthy {
+		log.Printf("read replica %s is back in rotation", r.name)
+	} else {
+		log.Printf("read replica %s out of rotation: %s", r.name, reason)
+	}
+}
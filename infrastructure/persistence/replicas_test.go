@@ -0,0 +1,167 @@
+package persistence
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// replicaFixture tem um primário e réplicas em arquivos SQLite separados, sem
+// replicação: cada pet é gravado só onde o teste quer, para ver de onde veio a leitura.
+type replicaFixture struct {
+	primary  *Repositories
+	replicas []*Repositories
+	lag      map[*gorm.DB]time.Duration
+	down     map[*gorm.DB]error
+	set      *ReplicaSet
+	repo     *PetRepo
+}
+
+func newReplicaFixture(t *testing.T, replicas int) *replicaFixture {
+	t.Helper()
+	dir := t.TempDir()
+	f := &replicaFixture{
+		primary: newSQLiteTestRepos(t, filepath.Join(dir, "primary.db")),
+		lag:     map[*gorm.DB]time.Duration{},
+		down:    map[*gorm.DB]error{},
+	}
+	var dbs []*gorm.DB
+	for i := 0; i < replicas; i++ {
+		replica := newSQLiteTestRepos(t, filepath.Join(dir, uuid.NewString()+".db"))
+		f.replicas = append(f.replicas, replica)
+		dbs = append(dbs, replica.db)
+	}
+	f.set = NewReplicaSet(dbs, time.Second, time.Minute, func(db *gorm.DB) (time.Duration, error) {
+		return f.lag[db], f.down[db]
+	})
+	f.set.Check()
+	f.repo = NewPetRepository(f.primary.db, WithReplicas(f.set))
+	return f
+}
+
+func savePetIn(t *testing.T, repos *Repositories, name string) *entity.Pet {
+	t.Helper()
+	pet := &entity.Pet{Uuid: uuid.New(), UuidGuardian: uuid.New(), Name: name, BirthYear: 2020, Breed: "SRD", Specie: entity.Dog}
+	_, errData := repos.Pet.SavePet(pet)
+	require.Nil(t, errData)
+	return pet
+}
+
+func TestPetRepo_ReadReplica(t *testing.T) {
+	f := newReplicaFixture(t, 1)
+	onReplica := savePetIn(t, f.replicas[0], "Rex")
+	onPrimary := savePetIn(t, f.primary, "Mel")
+
+	reads := f.repo.ReadReplica(time.Time{})
+	got, errData := reads.GetPet(onReplica.Uuid.String())
+	require.Nil(t, errData)
+	assert.Equal(t, "Rex", got.Name)
+	pets, errData := reads.ListPets(entity.PetFilter{}, "", -1)
+	require.Nil(t, errData)
+	require.Len(t, pets, 1)
+	assert.Equal(t, "Rex", pets[0].Name)
+	hits, errData := reads.SearchPets(entity.PetSearch{Query: "rex", Limit: 10})
+	require.Nil(t, errData)
+	assert.Len(t, hits, 1)
+	found, errData := reads.GetPets([]string{onReplica.Uuid.String(), onPrimary.Uuid.String()})
+	require.Nil(t, errData)
+	assert.Len(t, found, 1)
+
+	// o próprio repositório continua lendo do primário
+	got, errData = f.repo.GetPet(onPrimary.Uuid.String())
+	require.Nil(t, errData)
+	assert.Equal(t, "Mel", got.Name)
+}
+
+func TestPetRepo_ReadReplica_RecentWriteReadsPrimary(t *testing.T) {
+	f := newReplicaFixture(t, 1)
+	pet := savePetIn(t, f.primary, "Mel")
+
+	reads := f.repo.ReadReplica(time.Now().Add(-time.Second))
+	assert.Same(t, f.repo, reads)
+	got, errData := reads.GetPet(pet.Uuid.String())
+	require.Nil(t, errData)
+	assert.Equal(t, "Mel", got.Name)
+
+	// fora da janela (atraso máximo + intervalo das checagens) a réplica volta a valer
+	assert.NotSame(t, f.repo, f.repo.ReadReplica(time.Now().Add(-time.Minute-2*time.Second)))
+}
+
+func TestPetRepo_ReadReplica_FallsBackToPrimary(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(f *replicaFixture)
+	}{
+		{"lagging", func(f *replicaFixture) { f.lag[f.replicas[0].db] = 2 * time.Second }},
+		{"probe fails", func(f *replicaFixture) { f.down[f.replicas[0].db] = errors.New("connection refused") }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newReplicaFixture(t, 1)
+			pet := savePetIn(t, f.primary, "Mel")
+			tt.setup(f)
+			f.set.Check()
+
+			got, errData := f.repo.ReadReplica(time.Time{}).GetPet(pet.Uuid.String())
+			require.Nil(t, errData)
+			assert.Equal(t, "Mel", got.Name)
+
+			// volta à rotação quando a checagem seguinte passa
+			f.lag = map[*gorm.DB]time.Duration{}
+			f.down = map[*gorm.DB]error{}
+			f.set.Check()
+			_, errData = f.repo.ReadReplica(time.Time{}).GetPet(pet.Uuid.String())
+			assert.Equal(t, "record not found", errData["db_error"])
+		})
+	}
+}
+
+func TestPetRepo_ReadReplica_QueryErrorRetriesOnPrimary(t *testing.T) {
+	f := newReplicaFixture(t, 1)
+	pet := savePetIn(t, f.primary, "Mel")
+	require.NoError(t, f.replicas[0].db.Exec("DROP TABLE pets").Error)
+
+	got, errData := f.repo.ReadReplica(time.Time{}).GetPet(pet.Uuid.String())
+	require.Nil(t, errData)
+	assert.Equal(t, "Mel", got.Name)
+	assert.Nil(t, f.set.pick(), "the failing replica left the rotation")
+}
+
+func TestReplicaSet_RoundRobin(t *testing.T) {
+	f := newReplicaFixture(t, 2)
+	first := savePetIn(t, f.replicas[0], "Rex")
+	savePetIn(t, f.replicas[1], "Mel")
+
+	reads := f.repo.ReadReplica(time.Time{})
+	names := map[string]int{}
+	for i := 0; i < 4; i++ {
+		pets, errData := reads.ListPets(entity.PetFilter{}, "", -1)
+		require.Nil(t, errData)
+		require.Len(t, pets, 1)
+		names[pets[0].Name]++
+	}
+	assert.Equal(t, map[string]int{"Rex": 2, "Mel": 2}, names)
+
+	f.down[f.replicas[1].db] = errors.New("down")
+	f.set.Check()
+	for i := 0; i < 2; i++ {
+		got, errData := reads.GetPet(first.Uuid.String())
+		require.Nil(t, errData)
+		assert.Equal(t, "Rex", got.Name)
+	}
+}
+
+func TestPostgresLag(t *testing.T) {
+	db := newPostgresTestDB(t)
+	// no primário não há WAL a aplicar
+	lag, err := PostgresLag(db)
+	require.NoError(t, err)
+	assert.Zero(t, lag)
+}
@@ -0,0 +1,125 @@
+package persistence
+
+import (
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/LuizFJP/pet-ms/domain/repository"
+	"github.com/jinzhu/gorm"
+)
+
+type SpeciesRepo struct {
+	db *gorm.DB
+}
+
+func NewSpeciesRepository(db *gorm.DB) *SpeciesRepo {
+	return &SpeciesRepo{db}
+}
+
+var _ repository.SpeciesRepository = &SpeciesRepo{}
+
+func (r *SpeciesRepo) ListSpecies() ([]*entity.Species, map[string]string) {
+	var species []*entity.Species
+	if err := r.db.Order("value").Find(&species).Error; err != nil {
+		return nil, map[string]string{"db_error": err.Error()}
+	}
+	return species, nil
+}
+
+func (r *SpeciesRepo) GetSpeciesByCode(code string) (*entity.Species, map[string]string) {
+	species := &entity.Species{}
+	err := r.db.Where("code = ?", code).First(species).Error
+	if gorm.IsRecordNotFoundError(err) {
+		return nil, map[string]string{"not_found": "species not found"}
+	}
+	if err != nil {
+		return nil, map[string]string{"db_error": err.Error()}
+	}
+	return species, nil
+}
+
+// CreateSpecies atribui o próximo valor livre a partir de FirstCustomPetType.
+func (r *SpeciesRepo) CreateSpecies(species *entity.Species) (*entity.Species, map[string]string) {
+	var errData map[string]string
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var count int
+		if err := tx.Model(&entity.Species{}).Where("code = ?", species.Code).Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			errData = map[string]string{"conflict": "species code already exists"}
+			return errWriteFailed
+		}
+
+		var next struct{ Value int }
+		if err := tx.Model(&entity.Species{}).Select("COALESCE(MAX(value), 0) + 1 AS value").Scan(&next).Error; err != nil {
+			return err
+		}
+		species.Value = entity.PetType(next.Value)
+		if species.Value < entity.FirstCustomPetType {
+			species.Value = entity.FirstCustomPetType
+		}
+		return tx.Create(species).Error
+	})
+	if errData != nil {
+		return nil, errData
+	}
+	if err != nil {
+		return nil, map[string]string{"db_error": err.Error()}
+	}
+	return species, nil
+}
+
+// UpdateSpecies só altera o nome de exibição; code e value são estáveis.
+func (r *SpeciesRepo) UpdateSpecies(species *entity.Species) (*entity.Species, map[string]string) {
+	tx := r.db.Model(&entity.Species{}).Where("code = ?", species.Code).Update("display_name", species.DisplayName)
+	if tx.Error != nil {
+		return nil, map[string]string{"db_error": tx.Error.Error()}
+	}
+	if tx.RowsAffected == 0 {
+		return nil, map[string]string{"not_found": "species not found"}
+	}
+	return r.GetSpeciesByCode(species.Code)
+}
+
+// DeleteSpecies recusa remover espécies ainda usadas por algum pet.
+func (r *SpeciesRepo) DeleteSpecies(code string) map[string]string {
+	var errData map[string]string
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		species := &entity.Species{}
+		err := tx.Where("code = ?", code).First(species).Error
+		if gorm.IsRecordNotFoundError(err) {
+			errData = map[string]string{"not_found": "species not found"}
+			return errWriteFailed
+		}
+		if err != nil {
+			return err
+		}
+
+		var inUse int
+		if err := tx.Model(&entity.Pet{}).Where("specie = ?", species.Value).Count(&inUse).Error; err != nil {
+			return err
+		}
+		if inUse > 0 {
+			errData = map[string]string{"failed_precondition": "species is still used by pets"}
+			return errWriteFailed
+		}
+		return tx.Delete(species).Error
+	})
+	if errData != nil {
+		return errData
+	}
+	if err != nil {
+		return map[string]string{"db_error": err.Error()}
+	}
+	return nil
+}
+
+// seedSpecies grava as espécies padrão que ainda não existem, sem tocar nas já cadastradas.
+func seedSpecies(db *gorm.DB, defaults []entity.Species) error {
+	for _, species := range defaults {
+		species := species
+		if err := db.Where(entity.Species{Code: species.Code}).FirstOrCreate(&species).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
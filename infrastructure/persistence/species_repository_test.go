@@ -0,0 +1,72 @@
+package persistence
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+)
+
+func newSpeciesTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db := newTestDB(t)
+	require.NoError(t, db.AutoMigrate(&entity.Species{}).Error, "failed to automigrate Species")
+	require.NoError(t, seedSpecies(db, entity.DefaultSpecies()))
+	return db
+}
+
+func TestSpeciesRepository_SeedIsIdempotent(t *testing.T) {
+	db := newSpeciesTestDB(t)
+	defer db.Close()
+	repo := NewSpeciesRepository(db)
+
+	_, errMap := repo.UpdateSpecies(&entity.Species{Code: "dog", DisplayName: "Cão"})
+	require.Nil(t, errMap)
+	require.NoError(t, seedSpecies(db, entity.DefaultSpecies()))
+
+	species, errMap := repo.ListSpecies()
+	require.Nil(t, errMap)
+	assert.Len(t, species, len(entity.DefaultSpecies()))
+	assert.Equal(t, "Cão", species[0].DisplayName, "seeding must not overwrite edits")
+}
+
+func TestSpeciesRepository_CreateAssignsCustomValue(t *testing.T) {
+	db := newSpeciesTestDB(t)
+	defer db.Close()
+	repo := NewSpeciesRepository(db)
+
+	first, errMap := repo.CreateSpecies(&entity.Species{Code: "ferret", DisplayName: "Furão"})
+	require.Nil(t, errMap)
+	assert.Equal(t, entity.FirstCustomPetType, first.Value)
+
+	second, errMap := repo.CreateSpecies(&entity.Species{Code: "hedgehog", DisplayName: "Ouriço"})
+	require.Nil(t, errMap)
+	assert.Equal(t, entity.FirstCustomPetType+1, second.Value)
+
+	_, errMap = repo.CreateSpecies(&entity.Species{Code: "ferret", DisplayName: "Outro"})
+	assert.Contains(t, errMap, "conflict")
+
+	got, errMap := repo.GetSpeciesByCode("hedgehog")
+	require.Nil(t, errMap)
+	assert.Equal(t, "Ouriço", got.DisplayName)
+}
+
+func TestSpeciesRepository_DeleteRejectsSpeciesInUse(t *testing.T) {
+	db := newSpeciesTestDB(t)
+	defer db.Close()
+	repo := NewSpeciesRepository(db)
+
+	require.NoError(t, db.Create(&entity.Pet{Uuid: uuid.New(), Name: "Mingau", Breed: "SRD", Specie: entity.Cat}).Error)
+
+	assert.Contains(t, repo.DeleteSpecies("cat"), "failed_precondition")
+	assert.Nil(t, repo.DeleteSpecies("horse"))
+	assert.Contains(t, repo.DeleteSpecies("horse"), "not_found")
+
+	_, errMap := repo.UpdateSpecies(&entity.Species{Code: "unicorn", DisplayName: "Unicórnio"})
+	assert.Contains(t, errMap, "not_found")
+}
@@ -0,0 +1,84 @@
+package persistence
+
+import (
+	"fmt"
+
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+)
+
+// sqliteOptions liga o WAL, para leituras não esperarem as escritas, e faz as
+// transações pegarem o lock de escrita logo no BEGIN; o busy_timeout espera o lock
+// em vez de falhar com "database is locked".
+const sqliteOptions = "_journal_mode=WAL&_synchronous=NORMAL&_busy_timeout=5000&_foreign_keys=1&_txlock=immediate"
+
+// sqliteMigrations cria a tabela pets com tipos próprios do SQLite (uuid em TEXT,
+// chave inteira com AUTOINCREMENT) e os mesmos índices do Postgres. A versão aplicada
+// fica em PRAGMA user_version; migrações novas entram sempre no fim da lista.
+var sqliteMigrations = []string{
+	`CREATE TABLE IF NOT EXISTS pets (
+		n_identification INTEGER PRIMARY KEY AUTOINCREMENT,
+		uuid TEXT NOT NULL,
+		uuid_guardian TEXT NOT NULL,
+		name TEXT NOT NULL DEFAULT '',
+		birth_year INTEGER NOT NULL DEFAULT 0,
+		birth_date DATE,
+		birth_date_accuracy TEXT NOT NULL DEFAULT '',
+		breed TEXT NOT NULL DEFAULT '',
+		specie INTEGER NOT NULL DEFAULT 0,
+		sex TEXT NOT NULL DEFAULT '',
+		neutered BOOLEAN,
+		color TEXT NOT NULL DEFAULT '',
+		markings TEXT NOT NULL DEFAULT '',
+		weight_history TEXT,
+		microchip_number TEXT NOT NULL DEFAULT '',
+		photos TEXT,
+		notes TEXT
+	);
+	CREATE UNIQUE INDEX IF NOT EXISTS ` + petUuidIndex + ` ON pets (uuid);
+	CREATE INDEX IF NOT EXISTS ix_pets_uuid_guardian ON pets (uuid_guardian);
+	CREATE UNIQUE INDEX IF NOT EXISTS ` + microchipIndex + ` ON pets (microchip_number) WHERE microchip_number <> '';`,
+	`ALTER TABLE pets ADD COLUMN tenant_id TEXT NOT NULL DEFAULT '';
+	CREATE UNIQUE INDEX IF NOT EXISTS ` + tenantMicrochipIndex + ` ON pets (tenant_id, microchip_number) WHERE microchip_number <> '';
+	DROP INDEX IF EXISTS ` + microchipIndex + `;
+	CREATE INDEX IF NOT EXISTS ix_pets_tenant_guardian ON pets (tenant_id, uuid_guardian);`,
+	`ALTER TABLE pets ADD COLUMN status TEXT NOT NULL DEFAULT 'intake';
+	ALTER TABLE pets ADD COLUMN status_reason TEXT NOT NULL DEFAULT '';
+	ALTER TABLE pets ADD COLUMN status_changed_at DATETIME;
+	CREATE INDEX IF NOT EXISTS ix_pets_tenant_status ON pets (tenant_id, status);`,
+}
+
+// NewSQLiteRepo abre (ou cria) o banco SQLite em path. Os repositórios são os mesmos
+// do Postgres; só o dialeto e as migrações da tabela pets mudam.
+func NewSQLiteRepo(path string) (*Repositories, error) {
+	db, err := gorm.Open("sqlite3", fmt.Sprintf("file:%s?%s", path, sqliteOptions))
+	if err != nil {
+		return nil, err
+	}
+	// o SQLite tem um único escritor; com uma conexão só as transações se enfileiram
+	// no pool em vez de disputarem o lock do arquivo
+	db.DB().SetMaxOpenConns(1)
+
+	return newRepositories(db), nil
+}
+
+// migrateSQLite aplica, numa transação, as migrações que faltam e avança user_version.
+func migrateSQLite(db *gorm.DB) error {
+	var version int
+	if err := db.Raw("PRAGMA user_version").Row().Scan(&version); err != nil {
+		return err
+	}
+	if version >= len(sqliteMigrations) {
+		return nil
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		for i := version; i < len(sqliteMigrations); i++ {
+			if err := tx.Exec(sqliteMigrations[i]).Error; err != nil {
+				return fmt.Errorf("sqlite migration %d: %w", i+1, err)
+			}
+		}
+		// PRAGMA não aceita parâmetros
+		return tx.Exec(fmt.Sprintf("PRAGMA user_version = %d", len(sqliteMigrations))).Error
+	})
+}
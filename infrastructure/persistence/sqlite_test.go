@@ -0,0 +1,48 @@
+package persistence
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/LuizFJP/pet-ms/domain/repository"
+	"github.com/LuizFJP/pet-ms/domain/repository/repositorytest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newSQLiteTestRepos(t *testing.T, path string) *Repositories {
+	t.Helper()
+	repos, err := NewSQLiteRepo(path)
+	require.NoError(t, err)
+	repos.db.LogMode(false)
+	t.Cleanup(func() { repos.Close() })
+	require.NoError(t, repos.Automigrate())
+	return repos
+}
+
+func TestSQLiteRepo_Conformance(t *testing.T) {
+	repositorytest.TestPetRepository(t, func(t *testing.T) repository.PetRepository {
+		return newSQLiteTestRepos(t, filepath.Join(t.TempDir(), "pets.db")).Pet
+	})
+}
+
+func TestSQLiteRepo_WAL(t *testing.T) {
+	repos := newSQLiteTestRepos(t, filepath.Join(t.TempDir(), "pets.db"))
+
+	var mode string
+	require.NoError(t, repos.db.Raw("PRAGMA journal_mode").Row().Scan(&mode))
+	assert.Equal(t, "wal", mode)
+}
+
+func TestSQLiteRepo_MigrationsRunOnce(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "pets.db")
+	first := newSQLiteTestRepos(t, path)
+	require.NoError(t, first.Close())
+
+	// reabrir o mesmo arquivo não reaplica nada e mantém a versão
+	repos := newSQLiteTestRepos(t, path)
+	var version int
+	require.NoError(t, repos.db.Raw("PRAGMA user_version").Row().Scan(&version))
+	assert.Equal(t, len(sqliteMigrations), version)
+	assert.True(t, repos.db.HasTable("pets"))
+}
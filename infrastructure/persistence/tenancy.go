@@ -0,0 +1,125 @@
+package persistence
+
+import (
+	"fmt"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/jinzhu/gorm"
+)
+
+// tenantSetting é a variável de sessão que as políticas de row-level security leem.
+const tenantSetting = "pet_ms.tenant_id"
+
+// tenantMicrochipIndex substitui o microchipIndex global: o mesmo chip pode estar em
+// organizações diferentes, mas não duas vezes na mesma.
+const tenantMicrochipIndex = "uix_pets_tenant_microchip_number"
+
+// migratePetTenancy troca o índice de microchip pelo do tenant. No Postgres também
+// completa a coluna tenant_id criada pelo AutoMigrate: os pets de antes dela ficam no
+// tenant vazio.
+func migratePetTenancy(db *gorm.DB) error {
+	var statements []string
+	if db.Dialect().GetName() == "postgres" {
+		statements = append(statements,
+			"UPDATE pets SET tenant_id = '' WHERE tenant_id IS NULL",
+			"ALTER TABLE pets ALTER COLUMN tenant_id SET DEFAULT '', ALTER COLUMN tenant_id SET NOT NULL")
+	}
+	statements = append(statements,
+		"CREATE UNIQUE INDEX IF NOT EXISTS "+tenantMicrochipIndex+
+			" ON pets (tenant_id, microchip_number) WHERE microchip_number <> ''",
+		"DROP INDEX IF EXISTS "+microchipIndex,
+		"CREATE INDEX IF NOT EXISTS ix_pets_tenant_guardian ON pets (tenant_id, uuid_guardian)")
+	for _, statement := range statements {
+		if err := db.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// petsRowSecurity liga a row-level security na tabela pets. Uma sessão com
+// pet_ms.tenant_id definido só lê e grava as linhas do tenant; sem ele (tarefas
+// internas, migrações) vê tudo. FORCE vale também para o dono da tabela, que é o
+// usuário da aplicação; superusuários continuam ignorando as políticas.
+var petsRowSecurity = []string{
+	"ALTER TABLE pets ENABLE ROW LEVEL SECURITY",
+	"ALTER TABLE pets FORCE ROW LEVEL SECURITY",
+	"DROP POLICY IF EXISTS pets_tenant_isolation ON pets",
+	fmt.Sprintf(`CREATE POLICY pets_tenant_isolation ON pets
+		USING (coalesce(current_setting('%[1]s', true), '') IN ('', tenant_id))
+		WITH CHECK (coalesce(current_setting('%[1]s', true), '') IN ('', tenant_id))`, tenantSetting),
+}
+
+// WithRowLevelSecurity faz as visões de ForTenant definirem pet_ms.tenant_id em cada
+// transação, para o Postgres aplicar as políticas além do filtro das consultas.
+func WithRowLevelSecurity() RepoOption {
+	return func(o *repoOptions) {
+		o.rowSecurity = true
+	}
+}
+
+// setTenant vale até o fim da transação tx.
+func setTenant(tx *gorm.DB, tenant string) error {
+	return tx.Exec("SELECT set_config(?, ?, true)", tenantSetting, tenant).Error
+}
+
+// migrateIdempotencyTenancy troca a chave primária das chaves de idempotência, que
+// era só a chave, por (tenant_id, idempotency_key). As chaves de antes ficam no
+// tenant vazio. Roda depois do AutoMigrate, que cria a coluna tenant_id.
+func migrateIdempotencyTenancy(db *gorm.DB) error {
+	var keyColumns int
+	var query string
+	switch db.Dialect().GetName() {
+	case "postgres":
+		query = `SELECT count(*) FROM information_schema.key_column_usage
+			WHERE table_schema = current_schema() AND constraint_name = 'idempotency_keys_pkey'`
+	case "sqlite3":
+		query = "SELECT count(*) FROM pragma_table_info('idempotency_keys') WHERE pk > 0"
+	default:
+		return nil
+	}
+	if err := db.Raw(query).Row().Scan(&keyColumns); err != nil {
+		return err
+	}
+	if keyColumns > 1 {
+		return nil
+	}
+
+	if db.Dialect().GetName() == "postgres" {
+		for _, statement := range []string{
+			"UPDATE idempotency_keys SET tenant_id = '' WHERE tenant_id IS NULL",
+			"ALTER TABLE idempotency_keys ALTER COLUMN tenant_id SET DEFAULT ''",
+			"ALTER TABLE idempotency_keys DROP CONSTRAINT IF EXISTS idempotency_keys_pkey, ADD PRIMARY KEY (tenant_id, idempotency_key)",
+		} {
+			if err := db.Exec(statement).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	// o SQLite não troca a chave primária de uma tabela: ela é recriada
+	return db.Transaction(func(tx *gorm.DB) error {
+		for _, statement := range []string{
+			"DROP INDEX IF EXISTS idx_idempotency_keys_expires_at",
+			"ALTER TABLE idempotency_keys RENAME TO idempotency_keys_old",
+		} {
+			if err := tx.Exec(statement).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.AutoMigrate(&entity.IdempotencyKey{}).Error; err != nil {
+			return err
+		}
+		for _, statement := range []string{
+			`INSERT INTO idempotency_keys (tenant_id, idempotency_key, request_hash, response, created_at, expires_at)
+				SELECT coalesce(tenant_id, ''), idempotency_key, request_hash, response, created_at, expires_at FROM idempotency_keys_old`,
+			"DROP TABLE idempotency_keys_old",
+		} {
+			if err := tx.Exec(statement).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
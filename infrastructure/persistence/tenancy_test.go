@@ -0,0 +1,114 @@
+package persistence
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/LuizFJP/pet-ms/domain/repository"
+	"github.com/LuizFJP/pet-ms/domain/repository/repositorytest"
+)
+
+func TestSQLiteRepo_TenantMigrationKeepsExistingPets(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "pets.db")
+	repos, err := NewSQLiteRepo(path)
+	require.NoError(t, err)
+	repos.db.LogMode(false)
+
+	// banco criado antes dos tenants: só a primeira migração
+	require.NoError(t, repos.db.Exec(sqliteMigrations[0]).Error)
+	require.NoError(t, repos.db.Exec("PRAGMA user_version = 1").Error)
+	id := uuid.New()
+	require.NoError(t, repos.db.Exec("INSERT INTO pets (uuid, uuid_guardian, name, microchip_number) VALUES (?, ?, 'Rex', '985112000123456')",
+		id.String(), uuid.New().String()).Error)
+	require.NoError(t, repos.Close())
+
+	repos = newSQLiteTestRepos(t, path)
+	pet, errData := repos.Pet.GetPet(id.String())
+	require.Nil(t, errData)
+	assert.Equal(t, "", pet.TenantID)
+
+	// o chip agora só é único dentro do tenant
+	other := &entity.Pet{Uuid: uuid.New(), UuidGuardian: uuid.New(), Name: "Mel", MicrochipNumber: pet.MicrochipNumber}
+	_, errData = repos.Pet.(repository.TenantScoper).ForTenant("clinic").SavePet(other)
+	assert.Nil(t, errData)
+	_, errData = repos.Pet.SavePet(&entity.Pet{Uuid: uuid.New(), UuidGuardian: uuid.New(), Name: "Bolt", MicrochipNumber: pet.MicrochipNumber})
+	assert.Contains(t, errData, "conflict")
+}
+
+// newRowSecurityTestRepo liga as políticas no banco de POSTGRES_TEST_DSN. O usuário
+// do DSN não pode ser superusuário, que ignora a row-level security.
+func newRowSecurityTestRepo(t *testing.T) (*gorm.DB, *PetRepo) {
+	t.Helper()
+	db := newPostgresTestDB(t)
+	for _, statement := range petsRowSecurity {
+		require.NoError(t, db.Exec(statement).Error)
+	}
+	return db, NewPetRepository(db, WithRowLevelSecurity())
+}
+
+func TestPetRepository_RowLevelSecurity_Conformance(t *testing.T) {
+	repositorytest.TestPetRepository(t, func(t *testing.T) repository.PetRepository {
+		_, repo := newRowSecurityTestRepo(t)
+		return repo
+	})
+}
+
+func TestPetRepository_RowLevelSecurity_HidesOtherTenants(t *testing.T) {
+	db, repo := newRowSecurityTestRepo(t)
+	rex := &entity.Pet{Uuid: uuid.New(), UuidGuardian: uuid.New(), Name: "Rex", BirthYear: 2020, Breed: "SRD", Specie: entity.Dog}
+	_, errData := repo.ForTenant("shelter").SavePet(rex)
+	require.Nil(t, errData)
+
+	// mesmo uma consulta sem o filtro do repositório não vê as linhas do outro tenant
+	var count int
+	require.NoError(t, db.Transaction(func(tx *gorm.DB) error {
+		if err := setTenant(tx, "clinic"); err != nil {
+			return err
+		}
+		return tx.Table("pets").Count(&count).Error
+	}))
+	assert.Zero(t, count)
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := setTenant(tx, "clinic"); err != nil {
+			return err
+		}
+		return tx.Exec("UPDATE pets SET tenant_id = 'clinic' WHERE uuid = ?", rex.Uuid).Error
+	})
+	require.NoError(t, err)
+	got, errData := repo.GetPet(rex.Uuid.String())
+	require.Nil(t, errData)
+	assert.Equal(t, "shelter", got.TenantID)
+}
+
+func TestSQLiteRepo_IdempotencyTenancyMigrationKeepsExistingKeys(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "pets.db")
+	repos, err := NewSQLiteRepo(path)
+	require.NoError(t, err)
+	repos.db.LogMode(false)
+
+	// tabela criada antes dos tenants, com a chave primária só na chave
+	require.NoError(t, repos.db.Exec(`CREATE TABLE idempotency_keys (idempotency_key varchar(255), request_hash varchar(255),
+		response text, created_at datetime, expires_at datetime, PRIMARY KEY (idempotency_key))`).Error)
+	require.NoError(t, repos.db.Exec("CREATE INDEX idx_idempotency_keys_expires_at ON idempotency_keys (expires_at)").Error)
+	require.NoError(t, repos.db.Exec("INSERT INTO idempotency_keys (idempotency_key, request_hash, expires_at) VALUES ('k-1', 'h', ?)",
+		time.Now().Add(time.Hour)).Error)
+	require.NoError(t, repos.Close())
+
+	repos = newSQLiteTestRepos(t, path)
+	stored, errData := repos.Idempotency.GetIdempotencyKey("", "k-1")
+	require.Nil(t, errData)
+	assert.Equal(t, "h", stored.RequestHash)
+
+	// a chave antiga fica no tenant vazio; outro tenant pode usar a mesma
+	key := &entity.IdempotencyKey{TenantID: "clinic", Key: "k-1", RequestHash: "h", ExpiresAt: time.Now().Add(time.Hour)}
+	_, errData = NewPetRepository(repos.db).ForTenant("clinic").(*PetRepo).SavePetWithIdempotencyKey(&entity.Pet{Uuid: uuid.New(), UuidGuardian: uuid.New(), Name: "Mel"}, key)
+	assert.Nil(t, errData)
+}
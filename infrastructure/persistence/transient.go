@@ -0,0 +1,59 @@
+package persistence
+
+import (
+	"database/sql/driver"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"syscall"
+
+	"github.com/lib/pq"
+	"github.com/mattn/go-sqlite3"
+)
+
+// transientCodes são os SQLSTATE que passam sozinhos: falhas de conexão (classe 08),
+// conflitos de transação, banco reiniciando e excesso de conexões.
+var transientCodes = map[pq.ErrorCode]bool{
+	"40001": true, // serialization_failure
+	"40P01": true, // deadlock_detected
+	"53300": true, // too_many_connections
+	"57P01": true, // admin_shutdown
+	"57P02": true, // crash_shutdown
+	"57P03": true, // cannot_connect_now
+}
+
+// IsTransient diz se vale repetir a operação: o erro vem de uma queda ou disputa
+// momentânea, não do pedido.
+func IsTransient(err error) bool {
+	if err == nil {
+		return false
+	}
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return pqErr.Code.Class() == "08" || transientCodes[pqErr.Code]
+	}
+	var sqliteErr sqlite3.Error
+	if errors.As(err, &sqliteErr) {
+		return sqliteErr.Code == sqlite3.ErrBusy || sqliteErr.Code == sqlite3.ErrLocked
+	}
+	var netErr net.Error
+	if errors.Is(err, driver.ErrBadConn) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.EPIPE) ||
+		errors.As(err, &netErr) {
+		return true
+	}
+	// o gorm v1 e o pq nem sempre embrulham o erro da conexão
+	msg := err.Error()
+	return strings.Contains(msg, "connection reset by peer") || strings.Contains(msg, "connection refused") ||
+		strings.Contains(msg, "broken pipe") || strings.Contains(msg, "bad connection")
+}
+
+// dbError é o mapa de erro das falhas do banco. As transitórias viram unavailable,
+// que a camada de resiliência repete e o gRPC devolve como Unavailable.
+func dbError(err error) map[string]string {
+	if IsTransient(err) {
+		return map[string]string{"unavailable": "database unavailable: " + err.Error()}
+	}
+	return map[string]string{"db_error": err.Error()}
+}
@@ -0,0 +1,39 @@
+package persistence
+
+import (
+	"errors"
+	"fmt"
+	"syscall"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsTransient(t *testing.T) {
+	cases := []struct {
+		err       error
+		transient bool
+	}{
+		{&pq.Error{Code: "40001"}, true},
+		{&pq.Error{Code: "40P01"}, true},
+		{&pq.Error{Code: "08006"}, true},
+		{&pq.Error{Code: "57P01"}, true},
+		{&pq.Error{Code: "23505"}, false},
+		{sqlite3.Error{Code: sqlite3.ErrBusy}, true},
+		{sqlite3.Error{Code: sqlite3.ErrConstraint}, false},
+		{fmt.Errorf("write: %w", syscall.ECONNRESET), true},
+		{errors.New("read tcp 10.0.0.1:5432: connection reset by peer"), true},
+		{errors.New("record not found"), false},
+		{nil, false},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.transient, IsTransient(c.err), "%v", c.err)
+	}
+}
+
+func TestDBError(t *testing.T) {
+	assert.Equal(t, map[string]string{"unavailable": "database unavailable: pq: deadlock"}, dbError(&pq.Error{Code: "40P01", Message: "deadlock"}))
+	assert.Equal(t, map[string]string{"db_error": "record not found"}, dbError(errors.New("record not found")))
+}
@@ -0,0 +1,88 @@
+package persistence
+
+import (
+	"time"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/LuizFJP/pet-ms/domain/repository"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+)
+
+type VaccinationRepo struct {
+	db *gorm.DB
+}
+
+func NewVaccinationRepository(db *gorm.DB) *VaccinationRepo {
+	return &VaccinationRepo{db}
+}
+
+var _ repository.VaccinationRepository = &VaccinationRepo{}
+
+func (r *VaccinationRepo) CreateVaccination(vaccination *entity.Vaccination) (*entity.Vaccination, map[string]string) {
+	if err := r.db.Create(vaccination).Error; err != nil {
+		return nil, map[string]string{"db_error": err.Error()}
+	}
+	return vaccination, nil
+}
+
+func (r *VaccinationRepo) GetVaccination(id uuid.UUID) (*entity.Vaccination, map[string]string) {
+	vaccination := &entity.Vaccination{}
+	err := r.db.Where("uuid = ?", id).First(vaccination).Error
+	if gorm.IsRecordNotFoundError(err) {
+		return nil, map[string]string{"not_found": "vaccination not found"}
+	}
+	if err != nil {
+		return nil, map[string]string{"db_error": err.Error()}
+	}
+	return vaccination, nil
+}
+
+// UpdateVaccination substitui os dados da dose; o pet não muda.
+func (r *VaccinationRepo) UpdateVaccination(vaccination *entity.Vaccination) (*entity.Vaccination, map[string]string) {
+	tx := r.db.Model(&entity.Vaccination{}).Where("uuid = ?", vaccination.Uuid).Updates(map[string]interface{}{
+		"vaccine":               vaccination.Vaccine,
+		"dose":                  vaccination.Dose,
+		"administered_at":       vaccination.AdministeredAt,
+		"booster_interval_days": vaccination.BoosterIntervalDays,
+		"next_due_at":           vaccination.NextDueAt,
+		"lot_number":            vaccination.LotNumber,
+		"veterinarian":          vaccination.Veterinarian,
+		"notes":                 vaccination.Notes,
+	})
+	if tx.Error != nil {
+		return nil, map[string]string{"db_error": tx.Error.Error()}
+	}
+	if tx.RowsAffected == 0 {
+		return nil, map[string]string{"not_found": "vaccination not found"}
+	}
+	return r.GetVaccination(vaccination.Uuid)
+}
+
+func (r *VaccinationRepo) ListVaccinations(petUuid uuid.UUID) ([]*entity.Vaccination, map[string]string) {
+	var vaccinations []*entity.Vaccination
+	if err := r.db.Where("pet_uuid = ?", petUuid).Order("administered_at, id").Find(&vaccinations).Error; err != nil {
+		return nil, map[string]string{"db_error": err.Error()}
+	}
+	return vaccinations, nil
+}
+
+// ListOverdueVaccinations ignora doses de pets já removidos.
+func (r *VaccinationRepo) ListOverdueVaccinations(tenant string, asOf time.Time, afterID uint, limit int) ([]*entity.Vaccination, map[string]string) {
+	year, month, day := asOf.Date()
+	today := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+
+	var vaccinations []*entity.Vaccination
+	err := r.db.
+		Where("next_due_at < ? AND id > ?", today, afterID).
+		Where(`NOT EXISTS (SELECT 1 FROM vaccinations later WHERE later.pet_uuid = vaccinations.pet_uuid
+			AND LOWER(later.vaccine) = LOWER(vaccinations.vaccine) AND later.administered_at > vaccinations.administered_at)`).
+		Where("EXISTS (SELECT 1 FROM pets WHERE pets.uuid = vaccinations.pet_uuid AND (? = '' OR pets.tenant_id = ?))", tenant, tenant).
+		Order("id").
+		Limit(limit).
+		Find(&vaccinations).Error
+	if err != nil {
+		return nil, map[string]string{"db_error": err.Error()}
+	}
+	return vaccinations, nil
+}
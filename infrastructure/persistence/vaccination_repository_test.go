@@ -0,0 +1,93 @@
+package persistence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+)
+
+func newHealthTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db := newTestDB(t)
+	require.NoError(t, db.AutoMigrate(&entity.Vaccination{}, &entity.MedicalRecord{}).Error, "failed to automigrate health records")
+	return db
+}
+
+func day(year int, month time.Month, d int) time.Time {
+	return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestVaccinationRepository_ListOverdueSkipsBoostedDoses(t *testing.T) {
+	db := newHealthTestDB(t)
+	defer db.Close()
+	pets := NewPetRepository(db)
+	repo := NewVaccinationRepository(db)
+
+	pet := &entity.Pet{Uuid: uuid.New(), UuidGuardian: uuid.New(), Name: "Rex"}
+	_, errMap := pets.SavePet(pet)
+	require.Nil(t, errMap)
+
+	add := func(petUuid uuid.UUID, vaccine string, at time.Time, interval int) *entity.Vaccination {
+		v := &entity.Vaccination{Uuid: uuid.New(), PetUuid: petUuid, Vaccine: vaccine, Dose: 1, AdministeredAt: at, BoosterIntervalDays: interval}
+		v.ScheduleBooster()
+		saved, errMap := repo.CreateVaccination(v)
+		require.Nil(t, errMap)
+		return saved
+	}
+
+	add(pet.Uuid, "V10", day(2022, time.May, 1), 365)
+	add(pet.Uuid, "v10", day(2023, time.May, 1), 365)
+	rabies := add(pet.Uuid, "Antirrábica", day(2023, time.January, 10), 365)
+	add(pet.Uuid, "Giárdia", day(2024, time.January, 10), 365)
+	add(uuid.New(), "V10", day(2020, time.May, 1), 365)
+
+	overdue, errMap := repo.ListOverdueVaccinations("", day(2024, time.March, 1), 0, 10)
+	require.Nil(t, errMap)
+	require.Len(t, overdue, 1, "only the latest dose of a vaccine counts and removed pets are ignored")
+	assert.Equal(t, rabies.Uuid, overdue[0].Uuid)
+
+	overdue, errMap = repo.ListOverdueVaccinations("", day(2024, time.June, 1), 0, 10)
+	require.Nil(t, errMap)
+	assert.Len(t, overdue, 2)
+
+	page, errMap := repo.ListOverdueVaccinations("", day(2024, time.June, 1), overdue[0].ID, 10)
+	require.Nil(t, errMap)
+	require.Len(t, page, 1)
+	assert.Equal(t, overdue[1].Uuid, page[0].Uuid)
+}
+
+func TestVaccinationRepository_UpdateKeepsPet(t *testing.T) {
+	db := newHealthTestDB(t)
+	defer db.Close()
+	repo := NewVaccinationRepository(db)
+
+	petUuid := uuid.New()
+	v := &entity.Vaccination{Uuid: uuid.New(), PetUuid: petUuid, Vaccine: "V10", Dose: 1, AdministeredAt: day(2024, time.January, 5)}
+	_, errMap := repo.CreateVaccination(v)
+	require.Nil(t, errMap)
+
+	v.PetUuid = uuid.New()
+	v.Dose = 2
+	v.BoosterIntervalDays = 21
+	v.ScheduleBooster()
+	updated, errMap := repo.UpdateVaccination(v)
+	require.Nil(t, errMap)
+	assert.Equal(t, petUuid, updated.PetUuid)
+	assert.Equal(t, 2, updated.Dose)
+	require.NotNil(t, updated.NextDueAt)
+	assert.True(t, updated.NextDueAt.Equal(day(2024, time.January, 26)))
+
+	list, errMap := repo.ListVaccinations(petUuid)
+	require.Nil(t, errMap)
+	assert.Len(t, list, 1)
+
+	_, errMap = repo.UpdateVaccination(&entity.Vaccination{Uuid: uuid.New()})
+	assert.NotEmpty(t, errMap["not_found"])
+}
@@ -0,0 +1,55 @@
+package resilience
+
+import (
+	"context"
+	"math/rand"
+	"time"
+)
+
+const (
+	DefaultAttempts  = 3
+	DefaultBaseDelay = 50 * time.Millisecond
+	DefaultMaxDelay  = time.Second
+)
+
+// Backoff decide quantas vezes tentar e quanto esperar entre as tentativas. A espera
+// é aleatória entre zero e BaseDelay*2^n, limitada a MaxDelay ("full jitter"), para as
+// instâncias não voltarem todas juntas depois de uma queda.
+type Backoff struct {
+	Attempts  int
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+}
+
+func DefaultBackoff() Backoff {
+	return Backoff{Attempts: DefaultAttempts, BaseDelay: DefaultBaseDelay, MaxDelay: DefaultMaxDelay}
+}
+
+// delay é a espera antes da tentativa seguinte à de número attempt (a primeira é 0).
+func (b Backoff) delay(attempt int) time.Duration {
+	ceiling := b.MaxDelay
+	if shifted := b.BaseDelay << attempt; shifted > 0 && shifted < ceiling {
+		ceiling = shifted
+	}
+	if ceiling <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(ceiling) + 1))
+}
+
+// wait dorme a espera da tentativa, a menos que ctx acabe antes ou que o prazo dele
+// não comporte a espera; nesses casos devolve false e não há nova tentativa.
+func (b Backoff) wait(ctx context.Context, attempt int) bool {
+	d := b.delay(attempt)
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) <= d {
+		return false
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,36 @@
+package resilience
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackoff_DelayIsCappedAndJittered(t *testing.T) {
+	b := Backoff{Attempts: 5, BaseDelay: 10 * time.Millisecond, MaxDelay: 40 * time.Millisecond}
+	for attempt := 0; attempt < 10; attempt++ {
+		d := b.delay(attempt)
+		assert.GreaterOrEqual(t, d, time.Duration(0))
+		assert.LessOrEqual(t, d, 40*time.Millisecond)
+		if attempt == 0 {
+			assert.LessOrEqual(t, d, 10*time.Millisecond)
+		}
+	}
+}
+
+func TestBackoff_WaitRespectsDeadline(t *testing.T) {
+	b := Backoff{Attempts: 3, BaseDelay: time.Second, MaxDelay: time.Second}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	// a espera pode sortear menos que o prazo; na pior das hipóteses acaba com ele
+	b.wait(ctx, 3)
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+
+	cancelled, cancelNow := context.WithCancel(context.Background())
+	cancelNow()
+	assert.False(t, Backoff{BaseDelay: time.Hour, MaxDelay: time.Hour}.wait(cancelled, 0))
+}
@@ -0,0 +1,103 @@
+package resilience
+
+import (
+	"log"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultBreakerThreshold = 5
+	DefaultBreakerCooldown  = 10 * time.Second
+)
+
+type breakerState int
+
+const (
+	closed breakerState = iota
+	open
+	halfOpen
+)
+
+// Breaker abre depois de threshold falhas seguidas e, aberto, recusa as chamadas sem
+// tocar no banco. Passado o cooldown deixa passar uma chamada de teste: se ela der
+// certo o circuito fecha, se falhar abre de novo.
+type Breaker struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	state     breakerState
+	failures  int
+	openedAt  time.Time
+	probing   bool
+	now       func() time.Time
+}
+
+func NewBreaker(threshold int, cooldown time.Duration) *Breaker {
+	if threshold <= 0 {
+		threshold = DefaultBreakerThreshold
+	}
+	return &Breaker{threshold: threshold, cooldown: cooldown, now: time.Now}
+}
+
+// Allow diz se a chamada pode ir ao banco. Cada Allow verdadeiro precisa de um Record.
+func (b *Breaker) Allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case open:
+		if b.now().Sub(b.openedAt) < b.cooldown {
+			return false
+		}
+		b.state = halfOpen
+		b.probing = true
+		return true
+	case halfOpen:
+		if b.probing {
+			return false
+		}
+		b.probing = true
+		return true
+	default:
+		return true
+	}
+}
+
+// Record registra o resultado de uma chamada liberada por Allow.
+func (b *Breaker) Record(failed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.state == halfOpen {
+		b.probing = false
+		if failed {
+			b.trip()
+		} else {
+			b.state = closed
+			b.failures = 0
+			log.Printf("database circuit breaker closed")
+		}
+		return
+	}
+	if !failed {
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.state == closed && b.failures >= b.threshold {
+		b.trip()
+	}
+}
+
+// Open diz se o circuito está recusando chamadas.
+func (b *Breaker) Open() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state != closed
+}
+
+func (b *Breaker) trip() {
+	b.state = open
+	b.openedAt = b.now()
+	b.failures = 0
+	log.Printf("database circuit breaker open for %s", b.cooldown)
+}
@@ -0,0 +1,39 @@
+package resilience
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBreaker_OpensAfterThresholdAndProbesAfterCooldown(t *testing.T) {
+	now := time.Now()
+	b := NewBreaker(2, time.Minute)
+	b.now = func() time.Time { return now }
+
+	assert.True(t, b.Allow())
+	b.Record(true)
+	assert.True(t, b.Allow())
+	b.Record(false)
+	assert.False(t, b.Open(), "a success resets the failure count")
+
+	for i := 0; i < 2; i++ {
+		assert.True(t, b.Allow())
+		b.Record(true)
+	}
+	assert.True(t, b.Open())
+	assert.False(t, b.Allow())
+
+	now = now.Add(time.Minute)
+	assert.True(t, b.Allow(), "one probe after the cooldown")
+	assert.False(t, b.Allow(), "only one probe at a time")
+	b.Record(true)
+	assert.False(t, b.Allow(), "a failed probe opens the circuit again")
+
+	now = now.Add(time.Minute)
+	assert.True(t, b.Allow())
+	b.Record(false)
+	assert.False(t, b.Open())
+	assert.True(t, b.Allow())
+}
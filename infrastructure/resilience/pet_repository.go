@@ -0,0 +1,358 @@
+package resilience
+
+import (
+	"context"
+	"time"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/LuizFJP/pet-ms/domain/repository"
+	"github.com/google/uuid"
+)
+
+// circuitOpen é o erro das chamadas recusadas pelo breaker; vira Unavailable no gRPC.
+var circuitOpen = map[string]string{"unavailable": "database unavailable: circuit breaker open"}
+
+// PetRepository repete as operações que falham com unavailable (o erro transitório
+// do persistence) e corta as chamadas enquanto o banco estiver fora.
+//
+// Escritas que não podem ser repetidas às cegas (SavePet, SavePets, InsertPets,
+// DeletePet) também são repetidas, mas se a repetição falhar depois de uma tentativa
+// unavailable o erro devolvido é o unavailable: a primeira pode ter sido gravada e o
+// "já existe" ou "não encontrado" da repetição enganaria o cliente.
+type PetRepository struct {
+	next    repository.PetRepository
+	backoff Backoff
+	breaker *Breaker
+	ctx     context.Context
+}
+
+type Option func(*PetRepository)
+
+func WithBackoff(backoff Backoff) Option {
+	return func(r *PetRepository) {
+		r.backoff = backoff
+	}
+}
+
+// WithBreaker troca o breaker; repositórios que usam o mesmo banco podem dividi-lo.
+func WithBreaker(breaker *Breaker) Option {
+	return func(r *PetRepository) {
+		r.breaker = breaker
+	}
+}
+
+func NewPetRepository(next repository.PetRepository, opts ...Option) *PetRepository {
+	r := &PetRepository{
+		next:    next,
+		backoff: DefaultBackoff(),
+		breaker: NewBreaker(DefaultBreakerThreshold, DefaultBreakerCooldown),
+		ctx:     context.Background(),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+var (
+	_ repository.PetRepository    = &PetRepository{}
+	_ repository.ContextBinder    = &PetRepository{}
+	_ repository.ReplicaRouter    = &PetRepository{}
+	_ repository.TenantScoper     = &PetRepository{}
+	_ repository.PetCache         = &PetRepository{}
+	_ repository.IdempotentWriter = &PetRepository{}
+)
+
+// ForTenant mantém as repetições e o breaker na visão do tenant.
+func (r *PetRepository) ForTenant(tenant string) repository.PetRepository {
+	scoper, ok := r.next.(repository.TenantScoper)
+	if !ok {
+		return r
+	}
+	view := *r
+	view.next = scoper.ForTenant(tenant)
+	return &view
+}
+
+// WithContext para de repetir quando ctx acaba ou quando o prazo dele não comporta
+// a próxima espera.
+func (r *PetRepository) WithContext(ctx context.Context) repository.PetRepository {
+	bound := *r
+	bound.ctx = ctx
+	return &bound
+}
+
+// ReadReplica mantém as repetições e o breaker na visão de leitura das réplicas.
+func (r *PetRepository) ReadReplica(lastWrite time.Time) repository.PetRepository {
+	router, ok := r.next.(repository.ReplicaRouter)
+	if !ok {
+		return r
+	}
+	view := *r
+	view.next = router.ReadReplica(lastWrite)
+	return &view
+}
+
+// Forget repassa a invalidação ao cache por baixo, se houver.
+func (r *PetRepository) Forget(ids ...uuid.UUID) {
+	if cache, ok := r.next.(repository.PetCache); ok {
+		cache.Forget(ids...)
+	}
+}
+
+func unavailable(errData map[string]string) bool {
+	_, ok := errData["unavailable"]
+	return ok
+}
+
+// do roda op até ela não falhar com unavailable, as tentativas acabarem ou o contexto
+// não permitir esperar. idempotent diz se uma repetição pode ter efeito duplicado.
+func (r *PetRepository) do(idempotent bool, op func() map[string]string) map[string]string {
+	var firstUnavailable, lastUnavailable map[string]string
+	for attempt := 0; ; attempt++ {
+		if err := r.ctx.Err(); err != nil {
+			if firstUnavailable != nil {
+				return firstUnavailable
+			}
+			return map[string]string{"aborted": err.Error()}
+		}
+		if !r.breaker.Allow() {
+			// o circuito abriu no meio das tentativas: o erro do banco diz mais
+			if lastUnavailable != nil {
+				return lastUnavailable
+			}
+			return circuitOpen
+		}
+		errData := op()
+		failed := unavailable(errData)
+		r.breaker.Record(failed)
+		if !failed {
+			if errData != nil && firstUnavailable != nil && !idempotent {
+				return firstUnavailable
+			}
+			return errData
+		}
+		if firstUnavailable == nil {
+			firstUnavailable = errData
+		}
+		lastUnavailable = errData
+		if attempt+1 >= r.backoff.Attempts || !r.backoff.wait(r.ctx, attempt) {
+			return errData
+		}
+	}
+}
+
+// itemsUnavailable resume os erros por item de um lote para do: o lote só é repetido
+// inteiro, então só quando tudo foi desfeito (allOrNothing).
+func itemsUnavailable(itemErrs []map[string]string) map[string]string {
+	for _, errData := range itemErrs {
+		if unavailable(errData) {
+			return errData
+		}
+	}
+	for _, errData := range itemErrs {
+		if errData != nil {
+			return errData
+		}
+	}
+	return nil
+}
+
+func (r *PetRepository) SavePet(pet *entity.Pet) (*entity.Pet, map[string]string) {
+	var saved *entity.Pet
+	errData := r.do(false, func() (errData map[string]string) {
+		saved, errData = r.next.SavePet(pet)
+		return errData
+	})
+	if errData != nil {
+		return nil, errData
+	}
+	return saved, nil
+}
+
+// SavePetWithIdempotencyKey repete como SavePet. Sem suporte no repositório por baixo
+// falha na hora, sem tentativas nem contar no breaker.
+func (r *PetRepository) SavePetWithIdempotencyKey(pet *entity.Pet, key *entity.IdempotencyKey) (*entity.Pet, map[string]string) {
+	writer, ok := r.next.(repository.IdempotentWriter)
+	if !ok {
+		return nil, map[string]string{"unavailable": "idempotency keys are not supported by the pet repository"}
+	}
+	var saved *entity.Pet
+	errData := r.do(false, func() (errData map[string]string) {
+		saved, errData = writer.SavePetWithIdempotencyKey(pet, key)
+		return errData
+	})
+	if errData != nil {
+		return nil, errData
+	}
+	return saved, nil
+}
+
+func (r *PetRepository) GetPet(id string) (*entity.Pet, map[string]string) {
+	var pet *entity.Pet
+	errData := r.do(true, func() (errData map[string]string) {
+		pet, errData = r.next.GetPet(id)
+		return errData
+	})
+	if errData != nil {
+		return nil, errData
+	}
+	return pet, nil
+}
+
+func (r *PetRepository) GetPetByMicrochip(number string) (*entity.Pet, map[string]string) {
+	var pet *entity.Pet
+	errData := r.do(true, func() (errData map[string]string) {
+		pet, errData = r.next.GetPetByMicrochip(number)
+		return errData
+	})
+	if errData != nil {
+		return nil, errData
+	}
+	return pet, nil
+}
+
+func (r *PetRepository) UpdatePet(pet *entity.Pet) (*entity.Pet, map[string]string) {
+	var updated *entity.Pet
+	errData := r.do(true, func() (errData map[string]string) {
+		updated, errData = r.next.UpdatePet(pet)
+		return errData
+	})
+	if errData != nil {
+		return nil, errData
+	}
+	return updated, nil
+}
+
+func (r *PetRepository) UpdatePetFields(pet *entity.Pet, fields []string) (*entity.Pet, map[string]string) {
+	var updated *entity.Pet
+	errData := r.do(true, func() (errData map[string]string) {
+		updated, errData = r.next.UpdatePetFields(pet, fields)
+		return errData
+	})
+	if errData != nil {
+		return nil, errData
+	}
+	return updated, nil
+}
+
+func (r *PetRepository) DeletePet(uuidGuardian string) (map[string]string, map[string]string) {
+	var res map[string]string
+	errData := r.do(false, func() (errData map[string]string) {
+		res, errData = r.next.DeletePet(uuidGuardian)
+		return errData
+	})
+	if errData != nil {
+		return nil, errData
+	}
+	return res, nil
+}
+
+func (r *PetRepository) TransferPet(petUuid string, uuidGuardian string) (*entity.Pet, map[string]string) {
+	var transferred *entity.Pet
+	errData := r.do(true, func() (errData map[string]string) {
+		transferred, errData = r.next.TransferPet(petUuid, uuidGuardian)
+		return errData
+	})
+	if errData != nil {
+		return nil, errData
+	}
+	return transferred, nil
+}
+
+func (r *PetRepository) ChangePetStatus(petUuid string, change entity.StatusChange) (*entity.Pet, map[string]string) {
+	var changed *entity.Pet
+	// a repetição de uma transição já gravada voltaria aborted, não o pet
+	errData := r.do(false, func() (errData map[string]string) {
+		changed, errData = r.next.ChangePetStatus(petUuid, change)
+		return errData
+	})
+	if errData != nil {
+		return nil, errData
+	}
+	return changed, nil
+}
+
+func (r *PetRepository) SavePets(pets []*entity.Pet, allOrNothing bool) ([]*entity.Pet, []map[string]string) {
+	return r.batch(pets, allOrNothing, false, r.next.SavePets)
+}
+
+func (r *PetRepository) UpdatePets(pets []*entity.Pet, allOrNothing bool) ([]*entity.Pet, []map[string]string) {
+	return r.batch(pets, allOrNothing, true, r.next.UpdatePets)
+}
+
+// batch repete só os lotes allOrNothing: no modo por item parte dos pets já foi
+// gravada e repetir tudo duplicaria o trabalho.
+func (r *PetRepository) batch(pets []*entity.Pet, allOrNothing, idempotent bool, op func([]*entity.Pet, bool) ([]*entity.Pet, []map[string]string)) ([]*entity.Pet, []map[string]string) {
+	if !allOrNothing {
+		if !r.breaker.Allow() {
+			return make([]*entity.Pet, len(pets)), repeatError(circuitOpen, len(pets))
+		}
+		results, itemErrs := op(pets, false)
+		r.breaker.Record(unavailable(itemsUnavailable(itemErrs)))
+		return results, itemErrs
+	}
+
+	var results []*entity.Pet
+	var itemErrs []map[string]string
+	errData := r.do(idempotent, func() map[string]string {
+		results, itemErrs = op(pets, true)
+		return itemsUnavailable(itemErrs)
+	})
+	if errData != nil && (itemErrs == nil || unavailable(errData) && !unavailable(itemsUnavailable(itemErrs))) {
+		// o lote não rodou (circuito aberto, contexto encerrado) ou do devolveu o
+		// unavailable de uma tentativa anterior
+		return make([]*entity.Pet, len(pets)), repeatError(errData, len(pets))
+	}
+	return results, itemErrs
+}
+
+func repeatError(errData map[string]string, n int) []map[string]string {
+	itemErrs := make([]map[string]string, n)
+	for i := range itemErrs {
+		itemErrs[i] = errData
+	}
+	return itemErrs
+}
+
+func (r *PetRepository) GetPets(uuids []string) ([]*entity.Pet, map[string]string) {
+	var pets []*entity.Pet
+	errData := r.do(true, func() (errData map[string]string) {
+		pets, errData = r.next.GetPets(uuids)
+		return errData
+	})
+	if errData != nil {
+		return nil, errData
+	}
+	return pets, nil
+}
+
+func (r *PetRepository) InsertPets(pets []*entity.Pet) map[string]string {
+	return r.do(false, func() map[string]string {
+		return r.next.InsertPets(pets)
+	})
+}
+
+func (r *PetRepository) ListPets(filter entity.PetFilter, afterUuid string, limit int) ([]*entity.Pet, map[string]string) {
+	var pets []*entity.Pet
+	errData := r.do(true, func() (errData map[string]string) {
+		pets, errData = r.next.ListPets(filter, afterUuid, limit)
+		return errData
+	})
+	if errData != nil {
+		return nil, errData
+	}
+	return pets, nil
+}
+
+func (r *PetRepository) SearchPets(search entity.PetSearch) ([]*entity.PetSearchHit, map[string]string) {
+	var hits []*entity.PetSearchHit
+	errData := r.do(true, func() (errData map[string]string) {
+		hits, errData = r.next.SearchPets(search)
+		return errData
+	})
+	if errData != nil {
+		return nil, errData
+	}
+	return hits, nil
+}
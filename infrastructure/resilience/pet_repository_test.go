@@ -0,0 +1,195 @@
+package resilience
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/LuizFJP/pet-ms/domain/repository"
+	"github.com/LuizFJP/pet-ms/domain/repository/repositorytest"
+	"github.com/LuizFJP/pet-ms/infrastructure/memory"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var dbDown = map[string]string{"unavailable": "database unavailable: connection reset by peer"}
+
+// flakyRepo falha com unavailable nas próximas failures chamadas; failAfter faz a
+// falha acontecer depois da chamada chegar ao repositório, como uma conexão que cai
+// antes da resposta.
+type flakyRepo struct {
+	repository.PetRepository
+	failures  int
+	failAfter bool
+	calls     int
+}
+
+func (r *flakyRepo) fail() bool {
+	r.calls++
+	if r.failures > 0 {
+		r.failures--
+		return true
+	}
+	return false
+}
+
+func (r *flakyRepo) GetPet(id string) (*entity.Pet, map[string]string) {
+	if r.fail() {
+		return nil, dbDown
+	}
+	return r.PetRepository.GetPet(id)
+}
+
+func (r *flakyRepo) SavePet(pet *entity.Pet) (*entity.Pet, map[string]string) {
+	failed := r.fail()
+	if failed && !r.failAfter {
+		return nil, dbDown
+	}
+	saved, errData := r.PetRepository.SavePet(pet)
+	if failed {
+		return nil, dbDown
+	}
+	return saved, errData
+}
+
+func (r *flakyRepo) SavePets(pets []*entity.Pet, allOrNothing bool) ([]*entity.Pet, []map[string]string) {
+	if r.fail() {
+		return make([]*entity.Pet, len(pets)), repeatError(dbDown, len(pets))
+	}
+	return r.PetRepository.SavePets(pets, allOrNothing)
+}
+
+var fastBackoff = Backoff{Attempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+
+func newFlakyRepo(failures int, opts ...Option) (*PetRepository, *flakyRepo) {
+	next := &flakyRepo{PetRepository: memory.NewPetRepository(), failures: failures}
+	return NewPetRepository(next, append([]Option{WithBackoff(fastBackoff)}, opts...)...), next
+}
+
+func newResiliencePet() *entity.Pet {
+	return &entity.Pet{Uuid: uuid.New(), UuidGuardian: uuid.New(), Name: "Rex", BirthYear: 2020, Breed: "SRD", Specie: entity.Dog}
+}
+
+func TestPetRepository_Conformance(t *testing.T) {
+	repositorytest.TestPetRepository(t, func(t *testing.T) repository.PetRepository {
+		return NewPetRepository(memory.NewPetRepository())
+	})
+}
+
+func TestPetRepository_RetriesTransientErrors(t *testing.T) {
+	repo, next := newFlakyRepo(0)
+	pet := newResiliencePet()
+	_, errData := repo.SavePet(pet)
+	require.Nil(t, errData)
+
+	next.failures, next.calls = 2, 0
+	got, errData := repo.GetPet(pet.Uuid.String())
+	require.Nil(t, errData)
+	assert.Equal(t, pet.Name, got.Name)
+	assert.Equal(t, 3, next.calls)
+
+	next.failures, next.calls = 5, 0
+	_, errData = repo.GetPet(pet.Uuid.String())
+	assert.Equal(t, dbDown, errData, "gives up after the configured attempts")
+	assert.Equal(t, 3, next.calls)
+}
+
+func TestPetRepository_DoesNotRetryOtherErrors(t *testing.T) {
+	repo, next := newFlakyRepo(0)
+	_, errData := repo.GetPet(uuid.NewString())
+	assert.Equal(t, map[string]string{"db_error": "record not found"}, errData)
+	assert.Equal(t, 1, next.calls)
+}
+
+func TestPetRepository_NonIdempotentRetryKeepsTransientError(t *testing.T) {
+	repo, next := newFlakyRepo(1)
+	next.failAfter = true
+	pet := newResiliencePet()
+
+	// a primeira tentativa gravou e perdeu a resposta; a segunda esbarra no próprio pet
+	_, errData := repo.SavePet(pet)
+	assert.Equal(t, dbDown, errData)
+	assert.Equal(t, 2, next.calls)
+
+	_, errData = repo.GetPet(pet.Uuid.String())
+	assert.Nil(t, errData)
+}
+
+// keyedRepo é o flakyRepo que grava com chave de idempotência.
+type keyedRepo struct {
+	flakyRepo
+	keys []string
+}
+
+func (r *keyedRepo) SavePetWithIdempotencyKey(pet *entity.Pet, key *entity.IdempotencyKey) (*entity.Pet, map[string]string) {
+	if r.fail() {
+		return nil, dbDown
+	}
+	r.keys = append(r.keys, key.Key)
+	return r.PetRepository.SavePet(pet)
+}
+
+func TestPetRepository_SavePetWithIdempotencyKey(t *testing.T) {
+	next := &keyedRepo{flakyRepo: flakyRepo{PetRepository: memory.NewPetRepository(), failures: 1}}
+	repo := NewPetRepository(next, WithBackoff(fastBackoff))
+
+	_, errData := repo.SavePetWithIdempotencyKey(newResiliencePet(), &entity.IdempotencyKey{Key: "k-1"})
+	require.Nil(t, errData)
+	assert.Equal(t, 2, next.calls)
+	assert.Equal(t, []string{"k-1"}, next.keys)
+
+	// sem suporte por baixo falha sem tentar de novo
+	plain, flaky := newFlakyRepo(0)
+	_, errData = plain.SavePetWithIdempotencyKey(newResiliencePet(), &entity.IdempotencyKey{Key: "k-1"})
+	assert.NotEmpty(t, errData["unavailable"])
+	assert.Equal(t, 0, flaky.calls)
+}
+
+func TestPetRepository_BatchRetriesOnlyAllOrNothing(t *testing.T) {
+	repo, next := newFlakyRepo(1)
+	saved, itemErrs := repo.SavePets([]*entity.Pet{newResiliencePet(), newResiliencePet()}, true)
+	assert.Equal(t, []map[string]string{nil, nil}, itemErrs)
+	assert.NotNil(t, saved[0])
+	assert.Equal(t, 2, next.calls)
+
+	next.failures, next.calls = 1, 0
+	saved, itemErrs = repo.SavePets([]*entity.Pet{newResiliencePet()}, false)
+	assert.Equal(t, []map[string]string{dbDown}, itemErrs)
+	assert.Nil(t, saved[0])
+	assert.Equal(t, 1, next.calls)
+}
+
+func TestPetRepository_OpenCircuitFailsFast(t *testing.T) {
+	repo, next := newFlakyRepo(100, WithBreaker(NewBreaker(2, time.Hour)))
+
+	_, errData := repo.GetPet(uuid.NewString())
+	assert.Equal(t, dbDown, errData)
+	assert.Equal(t, 2, next.calls, "the breaker opens mid-retry")
+
+	_, errData = repo.GetPet(uuid.NewString())
+	assert.Equal(t, circuitOpen, errData)
+	saved, itemErrs := repo.SavePets([]*entity.Pet{newResiliencePet()}, false)
+	assert.Equal(t, []map[string]string{circuitOpen}, itemErrs)
+	assert.Len(t, saved, 1)
+	assert.Equal(t, 2, next.calls)
+}
+
+func TestPetRepository_WithContextStopsRetrying(t *testing.T) {
+	repo, next := newFlakyRepo(100, WithBackoff(Backoff{Attempts: 10, BaseDelay: time.Hour, MaxDelay: time.Hour}))
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	start := time.Now()
+	_, errData := repo.WithContext(ctx).GetPet(uuid.NewString())
+	assert.Equal(t, dbDown, errData)
+	assert.Equal(t, 1, next.calls, "a wait longer than the deadline is not attempted")
+	assert.Less(t, time.Since(start), time.Second)
+
+	cancel()
+	next.calls = 0
+	_, errData = repo.WithContext(ctx).GetPet(uuid.NewString())
+	assert.Contains(t, errData, "aborted")
+	assert.Equal(t, 0, next.calls)
+}
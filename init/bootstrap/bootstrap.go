@@ -0,0 +1,466 @@
+// Package bootstrap monta a aplicação a partir da configuração: repositórios,
+// migrações, cache, outbox e as opções do application layer.
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/LuizFJP/pet-ms/application"
+	"github.com/LuizFJP/pet-ms/domain/repository"
+	"github.com/LuizFJP/pet-ms/infrastructure/blobstore"
+	"github.com/LuizFJP/pet-ms/infrastructure/cache"
+	"github.com/LuizFJP/pet-ms/infrastructure/eventbus"
+	"github.com/LuizFJP/pet-ms/infrastructure/guardians"
+	"github.com/LuizFJP/pet-ms/infrastructure/memory"
+	"github.com/LuizFJP/pet-ms/infrastructure/outbox"
+	"github.com/LuizFJP/pet-ms/infrastructure/persistence"
+	"github.com/LuizFJP/pet-ms/infrastructure/resilience"
+	server "github.com/LuizFJP/pet-ms/interfaces/grpc"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/redis/go-redis/v9"
+)
+
+// Config centraliza parâmetros de infra
+type Config struct {
+	// StorageBackend escolhe onde ficam os pets: postgres, sqlite ou memory. Em memory
+	// nada é persistido e os recursos que dependem das outras tabelas ficam desligados.
+	StorageBackend string
+	// SQLitePath é o arquivo do banco quando StorageBackend é sqlite.
+	SQLitePath string
+
+	DBDriver   string
+	DBUser     string
+	DBPassword string
+	DBPort     string
+	DBHost     string
+	DBName     string
+	GRPCAddr   string
+
+	// ReplicaDSNs são as réplicas de leitura do Postgres; vazio lê tudo do primário.
+	ReplicaDSNs          []string
+	ReplicaMaxLag        time.Duration
+	ReplicaCheckInterval time.Duration
+
+	// DBRetry e o breaker protegem as chamadas ao repositório de pets: erros
+	// transitórios são repetidos e, com o banco fora, as chamadas falham na hora.
+	DBRetry          resilience.Backoff
+	BreakerThreshold int
+	BreakerCooldown  time.Duration
+
+	// GuardianRegistry decide como uuid_guardian é conferido: none (não é), internal
+	// (cadastro deste serviço, com chave estrangeira nos pets) ou external (serviço de
+	// guardiões em GuardianService).
+	GuardianRegistry string
+	GuardianService  guardians.HTTPConfig
+
+	// RequireTenant recusa as chamadas sem x-tenant-id; TenantRLS reforça o isolamento
+	// com row-level security no Postgres, além dos filtros do repositório.
+	RequireTenant bool
+	TenantRLS     bool
+
+	IdempotencyTTL time.Duration
+
+	// OutboxBroker escolhe para onde o relay publica: none, memory, kafka ou nats.
+	OutboxBroker        string
+	OutboxRelayInterval time.Duration
+	KafkaBrokers        []string
+	KafkaTopic          string
+	NatsURL             string
+	NatsSubjectPrefix   string
+
+	// BlobStore guarda os anexos: none (anexos desligados), local ou s3.
+	BlobStore    string
+	BlobLocalDir string
+	S3           blobstore.S3Config
+
+	// PetCache põe um cache de leitura na frente de GetPet: none, memory (LRU do
+	// processo) ou redis (compartilhado entre as instâncias).
+	PetCache            string
+	PetCacheSize        int
+	PetCacheTTL         time.Duration
+	PetCacheNegativeTTL time.Duration
+	RedisAddr           string
+	RedisPassword       string
+
+	// DisableWorkers sobe sem as tarefas de fundo (relay da outbox e limpeza das chaves
+	// de idempotência), que ficam com o servidor. Os eventos continuam indo para a
+	// outbox. É o modo do petctl.
+	DisableWorkers bool
+}
+
+// LoadConfig pode vir de env, flags, etc.
+func LoadConfig() Config {
+	return Config{
+		StorageBackend: getEnv("STORAGE_BACKEND", "postgres"),
+		SQLitePath:     getEnv("SQLITE_PATH", "/var/lib/pet-ms/pets.db"),
+
+		DBDriver:   getEnv("DB_DRIVER", "postgres"),
+		DBUser:     getEnv("DB_USER", "lgc_user"),
+		DBPassword: getEnv("DB_PASSWORD", "lgc_teste_password"),
+		DBPort:     getEnv("DB_PORT", "5432"),
+		DBHost:     getEnv("DB_HOST", "pg_pet"),
+		DBName:     getEnv("DB_NAME", "pet_db"),
+		GRPCAddr:   getEnv("GRPC_ADDR", ":50051"),
+
+		ReplicaDSNs:          getListEnv("REPLICA_DSNS"),
+		ReplicaMaxLag:        getDurationEnv("REPLICA_MAX_LAG", persistence.DefaultReplicaMaxLag),
+		ReplicaCheckInterval: getDurationEnv("REPLICA_CHECK_INTERVAL", persistence.DefaultReplicaCheckInterval),
+
+		DBRetry: resilience.Backoff{
+			Attempts:  getIntEnv("DB_RETRY_ATTEMPTS", resilience.DefaultAttempts),
+			BaseDelay: getDurationEnv("DB_RETRY_BASE_DELAY", resilience.DefaultBaseDelay),
+			MaxDelay:  getDurationEnv("DB_RETRY_MAX_DELAY", resilience.DefaultMaxDelay),
+		},
+		BreakerThreshold: getIntEnv("DB_BREAKER_THRESHOLD", resilience.DefaultBreakerThreshold),
+		BreakerCooldown:  getDurationEnv("DB_BREAKER_COOLDOWN", resilience.DefaultBreakerCooldown),
+
+		GuardianRegistry: getEnv("GUARDIAN_REGISTRY", "none"),
+		GuardianService: guardians.HTTPConfig{
+			BaseURL: getEnv("GUARDIAN_SERVICE_URL", "http://guardians:8080"),
+			Token:   os.Getenv("GUARDIAN_SERVICE_TOKEN"),
+			Timeout: getDurationEnv("GUARDIAN_SERVICE_TIMEOUT", guardians.DefaultTimeout),
+		},
+
+		RequireTenant: getEnv("REQUIRE_TENANT", "false") == "true",
+		TenantRLS:     getEnv("TENANT_RLS", "false") == "true",
+
+		IdempotencyTTL: getDurationEnv("IDEMPOTENCY_TTL", application.DefaultIdempotencyTTL),
+
+		OutboxBroker:        getEnv("OUTBOX_BROKER", "none"),
+		OutboxRelayInterval: getDurationEnv("OUTBOX_RELAY_INTERVAL", outbox.DefaultRelayInterval),
+		KafkaBrokers:        strings.Split(getEnv("KAFKA_BROKERS", "kafka:9092"), ","),
+		KafkaTopic:          getEnv("KAFKA_TOPIC", "pet-events"),
+		NatsURL:             getEnv("NATS_URL", "nats://nats:4222"),
+		NatsSubjectPrefix:   getEnv("NATS_SUBJECT_PREFIX", "pets"),
+
+		BlobStore:    getEnv("BLOB_STORE", "none"),
+		BlobLocalDir: getEnv("BLOB_LOCAL_DIR", "/var/lib/pet-ms/attachments"),
+		S3: blobstore.S3Config{
+			Endpoint:        getEnv("S3_ENDPOINT", "https://s3.amazonaws.com"),
+			Region:          getEnv("S3_REGION", "us-east-1"),
+			Bucket:          getEnv("S3_BUCKET", "pet-attachments"),
+			AccessKeyID:     os.Getenv("S3_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("S3_SECRET_ACCESS_KEY"),
+			PathStyle:       getEnv("S3_PATH_STYLE", "false") == "true",
+		},
+
+		PetCache:            getEnv("PET_CACHE", "none"),
+		PetCacheSize:        getIntEnv("PET_CACHE_SIZE", cache.DefaultLRUSize),
+		PetCacheTTL:         getDurationEnv("PET_CACHE_TTL", cache.DefaultTTL),
+		PetCacheNegativeTTL: getDurationEnv("PET_CACHE_NEGATIVE_TTL", cache.DefaultNegativeTTL),
+		RedisAddr:           getEnv("REDIS_ADDR", "redis:6379"),
+		RedisPassword:       os.Getenv("REDIS_PASSWORD"),
+	}
+}
+
+func getEnv(key, def string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return def
+}
+
+func getDurationEnv(key string, def time.Duration) time.Duration {
+	if v := os.Getenv(key); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			return d
+		}
+		log.Printf("invalid duration for %s: %q, using %s", key, v, def)
+	}
+	return def
+}
+
+// getListEnv separa a variável por vírgulas, ignorando itens vazios.
+func getListEnv(key string) []string {
+	var items []string
+	for _, item := range strings.Split(os.Getenv(key), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func getIntEnv(key string, def int) int {
+	if v := os.Getenv(key); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			return n
+		}
+		log.Printf("invalid integer for %s: %q, using %d", key, v, def)
+	}
+	return def
+}
+
+// App inicializa banco, automigrate e application layer. É o mesmo para o servidor
+// e para o petctl, que usa o banco direto.
+func App(cfg Config) (*application.PetApplicationInterface, func(), error) {
+	switch cfg.StorageBackend {
+	case "", "postgres":
+		return bootstrapDatabase(cfg, func() (*persistence.Repositories, error) {
+			return persistence.NewPetRepo(cfg.DBDriver, cfg.DBUser, cfg.DBPassword, cfg.DBPort, cfg.DBHost, cfg.DBName)
+		})
+	case "sqlite":
+		if len(cfg.ReplicaDSNs) > 0 {
+			return nil, nil, fmt.Errorf("REPLICA_DSNS requires STORAGE_BACKEND=postgres")
+		}
+		return bootstrapDatabase(cfg, func() (*persistence.Repositories, error) {
+			return persistence.NewSQLiteRepo(cfg.SQLitePath)
+		})
+	case "memory":
+		return bootstrapMemory(cfg)
+	default:
+		return nil, nil, fmt.Errorf("unknown STORAGE_BACKEND %q", cfg.StorageBackend)
+	}
+}
+
+// bootstrapMemory sobe só com os pets em memória, para testes e desenvolvimento local.
+// Idempotência, auditoria, catálogos editáveis, saúde, anexos e outbox precisam do
+// banco e ficam desligados.
+func bootstrapMemory(cfg Config) (*application.PetApplicationInterface, func(), error) {
+	if cfg.OutboxBroker != "" && cfg.OutboxBroker != "none" {
+		return nil, nil, fmt.Errorf("OUTBOX_BROKER=%s requires STORAGE_BACKEND=postgres or sqlite", cfg.OutboxBroker)
+	}
+	if cfg.BlobStore != "" && cfg.BlobStore != "none" {
+		return nil, nil, fmt.Errorf("BLOB_STORE=%s requires STORAGE_BACKEND=postgres or sqlite", cfg.BlobStore)
+	}
+	if cfg.PetCache != "" && cfg.PetCache != "none" {
+		return nil, nil, fmt.Errorf("PET_CACHE=%s requires STORAGE_BACKEND=postgres or sqlite", cfg.PetCache)
+	}
+	if len(cfg.ReplicaDSNs) > 0 {
+		return nil, nil, fmt.Errorf("REPLICA_DSNS requires STORAGE_BACKEND=postgres")
+	}
+	if cfg.TenantRLS {
+		return nil, nil, fmt.Errorf("TENANT_RLS requires STORAGE_BACKEND=postgres")
+	}
+
+	opts, err := guardianOptions(cfg, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	log.Printf("using in-memory storage: data is lost on restart")
+	opts = append(opts, application.WithEventBus(eventbus.NewMemoryBus(eventbus.DefaultRetention)))
+	app := application.NewPetApplication(memory.NewPetRepository(), opts...)
+	return &app, func() {}, nil
+}
+
+// bootstrapDatabase sobe com todos os recursos sobre os repositórios de open, que é
+// o mesmo para Postgres e SQLite.
+func bootstrapDatabase(cfg Config, open func() (*persistence.Repositories, error)) (*application.PetApplicationInterface, func(), error) {
+	services, err := open()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = services.Automigrate()
+	if err != nil {
+		services.Close()
+		return nil, nil, err
+	}
+	if cfg.TenantRLS {
+		if err := services.EnableRowLevelSecurity(); err != nil {
+			services.Close()
+			return nil, nil, err
+		}
+	}
+	guardianOpts, err := guardianOptions(cfg, services.Guardian)
+	if err != nil {
+		services.Close()
+		return nil, nil, err
+	}
+	if cfg.GuardianRegistry == "internal" {
+		if err := services.EnableGuardianForeignKey(); err != nil {
+			services.Close()
+			return nil, nil, err
+		}
+	}
+	if len(cfg.ReplicaDSNs) > 0 {
+		if err := services.EnableReplicas(cfg.DBDriver, cfg.ReplicaDSNs, cfg.ReplicaMaxLag, cfg.ReplicaCheckInterval); err != nil {
+			services.Close()
+			return nil, nil, err
+		}
+	}
+
+	blobs, err := newBlobStore(cfg)
+	if err != nil {
+		services.Close()
+		return nil, nil, err
+	}
+	cacheBackend, err := newCacheBackend(cfg)
+	if err != nil {
+		services.Close()
+		return nil, nil, err
+	}
+	var broker outbox.Broker
+	if !cfg.DisableWorkers {
+		if broker, err = newOutboxBroker(cfg); err != nil {
+			services.Close()
+			return nil, nil, err
+		}
+	}
+	species := application.NewSpeciesCatalog(services.Species, application.DefaultSpeciesCacheTTL)
+	stopRelay := func() {}
+	if cfg.OutboxBroker != "" && cfg.OutboxBroker != "none" {
+		services.EnableOutbox(server.NewPetEventEncoder(species.Lookup))
+	}
+	if broker != nil {
+		stopRelay = startOutboxRelay(outbox.NewRelay(services.Outbox, broker, cfg.OutboxRelayInterval, outbox.DefaultRelayBatchSize))
+	}
+	// o cache fica por fora da outbox, para as escritas dele passarem por ela
+	if cacheBackend != nil {
+		services.Pet = cache.NewPetRepository(services.Pet, cacheBackend,
+			cache.WithTTL(cfg.PetCacheTTL, cfg.PetCacheNegativeTTL), cache.WithMetrics(prometheus.DefaultRegisterer))
+	}
+	// as repetições ficam por fora de tudo, para valerem também nas faltas do cache
+	services.Pet = resilience.NewPetRepository(services.Pet,
+		resilience.WithBackoff(cfg.DBRetry), resilience.WithBreaker(resilience.NewBreaker(cfg.BreakerThreshold, cfg.BreakerCooldown)))
+
+	stopJanitor := func() {}
+	if !cfg.DisableWorkers {
+		stopJanitor = startIdempotencyJanitor(services.Idempotency, time.Hour)
+	}
+	cleanup := func() {
+		stopJanitor()
+		stopRelay()
+		if broker != nil {
+			broker.Close()
+		}
+		if closer, ok := cacheBackend.(io.Closer); ok {
+			closer.Close()
+		}
+		services.Close()
+	}
+
+	opts := []application.Option{
+		application.WithIdempotency(services.Idempotency, cfg.IdempotencyTTL),
+		application.WithEventBus(eventbus.NewMemoryBus(eventbus.DefaultRetention)),
+		application.WithAudit(services.Audit),
+		application.WithSpeciesCatalog(species),
+		application.WithBreedCatalog(application.NewBreedCatalog(services.Breed, application.DefaultBreedCacheTTL)),
+		application.WithHealthRecords(services.Vaccination, services.Medical),
+		application.WithPetGuardians(services.PetGuardian),
+		application.WithAdoptions(services.Adoption),
+	}
+	if blobs != nil {
+		opts = append(opts, application.WithAttachments(services.Attachment, blobs))
+	}
+	opts = append(opts, guardianOpts...)
+	app := application.NewPetApplication(services.Pet, opts...)
+
+	return &app, cleanup, nil
+}
+
+// startIdempotencyJanitor remove periodicamente as chaves de idempotência expiradas.
+func startIdempotencyJanitor(repo repository.IdempotencyRepository, interval time.Duration) func() {
+	ticker := time.NewTicker(interval)
+	done := make(chan struct{})
+
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				if _, errData := repo.DeleteExpiredIdempotencyKeys(time.Now()); errData != nil {
+					log.Printf("failed to purge idempotency keys: %v", errData)
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return func() {
+		ticker.Stop()
+		close(done)
+	}
+}
+
+// newOutboxBroker devolve nil quando a outbox está desligada.
+func newOutboxBroker(cfg Config) (outbox.Broker, error) {
+	switch cfg.OutboxBroker {
+	case "", "none":
+		return nil, nil
+	case "memory":
+		return outbox.NewMemoryBroker(), nil
+	case "kafka":
+		return outbox.NewKafkaBroker(cfg.KafkaBrokers, cfg.KafkaTopic), nil
+	case "nats":
+		return outbox.NewNatsBroker(cfg.NatsURL, cfg.NatsSubjectPrefix)
+	default:
+		return nil, fmt.Errorf("unknown OUTBOX_BROKER %q", cfg.OutboxBroker)
+	}
+}
+
+// newCacheBackend devolve nil quando o cache está desligado.
+func newCacheBackend(cfg Config) (cache.Backend, error) {
+	switch cfg.PetCache {
+	case "", "none":
+		return nil, nil
+	case "memory":
+		return cache.NewLRU(cfg.PetCacheSize), nil
+	case "redis":
+		client := redis.NewClient(&redis.Options{Addr: cfg.RedisAddr, Password: cfg.RedisPassword})
+		return cache.NewRedis(client, "pet-ms:"), nil
+	default:
+		return nil, fmt.Errorf("unknown PET_CACHE %q", cfg.PetCache)
+	}
+}
+
+// guardianOptions liga o cadastro ou a conferência de guardiões. registry é o cadastro
+// local, nil quando o backend não tem banco.
+func guardianOptions(cfg Config, registry *persistence.GuardianRepo) ([]application.Option, error) {
+	switch cfg.GuardianRegistry {
+	case "", "none":
+		return nil, nil
+	case "internal":
+		if registry == nil {
+			return nil, fmt.Errorf("GUARDIAN_REGISTRY=internal requires STORAGE_BACKEND=postgres or sqlite")
+		}
+		return []application.Option{application.WithGuardians(registry), application.WithGuardianCheck(registry)}, nil
+	case "external":
+		directory, err := guardians.NewHTTPDirectory(cfg.GuardianService)
+		if err != nil {
+			return nil, err
+		}
+		return []application.Option{application.WithGuardianCheck(directory)}, nil
+	default:
+		return nil, fmt.Errorf("unknown GUARDIAN_REGISTRY %q", cfg.GuardianRegistry)
+	}
+}
+
+// newBlobStore devolve nil quando os anexos estão desligados.
+func newBlobStore(cfg Config) (repository.BlobStore, error) {
+	switch cfg.BlobStore {
+	case "", "none":
+		return nil, nil
+	case "local":
+		return blobstore.NewLocalStore(cfg.BlobLocalDir)
+	case "s3":
+		return blobstore.NewS3Store(cfg.S3)
+	default:
+		return nil, fmt.Errorf("unknown BLOB_STORE %q", cfg.BlobStore)
+	}
+}
+
+// startOutboxRelay roda o relay em background até a função devolvida ser chamada.
+func startOutboxRelay(relay *outbox.Relay) func() {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		relay.Run(ctx)
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}
+}
@@ -0,0 +1,159 @@
+package bootstrap
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApp_MemoryBackend(t *testing.T) {
+	app, cleanup, err := App(Config{StorageBackend: "memory"})
+	require.NoError(t, err)
+	defer cleanup()
+
+	pet := &entity.Pet{Uuid: uuid.New(), UuidGuardian: uuid.New(), Name: "Rex", BirthYear: 2020, Breed: "SRD", Specie: entity.Dog}
+	_, errData := (*app).SavePet(context.Background(), pet)
+	require.Nil(t, errData)
+
+	got, errData := (*app).GetPet(context.Background(), pet.Uuid.String())
+	require.Nil(t, errData)
+	assert.Equal(t, "Rex", got.Name)
+
+	// sem banco não há onde gravar as chaves de idempotência
+	other := &entity.Pet{Uuid: uuid.New(), UuidGuardian: uuid.New(), Name: "Mel", BirthYear: 2020, Breed: "SRD", Specie: entity.Dog}
+	_, errData = (*app).SavePetWithIdempotencyKey(context.Background(), "k-1", "h", other)
+	assert.NotEmpty(t, errData["unavailable"])
+}
+
+func TestApp_IdempotentCreateThroughWrappers(t *testing.T) {
+	cfg := Config{StorageBackend: "sqlite", SQLitePath: filepath.Join(t.TempDir(), "pets.db"), PetCache: "memory", IdempotencyTTL: time.Hour}
+	app, cleanup, err := App(cfg)
+	require.NoError(t, err)
+	defer cleanup()
+	ctx := context.Background()
+
+	pet := &entity.Pet{Uuid: uuid.New(), UuidGuardian: uuid.New(), Name: "Rex", BirthYear: 2020, Breed: "SRD", Specie: entity.Dog}
+	// a leitura antes da criação deixa o pet marcado como inexistente no cache
+	_, errData := (*app).GetPet(ctx, pet.Uuid.String())
+	require.NotNil(t, errData)
+
+	first, errData := (*app).SavePetWithIdempotencyKey(ctx, "k-1", "h", pet)
+	require.Nil(t, errData)
+	again := &entity.Pet{Uuid: uuid.New(), UuidGuardian: pet.UuidGuardian, Name: "Rex", BirthYear: 2020, Breed: "SRD", Specie: entity.Dog}
+	second, errData := (*app).SavePetWithIdempotencyKey(ctx, "k-1", "h", again)
+	require.Nil(t, errData)
+	assert.Equal(t, first.Uuid, second.Uuid, "the retry replays the first pet")
+
+	got, errData := (*app).GetPet(ctx, pet.Uuid.String())
+	require.Nil(t, errData, "the create must go through the cache and invalidate it")
+	assert.Equal(t, "Rex", got.Name)
+}
+
+func TestApp_RejectsInvalidStorage(t *testing.T) {
+	_, _, err := App(Config{StorageBackend: "mongo"})
+	assert.Error(t, err)
+
+	_, _, err = App(Config{StorageBackend: "memory", OutboxBroker: "kafka"})
+	assert.Error(t, err, "the outbox needs the database")
+}
+
+func TestApp_SQLiteBackend(t *testing.T) {
+	cfg := Config{StorageBackend: "sqlite", SQLitePath: filepath.Join(t.TempDir(), "pets.db")}
+	app, cleanup, err := App(cfg)
+	require.NoError(t, err)
+
+	pet := &entity.Pet{Uuid: uuid.New(), UuidGuardian: uuid.New(), Name: "Rex", BirthYear: 2020, Breed: "SRD", Specie: entity.Dog}
+	_, errData := (*app).SavePet(context.Background(), pet)
+	require.Nil(t, errData)
+	cleanup()
+
+	// os dados sobrevivem a um novo bootstrap sobre o mesmo arquivo
+	app, cleanup, err = App(cfg)
+	require.NoError(t, err)
+	defer cleanup()
+	got, errData := (*app).GetPet(context.Background(), pet.Uuid.String())
+	require.Nil(t, errData)
+	assert.Equal(t, "Rex", got.Name)
+}
+
+func TestApp_PetCache(t *testing.T) {
+	cfg := Config{StorageBackend: "sqlite", SQLitePath: filepath.Join(t.TempDir(), "pets.db"), PetCache: "memory"}
+	app, cleanup, err := App(cfg)
+	require.NoError(t, err)
+	defer cleanup()
+
+	pet := &entity.Pet{Uuid: uuid.New(), UuidGuardian: uuid.New(), Name: "Rex", BirthYear: 2020, Breed: "SRD", Specie: entity.Dog}
+	_, errData := (*app).SavePet(context.Background(), pet)
+	require.Nil(t, errData)
+	_, errData = (*app).GetPet(context.Background(), pet.Uuid.String())
+	require.Nil(t, errData)
+
+	pet.Name = "Thor"
+	_, errData = (*app).UpdatePet(context.Background(), pet)
+	require.Nil(t, errData)
+	got, errData := (*app).GetPet(context.Background(), pet.Uuid.String())
+	require.Nil(t, errData)
+	assert.Equal(t, "Thor", got.Name)
+
+	_, _, err = App(Config{StorageBackend: "sqlite", SQLitePath: filepath.Join(t.TempDir(), "other.db"), PetCache: "memcached"})
+	assert.Error(t, err)
+	_, _, err = App(Config{StorageBackend: "memory", PetCache: "memory"})
+	assert.Error(t, err)
+}
+
+func TestApp_GuardianRegistry(t *testing.T) {
+	cfg := Config{StorageBackend: "sqlite", SQLitePath: filepath.Join(t.TempDir(), "pets.db"), GuardianRegistry: "internal"}
+	app, cleanup, err := App(cfg)
+	require.NoError(t, err)
+	defer cleanup()
+
+	pet := &entity.Pet{Uuid: uuid.New(), UuidGuardian: uuid.New(), Name: "Rex", BirthYear: 2020, Breed: "SRD", Specie: entity.Dog}
+	_, errData := (*app).SavePet(context.Background(), pet)
+	assert.NotEmpty(t, errData["invalid_argument"])
+
+	guardian, errData := (*app).CreateGuardian(context.Background(), &entity.Guardian{Name: "Ana", Email: "ana@example.com"})
+	require.Nil(t, errData)
+	pet.UuidGuardian = guardian.Uuid
+	_, errData = (*app).SavePet(context.Background(), pet)
+	require.Nil(t, errData)
+
+	_, _, err = App(Config{StorageBackend: "memory", GuardianRegistry: "internal"})
+	assert.Error(t, err)
+	_, _, err = App(Config{StorageBackend: "memory", GuardianRegistry: "ldap"})
+	assert.Error(t, err)
+}
+
+func TestApp_AdoptionApplications(t *testing.T) {
+	app, cleanup, err := App(Config{StorageBackend: "sqlite", SQLitePath: filepath.Join(t.TempDir(), "pets.db")})
+	require.NoError(t, err)
+	defer cleanup()
+	ctx := context.Background()
+
+	pet := &entity.Pet{Uuid: uuid.New(), UuidGuardian: uuid.New(), Name: "Rex", BirthYear: 2020, Breed: "SRD", Specie: entity.Dog}
+	_, errData := (*app).SavePet(ctx, pet)
+	require.Nil(t, errData)
+	_, errData = (*app).TransitionPet(ctx, pet.Uuid.String(), entity.StatusTransition{To: entity.StatusAvailable})
+	require.Nil(t, errData)
+
+	chosen, errData := (*app).SubmitApplication(ctx, &entity.AdoptionApplication{PetUuid: pet.Uuid, ApplicantUuid: uuid.New()})
+	require.Nil(t, errData)
+	competing, errData := (*app).SubmitApplication(ctx, &entity.AdoptionApplication{PetUuid: pet.Uuid, ApplicantUuid: uuid.New()})
+	require.Nil(t, errData)
+
+	approval, errData := (*app).ApproveApplication(ctx, chosen.Uuid.String(), "")
+	require.Nil(t, errData)
+	require.Len(t, approval.Rejected, 1)
+	assert.Equal(t, competing.Uuid, approval.Rejected[0].Uuid)
+
+	reserved, errData := (*app).GetPet(ctx, pet.Uuid.String())
+	require.Nil(t, errData)
+	assert.Equal(t, entity.StatusReserved, reserved.Status)
+	_, errData = (*app).SubmitApplication(ctx, &entity.AdoptionApplication{PetUuid: pet.Uuid, ApplicantUuid: uuid.New()})
+	assert.NotEmpty(t, errData["failed_precondition"])
+}
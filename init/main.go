@@ -2,12 +2,11 @@ package main
 
 import (
 	"github.com/LuizFJP/pet-ms/application"
-	"github.com/LuizFJP/pet-ms/infrastructure/persistence"
+	"github.com/LuizFJP/pet-ms/init/bootstrap"
 	server "github.com/LuizFJP/pet-ms/interfaces/grpc"
 	pb "github.com/LuizFJP/pet-ms/proto"
 	"log"
 	"net"
-	"os"
 
 	grpcprometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/prometheus/client_golang/prometheus"
@@ -15,72 +14,14 @@ import (
 	"google.golang.org/grpc/reflection"
 )
 
-// Config centraliza parâmetros de infra
-type Config struct {
-	DBDriver   string
-	DBUser     string
-	DBPassword string
-	DBPort     string
-	DBHost     string
-	DBName     string
-	GRPCAddr   string
-}
-
-// LoadConfig pode vir de env, flags, etc.
-func LoadConfig() Config {
-	return Config{
-		DBDriver:   getEnv("DB_DRIVER", "postgres"),
-		DBUser:     getEnv("DB_USER", "lgc_user"),
-		DBPassword: getEnv("DB_PASSWORD", "lgc_teste_password"),
-		DBPort:     getEnv("DB_PORT", "5432"),
-		DBHost:     getEnv("DB_HOST", "pg_pet"),
-		DBName:     getEnv("DB_NAME", "pet_db"),
-		GRPCAddr:   getEnv("GRPC_ADDR", ":50051"),
-	}
-}
-
-func getEnv(key, def string) string {
-	if v := os.Getenv(key); v != "" {
-		return v
-	}
-	return def
-}
-
-// bootstrapApp inicializa banco, automigrate e application layer.
-// Isso aqui é facilmente mockável num teste.
-func bootstrapApp(cfg Config) (*application.PetApplicationInterface, func(), error) {
-	services, err := persistence.NewPetRepo(
-		cfg.DBDriver,
-		cfg.DBUser,
-		cfg.DBPassword,
-		cfg.DBPort,
-		cfg.DBHost,
-		cfg.DBName,
-	)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	cleanup := func() {
-		services.Close()
-	}
-
-	err = services.Automigrate()
-	if err != nil {
-		return nil, nil, err
-	}
-
-	app := application.NewPetApplication(services.Pet)
-
-	return &app, cleanup, nil
-}
-
 // newGRPCServer cria o servidor gRPC com interceptors, reflection e serviço registrado.
 // Essa função é totalmente testável sem banco nem rede.
-func newGRPCServer(app *application.PetApplicationInterface) *grpc.Server {
+func newGRPCServer(app *application.PetApplicationInterface, requireTenant bool) *grpc.Server {
 	s := grpc.NewServer(
-		grpc.UnaryInterceptor(grpcprometheus.UnaryServerInterceptor),
-		grpc.StreamInterceptor(grpcprometheus.StreamServerInterceptor),
+		grpc.ChainUnaryInterceptor(grpcprometheus.UnaryServerInterceptor, server.UnaryActorInterceptor,
+			server.UnaryTenantInterceptor(requireTenant), server.UnarySessionInterceptor),
+		grpc.ChainStreamInterceptor(grpcprometheus.StreamServerInterceptor, server.StreamActorInterceptor,
+			server.StreamTenantInterceptor(requireTenant), server.StreamSessionInterceptor),
 	)
 
 	// registra métricas padrão do gRPC
@@ -112,15 +53,15 @@ func startGRPCServer(s *grpc.Server, addr string) error {
 }
 
 func main() {
-	cfg := LoadConfig()
+	cfg := bootstrap.LoadConfig()
 
-	app, cleanup, err := bootstrapApp(cfg)
+	app, cleanup, err := bootstrap.App(cfg)
 	if err != nil {
 		log.Fatalf("failed to bootstrap application: %v", err)
 	}
 	defer cleanup()
 
-	s := newGRPCServer(app)
+	s := newGRPCServer(app, cfg.RequireTenant)
 
 	if err := startGRPCServer(s, cfg.GRPCAddr); err != nil {
 		log.Fatalf("failed to start gRPC server: %v", err)
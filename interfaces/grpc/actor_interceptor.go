@@ -0,0 +1,58 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/LuizFJP/pet-ms/application"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// O principal vem do gateway que autentica as requisições; sem ele a chamada
+// é auditada como anônima.
+const (
+	principalHeader    = "x-principal"
+	requestIDHeader    = "x-request-id"
+	anonymousPrincipal = "anonymous"
+)
+
+// UnaryActorInterceptor coloca no contexto quem fez a chamada e o id da requisição,
+// gerando um id quando o cliente não envia e devolvendo-o no header da resposta.
+func UnaryActorInterceptor(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	actor := actorFromMetadata(ctx)
+	_ = grpc.SetHeader(ctx, metadata.Pairs(requestIDHeader, actor.RequestID))
+	return handler(application.ContextWithActor(ctx, actor), req)
+}
+
+func StreamActorInterceptor(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	actor := actorFromMetadata(ss.Context())
+	_ = ss.SetHeader(metadata.Pairs(requestIDHeader, actor.RequestID))
+	return handler(srv, &contextStream{ServerStream: ss, ctx: application.ContextWithActor(ss.Context(), actor)})
+}
+
+// contextStream troca o contexto do stream pelo dos interceptors.
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
+
+func actorFromMetadata(ctx context.Context) application.Actor {
+	actor := application.Actor{Principal: anonymousPrincipal}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(principalHeader); len(values) > 0 && values[0] != "" {
+			actor.Principal = values[0]
+		}
+		if values := md.Get(requestIDHeader); len(values) > 0 && values[0] != "" {
+			actor.RequestID = values[0]
+		}
+	}
+	if actor.RequestID == "" {
+		actor.RequestID = uuid.NewString()
+	}
+	return actor
+}
@@ -0,0 +1,133 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/LuizFJP/pet-ms/application"
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	pb "github.com/LuizFJP/pet-ms/proto"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var applicationStatusToProto = map[entity.ApplicationStatus]pb.AdoptionApplicationStatus{
+	entity.ApplicationPending:   pb.AdoptionApplicationStatus_ADOPTION_APPLICATION_STATUS_PENDING,
+	entity.ApplicationApproved:  pb.AdoptionApplicationStatus_ADOPTION_APPLICATION_STATUS_APPROVED,
+	entity.ApplicationRejected:  pb.AdoptionApplicationStatus_ADOPTION_APPLICATION_STATUS_REJECTED,
+	entity.ApplicationWithdrawn: pb.AdoptionApplicationStatus_ADOPTION_APPLICATION_STATUS_WITHDRAWN,
+}
+
+func (s *PetServer) SubmitAdoptionApplication(ctx context.Context, input *pb.SubmitAdoptionApplicationRequest) (*pb.AdoptionApplication, error) {
+	pet, err := uuid.Parse(input.PetUuid)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid pet_uuid")
+	}
+	applicant, err := uuid.Parse(input.ApplicantUuid)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid applicant_uuid")
+	}
+
+	res, errData := s.pa.SubmitApplication(ctx, &entity.AdoptionApplication{
+		PetUuid:       pet,
+		ApplicantUuid: applicant,
+		Message:       input.Message,
+	})
+	if errData != nil {
+		return nil, errorFromMap(errData)
+	}
+	return toProtoApplication(res), nil
+}
+
+func (s *PetServer) GetAdoptionApplication(ctx context.Context, input *pb.GetAdoptionApplicationRequest) (*pb.AdoptionApplication, error) {
+	res, errData := s.pa.GetApplication(ctx, input.Uuid)
+	if errData != nil {
+		return nil, errorFromMap(errData)
+	}
+	return toProtoApplication(res), nil
+}
+
+func (s *PetServer) ApproveAdoptionApplication(ctx context.Context, input *pb.DecideAdoptionApplicationRequest) (*pb.ApproveAdoptionApplicationResponse, error) {
+	approval, errData := s.pa.ApproveApplication(ctx, input.Uuid, input.Reason)
+	if errData != nil {
+		return nil, errorFromMap(errData)
+	}
+	res := &pb.ApproveAdoptionApplicationResponse{
+		Application: toProtoApplication(approval.Application),
+		Pet:         toGetPetResponse(approval.Pet, s.pa.LookupSpecies),
+	}
+	for _, rejected := range approval.Rejected {
+		res.Rejected = append(res.Rejected, toProtoApplication(rejected))
+	}
+	return res, nil
+}
+
+func (s *PetServer) RejectAdoptionApplication(ctx context.Context, input *pb.DecideAdoptionApplicationRequest) (*pb.AdoptionApplication, error) {
+	res, errData := s.pa.RejectApplication(ctx, input.Uuid, input.Reason)
+	if errData != nil {
+		return nil, errorFromMap(errData)
+	}
+	return toProtoApplication(res), nil
+}
+
+func (s *PetServer) WithdrawAdoptionApplication(ctx context.Context, input *pb.DecideAdoptionApplicationRequest) (*pb.AdoptionApplication, error) {
+	res, errData := s.pa.WithdrawApplication(ctx, input.Uuid, input.Reason)
+	if errData != nil {
+		return nil, errorFromMap(errData)
+	}
+	return toProtoApplication(res), nil
+}
+
+func (s *PetServer) ListPetAdoptionApplications(ctx context.Context, input *pb.ListPetAdoptionApplicationsRequest) (*pb.ListAdoptionApplicationsResponse, error) {
+	after, err := decodePageToken(input.PageToken)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+	}
+	applications, errData := s.pa.ListPetApplications(ctx, input.PetUuid, after, int(input.PageSize))
+	if errData != nil {
+		return nil, errorFromMap(errData)
+	}
+	return toApplicationsResponse(applications, int(input.PageSize)), nil
+}
+
+func (s *PetServer) ListApplicantAdoptionApplications(ctx context.Context, input *pb.ListApplicantAdoptionApplicationsRequest) (*pb.ListAdoptionApplicationsResponse, error) {
+	after, err := decodePageToken(input.PageToken)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+	}
+	applications, errData := s.pa.ListApplicantApplications(ctx, input.ApplicantUuid, after, int(input.PageSize))
+	if errData != nil {
+		return nil, errorFromMap(errData)
+	}
+	return toApplicationsResponse(applications, int(input.PageSize)), nil
+}
+
+func toApplicationsResponse(applications []*entity.AdoptionApplication, pageSize int) *pb.ListAdoptionApplicationsResponse {
+	res := &pb.ListAdoptionApplicationsResponse{}
+	for _, app := range applications {
+		res.Applications = append(res.Applications, toProtoApplication(app))
+	}
+	if len(applications) > 0 && len(applications) >= application.ApplicationPageSize(pageSize) {
+		res.NextPageToken = encodePageToken(applications[len(applications)-1].ID)
+	}
+	return res
+}
+
+func toProtoApplication(app *entity.AdoptionApplication) *pb.AdoptionApplication {
+	res := &pb.AdoptionApplication{
+		Uuid:           app.Uuid.String(),
+		PetUuid:        app.PetUuid.String(),
+		ApplicantUuid:  app.ApplicantUuid.String(),
+		Status:         applicationStatusToProto[app.Status],
+		Message:        app.Message,
+		DecisionReason: app.DecisionReason,
+		DecidedBy:      app.DecidedBy,
+		CreatedAt:      timestamppb.New(app.CreatedAt),
+		UpdatedAt:      timestamppb.New(app.UpdatedAt),
+	}
+	if app.DecidedAt != nil {
+		res.DecidedAt = timestamppb.New(*app.DecidedAt)
+	}
+	return res
+}
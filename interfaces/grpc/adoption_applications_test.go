@@ -0,0 +1,111 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	pb "github.com/LuizFJP/pet-ms/proto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPetServer_SubmitAdoptionApplication(t *testing.T) {
+	pet, applicant := uuid.New(), uuid.New()
+	app := &appMock{
+		submitApplicationFn: func(a *entity.AdoptionApplication) (*entity.AdoptionApplication, map[string]string) {
+			assert.Equal(t, pet, a.PetUuid)
+			assert.Equal(t, applicant, a.ApplicantUuid)
+			a.Uuid, a.Status, a.CreatedAt = uuid.New(), entity.ApplicationPending, time.Now()
+			return a, nil
+		},
+	}
+	s := NewPetServer(app)
+
+	resp, err := s.SubmitAdoptionApplication(context.Background(), &pb.SubmitAdoptionApplicationRequest{
+		PetUuid: pet.String(), ApplicantUuid: applicant.String(), Message: "I have a garden",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, pb.AdoptionApplicationStatus_ADOPTION_APPLICATION_STATUS_PENDING, resp.Status)
+	assert.Equal(t, "I have a garden", resp.Message)
+	assert.Nil(t, resp.DecidedAt)
+
+	_, err = s.SubmitAdoptionApplication(context.Background(), &pb.SubmitAdoptionApplicationRequest{PetUuid: pet.String(), ApplicantUuid: "bad"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestPetServer_ApproveAdoptionApplication(t *testing.T) {
+	pet := makePet()
+	pet.Status = entity.StatusReserved
+	decidedAt := time.Now()
+	approved := &entity.AdoptionApplication{Uuid: uuid.New(), PetUuid: pet.Uuid, Status: entity.ApplicationApproved, DecidedAt: &decidedAt}
+	rejected := &entity.AdoptionApplication{Uuid: uuid.New(), PetUuid: pet.Uuid, Status: entity.ApplicationRejected, DecisionReason: entity.CompetingApplicationReason}
+	app := &appMock{
+		approveApplicationFn: func(id, reason string) (*entity.ApplicationApproval, map[string]string) {
+			if id != approved.Uuid.String() {
+				return nil, map[string]string{"failed_precondition": "pet is not available for adoption"}
+			}
+			return &entity.ApplicationApproval{Application: approved, Pet: pet, Rejected: []*entity.AdoptionApplication{rejected}}, nil
+		},
+	}
+	s := NewPetServer(app)
+
+	resp, err := s.ApproveAdoptionApplication(context.Background(), &pb.DecideAdoptionApplicationRequest{Uuid: approved.Uuid.String()})
+	require.NoError(t, err)
+	assert.Equal(t, pb.AdoptionApplicationStatus_ADOPTION_APPLICATION_STATUS_APPROVED, resp.Application.Status)
+	assert.NotNil(t, resp.Application.DecidedAt)
+	assert.Equal(t, pb.PetStatus_PET_STATUS_RESERVED, resp.Pet.Status)
+	require.Len(t, resp.Rejected, 1)
+	assert.Equal(t, entity.CompetingApplicationReason, resp.Rejected[0].DecisionReason)
+
+	_, err = s.ApproveAdoptionApplication(context.Background(), &pb.DecideAdoptionApplicationRequest{Uuid: uuid.New().String()})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestPetServer_CloseAdoptionApplication(t *testing.T) {
+	var got []entity.ApplicationStatus
+	app := &appMock{
+		closeApplicationFn: func(id string, st entity.ApplicationStatus, reason string) (*entity.AdoptionApplication, map[string]string) {
+			got = append(got, st)
+			return &entity.AdoptionApplication{Uuid: uuid.MustParse(id), Status: st, DecisionReason: reason}, nil
+		},
+	}
+	s := NewPetServer(app)
+	id := uuid.New().String()
+
+	resp, err := s.RejectAdoptionApplication(context.Background(), &pb.DecideAdoptionApplicationRequest{Uuid: id, Reason: "no garden"})
+	require.NoError(t, err)
+	assert.Equal(t, pb.AdoptionApplicationStatus_ADOPTION_APPLICATION_STATUS_REJECTED, resp.Status)
+	resp, err = s.WithdrawAdoptionApplication(context.Background(), &pb.DecideAdoptionApplicationRequest{Uuid: id})
+	require.NoError(t, err)
+	assert.Equal(t, pb.AdoptionApplicationStatus_ADOPTION_APPLICATION_STATUS_WITHDRAWN, resp.Status)
+	assert.Equal(t, []entity.ApplicationStatus{entity.ApplicationRejected, entity.ApplicationWithdrawn}, got)
+}
+
+func TestPetServer_ListAdoptionApplications_Paginates(t *testing.T) {
+	var gotAfter uint
+	app := &appMock{
+		listApplicationsFn: func(id string, afterID uint, pageSize int) ([]*entity.AdoptionApplication, map[string]string) {
+			gotAfter = afterID
+			return []*entity.AdoptionApplication{{ID: 4, Status: entity.ApplicationPending}, {ID: 5, Status: entity.ApplicationRejected}}, nil
+		},
+	}
+	s := NewPetServer(app)
+
+	resp, err := s.ListPetAdoptionApplications(context.Background(), &pb.ListPetAdoptionApplicationsRequest{PetUuid: uuid.New().String(), PageSize: 2, PageToken: encodePageToken(3)})
+	require.NoError(t, err)
+	assert.Equal(t, uint(3), gotAfter)
+	require.Len(t, resp.Applications, 2)
+	assert.Equal(t, encodePageToken(5), resp.NextPageToken)
+
+	resp, err = s.ListApplicantAdoptionApplications(context.Background(), &pb.ListApplicantAdoptionApplicationsRequest{ApplicantUuid: uuid.New().String(), PageSize: 10})
+	require.NoError(t, err)
+	assert.Empty(t, resp.NextPageToken)
+
+	_, err = s.ListApplicantAdoptionApplications(context.Background(), &pb.ListApplicantAdoptionApplicationsRequest{PageToken: "%%"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,145 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	pb "github.com/LuizFJP/pet-ms/proto"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// AttachmentChunkSize é o tamanho dos blocos enviados no download.
+const AttachmentChunkSize = 64 << 10
+
+var attachmentKindToEntity = map[pb.AttachmentKind]entity.AttachmentKind{
+	pb.AttachmentKind_ATTACHMENT_KIND_PHOTO:    entity.AttachmentPhoto,
+	pb.AttachmentKind_ATTACHMENT_KIND_DOCUMENT: entity.AttachmentDocument,
+}
+
+var attachmentKindToProto = map[entity.AttachmentKind]pb.AttachmentKind{
+	entity.AttachmentPhoto:    pb.AttachmentKind_ATTACHMENT_KIND_PHOTO,
+	entity.AttachmentDocument: pb.AttachmentKind_ATTACHMENT_KIND_DOCUMENT,
+}
+
+func (s *PetServer) UploadAttachment(stream pb.PetService_UploadAttachmentServer) error {
+	first, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return status.Error(codes.InvalidArgument, "upload is empty")
+	}
+	if err != nil {
+		return err
+	}
+	meta := first.Metadata
+	if meta == nil {
+		return status.Error(codes.InvalidArgument, "first message must carry metadata")
+	}
+
+	petUuid, _ := uuid.Parse(meta.PetUuid)
+	attachment := &entity.Attachment{
+		PetUuid:     petUuid,
+		Kind:        attachmentKindToEntity[meta.Kind],
+		FileName:    meta.FileName,
+		ContentType: meta.ContentType,
+		SizeBytes:   int64(meta.SizeBytes),
+	}
+
+	content := &uploadReader{stream: stream, pending: first.Chunk}
+	res, errData := s.pa.UploadAttachment(stream.Context(), attachment, content)
+	if content.err != nil {
+		return content.err
+	}
+	if errData != nil {
+		return errorFromMap(errData)
+	}
+	return stream.SendAndClose(toProtoAttachment(res))
+}
+
+// uploadReader junta os chunks do stream num io.Reader. Metadata repetida no meio do
+// stream é um erro do cliente e interrompe o envio.
+type uploadReader struct {
+	stream  pb.PetService_UploadAttachmentServer
+	pending []byte
+	err     error
+}
+
+func (r *uploadReader) Read(b []byte) (int, error) {
+	for len(r.pending) == 0 {
+		msg, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		if msg.Metadata != nil {
+			r.err = status.Error(codes.InvalidArgument, "metadata must only be sent in the first message")
+			return 0, r.err
+		}
+		r.pending = msg.Chunk
+	}
+	n := copy(b, r.pending)
+	r.pending = r.pending[n:]
+	return n, nil
+}
+
+func (s *PetServer) DownloadAttachment(input *pb.DownloadAttachmentRequest, stream pb.PetService_DownloadAttachmentServer) error {
+	attachment, content, errData := s.pa.OpenAttachment(stream.Context(), input.Uuid)
+	if errData != nil {
+		return errorFromMap(errData)
+	}
+	defer content.Close()
+
+	if err := stream.Send(&pb.DownloadAttachmentResponse{Attachment: toProtoAttachment(attachment)}); err != nil {
+		return err
+	}
+	buf := make([]byte, AttachmentChunkSize)
+	for {
+		n, err := io.ReadFull(content, buf)
+		if n > 0 {
+			if sendErr := stream.Send(&pb.DownloadAttachmentResponse{Chunk: buf[:n]}); sendErr != nil {
+				return sendErr
+			}
+		}
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil
+		}
+		if err != nil {
+			return status.Errorf(codes.Unavailable, "reading attachment: %v", err)
+		}
+	}
+}
+
+func (s *PetServer) ListAttachments(ctx context.Context, input *pb.ListAttachmentsRequest) (*pb.ListAttachmentsResponse, error) {
+	attachments, errData := s.pa.ListAttachments(ctx, input.PetUuid)
+	if errData != nil {
+		return nil, errorFromMap(errData)
+	}
+
+	res := &pb.ListAttachmentsResponse{}
+	for _, attachment := range attachments {
+		res.Attachments = append(res.Attachments, toProtoAttachment(attachment))
+	}
+	return res, nil
+}
+
+func (s *PetServer) DeleteAttachment(ctx context.Context, input *pb.DeleteAttachmentRequest) (*pb.DeleteAttachmentResponse, error) {
+	if errData := s.pa.DeleteAttachment(ctx, input.Uuid); errData != nil {
+		return nil, errorFromMap(errData)
+	}
+	return &pb.DeleteAttachmentResponse{Message: "anexo removido"}, nil
+}
+
+func toProtoAttachment(attachment *entity.Attachment) *pb.Attachment {
+	return &pb.Attachment{
+		Uuid:           attachment.Uuid.String(),
+		PetUuid:        attachment.PetUuid.String(),
+		Kind:           attachmentKindToProto[attachment.Kind],
+		FileName:       attachment.FileName,
+		ContentType:    attachment.ContentType,
+		SizeBytes:      uint64(attachment.SizeBytes),
+		ChecksumSha256: attachment.Checksum,
+		CreatedAt:      timestamppb.New(attachment.CreatedAt),
+	}
+}
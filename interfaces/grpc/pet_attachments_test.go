@@ -0,0 +1,129 @@
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	pb "github.com/LuizFJP/pet-ms/proto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type uploadStreamMock struct {
+	grpc.ServerStream
+	msgs     []*pb.UploadAttachmentRequest
+	response *pb.Attachment
+}
+
+func (m *uploadStreamMock) Context() context.Context { return context.Background() }
+
+func (m *uploadStreamMock) Recv() (*pb.UploadAttachmentRequest, error) {
+	if len(m.msgs) == 0 {
+		return nil, io.EOF
+	}
+	msg := m.msgs[0]
+	m.msgs = m.msgs[1:]
+	return msg, nil
+}
+
+func (m *uploadStreamMock) SendAndClose(res *pb.Attachment) error {
+	m.response = res
+	return nil
+}
+
+type downloadStreamMock struct {
+	grpc.ServerStream
+	sent []*pb.DownloadAttachmentResponse
+}
+
+func (m *downloadStreamMock) Context() context.Context { return context.Background() }
+
+func (m *downloadStreamMock) Send(res *pb.DownloadAttachmentResponse) error {
+	m.sent = append(m.sent, res)
+	return nil
+}
+
+func TestPetServer_UploadAttachment_JoinsChunks(t *testing.T) {
+	petUuid := uuid.New()
+	var received []byte
+	app := &appMock{
+		uploadFn: func(a *entity.Attachment, content io.Reader) (*entity.Attachment, map[string]string) {
+			assert.Equal(t, petUuid, a.PetUuid)
+			assert.Equal(t, entity.AttachmentDocument, a.Kind)
+			var err error
+			received, err = io.ReadAll(content)
+			require.NoError(t, err)
+			a.Uuid = uuid.New()
+			return a, nil
+		},
+	}
+	s := NewPetServer(app)
+
+	stream := &uploadStreamMock{msgs: []*pb.UploadAttachmentRequest{
+		{Metadata: &pb.AttachmentMetadata{
+			PetUuid:     petUuid.String(),
+			Kind:        pb.AttachmentKind_ATTACHMENT_KIND_DOCUMENT,
+			FileName:    "termo.pdf",
+			ContentType: "application/pdf",
+			SizeBytes:   9,
+		}, Chunk: []byte("%PDF")},
+		{Chunk: []byte("-1.4")},
+		{Chunk: []byte("\n")},
+	}}
+	require.NoError(t, s.UploadAttachment(stream))
+	assert.Equal(t, "%PDF-1.4\n", string(received))
+	assert.Equal(t, pb.AttachmentKind_ATTACHMENT_KIND_DOCUMENT, stream.response.Kind)
+}
+
+func TestPetServer_UploadAttachment_RejectsMisplacedMetadata(t *testing.T) {
+	app := &appMock{
+		uploadFn: func(a *entity.Attachment, content io.Reader) (*entity.Attachment, map[string]string) {
+			_, err := io.ReadAll(content)
+			return nil, map[string]string{"aborted": err.Error()}
+		},
+	}
+	s := NewPetServer(app)
+
+	err := s.UploadAttachment(&uploadStreamMock{msgs: []*pb.UploadAttachmentRequest{{Chunk: []byte("x")}}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	err = s.UploadAttachment(&uploadStreamMock{msgs: []*pb.UploadAttachmentRequest{
+		{Metadata: &pb.AttachmentMetadata{SizeBytes: 2}},
+		{Metadata: &pb.AttachmentMetadata{}},
+	}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestPetServer_DownloadAttachment_SendsMetadataThenChunks(t *testing.T) {
+	content := bytes.Repeat([]byte{7}, AttachmentChunkSize*2+10)
+	app := &appMock{
+		openAttachmentFn: func(id string) (*entity.Attachment, io.ReadCloser, map[string]string) {
+			return &entity.Attachment{Uuid: uuid.New(), Kind: entity.AttachmentPhoto, SizeBytes: int64(len(content))}, io.NopCloser(bytes.NewReader(content)), nil
+		},
+	}
+	s := NewPetServer(app)
+
+	stream := &downloadStreamMock{}
+	require.NoError(t, s.DownloadAttachment(&pb.DownloadAttachmentRequest{Uuid: uuid.New().String()}, stream))
+	require.Len(t, stream.sent, 4)
+	assert.NotNil(t, stream.sent[0].Attachment)
+
+	var got []byte
+	for _, msg := range stream.sent[1:] {
+		got = append(got, msg.Chunk...)
+	}
+	assert.Equal(t, content, got)
+
+	app.openAttachmentFn = func(id string) (*entity.Attachment, io.ReadCloser, map[string]string) {
+		return nil, nil, map[string]string{"not_found": "attachment not found"}
+	}
+	err := s.DownloadAttachment(&pb.DownloadAttachmentRequest{Uuid: uuid.New().String()}, &downloadStreamMock{})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
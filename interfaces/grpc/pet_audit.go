@@ -0,0 +1,94 @@
+package grpc
+
+import (
+	"context"
+	"log"
+
+	"github.com/LuizFJP/pet-ms/application"
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	pb "github.com/LuizFJP/pet-ms/proto"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *PetServer) GetPetAuditLog(ctx context.Context, input *pb.GetPetAuditLogRequest) (*pb.AuditLogResponse, error) {
+	after, err := decodePageToken(input.PageToken)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+	}
+
+	entries, errData := s.pa.GetPetAuditLog(ctx, input.Uuid, after, int(input.PageSize))
+	if errData != nil {
+		return nil, errorFromMap(errData)
+	}
+	return toAuditLogResponse(entries, int(input.PageSize)), nil
+}
+
+func (s *PetServer) ListGuardianAuditLog(ctx context.Context, input *pb.ListGuardianAuditLogRequest) (*pb.AuditLogResponse, error) {
+	after, err := decodePageToken(input.PageToken)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+	}
+
+	entries, errData := s.pa.ListGuardianAuditLog(ctx, input.UuidGuardian, after, int(input.PageSize))
+	if errData != nil {
+		return nil, errorFromMap(errData)
+	}
+	return toAuditLogResponse(entries, int(input.PageSize)), nil
+}
+
+// O token de página usa o mesmo formato opaco do resume token, com o id da última entrada.
+func encodePageToken(id uint) string {
+	return encodeResumeToken(uint64(id))
+}
+
+func decodePageToken(token string) (uint, error) {
+	id, err := decodeResumeToken(token)
+	return uint(id), err
+}
+
+// toAuditLogResponse só devolve next_page_token quando a página veio cheia.
+func toAuditLogResponse(entries []*entity.AuditEntry, pageSize int) *pb.AuditLogResponse {
+	if pageSize <= 0 {
+		pageSize = application.DefaultAuditPageSize
+	}
+	if pageSize > application.MaxAuditPageSize {
+		pageSize = application.MaxAuditPageSize
+	}
+
+	res := &pb.AuditLogResponse{}
+	for _, entry := range entries {
+		res.Entries = append(res.Entries, toAuditEntry(entry))
+	}
+	if len(entries) >= pageSize {
+		res.NextPageToken = encodePageToken(entries[len(entries)-1].ID)
+	}
+	return res
+}
+
+func toAuditEntry(entry *entity.AuditEntry) *pb.AuditEntry {
+	res := &pb.AuditEntry{
+		Id:           uint64(entry.ID),
+		PetUuid:      entry.PetUuid.String(),
+		UuidGuardian: entry.UuidGuardian.String(),
+		Action:       string(entry.Action),
+		Principal:    entry.Principal,
+		RequestId:    entry.RequestID,
+		OccurredAt:   timestamppb.New(entry.OccurredAt),
+	}
+	// nas mudanças de status só há guardião anterior quando o pet trocou de dono
+	if entry.Action == entity.AuditTransfer || (entry.Action == entity.AuditStatusChange && entry.PreviousGuardian != uuid.Nil) {
+		res.PreviousUuidGuardian = entry.PreviousGuardian.String()
+	}
+
+	changes, err := entry.FieldChanges()
+	if err != nil {
+		log.Printf("audit entry %d has invalid changes: %v", entry.ID, err)
+	}
+	for _, change := range changes {
+		res.Changes = append(res.Changes, &pb.AuditFieldChange{Field: change.Field, Before: change.Before, After: change.After})
+	}
+	return res
+}
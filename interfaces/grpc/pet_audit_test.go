@@ -0,0 +1,92 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/LuizFJP/pet-ms/application"
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	pb "github.com/LuizFJP/pet-ms/proto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func auditEntry(id uint, action entity.AuditAction) *entity.AuditEntry {
+	entry := &entity.AuditEntry{
+		ID:               id,
+		PetUuid:          uuid.New(),
+		UuidGuardian:     uuid.New(),
+		PreviousGuardian: uuid.New(),
+		Action:           action,
+		Principal:        "alice",
+		RequestID:        "req-1",
+		OccurredAt:       time.Now(),
+	}
+	_ = entry.SetChanges([]entity.FieldChange{{Field: "breed", Before: "Lab", After: "Labrador"}})
+	return entry
+}
+
+func TestPetServer_GetPetAuditLog_Paginates(t *testing.T) {
+	var gotAfter uint
+	app := &appMock{
+		petAuditFn: func(id string, afterID uint, pageSize int) ([]*entity.AuditEntry, map[string]string) {
+			gotAfter = afterID
+			return []*entity.AuditEntry{auditEntry(7, entity.AuditUpdate), auditEntry(8, entity.AuditTransfer)}, nil
+		},
+	}
+	s := NewPetServer(app)
+
+	resp, err := s.GetPetAuditLog(context.Background(), &pb.GetPetAuditLogRequest{Uuid: uuid.New().String(), PageSize: 2, PageToken: encodePageToken(6)})
+	require.NoError(t, err)
+	assert.Equal(t, uint(6), gotAfter)
+	require.Len(t, resp.Entries, 2)
+	assert.Equal(t, "update", resp.Entries[0].Action)
+	assert.Empty(t, resp.Entries[0].PreviousUuidGuardian)
+	assert.NotEmpty(t, resp.Entries[1].PreviousUuidGuardian)
+	require.Len(t, resp.Entries[0].Changes, 1)
+	assert.Equal(t, "breed", resp.Entries[0].Changes[0].Field)
+	assert.Equal(t, encodePageToken(8), resp.NextPageToken)
+
+	resp, err = s.GetPetAuditLog(context.Background(), &pb.GetPetAuditLogRequest{Uuid: uuid.New().String(), PageSize: 10})
+	require.NoError(t, err)
+	assert.Empty(t, resp.NextPageToken, "a partial page is the last one")
+}
+
+func TestPetServer_ListGuardianAuditLog_Errors(t *testing.T) {
+	app := &appMock{
+		guardianAuditFn: func(id string, afterID uint, pageSize int) ([]*entity.AuditEntry, map[string]string) {
+			return nil, map[string]string{"invalid_argument": "uuid_guardian must be a valid uuid"}
+		},
+	}
+	s := NewPetServer(app)
+
+	_, err := s.ListGuardianAuditLog(context.Background(), &pb.ListGuardianAuditLogRequest{UuidGuardian: "bad"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.ListGuardianAuditLog(context.Background(), &pb.ListGuardianAuditLogRequest{PageToken: "%%%"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestUnaryActorInterceptor(t *testing.T) {
+	var got application.Actor
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		got = application.ActorFromContext(ctx)
+		return nil, nil
+	}
+
+	md := metadata.Pairs(principalHeader, "alice", requestIDHeader, "req-42")
+	_, err := UnaryActorInterceptor(metadata.NewIncomingContext(context.Background(), md), nil, &grpc.UnaryServerInfo{}, handler)
+	require.NoError(t, err)
+	assert.Equal(t, application.Actor{Principal: "alice", RequestID: "req-42"}, got)
+
+	_, err = UnaryActorInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, handler)
+	require.NoError(t, err)
+	assert.Equal(t, anonymousPrincipal, got.Principal)
+	assert.NotEmpty(t, got.RequestID, "a request id is generated when missing")
+}
@@ -0,0 +1,106 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/LuizFJP/pet-ms/application"
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	pb "github.com/LuizFJP/pet-ms/proto"
+	"github.com/google/uuid"
+)
+
+func (s *PetServer) BatchCreatePets(ctx context.Context, input *pb.BatchCreatePetsRequest) (*pb.BatchCreatePetsResponse, error) {
+	pets := make([]*entity.Pet, len(input.Pets))
+	for i, item := range input.Pets {
+		pets[i] = s.newPetFromCreateRequest(item)
+	}
+
+	results, committed, errData := s.pa.BatchSavePets(ctx, pets, batchMode(input.Mode))
+	if errData != nil {
+		return nil, errorFromMap(errData)
+	}
+
+	response := &pb.BatchCreatePetsResponse{Committed: committed}
+	for i, result := range results {
+		item := &pb.BatchCreatePetsResult{Index: uint32(i), Errors: result.Errors}
+		if result.Pet != nil {
+			item.Pet = toCreatePetResponse(result.Pet, s.pa.LookupSpecies)
+		}
+		response.Results = append(response.Results, item)
+	}
+	return response, nil
+}
+
+func (s *PetServer) BatchGetPets(ctx context.Context, input *pb.BatchGetPetsRequest) (*pb.BatchGetPetsResponse, error) {
+	pets, notFound, errData := s.pa.BatchGetPets(ctx, input.Uuids)
+	if errData != nil {
+		return nil, errorFromMap(errData)
+	}
+
+	response := &pb.BatchGetPetsResponse{NotFound: notFound}
+	for _, pet := range pets {
+		response.Pets = append(response.Pets, toGetPetResponse(pet, s.pa.LookupSpecies))
+	}
+	return response, nil
+}
+
+func (s *PetServer) BatchUpdatePets(ctx context.Context, input *pb.BatchUpdatePetsRequest) (*pb.BatchUpdatePetsResponse, error) {
+	pets := make([]*entity.Pet, len(input.Pets))
+	for i, item := range input.Pets {
+		petUuid, _ := uuid.Parse(item.Uuid)
+		specie, _ := s.specieFromRequest(item.SpeciesCode, item.Specie)
+		birthDate, accuracy, _ := birthDateFromRequest(item.BirthDate, item.BirthDateAccuracy)
+		pets[i] = &entity.Pet{
+			Uuid:              petUuid,
+			Name:              item.Name,
+			BirthYear:         int(item.BirthYear),
+			BirthDate:         birthDate,
+			BirthDateAccuracy: accuracy,
+			Breed:             item.Breed,
+			Specie:            specie,
+		}
+	}
+
+	results, committed, errData := s.pa.BatchUpdatePets(ctx, pets, batchMode(input.Mode))
+	if errData != nil {
+		return nil, errorFromMap(errData)
+	}
+
+	response := &pb.BatchUpdatePetsResponse{Committed: committed}
+	for i, result := range results {
+		item := &pb.BatchUpdatePetsResult{Index: uint32(i), Errors: result.Errors}
+		if result.Pet != nil {
+			item.Pet = toUpdatePetResponse(result.Pet, s.pa.LookupSpecies)
+		}
+		response.Results = append(response.Results, item)
+	}
+	return response, nil
+}
+
+// newPetFromCreateRequest não entra em pânico com uuid inválido: ele vira uuid.Nil
+// e é reportado pelo Validate do item. O mesmo vale para species_code desconhecido
+// e birth_date inexistente.
+func (s *PetServer) newPetFromCreateRequest(item *pb.CreatePetRequest) *entity.Pet {
+	guardian, _ := uuid.Parse(item.UuidGuardian)
+	specie, _ := s.specieFromRequest(item.SpeciesCode, item.Specie)
+	birthDate, accuracy, _ := birthDateFromRequest(item.BirthDate, item.BirthDateAccuracy)
+	pet := &entity.Pet{
+		Name:              item.Name,
+		Uuid:              uuid.New(),
+		UuidGuardian:      guardian,
+		BirthYear:         int(item.BirthYear),
+		BirthDate:         birthDate,
+		BirthDateAccuracy: accuracy,
+		Breed:             item.Breed,
+		Specie:            specie,
+	}
+	setProfile(pet, item)
+	return pet
+}
+
+func batchMode(mode pb.BatchMode) application.BatchMode {
+	if mode == pb.BatchMode_BATCH_MODE_PER_ITEM {
+		return application.BatchPerItem
+	}
+	return application.BatchAllOrNothing
+}
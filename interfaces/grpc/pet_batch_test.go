@@ -0,0 +1,101 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/LuizFJP/pet-ms/application"
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	pb "github.com/LuizFJP/pet-ms/proto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPetServer_BatchCreatePets_MapsResults(t *testing.T) {
+	var gotPets []*entity.Pet
+	var gotMode application.BatchMode
+	app := &appMock{
+		batchSaveFn: func(pets []*entity.Pet, mode application.BatchMode) ([]application.BatchItemResult, bool, map[string]string) {
+			gotPets, gotMode = pets, mode
+			return []application.BatchItemResult{
+				{Pet: pets[0]},
+				{Errors: map[string]string{"uuid_guardian": "guardian uuid is missing or invalid"}},
+			}, true, nil
+		},
+	}
+	s := NewPetServer(app)
+
+	req := &pb.BatchCreatePetsRequest{
+		Mode: pb.BatchMode_BATCH_MODE_PER_ITEM,
+		Pets: []*pb.CreatePetRequest{
+			{Name: "Rex", UuidGuardian: uuid.New().String(), BirthYear: 2020, Breed: "SRD", Specie: 1},
+			{Name: "Bad", UuidGuardian: "not-a-uuid", BirthYear: 2020, Breed: "SRD"},
+		},
+	}
+
+	resp, err := s.BatchCreatePets(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 2)
+	assert.True(t, resp.Committed)
+	assert.Equal(t, application.BatchPerItem, gotMode)
+	assert.Equal(t, uuid.Nil, gotPets[1].UuidGuardian, "invalid guardian uuids are left for validation")
+
+	assert.Equal(t, uint32(0), resp.Results[0].Index)
+	assert.Equal(t, "Rex", resp.Results[0].Pet.Name)
+	assert.Equal(t, "1", resp.Results[0].Pet.Specie)
+	assert.Nil(t, resp.Results[1].Pet)
+	assert.Contains(t, resp.Results[1].Errors, "uuid_guardian")
+}
+
+func TestPetServer_BatchCreatePets_BatchError(t *testing.T) {
+	app := &appMock{
+		batchSaveFn: func(pets []*entity.Pet, mode application.BatchMode) ([]application.BatchItemResult, bool, map[string]string) {
+			return nil, false, map[string]string{"invalid_argument": "batch is empty"}
+		},
+	}
+	s := NewPetServer(app)
+
+	resp, err := s.BatchCreatePets(context.Background(), &pb.BatchCreatePetsRequest{})
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestPetServer_BatchGetPets(t *testing.T) {
+	pet := makePet()
+	missing := uuid.New().String()
+	app := &appMock{
+		batchGetFn: func(uuids []string) ([]*entity.Pet, []string, map[string]string) {
+			return []*entity.Pet{pet}, []string{missing}, nil
+		},
+	}
+	s := NewPetServer(app)
+
+	resp, err := s.BatchGetPets(context.Background(), &pb.BatchGetPetsRequest{Uuids: []string{pet.Uuid.String(), missing}})
+	require.NoError(t, err)
+	require.Len(t, resp.Pets, 1)
+	assert.Equal(t, pet.Uuid.String(), resp.Pets[0].Uuid)
+	assert.Equal(t, []string{missing}, resp.NotFound)
+}
+
+func TestPetServer_BatchUpdatePets_DefaultsToAllOrNothing(t *testing.T) {
+	petID := uuid.New()
+	var gotMode application.BatchMode = -1
+	app := &appMock{
+		batchUpdateFn: func(pets []*entity.Pet, mode application.BatchMode) ([]application.BatchItemResult, bool, map[string]string) {
+			gotMode = mode
+			return []application.BatchItemResult{{Pet: pets[0]}}, true, nil
+		},
+	}
+	s := NewPetServer(app)
+
+	req := &pb.BatchUpdatePetsRequest{Pets: []*pb.UpdatePetRequest{{Uuid: petID.String(), Name: "Luna", Breed: "Beagle"}}}
+	resp, err := s.BatchUpdatePets(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, application.BatchAllOrNothing, gotMode)
+	require.Len(t, resp.Results, 1)
+	assert.Equal(t, petID.String(), resp.Results[0].Pet.Uuid)
+}
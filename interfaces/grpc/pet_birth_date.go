@@ -0,0 +1,89 @@
+package grpc
+
+import (
+	"time"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	pb "github.com/LuizFJP/pet-ms/proto"
+	"google.golang.org/genproto/googleapis/type/date"
+)
+
+var accuracyToEntity = map[pb.BirthDateAccuracy]entity.BirthDateAccuracy{
+	pb.BirthDateAccuracy_BIRTH_DATE_ACCURACY_EXACT:     entity.BirthDateExact,
+	pb.BirthDateAccuracy_BIRTH_DATE_ACCURACY_MONTH:     entity.BirthDateMonth,
+	pb.BirthDateAccuracy_BIRTH_DATE_ACCURACY_YEAR:      entity.BirthDateYear,
+	pb.BirthDateAccuracy_BIRTH_DATE_ACCURACY_ESTIMATED: entity.BirthDateEstimated,
+}
+
+var accuracyToProto = map[entity.BirthDateAccuracy]pb.BirthDateAccuracy{
+	entity.BirthDateExact:     pb.BirthDateAccuracy_BIRTH_DATE_ACCURACY_EXACT,
+	entity.BirthDateMonth:     pb.BirthDateAccuracy_BIRTH_DATE_ACCURACY_MONTH,
+	entity.BirthDateYear:      pb.BirthDateAccuracy_BIRTH_DATE_ACCURACY_YEAR,
+	entity.BirthDateEstimated: pb.BirthDateAccuracy_BIRTH_DATE_ACCURACY_ESTIMATED,
+}
+
+// invalidBirthDate fica fora da faixa aceita; é usado nos lotes para que a validação
+// do item reporte uma birth_date que não existe no calendário.
+func invalidBirthDate() *time.Time {
+	return &time.Time{}
+}
+
+// birthDateFromRequest converte google.type.Date. Dia ou mês zerados viram 1 e, sem
+// precisão explícita, indicam precisão de mês ou de ano.
+func birthDateFromRequest(d *date.Date, accuracy pb.BirthDateAccuracy) (*time.Time, entity.BirthDateAccuracy, bool) {
+	explicit := accuracyToEntity[accuracy]
+	if d == nil {
+		return nil, explicit, true
+	}
+
+	inferred := entity.BirthDateExact
+	month, day := d.Month, d.Day
+	switch {
+	case d.Year == 0 || (month == 0 && day != 0):
+		return invalidBirthDate(), explicit, false
+	case month == 0:
+		inferred, month, day = entity.BirthDateYear, 1, 1
+	case day == 0:
+		inferred, day = entity.BirthDateMonth, 1
+	}
+
+	t := time.Date(int(d.Year), time.Month(month), int(day), 0, 0, 0, 0, time.UTC)
+	if int32(t.Month()) != month || int32(t.Day()) != day {
+		return invalidBirthDate(), explicit, false
+	}
+	if explicit == "" {
+		return &t, inferred, true
+	}
+	if explicit == entity.BirthDateExact && inferred != entity.BirthDateExact {
+		// precisão exata exige dia e mês
+		return invalidBirthDate(), explicit, false
+	}
+	return &t, explicit, true
+}
+
+// toProtoBirthDate zera as partes da data que estão além da precisão conhecida.
+func toProtoBirthDate(pet *entity.Pet) *date.Date {
+	if pet.BirthDate == nil {
+		return nil
+	}
+	d := &date.Date{
+		Year:  int32(pet.BirthDate.Year()),
+		Month: int32(pet.BirthDate.Month()),
+		Day:   int32(pet.BirthDate.Day()),
+	}
+	switch pet.BirthDateAccuracy {
+	case entity.BirthDateMonth:
+		d.Day = 0
+	case entity.BirthDateYear:
+		d.Month, d.Day = 0, 0
+	}
+	return d
+}
+
+func toPetAge(pet *entity.Pet, now time.Time) *pb.PetAge {
+	years, months, ok := pet.Age(now)
+	if !ok {
+		return nil
+	}
+	return &pb.PetAge{Years: uint32(years), Months: uint32(months)}
+}
@@ -0,0 +1,73 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	pb "github.com/LuizFJP/pet-ms/proto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBirthDateFromRequest(t *testing.T) {
+	born, accuracy, ok := birthDateFromRequest(&date.Date{Year: 2020, Month: 3}, pb.BirthDateAccuracy_BIRTH_DATE_ACCURACY_UNSPECIFIED)
+	require.True(t, ok)
+	assert.Equal(t, entity.BirthDateMonth, accuracy)
+	assert.Equal(t, time.Date(2020, time.March, 1, 0, 0, 0, 0, time.UTC), *born)
+
+	_, accuracy, ok = birthDateFromRequest(&date.Date{Year: 2020}, pb.BirthDateAccuracy_BIRTH_DATE_ACCURACY_ESTIMATED)
+	require.True(t, ok)
+	assert.Equal(t, entity.BirthDateEstimated, accuracy)
+
+	for _, invalid := range []*date.Date{{Year: 2021, Month: 2, Day: 30}, {Month: 1, Day: 1}, {Year: 2020, Day: 5}} {
+		_, _, ok := birthDateFromRequest(invalid, pb.BirthDateAccuracy_BIRTH_DATE_ACCURACY_UNSPECIFIED)
+		assert.False(t, ok, "%v must be rejected", invalid)
+	}
+
+	_, _, ok = birthDateFromRequest(&date.Date{Year: 2020, Month: 3}, pb.BirthDateAccuracy_BIRTH_DATE_ACCURACY_EXACT)
+	assert.False(t, ok, "exact accuracy needs the day")
+}
+
+func TestPetServer_Create_BirthDateAndAge(t *testing.T) {
+	app := &appMock{
+		savePetFn: func(p *entity.Pet) (*entity.Pet, map[string]string) {
+			p.ValidateBirth(time.Now())
+			return p, nil
+		},
+	}
+	s := NewPetServer(app)
+
+	year := int32(time.Now().Year() - 2)
+	resp, err := s.Create(context.Background(), &pb.CreatePetRequest{
+		UuidGuardian: uuid.New().String(), Name: "Rex", Breed: "SRD",
+		BirthDate: &date.Date{Year: year, Month: 1},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &date.Date{Year: year, Month: 1}, resp.BirthDate)
+	assert.Equal(t, pb.BirthDateAccuracy_BIRTH_DATE_ACCURACY_MONTH, resp.BirthDateAccuracy)
+	assert.Equal(t, uint64(year), resp.BirthYear)
+	require.NotNil(t, resp.Age)
+	assert.Equal(t, uint32(2), resp.Age.Years)
+
+	_, err = s.Create(context.Background(), &pb.CreatePetRequest{
+		UuidGuardian: uuid.New().String(), BirthDate: &date.Date{Year: 2021, Month: 2, Day: 30},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestToGetPetResponse_LegacyBirthYearOnly(t *testing.T) {
+	pet := makePet()
+	pet.BirthYear = time.Now().Year() - 3
+
+	resp := toGetPetResponse(pet, nil)
+	assert.Nil(t, resp.BirthDate)
+	assert.Equal(t, pb.BirthDateAccuracy_BIRTH_DATE_ACCURACY_YEAR, resp.BirthDateAccuracy)
+	require.NotNil(t, resp.Age)
+	assert.Equal(t, uint32(3), resp.Age.Years)
+}
@@ -0,0 +1,45 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/LuizFJP/pet-ms/application"
+	pb "github.com/LuizFJP/pet-ms/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *PetServer) SearchBreeds(ctx context.Context, input *pb.SearchBreedsRequest) (*pb.SearchBreedsResponse, error) {
+	query := application.BreedQuery{Text: input.Query, Limit: int(input.PageSize)}
+	for _, code := range input.SpeciesCodes {
+		species, ok := s.pa.LookupSpeciesCode(code)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown species_code %q", code)
+		}
+		query.Species = append(query.Species, species.Value)
+	}
+
+	matches, errData := s.pa.SearchBreeds(query)
+	if errData != nil {
+		return nil, errorFromMap(errData)
+	}
+
+	response := &pb.SearchBreedsResponse{}
+	for _, match := range matches {
+		response.Breeds = append(response.Breeds, toBreedInfo(match, s.pa.LookupSpecies))
+	}
+	return response, nil
+}
+
+func toBreedInfo(match application.BreedMatch, lookup speciesLookup) *pb.BreedInfo {
+	info := &pb.BreedInfo{
+		Name:    match.Breed.Name,
+		Aliases: match.Breed.AliasList(),
+		Matched: match.Matched,
+		Mixed:   match.Mixed,
+	}
+	if !match.Mixed {
+		info.Species = speciesInfoOf(match.Breed.Specie, lookup)
+	}
+	return info
+}
@@ -0,0 +1,46 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/LuizFJP/pet-ms/application"
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	pb "github.com/LuizFJP/pet-ms/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPetServer_SearchBreeds(t *testing.T) {
+	labrador := &entity.Breed{Specie: entity.Dog, Name: "Labrador Retriever"}
+	require.NoError(t, labrador.SetAliases([]string{"Lab"}))
+
+	var got application.BreedQuery
+	app := &appMock{
+		searchBreedsFn: func(query application.BreedQuery) ([]application.BreedMatch, map[string]string) {
+			got = query
+			return []application.BreedMatch{
+				{Breed: entity.NewMixedBreed(0), Matched: "SRD", Mixed: true},
+				{Breed: labrador, Matched: "Lab"},
+			}, nil
+		},
+	}
+	s := NewPetServer(app)
+
+	resp, err := s.SearchBreeds(context.Background(), &pb.SearchBreedsRequest{Query: "la", SpeciesCodes: []string{"dog"}, PageSize: 5})
+	require.NoError(t, err)
+	assert.Equal(t, application.BreedQuery{Text: "la", Species: []entity.PetType{entity.Dog}, Limit: 5}, got)
+
+	require.Len(t, resp.Breeds, 2)
+	assert.True(t, resp.Breeds[0].Mixed)
+	assert.Nil(t, resp.Breeds[0].Species)
+	assert.Equal(t, "Labrador Retriever", resp.Breeds[1].Name)
+	assert.Equal(t, "Lab", resp.Breeds[1].Matched)
+	assert.Equal(t, []string{"Lab"}, resp.Breeds[1].Aliases)
+	assert.Equal(t, pb.Species_SPECIES_DOG, resp.Breeds[1].Species.Species)
+
+	_, err = s.SearchBreeds(context.Background(), &pb.SearchBreedsRequest{SpeciesCodes: []string{"unicorn"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
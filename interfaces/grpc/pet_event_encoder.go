@@ -0,0 +1,55 @@
+package grpc
+
+import (
+	"fmt"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	"github.com/LuizFJP/pet-ms/domain/event"
+	pb "github.com/LuizFJP/pet-ms/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// NewPetEventEncoder serializa os eventos no envelope protobuf publicado pela outbox.
+// O tipo devolvido ("pet.created", "pet.transferred", ...) vira o tópico/assunto no
+// broker; lookup preenche a espécie do pet a partir do catálogo.
+func NewPetEventEncoder(lookup func(entity.PetType) (*entity.Species, bool)) event.Encoder {
+	return func(ev entity.PetEvent) (string, []byte, error) {
+		return encodePetEvent(ev, lookup)
+	}
+}
+
+func encodePetEvent(ev entity.PetEvent, lookup speciesLookup) (string, []byte, error) {
+	eventType := "pet." + ev.Type.String()
+	envelope := &pb.PetEventEnvelope{
+		EventId:    ev.ID.String(),
+		EventType:  eventType,
+		OccurredAt: timestamppb.New(ev.OccurredAt),
+		PetUuid:    ev.Pet.Uuid.String(),
+		TenantId:   ev.Pet.TenantID,
+	}
+
+	pet := toGetPetResponse(&ev.Pet, lookup)
+	switch ev.Type {
+	case entity.PetCreated:
+		envelope.Payload = &pb.PetEventEnvelope_Created{Created: &pb.PetCreated{Pet: pet}}
+	case entity.PetUpdated:
+		envelope.Payload = &pb.PetEventEnvelope_Updated{Updated: &pb.PetUpdated{Pet: pet}}
+	case entity.PetTransferred:
+		envelope.Payload = &pb.PetEventEnvelope_Transferred{Transferred: &pb.PetTransferred{
+			Pet:                  pet,
+			PreviousUuidGuardian: ev.PreviousGuardian.String(),
+			UuidGuardian:         ev.Pet.UuidGuardian.String(),
+		}}
+	case entity.PetDeleted:
+		envelope.Payload = &pb.PetEventEnvelope_Deleted{Deleted: &pb.PetDeleted{Pet: pet}}
+	default:
+		return "", nil, fmt.Errorf("unknown pet event type %d", ev.Type)
+	}
+
+	payload, err := proto.Marshal(envelope)
+	if err != nil {
+		return "", nil, err
+	}
+	return eventType, payload, nil
+}
@@ -0,0 +1,100 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	pb "github.com/LuizFJP/pet-ms/proto"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var guardianRoleToEntity = map[pb.GuardianRole]entity.GuardianRole{
+	pb.GuardianRole_GUARDIAN_ROLE_OWNER:             entity.RoleOwner,
+	pb.GuardianRole_GUARDIAN_ROLE_CO_OWNER:          entity.RoleCoOwner,
+	pb.GuardianRole_GUARDIAN_ROLE_FOSTER:            entity.RoleFoster,
+	pb.GuardianRole_GUARDIAN_ROLE_EMERGENCY_CONTACT: entity.RoleEmergencyContact,
+}
+
+var guardianRoleToProto = map[entity.GuardianRole]pb.GuardianRole{
+	entity.RoleOwner:            pb.GuardianRole_GUARDIAN_ROLE_OWNER,
+	entity.RoleCoOwner:          pb.GuardianRole_GUARDIAN_ROLE_CO_OWNER,
+	entity.RoleFoster:           pb.GuardianRole_GUARDIAN_ROLE_FOSTER,
+	entity.RoleEmergencyContact: pb.GuardianRole_GUARDIAN_ROLE_EMERGENCY_CONTACT,
+}
+
+// guardianFilter monta o filtro por guardião das listagens.
+func guardianFilter(filter *entity.PetFilter, uuidGuardian string, roles []pb.GuardianRole) error {
+	if uuidGuardian != "" {
+		guardian, err := uuid.Parse(uuidGuardian)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, "invalid uuid_guardian")
+		}
+		filter.UuidGuardian = guardian
+	}
+	if len(roles) > 0 && filter.UuidGuardian == uuid.Nil {
+		return status.Error(codes.InvalidArgument, "guardian_roles requires uuid_guardian")
+	}
+	for _, role := range roles {
+		converted, ok := guardianRoleToEntity[role]
+		if !ok {
+			return status.Error(codes.InvalidArgument, "invalid guardian_roles")
+		}
+		filter.GuardianRoles = append(filter.GuardianRoles, converted)
+	}
+	return nil
+}
+
+func (s *PetServer) AddPetGuardian(ctx context.Context, input *pb.AddPetGuardianRequest) (*pb.PetGuardian, error) {
+	pet, err := uuid.Parse(input.PetUuid)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid pet_uuid")
+	}
+	guardian, err := uuid.Parse(input.UuidGuardian)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid uuid_guardian")
+	}
+
+	res, errData := s.pa.AddPetGuardian(ctx, &entity.PetGuardian{
+		PetUuid:      pet,
+		GuardianUuid: guardian,
+		Role:         guardianRoleToEntity[input.Role],
+	})
+	if errData != nil {
+		return nil, errorFromMap(errData)
+	}
+	return toProtoPetGuardian(res), nil
+}
+
+func (s *PetServer) RemovePetGuardian(ctx context.Context, input *pb.RemovePetGuardianRequest) (*pb.RemovePetGuardianResponse, error) {
+	if errData := s.pa.RemovePetGuardian(ctx, input.PetUuid, input.UuidGuardian); errData != nil {
+		return nil, errorFromMap(errData)
+	}
+	return &pb.RemovePetGuardianResponse{Message: "guardião desvinculado do pet"}, nil
+}
+
+func (s *PetServer) ListPetGuardians(ctx context.Context, input *pb.ListPetGuardiansRequest) (*pb.ListPetGuardiansResponse, error) {
+	links, errData := s.pa.ListPetGuardians(ctx, input.PetUuid)
+	if errData != nil {
+		return nil, errorFromMap(errData)
+	}
+	res := &pb.ListPetGuardiansResponse{}
+	for _, link := range links {
+		res.Guardians = append(res.Guardians, toProtoPetGuardian(link))
+	}
+	return res, nil
+}
+
+func toProtoPetGuardian(link *entity.PetGuardian) *pb.PetGuardian {
+	res := &pb.PetGuardian{
+		PetUuid:      link.PetUuid.String(),
+		UuidGuardian: link.GuardianUuid.String(),
+		Role:         guardianRoleToProto[link.Role],
+	}
+	if !link.CreatedAt.IsZero() {
+		res.CreatedAt = timestamppb.New(link.CreatedAt)
+	}
+	return res
+}
@@ -0,0 +1,75 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	pb "github.com/LuizFJP/pet-ms/proto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPetServer_AddPetGuardian(t *testing.T) {
+	pet, guardian := uuid.New(), uuid.New()
+	app := &appMock{
+		addPetGuardianFn: func(link *entity.PetGuardian) (*entity.PetGuardian, map[string]string) {
+			assert.Equal(t, entity.RoleEmergencyContact, link.Role)
+			link.CreatedAt = time.Now()
+			return link, nil
+		},
+	}
+	s := NewPetServer(app)
+
+	resp, err := s.AddPetGuardian(context.Background(), &pb.AddPetGuardianRequest{
+		PetUuid: pet.String(), UuidGuardian: guardian.String(), Role: pb.GuardianRole_GUARDIAN_ROLE_EMERGENCY_CONTACT,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, pb.GuardianRole_GUARDIAN_ROLE_EMERGENCY_CONTACT, resp.Role)
+	assert.NotNil(t, resp.CreatedAt)
+
+	_, err = s.AddPetGuardian(context.Background(), &pb.AddPetGuardianRequest{PetUuid: "bad", UuidGuardian: guardian.String()})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestPetServer_ListPetGuardians(t *testing.T) {
+	pet, owner, foster := uuid.New(), uuid.New(), uuid.New()
+	app := &appMock{
+		listPetGuardiansFn: func(string) ([]*entity.PetGuardian, map[string]string) {
+			return []*entity.PetGuardian{
+				{PetUuid: pet, GuardianUuid: owner, Role: entity.RoleOwner},
+				{PetUuid: pet, GuardianUuid: foster, Role: entity.RoleFoster, CreatedAt: time.Now()},
+			}, nil
+		},
+		removePetGuardianFn: func(string, string) map[string]string {
+			return map[string]string{"not_found": "guardian is not linked to this pet"}
+		},
+	}
+	s := NewPetServer(app)
+
+	resp, err := s.ListPetGuardians(context.Background(), &pb.ListPetGuardiansRequest{PetUuid: pet.String()})
+	require.NoError(t, err)
+	require.Len(t, resp.Guardians, 2)
+	assert.Equal(t, pb.GuardianRole_GUARDIAN_ROLE_OWNER, resp.Guardians[0].Role)
+	assert.Nil(t, resp.Guardians[0].CreatedAt)
+	assert.Equal(t, foster.String(), resp.Guardians[1].UuidGuardian)
+
+	_, err = s.RemovePetGuardian(context.Background(), &pb.RemovePetGuardianRequest{PetUuid: pet.String(), UuidGuardian: foster.String()})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestGuardianFilter(t *testing.T) {
+	guardian := uuid.New()
+	filter := entity.PetFilter{}
+	require.NoError(t, guardianFilter(&filter, guardian.String(), []pb.GuardianRole{pb.GuardianRole_GUARDIAN_ROLE_OWNER, pb.GuardianRole_GUARDIAN_ROLE_FOSTER}))
+	assert.Equal(t, []entity.GuardianRole{entity.RoleOwner, entity.RoleFoster}, filter.GuardianRoles)
+
+	err := guardianFilter(&entity.PetFilter{}, "", []pb.GuardianRole{pb.GuardianRole_GUARDIAN_ROLE_FOSTER})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	err = guardianFilter(&entity.PetFilter{}, guardian.String(), []pb.GuardianRole{pb.GuardianRole_GUARDIAN_ROLE_UNSPECIFIED})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
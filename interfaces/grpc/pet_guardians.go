@@ -0,0 +1,135 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/LuizFJP/pet-ms/application"
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	pb "github.com/LuizFJP/pet-ms/proto"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// guardianRequest é atendida por CreateGuardianRequest e UpdateGuardianRequest.
+type guardianRequest interface {
+	GetName() string
+	GetEmail() string
+	GetPhone() string
+	GetAddress() *pb.GuardianAddress
+	GetConsent() *pb.GuardianConsent
+}
+
+func newGuardian(req guardianRequest) *entity.Guardian {
+	address, consent := req.GetAddress(), req.GetConsent()
+	return &entity.Guardian{
+		Name:  req.GetName(),
+		Email: req.GetEmail(),
+		Phone: req.GetPhone(),
+		Address: entity.Address{
+			Street:     address.GetStreet(),
+			Number:     address.GetNumber(),
+			Complement: address.GetComplement(),
+			District:   address.GetDistrict(),
+			City:       address.GetCity(),
+			State:      address.GetState(),
+			PostalCode: address.GetPostalCode(),
+			Country:    address.GetCountry(),
+		},
+		Consent: entity.Consent{
+			Contact:     consent.GetContact(),
+			Marketing:   consent.GetMarketing(),
+			DataSharing: consent.GetDataSharing(),
+		},
+	}
+}
+
+func (s *PetServer) CreateGuardian(ctx context.Context, input *pb.CreateGuardianRequest) (*pb.Guardian, error) {
+	res, errData := s.pa.CreateGuardian(ctx, newGuardian(input))
+	if errData != nil {
+		return nil, errorFromMap(errData)
+	}
+	return toProtoGuardian(res), nil
+}
+
+func (s *PetServer) GetGuardian(ctx context.Context, input *pb.GetGuardianRequest) (*pb.Guardian, error) {
+	res, errData := s.pa.GetGuardian(ctx, input.Uuid)
+	if errData != nil {
+		return nil, errorFromMap(errData)
+	}
+	return toProtoGuardian(res), nil
+}
+
+func (s *PetServer) UpdateGuardian(ctx context.Context, input *pb.UpdateGuardianRequest) (*pb.Guardian, error) {
+	id, err := uuid.Parse(input.Uuid)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid uuid")
+	}
+	guardian := newGuardian(input)
+	guardian.Uuid = id
+
+	res, errData := s.pa.UpdateGuardian(ctx, guardian)
+	if errData != nil {
+		return nil, errorFromMap(errData)
+	}
+	return toProtoGuardian(res), nil
+}
+
+func (s *PetServer) DeleteGuardian(ctx context.Context, input *pb.DeleteGuardianRequest) (*pb.DeleteGuardianResponse, error) {
+	if errData := s.pa.DeleteGuardian(ctx, input.Uuid); errData != nil {
+		return nil, errorFromMap(errData)
+	}
+	return &pb.DeleteGuardianResponse{Message: "guardião removido"}, nil
+}
+
+// ListGuardians só devolve next_page_token quando a página veio cheia.
+func (s *PetServer) ListGuardians(ctx context.Context, input *pb.ListGuardiansRequest) (*pb.ListGuardiansResponse, error) {
+	after, err := decodePageToken(input.PageToken)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+	}
+
+	guardians, errData := s.pa.ListGuardians(ctx, after, int(input.PageSize))
+	if errData != nil {
+		return nil, errorFromMap(errData)
+	}
+	res := &pb.ListGuardiansResponse{}
+	for _, guardian := range guardians {
+		res.Guardians = append(res.Guardians, toProtoGuardian(guardian))
+	}
+	if len(guardians) > 0 && len(guardians) >= application.GuardianPageSize(int(input.PageSize)) {
+		res.NextPageToken = encodePageToken(guardians[len(guardians)-1].ID)
+	}
+	return res, nil
+}
+
+func toProtoGuardian(guardian *entity.Guardian) *pb.Guardian {
+	res := &pb.Guardian{
+		Uuid:  guardian.Uuid.String(),
+		Name:  guardian.Name,
+		Email: guardian.Email,
+		Phone: guardian.Phone,
+		Address: &pb.GuardianAddress{
+			Street:     guardian.Address.Street,
+			Number:     guardian.Address.Number,
+			Complement: guardian.Address.Complement,
+			District:   guardian.Address.District,
+			City:       guardian.Address.City,
+			State:      guardian.Address.State,
+			PostalCode: guardian.Address.PostalCode,
+			Country:    guardian.Address.Country,
+		},
+		Consent: &pb.GuardianConsent{
+			Contact:     guardian.Consent.Contact,
+			Marketing:   guardian.Consent.Marketing,
+			DataSharing: guardian.Consent.DataSharing,
+		},
+		CreatedAt: timestamppb.New(guardian.CreatedAt),
+		UpdatedAt: timestamppb.New(guardian.UpdatedAt),
+	}
+	if guardian.ConsentUpdatedAt != nil {
+		res.ConsentUpdatedAt = timestamppb.New(*guardian.ConsentUpdatedAt)
+	}
+	return res
+}
@@ -0,0 +1,88 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	pb "github.com/LuizFJP/pet-ms/proto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPetServer_CreateGuardian(t *testing.T) {
+	consentAt := time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)
+	app := &appMock{
+		createGuardianFn: func(g *entity.Guardian) (*entity.Guardian, map[string]string) {
+			assert.Equal(t, "Campinas", g.Address.City)
+			assert.True(t, g.Consent.Marketing)
+			g.Uuid = uuid.New()
+			g.ConsentUpdatedAt = &consentAt
+			return g, nil
+		},
+	}
+	s := NewPetServer(app)
+
+	resp, err := s.CreateGuardian(context.Background(), &pb.CreateGuardianRequest{
+		Name:    "Ana",
+		Email:   "ana@example.com",
+		Address: &pb.GuardianAddress{City: "Campinas", Country: "BR"},
+		Consent: &pb.GuardianConsent{Marketing: true},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Ana", resp.Name)
+	assert.Equal(t, "BR", resp.Address.Country)
+	assert.Equal(t, consentAt, resp.ConsentUpdatedAt.AsTime())
+}
+
+func TestPetServer_Guardian_Errors(t *testing.T) {
+	app := &appMock{
+		getGuardianFn: func(string) (*entity.Guardian, map[string]string) {
+			return nil, map[string]string{"not_found": "guardian not found"}
+		},
+		deleteGuardianFn: func(string) map[string]string {
+			return map[string]string{"failed_precondition": "guardian still has pets"}
+		},
+		createGuardianFn: func(*entity.Guardian) (*entity.Guardian, map[string]string) {
+			return nil, map[string]string{"unavailable": "guardian registry is not enabled"}
+		},
+	}
+	s := NewPetServer(app)
+
+	_, err := s.GetGuardian(context.Background(), &pb.GetGuardianRequest{Uuid: uuid.New().String()})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = s.DeleteGuardian(context.Background(), &pb.DeleteGuardianRequest{Uuid: uuid.New().String()})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = s.CreateGuardian(context.Background(), &pb.CreateGuardianRequest{Name: "Ana"})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	_, err = s.UpdateGuardian(context.Background(), &pb.UpdateGuardianRequest{Uuid: "bad"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestPetServer_ListGuardians_Pages(t *testing.T) {
+	var gotAfter uint
+	app := &appMock{
+		listGuardiansFn: func(after uint, pageSize int) ([]*entity.Guardian, map[string]string) {
+			gotAfter = after
+			return []*entity.Guardian{{ID: 7, Uuid: uuid.New(), Name: "Ana"}, {ID: 9, Uuid: uuid.New(), Name: "Bia"}}, nil
+		},
+	}
+	s := NewPetServer(app)
+
+	resp, err := s.ListGuardians(context.Background(), &pb.ListGuardiansRequest{PageSize: 2})
+	require.NoError(t, err)
+	require.Len(t, resp.Guardians, 2)
+	require.NotEmpty(t, resp.NextPageToken)
+
+	resp, err = s.ListGuardians(context.Background(), &pb.ListGuardiansRequest{PageSize: 3, PageToken: resp.NextPageToken})
+	require.NoError(t, err)
+	assert.Equal(t, uint(9), gotAfter)
+	assert.Empty(t, resp.NextPageToken)
+
+	_, err = s.ListGuardians(context.Background(), &pb.ListGuardiansRequest{PageToken: "???"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,267 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/LuizFJP/pet-ms/application"
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	pb "github.com/LuizFJP/pet-ms/proto"
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var medicalKindToEntity = map[pb.MedicalRecordKind]entity.MedicalRecordKind{
+	pb.MedicalRecordKind_MEDICAL_RECORD_KIND_CONSULTATION: entity.MedicalConsultation,
+	pb.MedicalRecordKind_MEDICAL_RECORD_KIND_TREATMENT:    entity.MedicalTreatment,
+	pb.MedicalRecordKind_MEDICAL_RECORD_KIND_SURGERY:      entity.MedicalSurgery,
+	pb.MedicalRecordKind_MEDICAL_RECORD_KIND_EXAM:         entity.MedicalExam,
+	pb.MedicalRecordKind_MEDICAL_RECORD_KIND_OTHER:        entity.MedicalOther,
+}
+
+var medicalKindToProto = map[entity.MedicalRecordKind]pb.MedicalRecordKind{
+	entity.MedicalConsultation: pb.MedicalRecordKind_MEDICAL_RECORD_KIND_CONSULTATION,
+	entity.MedicalTreatment:    pb.MedicalRecordKind_MEDICAL_RECORD_KIND_TREATMENT,
+	entity.MedicalSurgery:      pb.MedicalRecordKind_MEDICAL_RECORD_KIND_SURGERY,
+	entity.MedicalExam:         pb.MedicalRecordKind_MEDICAL_RECORD_KIND_EXAM,
+	entity.MedicalOther:        pb.MedicalRecordKind_MEDICAL_RECORD_KIND_OTHER,
+}
+
+// dateFromProto exige data completa; nil vira a data zero.
+func dateFromProto(d *date.Date) (time.Time, bool) {
+	if d == nil {
+		return time.Time{}, true
+	}
+	t := time.Date(int(d.Year), time.Month(d.Month), int(d.Day), 0, 0, 0, 0, time.UTC)
+	if d.Year == 0 || int32(t.Month()) != d.Month || int32(t.Day()) != d.Day {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+func optionalDateFromProto(d *date.Date) (*time.Time, bool) {
+	t, ok := dateFromProto(d)
+	if !ok || t.IsZero() {
+		return nil, ok
+	}
+	return &t, true
+}
+
+func toProtoDate(t *time.Time) *date.Date {
+	if t == nil || t.IsZero() {
+		return nil
+	}
+	return &date.Date{Year: int32(t.Year()), Month: int32(t.Month()), Day: int32(t.Day())}
+}
+
+// vaccinationRequest é atendida por AddVaccinationRequest e UpdateVaccinationRequest.
+type vaccinationRequest interface {
+	GetVaccine() string
+	GetDose() uint32
+	GetAdministeredOn() *date.Date
+	GetBoosterIntervalDays() uint32
+	GetLotNumber() string
+	GetVeterinarian() string
+	GetNotes() string
+}
+
+func newVaccination(req vaccinationRequest) (*entity.Vaccination, error) {
+	administered, ok := dateFromProto(req.GetAdministeredOn())
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid administered_on")
+	}
+	return &entity.Vaccination{
+		Vaccine:             req.GetVaccine(),
+		Dose:                int(req.GetDose()),
+		AdministeredAt:      administered,
+		BoosterIntervalDays: int(req.GetBoosterIntervalDays()),
+		LotNumber:           req.GetLotNumber(),
+		Veterinarian:        req.GetVeterinarian(),
+		Notes:               req.GetNotes(),
+	}, nil
+}
+
+func (s *PetServer) AddVaccination(ctx context.Context, input *pb.AddVaccinationRequest) (*pb.Vaccination, error) {
+	vaccination, err := newVaccination(input)
+	if err != nil {
+		return nil, err
+	}
+	vaccination.PetUuid, _ = uuid.Parse(input.PetUuid)
+
+	res, errData := s.pa.AddVaccination(ctx, vaccination)
+	if errData != nil {
+		return nil, errorFromMap(errData)
+	}
+	return toProtoVaccination(res, time.Now()), nil
+}
+
+func (s *PetServer) UpdateVaccination(ctx context.Context, input *pb.UpdateVaccinationRequest) (*pb.Vaccination, error) {
+	id, err := uuid.Parse(input.Uuid)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid uuid")
+	}
+	vaccination, err := newVaccination(input)
+	if err != nil {
+		return nil, err
+	}
+	vaccination.Uuid = id
+
+	res, errData := s.pa.UpdateVaccination(ctx, vaccination)
+	if errData != nil {
+		return nil, errorFromMap(errData)
+	}
+	return toProtoVaccination(res, time.Now()), nil
+}
+
+func (s *PetServer) ListVaccinations(ctx context.Context, input *pb.ListVaccinationsRequest) (*pb.ListVaccinationsResponse, error) {
+	vaccinations, errData := s.pa.ListVaccinations(ctx, input.PetUuid)
+	if errData != nil {
+		return nil, errorFromMap(errData)
+	}
+
+	now := time.Now()
+	res := &pb.ListVaccinationsResponse{}
+	for _, vaccination := range vaccinations {
+		res.Vaccinations = append(res.Vaccinations, toProtoVaccination(vaccination, now))
+	}
+	return res, nil
+}
+
+// ListOverdueVaccinations só devolve next_page_token quando a página veio cheia.
+func (s *PetServer) ListOverdueVaccinations(ctx context.Context, input *pb.ListOverdueVaccinationsRequest) (*pb.ListOverdueVaccinationsResponse, error) {
+	after, err := decodePageToken(input.PageToken)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+	}
+	asOf, ok := dateFromProto(input.AsOf)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid as_of")
+	}
+
+	vaccinations, errData := s.pa.ListOverdueVaccinations(ctx, asOf, after, int(input.PageSize))
+	if errData != nil {
+		return nil, errorFromMap(errData)
+	}
+
+	if asOf.IsZero() {
+		asOf = time.Now()
+	}
+	res := &pb.ListOverdueVaccinationsResponse{}
+	for _, vaccination := range vaccinations {
+		res.Vaccinations = append(res.Vaccinations, toProtoVaccination(vaccination, asOf))
+	}
+	if len(vaccinations) > 0 && len(vaccinations) >= application.OverduePageSize(int(input.PageSize)) {
+		res.NextPageToken = encodePageToken(vaccinations[len(vaccinations)-1].ID)
+	}
+	return res, nil
+}
+
+func toProtoVaccination(vaccination *entity.Vaccination, now time.Time) *pb.Vaccination {
+	return &pb.Vaccination{
+		Uuid:                vaccination.Uuid.String(),
+		PetUuid:             vaccination.PetUuid.String(),
+		Vaccine:             vaccination.Vaccine,
+		Dose:                uint32(vaccination.Dose),
+		AdministeredOn:      toProtoDate(&vaccination.AdministeredAt),
+		BoosterIntervalDays: uint32(vaccination.BoosterIntervalDays),
+		NextDueDate:         toProtoDate(vaccination.NextDueAt),
+		Overdue:             vaccination.Overdue(now),
+		LotNumber:           vaccination.LotNumber,
+		Veterinarian:        vaccination.Veterinarian,
+		Notes:               vaccination.Notes,
+		CreatedAt:           timestamppb.New(vaccination.CreatedAt),
+		UpdatedAt:           timestamppb.New(vaccination.UpdatedAt),
+	}
+}
+
+// medicalRecordRequest é atendida por AddMedicalRecordRequest e UpdateMedicalRecordRequest.
+type medicalRecordRequest interface {
+	GetKind() pb.MedicalRecordKind
+	GetTitle() string
+	GetDescription() string
+	GetVeterinarian() string
+	GetOccurredOn() *date.Date
+	GetFollowUpOn() *date.Date
+}
+
+func newMedicalRecord(req medicalRecordRequest) (*entity.MedicalRecord, error) {
+	occurred, ok := dateFromProto(req.GetOccurredOn())
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid occurred_on")
+	}
+	followUp, ok := optionalDateFromProto(req.GetFollowUpOn())
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid follow_up_on")
+	}
+	return &entity.MedicalRecord{
+		Kind:         medicalKindToEntity[req.GetKind()],
+		Title:        req.GetTitle(),
+		Description:  req.GetDescription(),
+		Veterinarian: req.GetVeterinarian(),
+		OccurredAt:   occurred,
+		FollowUpAt:   followUp,
+	}, nil
+}
+
+func (s *PetServer) AddMedicalRecord(ctx context.Context, input *pb.AddMedicalRecordRequest) (*pb.MedicalRecord, error) {
+	record, err := newMedicalRecord(input)
+	if err != nil {
+		return nil, err
+	}
+	record.PetUuid, _ = uuid.Parse(input.PetUuid)
+
+	res, errData := s.pa.AddMedicalRecord(ctx, record)
+	if errData != nil {
+		return nil, errorFromMap(errData)
+	}
+	return toProtoMedicalRecord(res), nil
+}
+
+func (s *PetServer) UpdateMedicalRecord(ctx context.Context, input *pb.UpdateMedicalRecordRequest) (*pb.MedicalRecord, error) {
+	id, err := uuid.Parse(input.Uuid)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid uuid")
+	}
+	record, err := newMedicalRecord(input)
+	if err != nil {
+		return nil, err
+	}
+	record.Uuid = id
+
+	res, errData := s.pa.UpdateMedicalRecord(ctx, record)
+	if errData != nil {
+		return nil, errorFromMap(errData)
+	}
+	return toProtoMedicalRecord(res), nil
+}
+
+func (s *PetServer) ListMedicalRecords(ctx context.Context, input *pb.ListMedicalRecordsRequest) (*pb.ListMedicalRecordsResponse, error) {
+	records, errData := s.pa.ListMedicalRecords(ctx, input.PetUuid)
+	if errData != nil {
+		return nil, errorFromMap(errData)
+	}
+
+	res := &pb.ListMedicalRecordsResponse{}
+	for _, record := range records {
+		res.Records = append(res.Records, toProtoMedicalRecord(record))
+	}
+	return res, nil
+}
+
+func toProtoMedicalRecord(record *entity.MedicalRecord) *pb.MedicalRecord {
+	return &pb.MedicalRecord{
+		Uuid:         record.Uuid.String(),
+		PetUuid:      record.PetUuid.String(),
+		Kind:         medicalKindToProto[record.Kind],
+		Title:        record.Title,
+		Description:  record.Description,
+		Veterinarian: record.Veterinarian,
+		OccurredOn:   toProtoDate(&record.OccurredAt),
+		FollowUpOn:   toProtoDate(record.FollowUpAt),
+		CreatedAt:    timestamppb.New(record.CreatedAt),
+		UpdatedAt:    timestamppb.New(record.UpdatedAt),
+	}
+}
@@ -0,0 +1,104 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	pb "github.com/LuizFJP/pet-ms/proto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPetServer_AddVaccination(t *testing.T) {
+	petUuid := uuid.New()
+	app := &appMock{
+		addVaccinationFn: func(v *entity.Vaccination) (*entity.Vaccination, map[string]string) {
+			assert.Equal(t, petUuid, v.PetUuid)
+			assert.True(t, v.AdministeredAt.Equal(time.Date(2020, time.May, 1, 0, 0, 0, 0, time.UTC)))
+			v.Uuid = uuid.New()
+			v.ScheduleBooster()
+			return v, nil
+		},
+	}
+	s := NewPetServer(app)
+
+	resp, err := s.AddVaccination(context.Background(), &pb.AddVaccinationRequest{
+		PetUuid:             petUuid.String(),
+		Vaccine:             "V10",
+		AdministeredOn:      &date.Date{Year: 2020, Month: 5, Day: 1},
+		BoosterIntervalDays: 365,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &date.Date{Year: 2021, Month: 5, Day: 1}, resp.NextDueDate)
+	assert.True(t, resp.Overdue)
+
+	_, err = s.AddVaccination(context.Background(), &pb.AddVaccinationRequest{PetUuid: petUuid.String(), AdministeredOn: &date.Date{Year: 2020, Month: 2, Day: 30}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestPetServer_ListOverdueVaccinations_Pages(t *testing.T) {
+	app := &appMock{
+		listOverdueFn: func(asOf time.Time, afterID uint, pageSize int) ([]*entity.Vaccination, map[string]string) {
+			assert.True(t, asOf.Equal(time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)))
+			due := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+			var page []*entity.Vaccination
+			for id := afterID + 1; id <= 3 && len(page) < pageSize; id++ {
+				page = append(page, &entity.Vaccination{ID: id, Uuid: uuid.New(), NextDueAt: &due})
+			}
+			return page, nil
+		},
+	}
+	s := NewPetServer(app)
+
+	req := &pb.ListOverdueVaccinationsRequest{AsOf: &date.Date{Year: 2024, Month: 6, Day: 1}, PageSize: 2}
+	first, err := s.ListOverdueVaccinations(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, first.Vaccinations, 2)
+	assert.True(t, first.Vaccinations[0].Overdue)
+	require.NotEmpty(t, first.NextPageToken)
+
+	req.PageToken = first.NextPageToken
+	second, err := s.ListOverdueVaccinations(context.Background(), req)
+	require.NoError(t, err)
+	assert.Len(t, second.Vaccinations, 1)
+	assert.Empty(t, second.NextPageToken)
+}
+
+func TestPetServer_MedicalRecords(t *testing.T) {
+	petUuid := uuid.New()
+	app := &appMock{
+		addMedicalFn: func(r *entity.MedicalRecord) (*entity.MedicalRecord, map[string]string) {
+			assert.Equal(t, entity.MedicalSurgery, r.Kind)
+			require.NotNil(t, r.FollowUpAt)
+			r.Uuid = uuid.New()
+			return r, nil
+		},
+		listMedicalFn: func(id string) ([]*entity.MedicalRecord, map[string]string) {
+			return nil, map[string]string{"not_found": "pet not found"}
+		},
+	}
+	s := NewPetServer(app)
+
+	resp, err := s.AddMedicalRecord(context.Background(), &pb.AddMedicalRecordRequest{
+		PetUuid:    petUuid.String(),
+		Kind:       pb.MedicalRecordKind_MEDICAL_RECORD_KIND_SURGERY,
+		Title:      "Castração",
+		OccurredOn: &date.Date{Year: 2024, Month: 3, Day: 2},
+		FollowUpOn: &date.Date{Year: 2024, Month: 3, Day: 12},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, pb.MedicalRecordKind_MEDICAL_RECORD_KIND_SURGERY, resp.Kind)
+	assert.Equal(t, int32(12), resp.FollowUpOn.Day)
+
+	_, err = s.ListMedicalRecords(context.Background(), &pb.ListMedicalRecordsRequest{PetUuid: petUuid.String()})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = s.UpdateMedicalRecord(context.Background(), &pb.UpdateMedicalRecordRequest{Uuid: "bad"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,75 @@
+package grpc
+
+import (
+	"errors"
+	"io"
+	"sort"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	pb "github.com/LuizFJP/pet-ms/proto"
+)
+
+func (s *PetServer) ImportPets(stream pb.PetService_ImportPetsServer) error {
+	summary := &pb.ImportPetsResponse{}
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return stream.SendAndClose(summary)
+		}
+		if err != nil {
+			return err
+		}
+
+		pets := make([]*entity.Pet, len(chunk.Pets))
+		for i, item := range chunk.Pets {
+			pets[i] = s.newPetFromCreateRequest(item)
+		}
+
+		imported, itemErrs := s.pa.ImportPets(stream.Context(), pets)
+
+		indexes := make([]int, 0, len(itemErrs))
+		for i := range itemErrs {
+			indexes = append(indexes, i)
+		}
+		sort.Ints(indexes)
+		for _, i := range indexes {
+			summary.Errors = append(summary.Errors, &pb.ImportPetError{
+				Record: summary.Received + uint64(i),
+				Errors: itemErrs[i],
+			})
+		}
+
+		summary.Received += uint64(len(pets))
+		summary.Imported += uint64(imported)
+	}
+}
+
+func (s *PetServer) ExportPets(input *pb.ExportPetsRequest, stream pb.PetService_ExportPetsServer) error {
+	filter := entity.PetFilter{
+		Breed:         input.Breed,
+		BirthYearFrom: int(input.BirthYearFrom),
+		BirthYearTo:   int(input.BirthYearTo),
+	}
+	if err := guardianFilter(&filter, input.UuidGuardian, input.GuardianRoles); err != nil {
+		return err
+	}
+	if err := statusFilter(&filter, input.Statuses); err != nil {
+		return err
+	}
+	for _, specie := range input.Species {
+		filter.Species = append(filter.Species, entity.PetType(specie))
+	}
+
+	var sendErr error
+	errData := s.pa.ExportPets(stream.Context(), filter, int(input.PageSize), func(pet *entity.Pet) error {
+		sendErr = stream.Send(toGetPetResponse(pet, s.pa.LookupSpecies))
+		return sendErr
+	})
+	if sendErr != nil {
+		return sendErr
+	}
+	if errData != nil {
+		return errorFromMap(errData)
+	}
+	return nil
+}
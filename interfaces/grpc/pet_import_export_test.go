@@ -0,0 +1,140 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	pb "github.com/LuizFJP/pet-ms/proto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type importStreamMock struct {
+	grpc.ServerStream
+	chunks   []*pb.ImportPetsRequest
+	response *pb.ImportPetsResponse
+}
+
+func (m *importStreamMock) Context() context.Context { return context.Background() }
+
+func (m *importStreamMock) Recv() (*pb.ImportPetsRequest, error) {
+	if len(m.chunks) == 0 {
+		return nil, io.EOF
+	}
+	chunk := m.chunks[0]
+	m.chunks = m.chunks[1:]
+	return chunk, nil
+}
+
+func (m *importStreamMock) SendAndClose(res *pb.ImportPetsResponse) error {
+	m.response = res
+	return nil
+}
+
+type exportStreamMock struct {
+	grpc.ServerStream
+	sent    []*pb.GetPetResponse
+	sendErr error
+}
+
+func (m *exportStreamMock) Context() context.Context { return context.Background() }
+
+func (m *exportStreamMock) Send(res *pb.GetPetResponse) error {
+	if m.sendErr != nil {
+		return m.sendErr
+	}
+	m.sent = append(m.sent, res)
+	return nil
+}
+
+func TestPetServer_ImportPets_ReportsRecordsAcrossChunks(t *testing.T) {
+	app := &appMock{
+		importFn: func(pets []*entity.Pet) (int, map[int]map[string]string) {
+			errs := map[int]map[string]string{}
+			imported := 0
+			for i, pet := range pets {
+				if pet.Name == "" {
+					errs[i] = map[string]string{"pet name is required": "pet name is empty"}
+				} else {
+					imported++
+				}
+			}
+			return imported, errs
+		},
+	}
+	s := NewPetServer(app)
+
+	guardian := uuid.New().String()
+	stream := &importStreamMock{chunks: []*pb.ImportPetsRequest{
+		{Pets: []*pb.CreatePetRequest{{Name: "A", UuidGuardian: guardian}, {Name: "", UuidGuardian: guardian}}},
+		{Pets: []*pb.CreatePetRequest{{Name: "", UuidGuardian: guardian}, {Name: "B", UuidGuardian: guardian}}},
+	}}
+
+	require.NoError(t, s.ImportPets(stream))
+	require.NotNil(t, stream.response)
+	assert.Equal(t, uint64(4), stream.response.Received)
+	assert.Equal(t, uint64(2), stream.response.Imported)
+	require.Len(t, stream.response.Errors, 2)
+	assert.Equal(t, uint64(1), stream.response.Errors[0].Record)
+	assert.Equal(t, uint64(2), stream.response.Errors[1].Record)
+}
+
+func TestPetServer_ExportPets_StreamsWithFilter(t *testing.T) {
+	guardian := uuid.New()
+	pets := []*entity.Pet{makePet(), makePet()}
+	var gotFilter entity.PetFilter
+	var gotPageSize int
+	app := &appMock{
+		exportFn: func(filter entity.PetFilter, pageSize int, send func(*entity.Pet) error) map[string]string {
+			gotFilter, gotPageSize = filter, pageSize
+			for _, pet := range pets {
+				if err := send(pet); err != nil {
+					return map[string]string{"aborted": err.Error()}
+				}
+			}
+			return nil
+		},
+	}
+	s := NewPetServer(app)
+
+	stream := &exportStreamMock{}
+	req := &pb.ExportPetsRequest{UuidGuardian: guardian.String(), Species: []uint64{1}, BirthYearFrom: 2010, PageSize: 50}
+
+	require.NoError(t, s.ExportPets(req, stream))
+	require.Len(t, stream.sent, 2)
+	assert.Equal(t, pets[0].Uuid.String(), stream.sent[0].Uuid)
+	assert.Equal(t, guardian, gotFilter.UuidGuardian)
+	assert.Equal(t, []entity.PetType{entity.Cat}, gotFilter.Species)
+	assert.Equal(t, 2010, gotFilter.BirthYearFrom)
+	assert.Equal(t, 50, gotPageSize)
+}
+
+func TestPetServer_ExportPets_ReturnsSendError(t *testing.T) {
+	app := &appMock{
+		exportFn: func(filter entity.PetFilter, pageSize int, send func(*entity.Pet) error) map[string]string {
+			if err := send(makePet()); err != nil {
+				return map[string]string{"aborted": err.Error()}
+			}
+			return nil
+		},
+	}
+	s := NewPetServer(app)
+
+	sendErr := errors.New("stream closed")
+	err := s.ExportPets(&pb.ExportPetsRequest{}, &exportStreamMock{sendErr: sendErr})
+	assert.ErrorIs(t, err, sendErr)
+}
+
+func TestPetServer_ExportPets_InvalidGuardian(t *testing.T) {
+	s := NewPetServer(&appMock{})
+
+	err := s.ExportPets(&pb.ExportPetsRequest{UuidGuardian: "nope"}, &exportStreamMock{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,15 @@
+package grpc
+
+import (
+	"context"
+
+	pb "github.com/LuizFJP/pet-ms/proto"
+)
+
+func (s *PetServer) LookupByMicrochip(ctx context.Context, input *pb.LookupByMicrochipRequest) (*pb.GetPetResponse, error) {
+	res, errData := s.pa.LookupByMicrochip(ctx, input.MicrochipNumber)
+	if errData != nil {
+		return nil, errorFromMap(errData)
+	}
+	return toGetPetResponse(res, s.pa.LookupSpecies), nil
+}
@@ -0,0 +1,48 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	pb "github.com/LuizFJP/pet-ms/proto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPetServer_LookupByMicrochip(t *testing.T) {
+	pet := makePet()
+	pet.MicrochipNumber = "985112000123456"
+	app := &appMock{
+		microchipFn: func(number string) (*entity.Pet, map[string]string) {
+			if number != "985 112 000 123 456" {
+				return nil, map[string]string{"not_found": "no pet with this microchip"}
+			}
+			return pet, nil
+		},
+	}
+	s := NewPetServer(app)
+
+	resp, err := s.LookupByMicrochip(context.Background(), &pb.LookupByMicrochipRequest{MicrochipNumber: "985 112 000 123 456"})
+	require.NoError(t, err)
+	assert.Equal(t, pet.Uuid.String(), resp.Uuid)
+	assert.Equal(t, "985112000123456", resp.MicrochipNumber)
+
+	_, err = s.LookupByMicrochip(context.Background(), &pb.LookupByMicrochipRequest{MicrochipNumber: "985112000654321"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestPetServer_Create_MicrochipConflict(t *testing.T) {
+	app := &appMock{
+		savePetFn: func(p *entity.Pet) (*entity.Pet, map[string]string) {
+			return nil, map[string]string{"conflict": "microchip already registered to another pet"}
+		},
+	}
+	s := NewPetServer(app)
+
+	_, err := s.Create(context.Background(), &pb.CreatePetRequest{UuidGuardian: uuid.New().String(), Name: "Rex", MicrochipNumber: "985112000123456"})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+}
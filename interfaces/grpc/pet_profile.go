@@ -0,0 +1,109 @@
+package grpc
+
+import (
+	"fmt"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	pb "github.com/LuizFJP/pet-ms/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var sexToEntity = map[pb.PetSex]entity.PetSex{
+	pb.PetSex_PET_SEX_MALE:   entity.SexMale,
+	pb.PetSex_PET_SEX_FEMALE: entity.SexFemale,
+}
+
+var sexToProto = map[entity.PetSex]pb.PetSex{
+	entity.SexMale:   pb.PetSex_PET_SEX_MALE,
+	entity.SexFemale: pb.PetSex_PET_SEX_FEMALE,
+}
+
+// maskPaths liga os campos do UpdatePetRequest aos campos do Pet.
+var maskPaths = map[string][]string{
+	"name":                {"name"},
+	"birth_year":          {"birth_year"},
+	"birth_date":          {"birth_date", "birth_date_accuracy"},
+	"birth_date_accuracy": {"birth_date_accuracy"},
+	"breed":               {"breed"},
+	"specie":              {"specie"},
+	"species_code":        {"specie"},
+	"sex":                 {"sex"},
+	"neutered":            {"neutered"},
+	"color":               {"color"},
+	"markings":            {"markings"},
+	"weight_grams":        {"weight_history"},
+	"microchip_number":    {"microchip_number"},
+	"photo_urls":          {"photos"},
+	"notes":               {"notes"},
+}
+
+// profileRequest é atendida tanto por CreatePetRequest quanto por UpdatePetRequest.
+type profileRequest interface {
+	GetSex() pb.PetSex
+	GetNeutered() *wrapperspb.BoolValue
+	GetColor() string
+	GetMarkings() string
+	GetWeightGrams() uint32
+	GetMicrochipNumber() string
+	GetPhotoUrls() []string
+	GetNotes() string
+}
+
+func setProfile(pet *entity.Pet, req profileRequest) {
+	pet.Sex = sexToEntity[req.GetSex()]
+	if neutered := req.GetNeutered(); neutered != nil {
+		value := neutered.Value
+		pet.Neutered = &value
+	}
+	pet.Color = req.GetColor()
+	pet.Markings = req.GetMarkings()
+	if grams := req.GetWeightGrams(); grams > 0 {
+		// a data da pesagem é preenchida pela validação
+		pet.WeightHistory = entity.WeightHistory{{Grams: int(grams)}}
+	}
+	pet.MicrochipNumber = req.GetMicrochipNumber()
+	pet.Photos = entity.StringList(req.GetPhotoUrls())
+	pet.Notes = req.GetNotes()
+}
+
+func maskFields(mask *fieldmaskpb.FieldMask) ([]string, error) {
+	seen := map[string]bool{}
+	var fields []string
+	for _, path := range mask.GetPaths() {
+		mapped, ok := maskPaths[path]
+		if !ok {
+			return nil, fmt.Errorf("unknown update_mask path %q", path)
+		}
+		for _, field := range mapped {
+			if !seen[field] {
+				seen[field] = true
+				fields = append(fields, field)
+			}
+		}
+	}
+	return fields, nil
+}
+
+func toProtoNeutered(pet *entity.Pet) *wrapperspb.BoolValue {
+	if pet.Neutered == nil {
+		return nil
+	}
+	return wrapperspb.Bool(*pet.Neutered)
+}
+
+func toProtoWeights(history entity.WeightHistory) []*pb.WeightMeasurement {
+	var weights []*pb.WeightMeasurement
+	for _, m := range history {
+		weights = append(weights, &pb.WeightMeasurement{Grams: uint32(m.Grams), MeasuredAt: timestamppb.New(m.MeasuredAt)})
+	}
+	return weights
+}
+
+func latestWeight(history entity.WeightHistory) uint32 {
+	if latest := history.Latest(); latest != nil {
+		return uint32(latest.Grams)
+	}
+	return 0
+}
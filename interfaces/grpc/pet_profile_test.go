@@ -0,0 +1,80 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	pb "github.com/LuizFJP/pet-ms/proto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestPetServer_Create_ProfileFields(t *testing.T) {
+	var saved *entity.Pet
+	app := &appMock{
+		savePetFn: func(p *entity.Pet) (*entity.Pet, map[string]string) {
+			saved = p
+			p.ValidateProfile(time.Now())
+			return p, nil
+		},
+	}
+	s := NewPetServer(app)
+
+	resp, err := s.Create(context.Background(), &pb.CreatePetRequest{
+		UuidGuardian: uuid.New().String(), Name: "Rex", Breed: "SRD",
+		Sex: pb.PetSex_PET_SEX_MALE, Neutered: wrapperspb.Bool(false), Color: "Caramelo",
+		WeightGrams: 12500, MicrochipNumber: "985112000123456",
+		PhotoUrls: []string{"https://cdn.example.com/rex.jpg"}, Notes: "Dócil",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, entity.SexMale, saved.Sex)
+	require.NotNil(t, saved.Neutered)
+	assert.False(t, *saved.Neutered)
+
+	assert.Equal(t, pb.PetSex_PET_SEX_MALE, resp.Sex)
+	assert.False(t, resp.Neutered.GetValue())
+	assert.Equal(t, uint32(12500), resp.WeightGrams)
+	require.Len(t, resp.WeightHistory, 1)
+	assert.Equal(t, []string{"https://cdn.example.com/rex.jpg"}, resp.PhotoUrls)
+	assert.Equal(t, "Dócil", resp.Notes)
+}
+
+func TestPetServer_Update_WithMask(t *testing.T) {
+	var gotFields []string
+	var got *entity.Pet
+	app := &appMock{
+		updateFieldsFn: func(p *entity.Pet, fields []string) (*entity.Pet, map[string]string) {
+			got, gotFields = p, fields
+			return p, nil
+		},
+		updatePetFn: func(p *entity.Pet) (*entity.Pet, map[string]string) {
+			t.Fatal("masked update must not fall back to the full update")
+			return nil, nil
+		},
+	}
+	s := NewPetServer(app)
+
+	resp, err := s.Update(context.Background(), &pb.UpdatePetRequest{
+		Uuid:        uuid.New().String(),
+		Color:       "Branco",
+		WeightGrams: 9000,
+		UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"color", "weight_grams", "birth_date"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"color", "weight_history", "birth_date", "birth_date_accuracy"}, gotFields)
+	assert.Equal(t, "Branco", got.Color)
+	assert.Equal(t, "Branco", resp.Color)
+
+	_, err = s.Update(context.Background(), &pb.UpdatePetRequest{
+		Uuid:       uuid.New().String(),
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"uuid_guardian"}},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
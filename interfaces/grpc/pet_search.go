@@ -0,0 +1,41 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	pb "github.com/LuizFJP/pet-ms/proto"
+)
+
+// searchHighlightFields fixa a ordem dos destaques na resposta.
+var searchHighlightFields = []string{entity.SearchFieldName, entity.SearchFieldBreed, entity.SearchFieldNotes}
+
+func (s *PetServer) SearchPets(ctx context.Context, input *pb.SearchPetsRequest) (*pb.SearchPetsResponse, error) {
+	search := entity.PetSearch{Query: input.Query, Limit: int(input.PageSize)}
+	if err := guardianFilter(&search.Filter, input.UuidGuardian, input.GuardianRoles); err != nil {
+		return nil, err
+	}
+	if err := statusFilter(&search.Filter, input.Statuses); err != nil {
+		return nil, err
+	}
+	for _, specie := range input.Species {
+		search.Filter.Species = append(search.Filter.Species, entity.PetType(specie))
+	}
+
+	hits, errData := s.pa.SearchPets(ctx, search)
+	if errData != nil {
+		return nil, errorFromMap(errData)
+	}
+
+	res := &pb.SearchPetsResponse{}
+	for _, hit := range hits {
+		protoHit := &pb.PetSearchHit{Pet: toGetPetResponse(hit.Pet, s.pa.LookupSpecies), Rank: hit.Rank}
+		for _, field := range searchHighlightFields {
+			if text, ok := hit.Highlights[field]; ok {
+				protoHit.Highlights = append(protoHit.Highlights, &pb.SearchHighlight{Field: field, Text: text})
+			}
+		}
+		res.Hits = append(res.Hits, protoHit)
+	}
+	return res, nil
+}
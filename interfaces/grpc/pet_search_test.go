@@ -0,0 +1,53 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	pb "github.com/LuizFJP/pet-ms/proto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPetServer_SearchPets(t *testing.T) {
+	pet := makePet()
+	guardian := uuid.New()
+	var got entity.PetSearch
+	app := &appMock{
+		searchPetsFn: func(search entity.PetSearch) ([]*entity.PetSearchHit, map[string]string) {
+			got = search
+			return []*entity.PetSearchHit{{
+				Pet:  pet,
+				Rank: 1.5,
+				Highlights: map[string]string{
+					entity.SearchFieldNotes: "amigo do <mark>Rex</mark>",
+					entity.SearchFieldName:  "<mark>Rex</mark>",
+				},
+			}}, nil
+		},
+	}
+	s := NewPetServer(app)
+
+	resp, err := s.SearchPets(context.Background(), &pb.SearchPetsRequest{
+		Query:        "rex golden",
+		UuidGuardian: guardian.String(),
+		Species:      []uint64{uint64(entity.Dog)},
+		PageSize:     5,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, entity.PetSearch{Query: "rex golden", Filter: entity.PetFilter{UuidGuardian: guardian, Species: []entity.PetType{entity.Dog}}, Limit: 5}, got)
+
+	require.Len(t, resp.Hits, 1)
+	assert.Equal(t, pet.Uuid.String(), resp.Hits[0].Pet.Uuid)
+	assert.Equal(t, 1.5, resp.Hits[0].Rank)
+	require.Len(t, resp.Hits[0].Highlights, 2)
+	assert.Equal(t, entity.SearchFieldName, resp.Hits[0].Highlights[0].Field, "highlights follow field order")
+	assert.Equal(t, entity.SearchFieldNotes, resp.Hits[0].Highlights[1].Field)
+
+	_, err = s.SearchPets(context.Background(), &pb.SearchPetsRequest{Query: "rex", UuidGuardian: "bad"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,102 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	pb "github.com/LuizFJP/pet-ms/proto"
+)
+
+// speciesLookup resolve o valor gravado no pet para a entrada do catálogo.
+type speciesLookup func(entity.PetType) (*entity.Species, bool)
+
+// unknownPetType não existe no catálogo; é usado quando species_code não é encontrado
+// para que a validação do item reporte o erro.
+const unknownPetType entity.PetType = -1
+
+// builtinSpecies liga os códigos das espécies padrão aos valores do enum.
+var builtinSpecies = map[string]pb.Species{
+	"dog":     pb.Species_SPECIES_DOG,
+	"cat":     pb.Species_SPECIES_CAT,
+	"bird":    pb.Species_SPECIES_BIRD,
+	"rabbit":  pb.Species_SPECIES_RABBIT,
+	"reptile": pb.Species_SPECIES_REPTILE,
+	"rodent":  pb.Species_SPECIES_RODENT,
+	"fish":    pb.Species_SPECIES_FISH,
+	"horse":   pb.Species_SPECIES_HORSE,
+}
+
+func (s *PetServer) CreateSpecies(ctx context.Context, input *pb.CreateSpeciesRequest) (*pb.SpeciesInfo, error) {
+	res, errData := s.pa.CreateSpecies(&entity.Species{Code: input.Code, DisplayName: input.DisplayName})
+	if errData != nil {
+		return nil, errorFromMap(errData)
+	}
+	return toSpeciesInfo(res), nil
+}
+
+func (s *PetServer) GetSpecies(ctx context.Context, input *pb.GetSpeciesRequest) (*pb.SpeciesInfo, error) {
+	res, errData := s.pa.GetSpecies(input.Code)
+	if errData != nil {
+		return nil, errorFromMap(errData)
+	}
+	return toSpeciesInfo(res), nil
+}
+
+func (s *PetServer) ListSpecies(ctx context.Context, input *pb.ListSpeciesRequest) (*pb.ListSpeciesResponse, error) {
+	list, errData := s.pa.ListSpecies()
+	if errData != nil {
+		return nil, errorFromMap(errData)
+	}
+
+	response := &pb.ListSpeciesResponse{}
+	for _, species := range list {
+		response.Species = append(response.Species, toSpeciesInfo(species))
+	}
+	return response, nil
+}
+
+func (s *PetServer) UpdateSpecies(ctx context.Context, input *pb.UpdateSpeciesRequest) (*pb.SpeciesInfo, error) {
+	res, errData := s.pa.UpdateSpecies(&entity.Species{Code: input.Code, DisplayName: input.DisplayName})
+	if errData != nil {
+		return nil, errorFromMap(errData)
+	}
+	return toSpeciesInfo(res), nil
+}
+
+func (s *PetServer) DeleteSpecies(ctx context.Context, input *pb.DeleteSpeciesRequest) (*pb.DeleteSpeciesResponse, error) {
+	if errData := s.pa.DeleteSpecies(input.Code); errData != nil {
+		return nil, errorFromMap(errData)
+	}
+	return &pb.DeleteSpeciesResponse{Message: "espécie removida"}, nil
+}
+
+// specieFromRequest prefere species_code ao valor numérico legado.
+func (s *PetServer) specieFromRequest(code string, legacy uint64) (entity.PetType, bool) {
+	if code == "" {
+		return entity.PetType(legacy), true
+	}
+	species, ok := s.pa.LookupSpeciesCode(code)
+	if !ok {
+		return unknownPetType, false
+	}
+	return species.Value, true
+}
+
+func speciesInfoOf(value entity.PetType, lookup speciesLookup) *pb.SpeciesInfo {
+	if lookup == nil {
+		return nil
+	}
+	species, ok := lookup(value)
+	if !ok {
+		return &pb.SpeciesInfo{Species: pb.Species_SPECIES_UNSPECIFIED}
+	}
+	return toSpeciesInfo(species)
+}
+
+func toSpeciesInfo(species *entity.Species) *pb.SpeciesInfo {
+	enum, ok := builtinSpecies[species.Code]
+	if !ok {
+		enum = pb.Species_SPECIES_CUSTOM
+	}
+	return &pb.SpeciesInfo{Species: enum, Code: species.Code, DisplayName: species.DisplayName}
+}
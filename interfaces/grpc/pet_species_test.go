@@ -0,0 +1,71 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	pb "github.com/LuizFJP/pet-ms/proto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPetServer_Create_UsesSpeciesCode(t *testing.T) {
+	var saved *entity.Pet
+	app := &appMock{
+		savePetFn: func(p *entity.Pet) (*entity.Pet, map[string]string) {
+			saved = p
+			return p, nil
+		},
+	}
+	s := NewPetServer(app)
+
+	resp, err := s.Create(context.Background(), &pb.CreatePetRequest{
+		UuidGuardian: uuid.New().String(), Name: "Rex", Breed: "SRD", Specie: 1, SpeciesCode: "rabbit",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, entity.Rabbit, saved.Specie)
+	assert.Equal(t, pb.Species_SPECIES_RABBIT, resp.Species.Species)
+	assert.Equal(t, "rabbit", resp.Species.Code)
+	assert.Equal(t, "Coelho", resp.Species.DisplayName)
+
+	_, err = s.Create(context.Background(), &pb.CreatePetRequest{UuidGuardian: uuid.New().String(), SpeciesCode: "unicorn"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestPetServer_SpeciesCrud(t *testing.T) {
+	ferret := &entity.Species{Value: entity.FirstCustomPetType, Code: "ferret", DisplayName: "Furão"}
+	app := &appMock{
+		createSpeciesFn: func(species *entity.Species) (*entity.Species, map[string]string) {
+			return ferret, nil
+		},
+		listSpeciesFn: func() ([]*entity.Species, map[string]string) {
+			return []*entity.Species{{Value: entity.Dog, Code: "dog", DisplayName: "Cachorro"}, ferret}, nil
+		},
+		getSpeciesFn: func(code string) (*entity.Species, map[string]string) {
+			return nil, map[string]string{"not_found": "species not found"}
+		},
+		deleteSpeciesFn: func(code string) map[string]string {
+			return map[string]string{"failed_precondition": "species is still used by pets"}
+		},
+	}
+	s := NewPetServer(app)
+
+	created, err := s.CreateSpecies(context.Background(), &pb.CreateSpeciesRequest{Code: "ferret", DisplayName: "Furão"})
+	require.NoError(t, err)
+	assert.Equal(t, pb.Species_SPECIES_CUSTOM, created.Species)
+
+	list, err := s.ListSpecies(context.Background(), &pb.ListSpeciesRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.Species, 2)
+	assert.Equal(t, pb.Species_SPECIES_DOG, list.Species[0].Species)
+
+	_, err = s.GetSpecies(context.Background(), &pb.GetSpeciesRequest{Code: "unicorn"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = s.DeleteSpecies(context.Background(), &pb.DeleteSpeciesRequest{Code: "cat"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
@@ -0,0 +1,71 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	pb "github.com/LuizFJP/pet-ms/proto"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var statusToEntity = map[pb.PetStatus]entity.PetStatus{
+	pb.PetStatus_PET_STATUS_INTAKE:    entity.StatusIntake,
+	pb.PetStatus_PET_STATUS_AVAILABLE: entity.StatusAvailable,
+	pb.PetStatus_PET_STATUS_RESERVED:  entity.StatusReserved,
+	pb.PetStatus_PET_STATUS_ADOPTED:   entity.StatusAdopted,
+	pb.PetStatus_PET_STATUS_RETURNED:  entity.StatusReturned,
+}
+
+var statusToProto = map[entity.PetStatus]pb.PetStatus{
+	entity.StatusIntake:    pb.PetStatus_PET_STATUS_INTAKE,
+	entity.StatusAvailable: pb.PetStatus_PET_STATUS_AVAILABLE,
+	entity.StatusReserved:  pb.PetStatus_PET_STATUS_RESERVED,
+	entity.StatusAdopted:   pb.PetStatus_PET_STATUS_ADOPTED,
+	entity.StatusReturned:  pb.PetStatus_PET_STATUS_RETURNED,
+}
+
+// statusFilter acrescenta ao filtro das listagens os status pedidos.
+func statusFilter(filter *entity.PetFilter, statuses []pb.PetStatus) error {
+	for _, st := range statuses {
+		converted, ok := statusToEntity[st]
+		if !ok {
+			return status.Error(codes.InvalidArgument, "invalid statuses")
+		}
+		filter.Statuses = append(filter.Statuses, converted)
+	}
+	return nil
+}
+
+func (s *PetServer) TransitionPet(ctx context.Context, input *pb.TransitionPetRequest) (*pb.GetPetResponse, error) {
+	if _, err := uuid.Parse(input.Uuid); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid uuid")
+	}
+	to, ok := statusToEntity[input.Status]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "status is required")
+	}
+	transition := entity.StatusTransition{To: to, Reason: input.Reason}
+	if input.UuidGuardian != "" {
+		guardian, err := uuid.Parse(input.UuidGuardian)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid uuid_guardian")
+		}
+		transition.Guardian = guardian
+	}
+
+	res, errData := s.pa.TransitionPet(ctx, input.Uuid, transition)
+	if errData != nil {
+		return nil, errorFromMap(errData)
+	}
+	return toGetPetResponse(res, s.pa.LookupSpecies), nil
+}
+
+func toProtoStatusChangedAt(pet *entity.Pet) *timestamppb.Timestamp {
+	if pet.StatusChangedAt == nil {
+		return nil
+	}
+	return timestamppb.New(*pet.StatusChangedAt)
+}
@@ -0,0 +1,69 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	pb "github.com/LuizFJP/pet-ms/proto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPetServer_TransitionPet(t *testing.T) {
+	pet, adopter := makePet(), uuid.New()
+	app := &appMock{
+		transitionFn: func(id string, transition entity.StatusTransition) (*entity.Pet, map[string]string) {
+			assert.Equal(t, pet.Uuid.String(), id)
+			assert.Equal(t, entity.StatusTransition{To: entity.StatusAdopted, Guardian: adopter}, transition)
+			ret := *pet
+			entity.StatusChange{To: transition.To, Guardian: transition.Guardian, ChangedAt: time.Now()}.Apply(&ret)
+			return &ret, nil
+		},
+	}
+	s := NewPetServer(app)
+
+	resp, err := s.TransitionPet(context.Background(), &pb.TransitionPetRequest{
+		Uuid: pet.Uuid.String(), Status: pb.PetStatus_PET_STATUS_ADOPTED, UuidGuardian: adopter.String(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, pb.PetStatus_PET_STATUS_ADOPTED, resp.Status)
+	assert.Equal(t, adopter.String(), resp.UuidGuardian)
+	assert.NotNil(t, resp.StatusChangedAt)
+
+	for _, req := range []*pb.TransitionPetRequest{
+		{Uuid: "bad", Status: pb.PetStatus_PET_STATUS_AVAILABLE},
+		{Uuid: pet.Uuid.String()},
+		{Uuid: pet.Uuid.String(), Status: pb.PetStatus_PET_STATUS_ADOPTED, UuidGuardian: "bad"},
+	} {
+		_, err := s.TransitionPet(context.Background(), req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+}
+
+func TestPetServer_TransitionPet_IllegalTransition(t *testing.T) {
+	app := &appMock{
+		transitionFn: func(string, entity.StatusTransition) (*entity.Pet, map[string]string) {
+			return nil, map[string]string{"failed_precondition": "cannot move pet from intake to adopted"}
+		},
+	}
+	s := NewPetServer(app)
+
+	_, err := s.TransitionPet(context.Background(), &pb.TransitionPetRequest{
+		Uuid: uuid.New().String(), Status: pb.PetStatus_PET_STATUS_ADOPTED, UuidGuardian: uuid.New().String(),
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestStatusFilter(t *testing.T) {
+	var filter entity.PetFilter
+	require.NoError(t, statusFilter(&filter, []pb.PetStatus{pb.PetStatus_PET_STATUS_AVAILABLE, pb.PetStatus_PET_STATUS_RESERVED}))
+	assert.Equal(t, []entity.PetStatus{entity.StatusAvailable, entity.StatusReserved}, filter.Statuses)
+
+	err := statusFilter(&filter, []pb.PetStatus{pb.PetStatus_PET_STATUS_UNSPECIFIED})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
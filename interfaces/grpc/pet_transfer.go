@@ -0,0 +1,22 @@
+package grpc
+
+import (
+	"context"
+
+	pb "github.com/LuizFJP/pet-ms/proto"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *PetServer) Transfer(ctx context.Context, input *pb.TransferPetRequest) (*pb.GetPetResponse, error) {
+	if _, err := uuid.Parse(input.Uuid); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid uuid")
+	}
+
+	res, errData := s.pa.TransferPet(ctx, input.Uuid, input.UuidGuardian)
+	if errData != nil {
+		return nil, errorFromMap(errData)
+	}
+	return toGetPetResponse(res, s.pa.LookupSpecies), nil
+}
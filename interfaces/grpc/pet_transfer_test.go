@@ -0,0 +1,75 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/LuizFJP/pet-ms/application"
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	pb "github.com/LuizFJP/pet-ms/proto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestPetServer_Transfer_Success(t *testing.T) {
+	pet := makePet()
+	newGuardian := uuid.New()
+	app := &appMock{
+		transferFn: func(id, guardian string) (*entity.Pet, map[string]string) {
+			assert.Equal(t, pet.Uuid.String(), id)
+			ret := *pet
+			ret.UuidGuardian = uuid.MustParse(guardian)
+			return &ret, nil
+		},
+	}
+	s := NewPetServer(app)
+
+	resp, err := s.Transfer(context.Background(), &pb.TransferPetRequest{Uuid: pet.Uuid.String(), UuidGuardian: newGuardian.String()})
+	require.NoError(t, err)
+	assert.Equal(t, newGuardian.String(), resp.UuidGuardian)
+}
+
+func TestPetServer_Transfer_NotFound(t *testing.T) {
+	app := &appMock{
+		transferFn: func(id, guardian string) (*entity.Pet, map[string]string) {
+			return nil, map[string]string{"not_found": "pet not found"}
+		},
+	}
+	s := NewPetServer(app)
+
+	_, err := s.Transfer(context.Background(), &pb.TransferPetRequest{Uuid: uuid.New().String(), UuidGuardian: uuid.New().String()})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = s.Transfer(context.Background(), &pb.TransferPetRequest{Uuid: "bad", UuidGuardian: uuid.New().String()})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestEncodePetEvent_Transferred(t *testing.T) {
+	pet := makePet()
+	previous := uuid.New()
+	ev := entity.PetEvent{ID: uuid.New(), Type: entity.PetTransferred, Pet: *pet, PreviousGuardian: previous}
+
+	eventType, payload, err := NewPetEventEncoder(application.NewSpeciesCatalog(nil, 0).Lookup)(ev)
+	require.NoError(t, err)
+	assert.Equal(t, "pet.transferred", eventType)
+
+	envelope := &pb.PetEventEnvelope{}
+	require.NoError(t, proto.Unmarshal(payload, envelope))
+	assert.Equal(t, ev.ID.String(), envelope.EventId)
+	assert.Equal(t, pet.Uuid.String(), envelope.PetUuid)
+	transferred := envelope.GetTransferred()
+	require.NotNil(t, transferred)
+	assert.Equal(t, previous.String(), transferred.PreviousUuidGuardian)
+	assert.Equal(t, pet.UuidGuardian.String(), transferred.UuidGuardian)
+	assert.Equal(t, pet.Name, transferred.Pet.Name)
+	assert.Equal(t, "bird", transferred.Pet.Species.Code)
+}
+
+func TestEncodePetEvent_UnknownType(t *testing.T) {
+	_, _, err := NewPetEventEncoder(nil)(entity.PetEvent{Type: entity.PetEventType(99)})
+	assert.Error(t, err)
+}
@@ -0,0 +1,64 @@
+package grpc
+
+import (
+	"encoding/base64"
+	"strconv"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	pb "github.com/LuizFJP/pet-ms/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *PetServer) WatchPets(input *pb.WatchPetsRequest, stream pb.PetService_WatchPetsServer) error {
+	filter := entity.PetFilter{}
+	if err := guardianFilter(&filter, input.UuidGuardian, input.GuardianRoles); err != nil {
+		return err
+	}
+	if err := statusFilter(&filter, input.Statuses); err != nil {
+		return err
+	}
+	for _, specie := range input.Species {
+		filter.Species = append(filter.Species, entity.PetType(specie))
+	}
+
+	after, err := decodeResumeToken(input.ResumeToken)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "invalid resume_token")
+	}
+
+	var sendErr error
+	errData := s.pa.WatchPets(stream.Context(), after, filter, func(ev entity.PetEvent) error {
+		sendErr = stream.Send(&pb.WatchPetsResponse{
+			Type:        pb.PetEventType(ev.Type),
+			Pet:         toGetPetResponse(&ev.Pet, s.pa.LookupSpecies),
+			OccurredAt:  timestamppb.New(ev.OccurredAt),
+			ResumeToken: encodeResumeToken(ev.Sequence),
+		})
+		return sendErr
+	})
+	if sendErr != nil {
+		return sendErr
+	}
+	if errData != nil {
+		return errorFromMap(errData)
+	}
+	return nil
+}
+
+// O token é opaco para o cliente; hoje carrega só a sequência do evento.
+func encodeResumeToken(sequence uint64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatUint(sequence, 10)))
+}
+
+func decodeResumeToken(token string) (uint64, error) {
+	if token == "" {
+		return 0, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(string(raw), 10, 64)
+}
@@ -0,0 +1,92 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/LuizFJP/pet-ms/domain/entity"
+	pb "github.com/LuizFJP/pet-ms/proto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type watchStreamMock struct {
+	grpc.ServerStream
+	sent []*pb.WatchPetsResponse
+}
+
+func (m *watchStreamMock) Context() context.Context { return context.Background() }
+
+func (m *watchStreamMock) Send(res *pb.WatchPetsResponse) error {
+	m.sent = append(m.sent, res)
+	return nil
+}
+
+func TestPetServer_WatchPets_SendsEventsWithResumeTokens(t *testing.T) {
+	pet := makePet()
+	occurred := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	var gotAfter uint64
+	var gotFilter entity.PetFilter
+	app := &appMock{
+		watchFn: func(ctx context.Context, after uint64, filter entity.PetFilter, send func(entity.PetEvent) error) map[string]string {
+			gotAfter, gotFilter = after, filter
+			_ = send(entity.PetEvent{Sequence: 42, Type: entity.PetUpdated, Pet: *pet, OccurredAt: occurred})
+			return nil
+		},
+	}
+	s := NewPetServer(app)
+
+	stream := &watchStreamMock{}
+	req := &pb.WatchPetsRequest{
+		UuidGuardian: pet.UuidGuardian.String(),
+		Species:      []uint64{2},
+		ResumeToken:  encodeResumeToken(41),
+	}
+
+	require.NoError(t, s.WatchPets(req, stream))
+	assert.Equal(t, uint64(41), gotAfter)
+	assert.Equal(t, pet.UuidGuardian, gotFilter.UuidGuardian)
+	assert.Equal(t, []entity.PetType{2}, gotFilter.Species)
+
+	require.Len(t, stream.sent, 1)
+	assert.Equal(t, pb.PetEventType_PET_EVENT_TYPE_UPDATED, stream.sent[0].Type)
+	assert.Equal(t, pet.Uuid.String(), stream.sent[0].Pet.Uuid)
+	assert.Equal(t, occurred, stream.sent[0].OccurredAt.AsTime())
+
+	next, err := decodeResumeToken(stream.sent[0].ResumeToken)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(42), next)
+}
+
+func TestPetServer_WatchPets_InvalidInput(t *testing.T) {
+	s := NewPetServer(&appMock{})
+
+	err := s.WatchPets(&pb.WatchPetsRequest{UuidGuardian: "x"}, &watchStreamMock{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	err = s.WatchPets(&pb.WatchPetsRequest{ResumeToken: "***"}, &watchStreamMock{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestPetServer_WatchPets_ExpiredToken(t *testing.T) {
+	app := &appMock{
+		watchFn: func(ctx context.Context, after uint64, filter entity.PetFilter, send func(entity.PetEvent) error) map[string]string {
+			return map[string]string{"failed_precondition": "resume token expired"}
+		},
+	}
+	s := NewPetServer(app)
+
+	err := s.WatchPets(&pb.WatchPetsRequest{ResumeToken: encodeResumeToken(uint64(uuid.New().ID()))}, &watchStreamMock{})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestResumeToken_EmptyStartsFromNow(t *testing.T) {
+	after, err := decodeResumeToken("")
+	require.NoError(t, err)
+	assert.Zero(t, after)
+}
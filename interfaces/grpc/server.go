@@ -2,14 +2,23 @@ package grpc
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"github.com/LuizFJP/pet-ms/application"
 	"github.com/LuizFJP/pet-ms/domain/entity"
 	pb "github.com/LuizFJP/pet-ms/proto"
 	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"strconv"
+	"time"
 )
 
+const idempotencyKeyHeader = "idempotency-key"
+
 type PetServer struct {
 	pa application.PetApplicationInterface
 	pb.UnimplementedPetServiceServer
@@ -20,83 +29,100 @@ func NewPetServer(pa application.PetApplicationInterface) *PetServer {
 }
 
 func (s *PetServer) Create(ctx context.Context, input *pb.CreatePetRequest) (*pb.CreatePetResponse, error) {
+	guardian, err := uuid.Parse(input.UuidGuardian)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid uuid_guardian")
+	}
+	specie, ok := s.specieFromRequest(input.SpeciesCode, input.Specie)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown species_code %q", input.SpeciesCode)
+	}
+	birthDate, accuracy, ok := birthDateFromRequest(input.BirthDate, input.BirthDateAccuracy)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid birth_date")
+	}
 	petEntity := &entity.Pet{
-		Name:         input.Name,
-		Uuid:         uuid.New(),
-		UuidGuardian: uuid.MustParse(input.UuidGuardian),
-		BirthYear:    int(input.BirthYear),
-		Breed:        input.Breed,
-		Specie:       entity.PetType(input.Specie),
+		Name:              input.Name,
+		Uuid:              uuid.New(),
+		UuidGuardian:      guardian,
+		BirthYear:         int(input.BirthYear),
+		BirthDate:         birthDate,
+		BirthDateAccuracy: accuracy,
+		Breed:             input.Breed,
+		Specie:            specie,
 	}
-	petEntity.Validate("default")
+	setProfile(petEntity, input)
 
-	res, errData := s.pa.SavePet(petEntity)
-	if errData != nil {
-		return nil, fmt.Errorf("something went wrong: %v", errData["message"])
+	var res *entity.Pet
+	var errData map[string]string
+	if key := idempotencyKey(ctx, input); key != "" {
+		res, errData = s.pa.SavePetWithIdempotencyKey(ctx, key, createRequestHash(input), petEntity)
+	} else {
+		res, errData = s.pa.SavePet(ctx, petEntity)
 	}
-
-	petResponse := &pb.CreatePetResponse{
-		NIdentification: int64(res.NIdentification),
-		Uuid:            res.Uuid.String(),
-		UuidGuardian:    res.UuidGuardian.String(),
-		Name:            res.Name,
-		BirthYear:       uint64(res.BirthYear),
-		Breed:           res.Breed,
-		Specie:          strconv.FormatInt(int64(res.Specie), 10),
+	if errData != nil {
+		return nil, errorFromMap(errData)
 	}
 
-	return petResponse, nil
+	return toCreatePetResponse(res, s.pa.LookupSpecies), nil
 }
 
 func (s *PetServer) Update(ctx context.Context, input *pb.UpdatePetRequest) (*pb.UpdatePetResponse, error) {
+	petUuid, err := uuid.Parse(input.Uuid)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid uuid")
+	}
+	specie, ok := s.specieFromRequest(input.SpeciesCode, input.Specie)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown species_code %q", input.SpeciesCode)
+	}
+	birthDate, accuracy, ok := birthDateFromRequest(input.BirthDate, input.BirthDateAccuracy)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid birth_date")
+	}
 	petEntity := &entity.Pet{
-		Uuid:      uuid.MustParse(input.Uuid),
-		Name:      input.Name,
-		BirthYear: int(input.BirthYear),
-		Breed:     input.Breed,
-		Specie:    entity.PetType(input.Specie),
+		Uuid:              petUuid,
+		Name:              input.Name,
+		BirthYear:         int(input.BirthYear),
+		BirthDate:         birthDate,
+		BirthDateAccuracy: accuracy,
+		Breed:             input.Breed,
+		Specie:            specie,
 	}
-	petEntity.Validate("default")
 
-	res, errData := s.pa.UpdatePet(petEntity)
-	if errData != nil {
-		return nil, fmt.Errorf("something went wrong: %v", errData["message"])
+	if len(input.UpdateMask.GetPaths()) > 0 {
+		setProfile(petEntity, input)
+		fields, err := maskFields(input.UpdateMask)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		res, errData := s.pa.UpdatePetFields(ctx, petEntity, fields)
+		if errData != nil {
+			return nil, errorFromMap(errData)
+		}
+		return toUpdatePetResponse(res, s.pa.LookupSpecies), nil
 	}
 
-	petResponse := &pb.UpdatePetResponse{
-		NIdentification: int64(res.NIdentification),
-		Uuid:            res.Uuid.String(),
-		UuidGuardian:    res.UuidGuardian.String(),
-		Name:            res.Name,
-		BirthYear:       uint64(res.BirthYear),
-		Breed:           res.Breed,
-		Specie:          strconv.FormatInt(int64(res.Specie), 10),
+	res, errData := s.pa.UpdatePet(ctx, petEntity)
+	if errData != nil {
+		return nil, errorFromMap(errData)
 	}
-	return petResponse, nil
+
+	return toUpdatePetResponse(res, s.pa.LookupSpecies), nil
 }
 
 func (s *PetServer) Get(ctx context.Context, input *pb.GetPetRequest) (*pb.GetPetResponse, error) {
-	res, errData := s.pa.GetPet(input.Uuid)
+	res, errData := s.pa.GetPet(ctx, input.Uuid)
 	if errData != nil {
-		return nil, fmt.Errorf("something went wrong: %v", errData["message"])
-	}
-	petResponse := &pb.GetPetResponse{
-		NIdentification: int64(res.NIdentification),
-		Uuid:            res.Uuid.String(),
-		UuidGuardian:    res.UuidGuardian.String(),
-		Name:            res.Name,
-		BirthYear:       uint64(res.BirthYear),
-		Breed:           res.Breed,
-		Specie:          strconv.FormatInt(int64(res.Specie), 10),
+		return nil, errorFromMap(errData)
 	}
-
-	return petResponse, nil
+	return toGetPetResponse(res, s.pa.LookupSpecies), nil
 }
 
 func (s *PetServer) Delete(ctx context.Context, input *pb.DeletePetRequest) (*pb.DeletePetResponse, error) {
-	res, errData := s.pa.DeletePet(input.UuidGuardian)
+	res, errData := s.pa.DeletePet(ctx, input.UuidGuardian)
 	if errData != nil {
-		return nil, fmt.Errorf("something went wrong: %v", errData["message"])
+		return nil, errorFromMap(errData)
 	}
 
 	deleteResponse := &pb.DeletePetResponse{
@@ -105,3 +131,133 @@ func (s *PetServer) Delete(ctx context.Context, input *pb.DeletePetRequest) (*pb
 
 	return deleteResponse, nil
 }
+
+// errorCodes associa as chaves dos mapas de erro da aplicação aos códigos gRPC,
+// em ordem de prioridade.
+var errorCodes = []struct {
+	key  string
+	code codes.Code
+}{
+	{"conflict", codes.AlreadyExists},
+	{"invalid_argument", codes.InvalidArgument},
+	{"not_found", codes.NotFound},
+	{"failed_precondition", codes.FailedPrecondition},
+	{"aborted", codes.Aborted},
+	{"unavailable", codes.Unavailable},
+}
+
+// errorFromMap converte o mapa de erros da aplicação num erro gRPC.
+func errorFromMap(errData map[string]string) error {
+	for _, ec := range errorCodes {
+		if msg, ok := errData[ec.key]; ok {
+			return status.Error(ec.code, msg)
+		}
+	}
+	return fmt.Errorf("something went wrong: %v", errData["message"])
+}
+
+func idempotencyKey(ctx context.Context, input *pb.CreatePetRequest) string {
+	if input.IdempotencyKey != "" {
+		return input.IdempotencyKey
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(idempotencyKeyHeader); len(values) > 0 {
+			return values[0]
+		}
+	}
+	return ""
+}
+
+// createRequestHash identifica o payload do Create, ignorando a própria chave,
+// para detectar reuso da chave com dados diferentes.
+func createRequestHash(input *pb.CreatePetRequest) string {
+	payload := proto.Clone(input).(*pb.CreatePetRequest)
+	payload.IdempotencyKey = ""
+	body, _ := proto.MarshalOptions{Deterministic: true}.Marshal(payload)
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:])
+}
+
+func toCreatePetResponse(pet *entity.Pet, lookup speciesLookup) *pb.CreatePetResponse {
+	return &pb.CreatePetResponse{
+		NIdentification:   int64(pet.NIdentification),
+		Uuid:              pet.Uuid.String(),
+		UuidGuardian:      pet.UuidGuardian.String(),
+		Name:              pet.Name,
+		BirthYear:         uint64(pet.BirthYear),
+		Breed:             pet.Breed,
+		Specie:            strconv.FormatInt(int64(pet.Specie), 10),
+		Species:           speciesInfoOf(pet.Specie, lookup),
+		BirthDate:         toProtoBirthDate(pet),
+		BirthDateAccuracy: accuracyToProto[pet.EffectiveBirthDateAccuracy()],
+		Age:               toPetAge(pet, time.Now()),
+		Sex:               sexToProto[pet.Sex],
+		Neutered:          toProtoNeutered(pet),
+		Color:             pet.Color,
+		Markings:          pet.Markings,
+		WeightHistory:     toProtoWeights(pet.WeightHistory),
+		WeightGrams:       latestWeight(pet.WeightHistory),
+		MicrochipNumber:   pet.MicrochipNumber,
+		PhotoUrls:         pet.Photos,
+		Notes:             pet.Notes,
+		Status:            statusToProto[pet.CurrentStatus()],
+		StatusReason:      pet.StatusReason,
+		StatusChangedAt:   toProtoStatusChangedAt(pet),
+	}
+}
+
+func toUpdatePetResponse(pet *entity.Pet, lookup speciesLookup) *pb.UpdatePetResponse {
+	return &pb.UpdatePetResponse{
+		NIdentification:   int64(pet.NIdentification),
+		Uuid:              pet.Uuid.String(),
+		UuidGuardian:      pet.UuidGuardian.String(),
+		Name:              pet.Name,
+		BirthYear:         uint64(pet.BirthYear),
+		Breed:             pet.Breed,
+		Specie:            strconv.FormatInt(int64(pet.Specie), 10),
+		Species:           speciesInfoOf(pet.Specie, lookup),
+		BirthDate:         toProtoBirthDate(pet),
+		BirthDateAccuracy: accuracyToProto[pet.EffectiveBirthDateAccuracy()],
+		Age:               toPetAge(pet, time.Now()),
+		Sex:               sexToProto[pet.Sex],
+		Neutered:          toProtoNeutered(pet),
+		Color:             pet.Color,
+		Markings:          pet.Markings,
+		WeightHistory:     toProtoWeights(pet.WeightHistory),
+		WeightGrams:       latestWeight(pet.WeightHistory),
+		MicrochipNumber:   pet.MicrochipNumber,
+		PhotoUrls:         pet.Photos,
+		Notes:             pet.Notes,
+		Status:            statusToProto[pet.CurrentStatus()],
+		StatusReason:      pet.StatusReason,
+		StatusChangedAt:   toProtoStatusChangedAt(pet),
+	}
+}
+
+func toGetPetResponse(pet *entity.Pet, lookup speciesLookup) *pb.GetPetResponse {
+	return &pb.GetPetResponse{
+		NIdentification:   int64(pet.NIdentification),
+		Uuid:              pet.Uuid.String(),
+		UuidGuardian:      pet.UuidGuardian.String(),
+		Name:              pet.Name,
+		BirthYear:         uint64(pet.BirthYear),
+		Breed:             pet.Breed,
+		Specie:            strconv.FormatInt(int64(pet.Specie), 10),
+		Species:           speciesInfoOf(pet.Specie, lookup),
+		BirthDate:         toProtoBirthDate(pet),
+		BirthDateAccuracy: accuracyToProto[pet.EffectiveBirthDateAccuracy()],
+		Age:               toPetAge(pet, time.Now()),
+		Sex:               sexToProto[pet.Sex],
+		Neutered:          toProtoNeutered(pet),
+		Color:             pet.Color,
+		Markings:          pet.Markings,
+		WeightHistory:     toProtoWeights(pet.WeightHistory),
+		WeightGrams:       latestWeight(pet.WeightHistory),
+		MicrochipNumber:   pet.MicrochipNumber,
+		PhotoUrls:         pet.Photos,
+		Notes:             pet.Notes,
+		Status:            statusToProto[pet.CurrentStatus()],
+		StatusReason:      pet.StatusReason,
+		StatusChangedAt:   toProtoStatusChangedAt(pet),
+	}
+}
@@ -2,50 +2,441 @@ package grpc
 
 import (
 	"context"
+	"io"
 	"testing"
+	"time"
 
+	"github.com/LuizFJP/pet-ms/application"
 	"github.com/LuizFJP/pet-ms/domain/entity"
 	pb "github.com/LuizFJP/pet-ms/proto"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 type appMock struct {
-	savePetFn   func(*entity.Pet) (*entity.Pet, map[string]string)
-	updatePetFn func(*entity.Pet) (*entity.Pet, map[string]string)
-	getPetFn    func(string) (*entity.Pet, map[string]string)
-	deletePetFn func(string) (map[string]string, map[string]string)
+	savePetFn      func(*entity.Pet) (*entity.Pet, map[string]string)
+	saveKeyFn      func(string, string, *entity.Pet) (*entity.Pet, map[string]string)
+	updatePetFn    func(*entity.Pet) (*entity.Pet, map[string]string)
+	updateFieldsFn func(*entity.Pet, []string) (*entity.Pet, map[string]string)
+	getPetFn       func(string) (*entity.Pet, map[string]string)
+	deletePetFn    func(string) (map[string]string, map[string]string)
+	transferFn     func(string, string) (*entity.Pet, map[string]string)
+	transitionFn   func(string, entity.StatusTransition) (*entity.Pet, map[string]string)
+
+	batchSaveFn     func([]*entity.Pet, application.BatchMode) ([]application.BatchItemResult, bool, map[string]string)
+	batchGetFn      func([]string) ([]*entity.Pet, []string, map[string]string)
+	batchUpdateFn   func([]*entity.Pet, application.BatchMode) ([]application.BatchItemResult, bool, map[string]string)
+	importFn        func([]*entity.Pet) (int, map[int]map[string]string)
+	exportFn        func(entity.PetFilter, int, func(*entity.Pet) error) map[string]string
+	watchFn         func(context.Context, uint64, entity.PetFilter, func(entity.PetEvent) error) map[string]string
+	petAuditFn      func(string, uint, int) ([]*entity.AuditEntry, map[string]string)
+	guardianAuditFn func(string, uint, int) ([]*entity.AuditEntry, map[string]string)
+
+	listSpeciesFn   func() ([]*entity.Species, map[string]string)
+	getSpeciesFn    func(string) (*entity.Species, map[string]string)
+	createSpeciesFn func(*entity.Species) (*entity.Species, map[string]string)
+	updateSpeciesFn func(*entity.Species) (*entity.Species, map[string]string)
+	deleteSpeciesFn func(string) map[string]string
+
+	searchBreedsFn func(application.BreedQuery) ([]application.BreedMatch, map[string]string)
+	microchipFn    func(string) (*entity.Pet, map[string]string)
+
+	addVaccinationFn    func(*entity.Vaccination) (*entity.Vaccination, map[string]string)
+	updateVaccinationFn func(*entity.Vaccination) (*entity.Vaccination, map[string]string)
+	listVaccinationsFn  func(string) ([]*entity.Vaccination, map[string]string)
+	listOverdueFn       func(time.Time, uint, int) ([]*entity.Vaccination, map[string]string)
+	addMedicalFn        func(*entity.MedicalRecord) (*entity.MedicalRecord, map[string]string)
+	updateMedicalFn     func(*entity.MedicalRecord) (*entity.MedicalRecord, map[string]string)
+	listMedicalFn       func(string) ([]*entity.MedicalRecord, map[string]string)
+
+	searchPetsFn func(entity.PetSearch) ([]*entity.PetSearchHit, map[string]string)
+
+	uploadFn           func(*entity.Attachment, io.Reader) (*entity.Attachment, map[string]string)
+	openAttachmentFn   func(string) (*entity.Attachment, io.ReadCloser, map[string]string)
+	listAttachmentsFn  func(string) ([]*entity.Attachment, map[string]string)
+	deleteAttachmentFn func(string) map[string]string
+
+	createGuardianFn func(*entity.Guardian) (*entity.Guardian, map[string]string)
+	getGuardianFn    func(string) (*entity.Guardian, map[string]string)
+	updateGuardianFn func(*entity.Guardian) (*entity.Guardian, map[string]string)
+	deleteGuardianFn func(string) map[string]string
+	listGuardiansFn  func(uint, int) ([]*entity.Guardian, map[string]string)
+
+	addPetGuardianFn    func(*entity.PetGuardian) (*entity.PetGuardian, map[string]string)
+	removePetGuardianFn func(string, string) map[string]string
+	listPetGuardiansFn  func(string) ([]*entity.PetGuardian, map[string]string)
+
+	submitApplicationFn  func(*entity.AdoptionApplication) (*entity.AdoptionApplication, map[string]string)
+	getApplicationFn     func(string) (*entity.AdoptionApplication, map[string]string)
+	approveApplicationFn func(string, string) (*entity.ApplicationApproval, map[string]string)
+	closeApplicationFn   func(string, entity.ApplicationStatus, string) (*entity.AdoptionApplication, map[string]string)
+	listApplicationsFn   func(string, uint, int) ([]*entity.AdoptionApplication, map[string]string)
 }
 
-func (m *appMock) SavePet(p *entity.Pet) (*entity.Pet, map[string]string) {
+func (m *appMock) SavePet(ctx context.Context, p *entity.Pet) (*entity.Pet, map[string]string) {
 	if m.savePetFn != nil {
 		return m.savePetFn(p)
 	}
 	return nil, map[string]string{"message": "not implemented"}
 }
 
-func (m *appMock) UpdatePet(p *entity.Pet) (*entity.Pet, map[string]string) {
+func (m *appMock) SavePetWithIdempotencyKey(ctx context.Context, key, requestHash string, p *entity.Pet) (*entity.Pet, map[string]string) {
+	if m.saveKeyFn != nil {
+		return m.saveKeyFn(key, requestHash, p)
+	}
+	return nil, map[string]string{"message": "not implemented"}
+}
+
+func (m *appMock) UpdatePet(ctx context.Context, p *entity.Pet) (*entity.Pet, map[string]string) {
 	if m.updatePetFn != nil {
 		return m.updatePetFn(p)
 	}
 	return nil, map[string]string{"message": "not implemented"}
 }
 
-func (m *appMock) GetPet(id string) (*entity.Pet, map[string]string) {
+func (m *appMock) UpdatePetFields(ctx context.Context, p *entity.Pet, fields []string) (*entity.Pet, map[string]string) {
+	if m.updateFieldsFn != nil {
+		return m.updateFieldsFn(p, fields)
+	}
+	return nil, map[string]string{"message": "not implemented"}
+}
+
+func (m *appMock) GetPet(ctx context.Context, id string) (*entity.Pet, map[string]string) {
 	if m.getPetFn != nil {
 		return m.getPetFn(id)
 	}
 	return nil, map[string]string{"message": "not implemented"}
 }
 
-func (m *appMock) DeletePet(uuidGuardian string) (map[string]string, map[string]string) {
+func (m *appMock) DeletePet(ctx context.Context, uuidGuardian string) (map[string]string, map[string]string) {
 	if m.deletePetFn != nil {
 		return m.deletePetFn(uuidGuardian)
 	}
 	return nil, map[string]string{"message": "not implemented"}
 }
 
+func (m *appMock) TransferPet(ctx context.Context, id, uuidGuardian string) (*entity.Pet, map[string]string) {
+	if m.transferFn != nil {
+		return m.transferFn(id, uuidGuardian)
+	}
+	return nil, map[string]string{"message": "not implemented"}
+}
+
+func (m *appMock) TransitionPet(ctx context.Context, id string, transition entity.StatusTransition) (*entity.Pet, map[string]string) {
+	if m.transitionFn != nil {
+		return m.transitionFn(id, transition)
+	}
+	return nil, map[string]string{"message": "not implemented"}
+}
+
+func (m *appMock) BatchSavePets(ctx context.Context, pets []*entity.Pet, mode application.BatchMode) ([]application.BatchItemResult, bool, map[string]string) {
+	if m.batchSaveFn != nil {
+		return m.batchSaveFn(pets, mode)
+	}
+	return nil, false, map[string]string{"message": "not implemented"}
+}
+
+func (m *appMock) BatchGetPets(ctx context.Context, uuids []string) ([]*entity.Pet, []string, map[string]string) {
+	if m.batchGetFn != nil {
+		return m.batchGetFn(uuids)
+	}
+	return nil, nil, map[string]string{"message": "not implemented"}
+}
+
+func (m *appMock) BatchUpdatePets(ctx context.Context, pets []*entity.Pet, mode application.BatchMode) ([]application.BatchItemResult, bool, map[string]string) {
+	if m.batchUpdateFn != nil {
+		return m.batchUpdateFn(pets, mode)
+	}
+	return nil, false, map[string]string{"message": "not implemented"}
+}
+
+func (m *appMock) ImportPets(ctx context.Context, pets []*entity.Pet) (int, map[int]map[string]string) {
+	if m.importFn != nil {
+		return m.importFn(pets)
+	}
+	return 0, nil
+}
+
+func (m *appMock) ExportPets(ctx context.Context, filter entity.PetFilter, pageSize int, send func(*entity.Pet) error) map[string]string {
+	if m.exportFn != nil {
+		return m.exportFn(filter, pageSize, send)
+	}
+	return map[string]string{"message": "not implemented"}
+}
+
+func (m *appMock) WatchPets(ctx context.Context, after uint64, filter entity.PetFilter, send func(entity.PetEvent) error) map[string]string {
+	if m.watchFn != nil {
+		return m.watchFn(ctx, after, filter, send)
+	}
+	return map[string]string{"message": "not implemented"}
+}
+
+func (m *appMock) GetPetAuditLog(ctx context.Context, id string, afterID uint, pageSize int) ([]*entity.AuditEntry, map[string]string) {
+	if m.petAuditFn != nil {
+		return m.petAuditFn(id, afterID, pageSize)
+	}
+	return nil, map[string]string{"message": "not implemented"}
+}
+
+func (m *appMock) ListGuardianAuditLog(ctx context.Context, uuidGuardian string, afterID uint, pageSize int) ([]*entity.AuditEntry, map[string]string) {
+	if m.guardianAuditFn != nil {
+		return m.guardianAuditFn(uuidGuardian, afterID, pageSize)
+	}
+	return nil, map[string]string{"message": "not implemented"}
+}
+
+// as buscas de espécie usam o catálogo padrão
+func (m *appMock) LookupSpecies(value entity.PetType) (*entity.Species, bool) {
+	return application.NewSpeciesCatalog(nil, 0).Lookup(value)
+}
+
+func (m *appMock) LookupSpeciesCode(code string) (*entity.Species, bool) {
+	return application.NewSpeciesCatalog(nil, 0).LookupCode(code)
+}
+
+func (m *appMock) ListSpecies() ([]*entity.Species, map[string]string) {
+	if m.listSpeciesFn != nil {
+		return m.listSpeciesFn()
+	}
+	return nil, map[string]string{"message": "not implemented"}
+}
+
+func (m *appMock) GetSpecies(code string) (*entity.Species, map[string]string) {
+	if m.getSpeciesFn != nil {
+		return m.getSpeciesFn(code)
+	}
+	return nil, map[string]string{"message": "not implemented"}
+}
+
+func (m *appMock) CreateSpecies(species *entity.Species) (*entity.Species, map[string]string) {
+	if m.createSpeciesFn != nil {
+		return m.createSpeciesFn(species)
+	}
+	return nil, map[string]string{"message": "not implemented"}
+}
+
+func (m *appMock) UpdateSpecies(species *entity.Species) (*entity.Species, map[string]string) {
+	if m.updateSpeciesFn != nil {
+		return m.updateSpeciesFn(species)
+	}
+	return nil, map[string]string{"message": "not implemented"}
+}
+
+func (m *appMock) DeleteSpecies(code string) map[string]string {
+	if m.deleteSpeciesFn != nil {
+		return m.deleteSpeciesFn(code)
+	}
+	return map[string]string{"message": "not implemented"}
+}
+
+func (m *appMock) SearchBreeds(query application.BreedQuery) ([]application.BreedMatch, map[string]string) {
+	if m.searchBreedsFn != nil {
+		return m.searchBreedsFn(query)
+	}
+	return nil, map[string]string{"message": "not implemented"}
+}
+
+func (m *appMock) SearchPets(ctx context.Context, search entity.PetSearch) ([]*entity.PetSearchHit, map[string]string) {
+	if m.searchPetsFn != nil {
+		return m.searchPetsFn(search)
+	}
+	return nil, map[string]string{"message": "not implemented"}
+}
+
+func (m *appMock) LookupByMicrochip(ctx context.Context, number string) (*entity.Pet, map[string]string) {
+	if m.microchipFn != nil {
+		return m.microchipFn(number)
+	}
+	return nil, map[string]string{"message": "not implemented"}
+}
+
+func (m *appMock) AddVaccination(ctx context.Context, v *entity.Vaccination) (*entity.Vaccination, map[string]string) {
+	if m.addVaccinationFn != nil {
+		return m.addVaccinationFn(v)
+	}
+	return nil, map[string]string{"message": "not implemented"}
+}
+
+func (m *appMock) UpdateVaccination(ctx context.Context, v *entity.Vaccination) (*entity.Vaccination, map[string]string) {
+	if m.updateVaccinationFn != nil {
+		return m.updateVaccinationFn(v)
+	}
+	return nil, map[string]string{"message": "not implemented"}
+}
+
+func (m *appMock) ListVaccinations(ctx context.Context, petUuid string) ([]*entity.Vaccination, map[string]string) {
+	if m.listVaccinationsFn != nil {
+		return m.listVaccinationsFn(petUuid)
+	}
+	return nil, map[string]string{"message": "not implemented"}
+}
+
+func (m *appMock) ListOverdueVaccinations(ctx context.Context, asOf time.Time, afterID uint, pageSize int) ([]*entity.Vaccination, map[string]string) {
+	if m.listOverdueFn != nil {
+		return m.listOverdueFn(asOf, afterID, pageSize)
+	}
+	return nil, map[string]string{"message": "not implemented"}
+}
+
+func (m *appMock) AddMedicalRecord(ctx context.Context, r *entity.MedicalRecord) (*entity.MedicalRecord, map[string]string) {
+	if m.addMedicalFn != nil {
+		return m.addMedicalFn(r)
+	}
+	return nil, map[string]string{"message": "not implemented"}
+}
+
+func (m *appMock) UpdateMedicalRecord(ctx context.Context, r *entity.MedicalRecord) (*entity.MedicalRecord, map[string]string) {
+	if m.updateMedicalFn != nil {
+		return m.updateMedicalFn(r)
+	}
+	return nil, map[string]string{"message": "not implemented"}
+}
+
+func (m *appMock) ListMedicalRecords(ctx context.Context, petUuid string) ([]*entity.MedicalRecord, map[string]string) {
+	if m.listMedicalFn != nil {
+		return m.listMedicalFn(petUuid)
+	}
+	return nil, map[string]string{"message": "not implemented"}
+}
+
+func (m *appMock) UploadAttachment(ctx context.Context, a *entity.Attachment, content io.Reader) (*entity.Attachment, map[string]string) {
+	if m.uploadFn != nil {
+		return m.uploadFn(a, content)
+	}
+	return nil, map[string]string{"message": "not implemented"}
+}
+
+func (m *appMock) OpenAttachment(ctx context.Context, id string) (*entity.Attachment, io.ReadCloser, map[string]string) {
+	if m.openAttachmentFn != nil {
+		return m.openAttachmentFn(id)
+	}
+	return nil, nil, map[string]string{"message": "not implemented"}
+}
+
+func (m *appMock) ListAttachments(ctx context.Context, petUuid string) ([]*entity.Attachment, map[string]string) {
+	if m.listAttachmentsFn != nil {
+		return m.listAttachmentsFn(petUuid)
+	}
+	return nil, map[string]string{"message": "not implemented"}
+}
+
+func (m *appMock) DeleteAttachment(ctx context.Context, id string) map[string]string {
+	if m.deleteAttachmentFn != nil {
+		return m.deleteAttachmentFn(id)
+	}
+	return map[string]string{"message": "not implemented"}
+}
+
+func (m *appMock) CreateGuardian(ctx context.Context, g *entity.Guardian) (*entity.Guardian, map[string]string) {
+	if m.createGuardianFn != nil {
+		return m.createGuardianFn(g)
+	}
+	return nil, map[string]string{"message": "not implemented"}
+}
+
+func (m *appMock) GetGuardian(ctx context.Context, id string) (*entity.Guardian, map[string]string) {
+	if m.getGuardianFn != nil {
+		return m.getGuardianFn(id)
+	}
+	return nil, map[string]string{"message": "not implemented"}
+}
+
+func (m *appMock) UpdateGuardian(ctx context.Context, g *entity.Guardian) (*entity.Guardian, map[string]string) {
+	if m.updateGuardianFn != nil {
+		return m.updateGuardianFn(g)
+	}
+	return nil, map[string]string{"message": "not implemented"}
+}
+
+func (m *appMock) DeleteGuardian(ctx context.Context, id string) map[string]string {
+	if m.deleteGuardianFn != nil {
+		return m.deleteGuardianFn(id)
+	}
+	return map[string]string{"message": "not implemented"}
+}
+
+func (m *appMock) ListGuardians(ctx context.Context, afterID uint, pageSize int) ([]*entity.Guardian, map[string]string) {
+	if m.listGuardiansFn != nil {
+		return m.listGuardiansFn(afterID, pageSize)
+	}
+	return nil, map[string]string{"message": "not implemented"}
+}
+
+func (m *appMock) AddPetGuardian(ctx context.Context, link *entity.PetGuardian) (*entity.PetGuardian, map[string]string) {
+	if m.addPetGuardianFn != nil {
+		return m.addPetGuardianFn(link)
+	}
+	return nil, map[string]string{"message": "not implemented"}
+}
+
+func (m *appMock) RemovePetGuardian(ctx context.Context, petUuid, uuidGuardian string) map[string]string {
+	if m.removePetGuardianFn != nil {
+		return m.removePetGuardianFn(petUuid, uuidGuardian)
+	}
+	return map[string]string{"message": "not implemented"}
+}
+
+func (m *appMock) ListPetGuardians(ctx context.Context, petUuid string) ([]*entity.PetGuardian, map[string]string) {
+	if m.listPetGuardiansFn != nil {
+		return m.listPetGuardiansFn(petUuid)
+	}
+	return nil, map[string]string{"message": "not implemented"}
+}
+
+func (m *appMock) SubmitApplication(ctx context.Context, app *entity.AdoptionApplication) (*entity.AdoptionApplication, map[string]string) {
+	if m.submitApplicationFn != nil {
+		return m.submitApplicationFn(app)
+	}
+	return nil, map[string]string{"message": "not implemented"}
+}
+
+func (m *appMock) GetApplication(ctx context.Context, id string) (*entity.AdoptionApplication, map[string]string) {
+	if m.getApplicationFn != nil {
+		return m.getApplicationFn(id)
+	}
+	return nil, map[string]string{"message": "not implemented"}
+}
+
+func (m *appMock) ApproveApplication(ctx context.Context, id, reason string) (*entity.ApplicationApproval, map[string]string) {
+	if m.approveApplicationFn != nil {
+		return m.approveApplicationFn(id, reason)
+	}
+	return nil, map[string]string{"message": "not implemented"}
+}
+
+func (m *appMock) RejectApplication(ctx context.Context, id, reason string) (*entity.AdoptionApplication, map[string]string) {
+	if m.closeApplicationFn != nil {
+		return m.closeApplicationFn(id, entity.ApplicationRejected, reason)
+	}
+	return nil, map[string]string{"message": "not implemented"}
+}
+
+func (m *appMock) WithdrawApplication(ctx context.Context, id, reason string) (*entity.AdoptionApplication, map[string]string) {
+	if m.closeApplicationFn != nil {
+		return m.closeApplicationFn(id, entity.ApplicationWithdrawn, reason)
+	}
+	return nil, map[string]string{"message": "not implemented"}
+}
+
+// ListPetApplications e ListApplicantApplications dividem listApplicationsFn.
+func (m *appMock) ListPetApplications(ctx context.Context, petUuid string, afterID uint, pageSize int) ([]*entity.AdoptionApplication, map[string]string) {
+	if m.listApplicationsFn != nil {
+		return m.listApplicationsFn(petUuid, afterID, pageSize)
+	}
+	return nil, map[string]string{"message": "not implemented"}
+}
+
+func (m *appMock) ListApplicantApplications(ctx context.Context, applicant string, afterID uint, pageSize int) ([]*entity.AdoptionApplication, map[string]string) {
+	if m.listApplicationsFn != nil {
+		return m.listApplicationsFn(applicant, afterID, pageSize)
+	}
+	return nil, map[string]string{"message": "not implemented"}
+}
+
 func makePet() *entity.Pet {
 	return &entity.Pet{
 		NIdentification: 101,
@@ -166,6 +557,26 @@ func TestPetServer_Update_Error(t *testing.T) {
 	assert.Contains(t, err.Error(), "update failed")
 }
 
+func TestPetServer_CreateAndUpdate_RejectInvalidUuids(t *testing.T) {
+	app := &appMock{
+		savePetFn: func(p *entity.Pet) (*entity.Pet, map[string]string) {
+			t.Fatalf("an invalid uuid_guardian must not reach the application")
+			return nil, nil
+		},
+		updatePetFn: func(p *entity.Pet) (*entity.Pet, map[string]string) {
+			t.Fatalf("an invalid uuid must not reach the application")
+			return nil, nil
+		},
+	}
+	s := NewPetServer(app)
+
+	_, err := s.Create(context.Background(), &pb.CreatePetRequest{Name: "Rex", UuidGuardian: "not-a-uuid", Breed: "SRD", Specie: 1})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.Update(context.Background(), &pb.UpdatePetRequest{Uuid: "", Name: "Rex", Breed: "SRD", Specie: 1})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
 func TestPetServer_Get_Success(t *testing.T) {
 	pet := makePet()
 	app := &appMock{
@@ -202,6 +613,18 @@ func TestPetServer_Get_Error(t *testing.T) {
 	assert.Contains(t, err.Error(), "not found")
 }
 
+func TestPetServer_Get_DatabaseUnavailable(t *testing.T) {
+	app := &appMock{
+		getPetFn: func(id string) (*entity.Pet, map[string]string) {
+			return nil, map[string]string{"unavailable": "database unavailable: circuit breaker open"}
+		},
+	}
+	s := NewPetServer(app)
+
+	_, err := s.Get(context.Background(), &pb.GetPetRequest{Uuid: uuid.New().String()})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
 func TestPetServer_Delete_Success(t *testing.T) {
 	app := &appMock{
 		deletePetFn: func(guardian string) (map[string]string, map[string]string) {
@@ -230,3 +653,74 @@ func TestPetServer_Delete_Error(t *testing.T) {
 	assert.Nil(t, resp)
 	assert.Contains(t, err.Error(), "no pets")
 }
+
+func TestPetServer_Create_WithIdempotencyKeyField(t *testing.T) {
+	var gotKey, gotHash string
+	app := &appMock{
+		saveKeyFn: func(key, hash string, p *entity.Pet) (*entity.Pet, map[string]string) {
+			gotKey, gotHash = key, hash
+			return p, nil
+		},
+	}
+	s := NewPetServer(app)
+
+	req := &pb.CreatePetRequest{
+		Name:           "Mingau",
+		UuidGuardian:   uuid.New().String(),
+		BirthYear:      2020,
+		Breed:          "SRD",
+		Specie:         1,
+		IdempotencyKey: "key-from-field",
+	}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("idempotency-key", "key-from-header"))
+
+	resp, err := s.Create(ctx, req)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	assert.Equal(t, "key-from-field", gotKey, "the request field takes precedence over metadata")
+	assert.NotEmpty(t, gotHash)
+}
+
+func TestPetServer_Create_WithIdempotencyKeyMetadata(t *testing.T) {
+	var gotKey string
+	app := &appMock{
+		saveKeyFn: func(key, hash string, p *entity.Pet) (*entity.Pet, map[string]string) {
+			gotKey = key
+			return p, nil
+		},
+	}
+	s := NewPetServer(app)
+
+	req := &pb.CreatePetRequest{Name: "Mingau", UuidGuardian: uuid.New().String(), BirthYear: 2020, Breed: "SRD"}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("idempotency-key", "key-from-header"))
+
+	_, err := s.Create(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, "key-from-header", gotKey)
+}
+
+func TestPetServer_Create_IdempotencyConflict(t *testing.T) {
+	app := &appMock{
+		saveKeyFn: func(key, hash string, p *entity.Pet) (*entity.Pet, map[string]string) {
+			return nil, map[string]string{"conflict": "idempotency key already used with a different payload"}
+		},
+	}
+	s := NewPetServer(app)
+
+	req := &pb.CreatePetRequest{Name: "Mingau", UuidGuardian: uuid.New().String(), IdempotencyKey: "k"}
+	resp, err := s.Create(context.Background(), req)
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+}
+
+func TestCreateRequestHash_IgnoresKeyAndTracksPayload(t *testing.T) {
+	guardian := uuid.New().String()
+	a := &pb.CreatePetRequest{Name: "Rex", UuidGuardian: guardian, IdempotencyKey: "a"}
+	b := &pb.CreatePetRequest{Name: "Rex", UuidGuardian: guardian, IdempotencyKey: "b"}
+	c := &pb.CreatePetRequest{Name: "Thor", UuidGuardian: guardian, IdempotencyKey: "a"}
+
+	assert.Equal(t, createRequestHash(a), createRequestHash(b))
+	assert.NotEqual(t, createRequestHash(a), createRequestHash(c))
+	assert.Equal(t, "a", a.IdempotencyKey, "hashing must not mutate the request")
+}
@@ -0,0 +1,71 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/LuizFJP/pet-ms/application"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// lastWriteHeader é a dica de sessão para ler as próprias escritas: o servidor a
+// devolve depois de cada escrita e o cliente a reenvia nas leituras seguintes. Com
+// ela a leitura vai ao primário enquanto as réplicas ainda podem não ter a escrita.
+const lastWriteHeader = "x-last-write"
+
+// readOnlyMethods não escrevem nada; todos os outros métodos devolvem a dica. Um
+// método novo sem entrada aqui só devolve uma dica a mais.
+var readOnlyMethods = map[string]bool{
+	"Get": true, "BatchGetPets": true, "ExportPets": true, "WatchPets": true,
+	"GetPetAuditLog": true, "ListGuardianAuditLog": true, "GetSpecies": true, "ListSpecies": true,
+	"SearchBreeds": true, "SearchPets": true, "LookupByMicrochip": true, "ListVaccinations": true,
+	"ListOverdueVaccinations": true, "ListMedicalRecords": true, "DownloadAttachment": true,
+	"ListAttachments": true,
+}
+
+func isReadOnly(fullMethod string) bool {
+	return readOnlyMethods[fullMethod[strings.LastIndex(fullMethod, "/")+1:]]
+}
+
+// UnarySessionInterceptor leva a dica recebida para o contexto e, nas escritas,
+// devolve a nova no header da resposta.
+func UnarySessionInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(contextWithSession(ctx), req)
+	if !isReadOnly(info.FullMethod) {
+		_ = grpc.SetHeader(ctx, lastWrite())
+	}
+	return resp, err
+}
+
+// StreamSessionInterceptor faz o mesmo nos streams; a dica vai no trailer, porque a
+// escrita só termina junto com o stream.
+func StreamSessionInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	err := handler(srv, &contextStream{ServerStream: ss, ctx: contextWithSession(ss.Context())})
+	if !isReadOnly(info.FullMethod) {
+		ss.SetTrailer(lastWrite())
+	}
+	return err
+}
+
+func contextWithSession(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	values := md.Get(lastWriteHeader)
+	if len(values) == 0 {
+		return ctx
+	}
+	// uma dica ilegível é ignorada: a leitura só perde a garantia
+	parsed, err := time.Parse(time.RFC3339Nano, values[0])
+	if err != nil {
+		return ctx
+	}
+	return application.ContextWithLastWrite(ctx, parsed)
+}
+
+func lastWrite() metadata.MD {
+	return metadata.Pairs(lastWriteHeader, time.Now().UTC().Format(time.RFC3339Nano))
+}
@@ -0,0 +1,64 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/LuizFJP/pet-ms/application"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// headerStream guarda o header que o interceptor define na resposta.
+type headerStream struct {
+	header metadata.MD
+}
+
+func (s *headerStream) Method() string { return "" }
+func (s *headerStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+func (s *headerStream) SendHeader(md metadata.MD) error { return s.SetHeader(md) }
+func (s *headerStream) SetTrailer(metadata.MD) error    { return nil }
+
+func callSession(t *testing.T, method string, incoming metadata.MD) (time.Time, metadata.MD) {
+	t.Helper()
+	stream := &headerStream{}
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+	if incoming != nil {
+		ctx = metadata.NewIncomingContext(ctx, incoming)
+	}
+	var got time.Time
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		got = application.LastWriteFromContext(ctx)
+		return nil, nil
+	}
+	_, err := UnarySessionInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+	require.NoError(t, err)
+	return got, stream.header
+}
+
+func TestUnarySessionInterceptor_PassesHintToReads(t *testing.T) {
+	written := time.Now().Add(-time.Second).UTC()
+	got, header := callSession(t, "/proto.PetService/Get", metadata.Pairs(lastWriteHeader, written.Format(time.RFC3339Nano)))
+	assert.True(t, got.Equal(written))
+	assert.Empty(t, header.Get(lastWriteHeader), "reads do not move the session")
+
+	got, _ = callSession(t, "/proto.PetService/Get", metadata.Pairs(lastWriteHeader, "ontem"))
+	assert.True(t, got.IsZero(), "an unreadable hint is ignored")
+	got, _ = callSession(t, "/proto.PetService/SearchPets", nil)
+	assert.True(t, got.IsZero())
+}
+
+func TestUnarySessionInterceptor_WritesReturnHint(t *testing.T) {
+	_, header := callSession(t, "/proto.PetService/Update", nil)
+	values := header.Get(lastWriteHeader)
+	require.Len(t, values, 1)
+	written, err := time.Parse(time.RFC3339Nano, values[0])
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now(), written, time.Second)
+}
@@ -0,0 +1,62 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/LuizFJP/pet-ms/application"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// O tenant, como o principal, vem do gateway que autentica as requisições: é a
+// organização (abrigo, clínica) da credencial, nunca um valor escolhido pelo cliente.
+const (
+	tenantHeader    = "x-tenant-id"
+	maxTenantLength = 64
+)
+
+// UnaryTenantInterceptor restringe a requisição aos pets do tenant do header. Com
+// required, chamadas sem tenant são recusadas; sem ele, enxergam todos os tenants
+// (instalações de uma organização só).
+func UnaryTenantInterceptor(required bool) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := contextWithTenant(ctx, info.FullMethod, required)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func StreamTenantInterceptor(required bool) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := contextWithTenant(ss.Context(), info.FullMethod, required)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func contextWithTenant(ctx context.Context, method string, required bool) (context.Context, error) {
+	var tenant string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(tenantHeader); len(values) > 0 {
+			tenant = values[0]
+		}
+	}
+	if len(tenant) > maxTenantLength {
+		return nil, status.Errorf(codes.InvalidArgument, "%s must have at most %d characters", tenantHeader, maxTenantLength)
+	}
+	if tenant == "" {
+		// reflection e health check não tocam em pets
+		if required && !strings.HasPrefix(method, "/grpc.") {
+			return nil, status.Errorf(codes.Unauthenticated, "missing %s", tenantHeader)
+		}
+		return ctx, nil
+	}
+	return application.ContextWithTenant(ctx, tenant), nil
+}
@@ -0,0 +1,63 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/LuizFJP/pet-ms/application"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func callTenant(required bool, method string, incoming metadata.MD) (string, error) {
+	ctx := context.Background()
+	if incoming != nil {
+		ctx = metadata.NewIncomingContext(ctx, incoming)
+	}
+	var got string
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		got = application.TenantFromContext(ctx)
+		return nil, nil
+	}
+	_, err := UnaryTenantInterceptor(required)(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+	return got, err
+}
+
+func TestUnaryTenantInterceptor_PassesTenant(t *testing.T) {
+	got, err := callTenant(true, "/proto.PetService/Get", metadata.Pairs(tenantHeader, "shelter-42"))
+	require.NoError(t, err)
+	assert.Equal(t, "shelter-42", got)
+
+	got, err = callTenant(false, "/proto.PetService/Get", nil)
+	require.NoError(t, err)
+	assert.Empty(t, got, "optional tenant sees every pet")
+}
+
+func TestUnaryTenantInterceptor_Rejects(t *testing.T) {
+	_, err := callTenant(true, "/proto.PetService/Get", nil)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = callTenant(false, "/proto.PetService/Get", metadata.Pairs(tenantHeader, strings.Repeat("x", maxTenantLength+1)))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// health check e reflection não pedem tenant
+	_, err = callTenant(true, "/grpc.health.v1.Health/Check", nil)
+	assert.NoError(t, err)
+}
+
+func TestStreamTenantInterceptor_PassesTenant(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(tenantHeader, "clinic"))
+	var got string
+	handler := func(srv interface{}, ss grpc.ServerStream) error {
+		got = application.TenantFromContext(ss.Context())
+		return nil
+	}
+	err := StreamTenantInterceptor(true)(nil, &contextStream{ctx: ctx}, &grpc.StreamServerInfo{FullMethod: "/proto.PetService/WatchPets"}, handler)
+	require.NoError(t, err)
+	assert.Equal(t, "clinic", got)
+}
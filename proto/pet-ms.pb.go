@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.7
+// 	protoc-gen-go v1.36.10
 // 	protoc        v6.32.0--rc1
 // source: pet-ms.proto
 
@@ -8,8 +8,12 @@ package pet_ms
 
 import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	date "google.golang.org/genproto/googleapis/type/date"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -22,32 +26,6047 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Em ALL_OR_NOTHING qualquer item inválido desfaz o lote inteiro; em PER_ITEM
+// os itens válidos são gravados e os inválidos reportados individualmente.
+type BatchMode int32
+
+const (
+	BatchMode_BATCH_MODE_ALL_OR_NOTHING BatchMode = 0
+	BatchMode_BATCH_MODE_PER_ITEM       BatchMode = 1
+)
+
+// Enum value maps for BatchMode.
+var (
+	BatchMode_name = map[int32]string{
+		0: "BATCH_MODE_ALL_OR_NOTHING",
+		1: "BATCH_MODE_PER_ITEM",
+	}
+	BatchMode_value = map[string]int32{
+		"BATCH_MODE_ALL_OR_NOTHING": 0,
+		"BATCH_MODE_PER_ITEM":       1,
+	}
+)
+
+func (x BatchMode) Enum() *BatchMode {
+	p := new(BatchMode)
+	*p = x
+	return p
+}
+
+func (x BatchMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BatchMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_pet_ms_proto_enumTypes[0].Descriptor()
+}
+
+func (BatchMode) Type() protoreflect.EnumType {
+	return &file_pet_ms_proto_enumTypes[0]
+}
+
+func (x BatchMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BatchMode.Descriptor instead.
+func (BatchMode) EnumDescriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{0}
+}
+
+// Fluxo de adoção: INTAKE -> AVAILABLE -> RESERVED -> ADOPTED -> RETURNED; um pet
+// disponível pode voltar à triagem, uma reserva pode ser desfeita e um pet devolvido
+// volta à triagem ou fica disponível. Pets novos começam em INTAKE.
+type PetStatus int32
+
+const (
+	PetStatus_PET_STATUS_UNSPECIFIED PetStatus = 0
+	PetStatus_PET_STATUS_INTAKE      PetStatus = 1
+	PetStatus_PET_STATUS_AVAILABLE   PetStatus = 2
+	PetStatus_PET_STATUS_RESERVED    PetStatus = 3
+	PetStatus_PET_STATUS_ADOPTED     PetStatus = 4
+	PetStatus_PET_STATUS_RETURNED    PetStatus = 5
+)
+
+// Enum value maps for PetStatus.
+var (
+	PetStatus_name = map[int32]string{
+		0: "PET_STATUS_UNSPECIFIED",
+		1: "PET_STATUS_INTAKE",
+		2: "PET_STATUS_AVAILABLE",
+		3: "PET_STATUS_RESERVED",
+		4: "PET_STATUS_ADOPTED",
+		5: "PET_STATUS_RETURNED",
+	}
+	PetStatus_value = map[string]int32{
+		"PET_STATUS_UNSPECIFIED": 0,
+		"PET_STATUS_INTAKE":      1,
+		"PET_STATUS_AVAILABLE":   2,
+		"PET_STATUS_RESERVED":    3,
+		"PET_STATUS_ADOPTED":     4,
+		"PET_STATUS_RETURNED":    5,
+	}
+)
+
+func (x PetStatus) Enum() *PetStatus {
+	p := new(PetStatus)
+	*p = x
+	return p
+}
+
+func (x PetStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PetStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pet_ms_proto_enumTypes[1].Descriptor()
+}
+
+func (PetStatus) Type() protoreflect.EnumType {
+	return &file_pet_ms_proto_enumTypes[1]
+}
+
+func (x PetStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PetStatus.Descriptor instead.
+func (PetStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{1}
+}
+
+type PetEventType int32
+
+const (
+	PetEventType_PET_EVENT_TYPE_UNSPECIFIED PetEventType = 0
+	PetEventType_PET_EVENT_TYPE_CREATED     PetEventType = 1
+	PetEventType_PET_EVENT_TYPE_UPDATED     PetEventType = 2
+	PetEventType_PET_EVENT_TYPE_DELETED     PetEventType = 3
+	PetEventType_PET_EVENT_TYPE_TRANSFERRED PetEventType = 4
+)
+
+// Enum value maps for PetEventType.
+var (
+	PetEventType_name = map[int32]string{
+		0: "PET_EVENT_TYPE_UNSPECIFIED",
+		1: "PET_EVENT_TYPE_CREATED",
+		2: "PET_EVENT_TYPE_UPDATED",
+		3: "PET_EVENT_TYPE_DELETED",
+		4: "PET_EVENT_TYPE_TRANSFERRED",
+	}
+	PetEventType_value = map[string]int32{
+		"PET_EVENT_TYPE_UNSPECIFIED": 0,
+		"PET_EVENT_TYPE_CREATED":     1,
+		"PET_EVENT_TYPE_UPDATED":     2,
+		"PET_EVENT_TYPE_DELETED":     3,
+		"PET_EVENT_TYPE_TRANSFERRED": 4,
+	}
+)
+
+func (x PetEventType) Enum() *PetEventType {
+	p := new(PetEventType)
+	*p = x
+	return p
+}
+
+func (x PetEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PetEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_pet_ms_proto_enumTypes[2].Descriptor()
+}
+
+func (PetEventType) Type() protoreflect.EnumType {
+	return &file_pet_ms_proto_enumTypes[2]
+}
+
+func (x PetEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PetEventType.Descriptor instead.
+func (PetEventType) EnumDescriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{2}
+}
+
+// Espécies padrão do catálogo. Espécies cadastradas depois não têm valor
+// próprio no enum e aparecem como SPECIES_CUSTOM, identificadas pelo code.
+type Species int32
+
+const (
+	Species_SPECIES_UNSPECIFIED Species = 0
+	Species_SPECIES_DOG         Species = 1
+	Species_SPECIES_CAT         Species = 2
+	Species_SPECIES_BIRD        Species = 3
+	Species_SPECIES_RABBIT      Species = 4
+	Species_SPECIES_REPTILE     Species = 5
+	Species_SPECIES_RODENT      Species = 6
+	Species_SPECIES_FISH        Species = 7
+	Species_SPECIES_HORSE       Species = 8
+	Species_SPECIES_CUSTOM      Species = 100
+)
+
+// Enum value maps for Species.
+var (
+	Species_name = map[int32]string{
+		0:   "SPECIES_UNSPECIFIED",
+		1:   "SPECIES_DOG",
+		2:   "SPECIES_CAT",
+		3:   "SPECIES_BIRD",
+		4:   "SPECIES_RABBIT",
+		5:   "SPECIES_REPTILE",
+		6:   "SPECIES_RODENT",
+		7:   "SPECIES_FISH",
+		8:   "SPECIES_HORSE",
+		100: "SPECIES_CUSTOM",
+	}
+	Species_value = map[string]int32{
+		"SPECIES_UNSPECIFIED": 0,
+		"SPECIES_DOG":         1,
+		"SPECIES_CAT":         2,
+		"SPECIES_BIRD":        3,
+		"SPECIES_RABBIT":      4,
+		"SPECIES_REPTILE":     5,
+		"SPECIES_RODENT":      6,
+		"SPECIES_FISH":        7,
+		"SPECIES_HORSE":       8,
+		"SPECIES_CUSTOM":      100,
+	}
+)
+
+func (x Species) Enum() *Species {
+	p := new(Species)
+	*p = x
+	return p
+}
+
+func (x Species) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Species) Descriptor() protoreflect.EnumDescriptor {
+	return file_pet_ms_proto_enumTypes[3].Descriptor()
+}
+
+func (Species) Type() protoreflect.EnumType {
+	return &file_pet_ms_proto_enumTypes[3]
+}
+
+func (x Species) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Species.Descriptor instead.
+func (Species) EnumDescriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{3}
+}
+
+type BirthDateAccuracy int32
+
+const (
+	BirthDateAccuracy_BIRTH_DATE_ACCURACY_UNSPECIFIED BirthDateAccuracy = 0
+	BirthDateAccuracy_BIRTH_DATE_ACCURACY_EXACT       BirthDateAccuracy = 1
+	BirthDateAccuracy_BIRTH_DATE_ACCURACY_MONTH       BirthDateAccuracy = 2
+	BirthDateAccuracy_BIRTH_DATE_ACCURACY_YEAR        BirthDateAccuracy = 3
+	BirthDateAccuracy_BIRTH_DATE_ACCURACY_ESTIMATED   BirthDateAccuracy = 4
+)
+
+// Enum value maps for BirthDateAccuracy.
+var (
+	BirthDateAccuracy_name = map[int32]string{
+		0: "BIRTH_DATE_ACCURACY_UNSPECIFIED",
+		1: "BIRTH_DATE_ACCURACY_EXACT",
+		2: "BIRTH_DATE_ACCURACY_MONTH",
+		3: "BIRTH_DATE_ACCURACY_YEAR",
+		4: "BIRTH_DATE_ACCURACY_ESTIMATED",
+	}
+	BirthDateAccuracy_value = map[string]int32{
+		"BIRTH_DATE_ACCURACY_UNSPECIFIED": 0,
+		"BIRTH_DATE_ACCURACY_EXACT":       1,
+		"BIRTH_DATE_ACCURACY_MONTH":       2,
+		"BIRTH_DATE_ACCURACY_YEAR":        3,
+		"BIRTH_DATE_ACCURACY_ESTIMATED":   4,
+	}
+)
+
+func (x BirthDateAccuracy) Enum() *BirthDateAccuracy {
+	p := new(BirthDateAccuracy)
+	*p = x
+	return p
+}
+
+func (x BirthDateAccuracy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BirthDateAccuracy) Descriptor() protoreflect.EnumDescriptor {
+	return file_pet_ms_proto_enumTypes[4].Descriptor()
+}
+
+func (BirthDateAccuracy) Type() protoreflect.EnumType {
+	return &file_pet_ms_proto_enumTypes[4]
+}
+
+func (x BirthDateAccuracy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BirthDateAccuracy.Descriptor instead.
+func (BirthDateAccuracy) EnumDescriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{4}
+}
+
+type PetSex int32
+
+const (
+	PetSex_PET_SEX_UNSPECIFIED PetSex = 0
+	PetSex_PET_SEX_MALE        PetSex = 1
+	PetSex_PET_SEX_FEMALE      PetSex = 2
+)
+
+// Enum value maps for PetSex.
+var (
+	PetSex_name = map[int32]string{
+		0: "PET_SEX_UNSPECIFIED",
+		1: "PET_SEX_MALE",
+		2: "PET_SEX_FEMALE",
+	}
+	PetSex_value = map[string]int32{
+		"PET_SEX_UNSPECIFIED": 0,
+		"PET_SEX_MALE":        1,
+		"PET_SEX_FEMALE":      2,
+	}
+)
+
+func (x PetSex) Enum() *PetSex {
+	p := new(PetSex)
+	*p = x
+	return p
+}
+
+func (x PetSex) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PetSex) Descriptor() protoreflect.EnumDescriptor {
+	return file_pet_ms_proto_enumTypes[5].Descriptor()
+}
+
+func (PetSex) Type() protoreflect.EnumType {
+	return &file_pet_ms_proto_enumTypes[5]
+}
+
+func (x PetSex) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PetSex.Descriptor instead.
+func (PetSex) EnumDescriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{5}
+}
+
+type MedicalRecordKind int32
+
+const (
+	MedicalRecordKind_MEDICAL_RECORD_KIND_UNSPECIFIED  MedicalRecordKind = 0
+	MedicalRecordKind_MEDICAL_RECORD_KIND_CONSULTATION MedicalRecordKind = 1
+	MedicalRecordKind_MEDICAL_RECORD_KIND_TREATMENT    MedicalRecordKind = 2
+	MedicalRecordKind_MEDICAL_RECORD_KIND_SURGERY      MedicalRecordKind = 3
+	MedicalRecordKind_MEDICAL_RECORD_KIND_EXAM         MedicalRecordKind = 4
+	MedicalRecordKind_MEDICAL_RECORD_KIND_OTHER        MedicalRecordKind = 5
+)
+
+// Enum value maps for MedicalRecordKind.
+var (
+	MedicalRecordKind_name = map[int32]string{
+		0: "MEDICAL_RECORD_KIND_UNSPECIFIED",
+		1: "MEDICAL_RECORD_KIND_CONSULTATION",
+		2: "MEDICAL_RECORD_KIND_TREATMENT",
+		3: "MEDICAL_RECORD_KIND_SURGERY",
+		4: "MEDICAL_RECORD_KIND_EXAM",
+		5: "MEDICAL_RECORD_KIND_OTHER",
+	}
+	MedicalRecordKind_value = map[string]int32{
+		"MEDICAL_RECORD_KIND_UNSPECIFIED":  0,
+		"MEDICAL_RECORD_KIND_CONSULTATION": 1,
+		"MEDICAL_RECORD_KIND_TREATMENT":    2,
+		"MEDICAL_RECORD_KIND_SURGERY":      3,
+		"MEDICAL_RECORD_KIND_EXAM":         4,
+		"MEDICAL_RECORD_KIND_OTHER":        5,
+	}
+)
+
+func (x MedicalRecordKind) Enum() *MedicalRecordKind {
+	p := new(MedicalRecordKind)
+	*p = x
+	return p
+}
+
+func (x MedicalRecordKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MedicalRecordKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_pet_ms_proto_enumTypes[6].Descriptor()
+}
+
+func (MedicalRecordKind) Type() protoreflect.EnumType {
+	return &file_pet_ms_proto_enumTypes[6]
+}
+
+func (x MedicalRecordKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MedicalRecordKind.Descriptor instead.
+func (MedicalRecordKind) EnumDescriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{6}
+}
+
+type AttachmentKind int32
+
+const (
+	AttachmentKind_ATTACHMENT_KIND_UNSPECIFIED AttachmentKind = 0
+	AttachmentKind_ATTACHMENT_KIND_PHOTO       AttachmentKind = 1
+	AttachmentKind_ATTACHMENT_KIND_DOCUMENT    AttachmentKind = 2
+)
+
+// Enum value maps for AttachmentKind.
+var (
+	AttachmentKind_name = map[int32]string{
+		0: "ATTACHMENT_KIND_UNSPECIFIED",
+		1: "ATTACHMENT_KIND_PHOTO",
+		2: "ATTACHMENT_KIND_DOCUMENT",
+	}
+	AttachmentKind_value = map[string]int32{
+		"ATTACHMENT_KIND_UNSPECIFIED": 0,
+		"ATTACHMENT_KIND_PHOTO":       1,
+		"ATTACHMENT_KIND_DOCUMENT":    2,
+	}
+)
+
+func (x AttachmentKind) Enum() *AttachmentKind {
+	p := new(AttachmentKind)
+	*p = x
+	return p
+}
+
+func (x AttachmentKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AttachmentKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_pet_ms_proto_enumTypes[7].Descriptor()
+}
+
+func (AttachmentKind) Type() protoreflect.EnumType {
+	return &file_pet_ms_proto_enumTypes[7]
+}
+
+func (x AttachmentKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AttachmentKind.Descriptor instead.
+func (AttachmentKind) EnumDescriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{7}
+}
+
+type GuardianRole int32
+
+const (
+	GuardianRole_GUARDIAN_ROLE_UNSPECIFIED       GuardianRole = 0
+	GuardianRole_GUARDIAN_ROLE_OWNER             GuardianRole = 1
+	GuardianRole_GUARDIAN_ROLE_CO_OWNER          GuardianRole = 2
+	GuardianRole_GUARDIAN_ROLE_FOSTER            GuardianRole = 3
+	GuardianRole_GUARDIAN_ROLE_EMERGENCY_CONTACT GuardianRole = 4
+)
+
+// Enum value maps for GuardianRole.
+var (
+	GuardianRole_name = map[int32]string{
+		0: "GUARDIAN_ROLE_UNSPECIFIED",
+		1: "GUARDIAN_ROLE_OWNER",
+		2: "GUARDIAN_ROLE_CO_OWNER",
+		3: "GUARDIAN_ROLE_FOSTER",
+		4: "GUARDIAN_ROLE_EMERGENCY_CONTACT",
+	}
+	GuardianRole_value = map[string]int32{
+		"GUARDIAN_ROLE_UNSPECIFIED":       0,
+		"GUARDIAN_ROLE_OWNER":             1,
+		"GUARDIAN_ROLE_CO_OWNER":          2,
+		"GUARDIAN_ROLE_FOSTER":            3,
+		"GUARDIAN_ROLE_EMERGENCY_CONTACT": 4,
+	}
+)
+
+func (x GuardianRole) Enum() *GuardianRole {
+	p := new(GuardianRole)
+	*p = x
+	return p
+}
+
+func (x GuardianRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GuardianRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_pet_ms_proto_enumTypes[8].Descriptor()
+}
+
+func (GuardianRole) Type() protoreflect.EnumType {
+	return &file_pet_ms_proto_enumTypes[8]
+}
+
+func (x GuardianRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GuardianRole.Descriptor instead.
+func (GuardianRole) EnumDescriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{8}
+}
+
+type AdoptionApplicationStatus int32
+
+const (
+	AdoptionApplicationStatus_ADOPTION_APPLICATION_STATUS_UNSPECIFIED AdoptionApplicationStatus = 0
+	AdoptionApplicationStatus_ADOPTION_APPLICATION_STATUS_PENDING     AdoptionApplicationStatus = 1
+	AdoptionApplicationStatus_ADOPTION_APPLICATION_STATUS_APPROVED    AdoptionApplicationStatus = 2
+	AdoptionApplicationStatus_ADOPTION_APPLICATION_STATUS_REJECTED    AdoptionApplicationStatus = 3
+	AdoptionApplicationStatus_ADOPTION_APPLICATION_STATUS_WITHDRAWN   AdoptionApplicationStatus = 4
+)
+
+// Enum value maps for AdoptionApplicationStatus.
+var (
+	AdoptionApplicationStatus_name = map[int32]string{
+		0: "ADOPTION_APPLICATION_STATUS_UNSPECIFIED",
+		1: "ADOPTION_APPLICATION_STATUS_PENDING",
+		2: "ADOPTION_APPLICATION_STATUS_APPROVED",
+		3: "ADOPTION_APPLICATION_STATUS_REJECTED",
+		4: "ADOPTION_APPLICATION_STATUS_WITHDRAWN",
+	}
+	AdoptionApplicationStatus_value = map[string]int32{
+		"ADOPTION_APPLICATION_STATUS_UNSPECIFIED": 0,
+		"ADOPTION_APPLICATION_STATUS_PENDING":     1,
+		"ADOPTION_APPLICATION_STATUS_APPROVED":    2,
+		"ADOPTION_APPLICATION_STATUS_REJECTED":    3,
+		"ADOPTION_APPLICATION_STATUS_WITHDRAWN":   4,
+	}
+)
+
+func (x AdoptionApplicationStatus) Enum() *AdoptionApplicationStatus {
+	p := new(AdoptionApplicationStatus)
+	*p = x
+	return p
+}
+
+func (x AdoptionApplicationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AdoptionApplicationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pet_ms_proto_enumTypes[9].Descriptor()
+}
+
+func (AdoptionApplicationStatus) Type() protoreflect.EnumType {
+	return &file_pet_ms_proto_enumTypes[9]
+}
+
+func (x AdoptionApplicationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AdoptionApplicationStatus.Descriptor instead.
+func (AdoptionApplicationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{9}
+}
+
 type CreatePetRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	UuidGuardian string                 `protobuf:"bytes,1,opt,name=uuid_guardian,json=uuidGuardian,proto3" json:"uuid_guardian,omitempty"`
+	Name         string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	BirthYear    uint64                 `protobuf:"varint,3,opt,name=birth_year,json=birthYear,proto3" json:"birth_year,omitempty"`
+	Breed        string                 `protobuf:"bytes,4,opt,name=breed,proto3" json:"breed,omitempty"`
+	Specie       uint64                 `protobuf:"varint,5,opt,name=specie,proto3" json:"specie,omitempty"`
+	// Chave opcional para tornar o Create idempotente. Também pode ser enviada
+	// no metadata "idempotency-key"; o campo tem precedência.
+	IdempotencyKey string `protobuf:"bytes,6,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// Código da espécie no catálogo (ex.: "dog"); tem precedência sobre specie.
+	SpeciesCode string `protobuf:"bytes,7,opt,name=species_code,json=speciesCode,proto3" json:"species_code,omitempty"`
+	// Data de nascimento opcional. Com dia ou mês zerados a precisão é inferida
+	// (mês ou ano) quando birth_date_accuracy não é informado.
+	BirthDate         *date.Date        `protobuf:"bytes,8,opt,name=birth_date,json=birthDate,proto3" json:"birth_date,omitempty"`
+	BirthDateAccuracy BirthDateAccuracy `protobuf:"varint,9,opt,name=birth_date_accuracy,json=birthDateAccuracy,proto3,enum=proto.BirthDateAccuracy" json:"birth_date_accuracy,omitempty"`
+	Sex               PetSex            `protobuf:"varint,10,opt,name=sex,proto3,enum=proto.PetSex" json:"sex,omitempty"`
+	// Vazio quando não se sabe se o pet é castrado.
+	Neutered *wrapperspb.BoolValue `protobuf:"bytes,11,opt,name=neutered,proto3" json:"neutered,omitempty"`
+	Color    string                `protobuf:"bytes,12,opt,name=color,proto3" json:"color,omitempty"`
+	Markings string                `protobuf:"bytes,13,opt,name=markings,proto3" json:"markings,omitempty"`
+	// Peso atual; entra no histórico com a data da requisição.
+	WeightGrams     uint32   `protobuf:"varint,14,opt,name=weight_grams,json=weightGrams,proto3" json:"weight_grams,omitempty"`
+	MicrochipNumber string   `protobuf:"bytes,15,opt,name=microchip_number,json=microchipNumber,proto3" json:"microchip_number,omitempty"`
+	PhotoUrls       []string `protobuf:"bytes,16,rep,name=photo_urls,json=photoUrls,proto3" json:"photo_urls,omitempty"`
+	Notes           string   `protobuf:"bytes,17,opt,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreatePetRequest) Reset() {
+	*x = CreatePetRequest{}
+	mi := &file_pet_ms_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePetRequest) ProtoMessage() {}
+
+func (x *CreatePetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePetRequest.ProtoReflect.Descriptor instead.
+func (*CreatePetRequest) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreatePetRequest) GetUuidGuardian() string {
+	if x != nil {
+		return x.UuidGuardian
+	}
+	return ""
+}
+
+func (x *CreatePetRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreatePetRequest) GetBirthYear() uint64 {
+	if x != nil {
+		return x.BirthYear
+	}
+	return 0
+}
+
+func (x *CreatePetRequest) GetBreed() string {
+	if x != nil {
+		return x.Breed
+	}
+	return ""
+}
+
+func (x *CreatePetRequest) GetSpecie() uint64 {
+	if x != nil {
+		return x.Specie
+	}
+	return 0
+}
+
+func (x *CreatePetRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *CreatePetRequest) GetSpeciesCode() string {
+	if x != nil {
+		return x.SpeciesCode
+	}
+	return ""
+}
+
+func (x *CreatePetRequest) GetBirthDate() *date.Date {
+	if x != nil {
+		return x.BirthDate
+	}
+	return nil
+}
+
+func (x *CreatePetRequest) GetBirthDateAccuracy() BirthDateAccuracy {
+	if x != nil {
+		return x.BirthDateAccuracy
+	}
+	return BirthDateAccuracy_BIRTH_DATE_ACCURACY_UNSPECIFIED
+}
+
+func (x *CreatePetRequest) GetSex() PetSex {
+	if x != nil {
+		return x.Sex
+	}
+	return PetSex_PET_SEX_UNSPECIFIED
+}
+
+func (x *CreatePetRequest) GetNeutered() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Neutered
+	}
+	return nil
+}
+
+func (x *CreatePetRequest) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *CreatePetRequest) GetMarkings() string {
+	if x != nil {
+		return x.Markings
+	}
+	return ""
+}
+
+func (x *CreatePetRequest) GetWeightGrams() uint32 {
+	if x != nil {
+		return x.WeightGrams
+	}
+	return 0
+}
+
+func (x *CreatePetRequest) GetMicrochipNumber() string {
+	if x != nil {
+		return x.MicrochipNumber
+	}
+	return ""
+}
+
+func (x *CreatePetRequest) GetPhotoUrls() []string {
+	if x != nil {
+		return x.PhotoUrls
+	}
+	return nil
+}
+
+func (x *CreatePetRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+type CreatePetResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	NIdentification int64                  `protobuf:"varint,1,opt,name=n_identification,json=nIdentification,proto3" json:"n_identification,omitempty"`
+	Uuid            string                 `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	UuidGuardian    string                 `protobuf:"bytes,3,opt,name=uuid_guardian,json=uuidGuardian,proto3" json:"uuid_guardian,omitempty"`
+	Name            string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	BirthYear       uint64                 `protobuf:"varint,5,opt,name=birth_year,json=birthYear,proto3" json:"birth_year,omitempty"`
+	Breed           string                 `protobuf:"bytes,6,opt,name=breed,proto3" json:"breed,omitempty"`
+	// Valor numérico legado; prefira species.
+	Specie  string       `protobuf:"bytes,7,opt,name=specie,proto3" json:"specie,omitempty"`
+	Species *SpeciesInfo `protobuf:"bytes,8,opt,name=species,proto3" json:"species,omitempty"`
+	// Partes além da precisão vêm zeradas (ex.: dia 0 quando a precisão é de mês).
+	BirthDate         *date.Date        `protobuf:"bytes,9,opt,name=birth_date,json=birthDate,proto3" json:"birth_date,omitempty"`
+	BirthDateAccuracy BirthDateAccuracy `protobuf:"varint,10,opt,name=birth_date_accuracy,json=birthDateAccuracy,proto3,enum=proto.BirthDateAccuracy" json:"birth_date_accuracy,omitempty"`
+	// Calculada na resposta a partir de birth_date ou, na falta dela, de birth_year.
+	Age           *PetAge               `protobuf:"bytes,11,opt,name=age,proto3" json:"age,omitempty"`
+	Sex           PetSex                `protobuf:"varint,12,opt,name=sex,proto3,enum=proto.PetSex" json:"sex,omitempty"`
+	Neutered      *wrapperspb.BoolValue `protobuf:"bytes,13,opt,name=neutered,proto3" json:"neutered,omitempty"`
+	Color         string                `protobuf:"bytes,14,opt,name=color,proto3" json:"color,omitempty"`
+	Markings      string                `protobuf:"bytes,15,opt,name=markings,proto3" json:"markings,omitempty"`
+	WeightHistory []*WeightMeasurement  `protobuf:"bytes,16,rep,name=weight_history,json=weightHistory,proto3" json:"weight_history,omitempty"`
+	// Pesagem mais recente do histórico.
+	WeightGrams     uint32    `protobuf:"varint,17,opt,name=weight_grams,json=weightGrams,proto3" json:"weight_grams,omitempty"`
+	MicrochipNumber string    `protobuf:"bytes,18,opt,name=microchip_number,json=microchipNumber,proto3" json:"microchip_number,omitempty"`
+	PhotoUrls       []string  `protobuf:"bytes,19,rep,name=photo_urls,json=photoUrls,proto3" json:"photo_urls,omitempty"`
+	Notes           string    `protobuf:"bytes,20,opt,name=notes,proto3" json:"notes,omitempty"`
+	Status          PetStatus `protobuf:"varint,21,opt,name=status,proto3,enum=proto.PetStatus" json:"status,omitempty"`
+	// Motivo da última transição de status.
+	StatusReason    string                 `protobuf:"bytes,22,opt,name=status_reason,json=statusReason,proto3" json:"status_reason,omitempty"`
+	StatusChangedAt *timestamppb.Timestamp `protobuf:"bytes,23,opt,name=status_changed_at,json=statusChangedAt,proto3" json:"status_changed_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreatePetResponse) Reset() {
+	*x = CreatePetResponse{}
+	mi := &file_pet_ms_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePetResponse) ProtoMessage() {}
+
+func (x *CreatePetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePetResponse.ProtoReflect.Descriptor instead.
+func (*CreatePetResponse) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreatePetResponse) GetNIdentification() int64 {
+	if x != nil {
+		return x.NIdentification
+	}
+	return 0
+}
+
+func (x *CreatePetResponse) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *CreatePetResponse) GetUuidGuardian() string {
+	if x != nil {
+		return x.UuidGuardian
+	}
+	return ""
+}
+
+func (x *CreatePetResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreatePetResponse) GetBirthYear() uint64 {
+	if x != nil {
+		return x.BirthYear
+	}
+	return 0
+}
+
+func (x *CreatePetResponse) GetBreed() string {
+	if x != nil {
+		return x.Breed
+	}
+	return ""
+}
+
+func (x *CreatePetResponse) GetSpecie() string {
+	if x != nil {
+		return x.Specie
+	}
+	return ""
+}
+
+func (x *CreatePetResponse) GetSpecies() *SpeciesInfo {
+	if x != nil {
+		return x.Species
+	}
+	return nil
+}
+
+func (x *CreatePetResponse) GetBirthDate() *date.Date {
+	if x != nil {
+		return x.BirthDate
+	}
+	return nil
+}
+
+func (x *CreatePetResponse) GetBirthDateAccuracy() BirthDateAccuracy {
+	if x != nil {
+		return x.BirthDateAccuracy
+	}
+	return BirthDateAccuracy_BIRTH_DATE_ACCURACY_UNSPECIFIED
+}
+
+func (x *CreatePetResponse) GetAge() *PetAge {
+	if x != nil {
+		return x.Age
+	}
+	return nil
+}
+
+func (x *CreatePetResponse) GetSex() PetSex {
+	if x != nil {
+		return x.Sex
+	}
+	return PetSex_PET_SEX_UNSPECIFIED
+}
+
+func (x *CreatePetResponse) GetNeutered() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Neutered
+	}
+	return nil
+}
+
+func (x *CreatePetResponse) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *CreatePetResponse) GetMarkings() string {
+	if x != nil {
+		return x.Markings
+	}
+	return ""
+}
+
+func (x *CreatePetResponse) GetWeightHistory() []*WeightMeasurement {
+	if x != nil {
+		return x.WeightHistory
+	}
+	return nil
+}
+
+func (x *CreatePetResponse) GetWeightGrams() uint32 {
+	if x != nil {
+		return x.WeightGrams
+	}
+	return 0
+}
+
+func (x *CreatePetResponse) GetMicrochipNumber() string {
+	if x != nil {
+		return x.MicrochipNumber
+	}
+	return ""
+}
+
+func (x *CreatePetResponse) GetPhotoUrls() []string {
+	if x != nil {
+		return x.PhotoUrls
+	}
+	return nil
+}
+
+func (x *CreatePetResponse) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *CreatePetResponse) GetStatus() PetStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PetStatus_PET_STATUS_UNSPECIFIED
+}
+
+func (x *CreatePetResponse) GetStatusReason() string {
+	if x != nil {
+		return x.StatusReason
+	}
+	return ""
+}
+
+func (x *CreatePetResponse) GetStatusChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StatusChangedAt
+	}
+	return nil
+}
+
+type UpdatePetRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Uuid      string                 `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Name      string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	BirthYear uint64                 `protobuf:"varint,5,opt,name=birth_year,json=birthYear,proto3" json:"birth_year,omitempty"`
+	Breed     string                 `protobuf:"bytes,6,opt,name=breed,proto3" json:"breed,omitempty"`
+	Specie    uint64                 `protobuf:"varint,7,opt,name=specie,proto3" json:"specie,omitempty"`
+	// Código da espécie no catálogo; tem precedência sobre specie.
+	SpeciesCode       string                `protobuf:"bytes,8,opt,name=species_code,json=speciesCode,proto3" json:"species_code,omitempty"`
+	BirthDate         *date.Date            `protobuf:"bytes,9,opt,name=birth_date,json=birthDate,proto3" json:"birth_date,omitempty"`
+	BirthDateAccuracy BirthDateAccuracy     `protobuf:"varint,10,opt,name=birth_date_accuracy,json=birthDateAccuracy,proto3,enum=proto.BirthDateAccuracy" json:"birth_date_accuracy,omitempty"`
+	Sex               PetSex                `protobuf:"varint,11,opt,name=sex,proto3,enum=proto.PetSex" json:"sex,omitempty"`
+	Neutered          *wrapperspb.BoolValue `protobuf:"bytes,12,opt,name=neutered,proto3" json:"neutered,omitempty"`
+	Color             string                `protobuf:"bytes,13,opt,name=color,proto3" json:"color,omitempty"`
+	Markings          string                `protobuf:"bytes,14,opt,name=markings,proto3" json:"markings,omitempty"`
+	// Acrescenta uma pesagem ao histórico; não substitui as anteriores.
+	WeightGrams     uint32   `protobuf:"varint,15,opt,name=weight_grams,json=weightGrams,proto3" json:"weight_grams,omitempty"`
+	MicrochipNumber string   `protobuf:"bytes,16,opt,name=microchip_number,json=microchipNumber,proto3" json:"microchip_number,omitempty"`
+	PhotoUrls       []string `protobuf:"bytes,17,rep,name=photo_urls,json=photoUrls,proto3" json:"photo_urls,omitempty"`
+	Notes           string   `protobuf:"bytes,18,opt,name=notes,proto3" json:"notes,omitempty"`
+	// Campos a alterar, pelos nomes desta mensagem (ex.: "name", "weight_grams").
+	// Sem máscara, o Update substitui só os campos básicos (name, birth_year,
+	// birth_date, breed e espécie), como antes dos campos de perfil.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,19,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePetRequest) Reset() {
+	*x = UpdatePetRequest{}
+	mi := &file_pet_ms_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePetRequest) ProtoMessage() {}
+
+func (x *UpdatePetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePetRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePetRequest) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UpdatePetRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *UpdatePetRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdatePetRequest) GetBirthYear() uint64 {
+	if x != nil {
+		return x.BirthYear
+	}
+	return 0
+}
+
+func (x *UpdatePetRequest) GetBreed() string {
+	if x != nil {
+		return x.Breed
+	}
+	return ""
+}
+
+func (x *UpdatePetRequest) GetSpecie() uint64 {
+	if x != nil {
+		return x.Specie
+	}
+	return 0
+}
+
+func (x *UpdatePetRequest) GetSpeciesCode() string {
+	if x != nil {
+		return x.SpeciesCode
+	}
+	return ""
+}
+
+func (x *UpdatePetRequest) GetBirthDate() *date.Date {
+	if x != nil {
+		return x.BirthDate
+	}
+	return nil
+}
+
+func (x *UpdatePetRequest) GetBirthDateAccuracy() BirthDateAccuracy {
+	if x != nil {
+		return x.BirthDateAccuracy
+	}
+	return BirthDateAccuracy_BIRTH_DATE_ACCURACY_UNSPECIFIED
+}
+
+func (x *UpdatePetRequest) GetSex() PetSex {
+	if x != nil {
+		return x.Sex
+	}
+	return PetSex_PET_SEX_UNSPECIFIED
+}
+
+func (x *UpdatePetRequest) GetNeutered() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Neutered
+	}
+	return nil
+}
+
+func (x *UpdatePetRequest) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *UpdatePetRequest) GetMarkings() string {
+	if x != nil {
+		return x.Markings
+	}
+	return ""
+}
+
+func (x *UpdatePetRequest) GetWeightGrams() uint32 {
+	if x != nil {
+		return x.WeightGrams
+	}
+	return 0
+}
+
+func (x *UpdatePetRequest) GetMicrochipNumber() string {
+	if x != nil {
+		return x.MicrochipNumber
+	}
+	return ""
+}
+
+func (x *UpdatePetRequest) GetPhotoUrls() []string {
+	if x != nil {
+		return x.PhotoUrls
+	}
+	return nil
+}
+
+func (x *UpdatePetRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *UpdatePetRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type UpdatePetResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	NIdentification int64                  `protobuf:"varint,1,opt,name=n_identification,json=nIdentification,proto3" json:"n_identification,omitempty"`
+	Uuid            string                 `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	UuidGuardian    string                 `protobuf:"bytes,3,opt,name=uuid_guardian,json=uuidGuardian,proto3" json:"uuid_guardian,omitempty"`
+	Name            string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	BirthYear       uint64                 `protobuf:"varint,5,opt,name=birth_year,json=birthYear,proto3" json:"birth_year,omitempty"`
+	Breed           string                 `protobuf:"bytes,6,opt,name=breed,proto3" json:"breed,omitempty"`
+	// Valor numérico legado; prefira species.
+	Specie  string       `protobuf:"bytes,7,opt,name=specie,proto3" json:"specie,omitempty"`
+	Species *SpeciesInfo `protobuf:"bytes,8,opt,name=species,proto3" json:"species,omitempty"`
+	// Partes além da precisão vêm zeradas (ex.: dia 0 quando a precisão é de mês).
+	BirthDate         *date.Date        `protobuf:"bytes,9,opt,name=birth_date,json=birthDate,proto3" json:"birth_date,omitempty"`
+	BirthDateAccuracy BirthDateAccuracy `protobuf:"varint,10,opt,name=birth_date_accuracy,json=birthDateAccuracy,proto3,enum=proto.BirthDateAccuracy" json:"birth_date_accuracy,omitempty"`
+	// Calculada na resposta a partir de birth_date ou, na falta dela, de birth_year.
+	Age           *PetAge               `protobuf:"bytes,11,opt,name=age,proto3" json:"age,omitempty"`
+	Sex           PetSex                `protobuf:"varint,12,opt,name=sex,proto3,enum=proto.PetSex" json:"sex,omitempty"`
+	Neutered      *wrapperspb.BoolValue `protobuf:"bytes,13,opt,name=neutered,proto3" json:"neutered,omitempty"`
+	Color         string                `protobuf:"bytes,14,opt,name=color,proto3" json:"color,omitempty"`
+	Markings      string                `protobuf:"bytes,15,opt,name=markings,proto3" json:"markings,omitempty"`
+	WeightHistory []*WeightMeasurement  `protobuf:"bytes,16,rep,name=weight_history,json=weightHistory,proto3" json:"weight_history,omitempty"`
+	// Pesagem mais recente do histórico.
+	WeightGrams     uint32    `protobuf:"varint,17,opt,name=weight_grams,json=weightGrams,proto3" json:"weight_grams,omitempty"`
+	MicrochipNumber string    `protobuf:"bytes,18,opt,name=microchip_number,json=microchipNumber,proto3" json:"microchip_number,omitempty"`
+	PhotoUrls       []string  `protobuf:"bytes,19,rep,name=photo_urls,json=photoUrls,proto3" json:"photo_urls,omitempty"`
+	Notes           string    `protobuf:"bytes,20,opt,name=notes,proto3" json:"notes,omitempty"`
+	Status          PetStatus `protobuf:"varint,21,opt,name=status,proto3,enum=proto.PetStatus" json:"status,omitempty"`
+	// Motivo da última transição de status.
+	StatusReason    string                 `protobuf:"bytes,22,opt,name=status_reason,json=statusReason,proto3" json:"status_reason,omitempty"`
+	StatusChangedAt *timestamppb.Timestamp `protobuf:"bytes,23,opt,name=status_changed_at,json=statusChangedAt,proto3" json:"status_changed_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdatePetResponse) Reset() {
+	*x = UpdatePetResponse{}
+	mi := &file_pet_ms_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePetResponse) ProtoMessage() {}
+
+func (x *UpdatePetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePetResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePetResponse) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdatePetResponse) GetNIdentification() int64 {
+	if x != nil {
+		return x.NIdentification
+	}
+	return 0
+}
+
+func (x *UpdatePetResponse) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *UpdatePetResponse) GetUuidGuardian() string {
+	if x != nil {
+		return x.UuidGuardian
+	}
+	return ""
+}
+
+func (x *UpdatePetResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdatePetResponse) GetBirthYear() uint64 {
+	if x != nil {
+		return x.BirthYear
+	}
+	return 0
+}
+
+func (x *UpdatePetResponse) GetBreed() string {
+	if x != nil {
+		return x.Breed
+	}
+	return ""
+}
+
+func (x *UpdatePetResponse) GetSpecie() string {
+	if x != nil {
+		return x.Specie
+	}
+	return ""
+}
+
+func (x *UpdatePetResponse) GetSpecies() *SpeciesInfo {
+	if x != nil {
+		return x.Species
+	}
+	return nil
+}
+
+func (x *UpdatePetResponse) GetBirthDate() *date.Date {
+	if x != nil {
+		return x.BirthDate
+	}
+	return nil
+}
+
+func (x *UpdatePetResponse) GetBirthDateAccuracy() BirthDateAccuracy {
+	if x != nil {
+		return x.BirthDateAccuracy
+	}
+	return BirthDateAccuracy_BIRTH_DATE_ACCURACY_UNSPECIFIED
+}
+
+func (x *UpdatePetResponse) GetAge() *PetAge {
+	if x != nil {
+		return x.Age
+	}
+	return nil
+}
+
+func (x *UpdatePetResponse) GetSex() PetSex {
+	if x != nil {
+		return x.Sex
+	}
+	return PetSex_PET_SEX_UNSPECIFIED
+}
+
+func (x *UpdatePetResponse) GetNeutered() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Neutered
+	}
+	return nil
+}
+
+func (x *UpdatePetResponse) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *UpdatePetResponse) GetMarkings() string {
+	if x != nil {
+		return x.Markings
+	}
+	return ""
+}
+
+func (x *UpdatePetResponse) GetWeightHistory() []*WeightMeasurement {
+	if x != nil {
+		return x.WeightHistory
+	}
+	return nil
+}
+
+func (x *UpdatePetResponse) GetWeightGrams() uint32 {
+	if x != nil {
+		return x.WeightGrams
+	}
+	return 0
+}
+
+func (x *UpdatePetResponse) GetMicrochipNumber() string {
+	if x != nil {
+		return x.MicrochipNumber
+	}
+	return ""
+}
+
+func (x *UpdatePetResponse) GetPhotoUrls() []string {
+	if x != nil {
+		return x.PhotoUrls
+	}
+	return nil
+}
+
+func (x *UpdatePetResponse) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *UpdatePetResponse) GetStatus() PetStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PetStatus_PET_STATUS_UNSPECIFIED
+}
+
+func (x *UpdatePetResponse) GetStatusReason() string {
+	if x != nil {
+		return x.StatusReason
+	}
+	return ""
+}
+
+func (x *UpdatePetResponse) GetStatusChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StatusChangedAt
+	}
+	return nil
+}
+
+type DeletePetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UuidGuardian  string                 `protobuf:"bytes,1,opt,name=uuid_guardian,json=uuidGuardian,proto3" json:"uuid_guardian,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePetRequest) Reset() {
+	*x = DeletePetRequest{}
+	mi := &file_pet_ms_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePetRequest) ProtoMessage() {}
+
+func (x *DeletePetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePetRequest.ProtoReflect.Descriptor instead.
+func (*DeletePetRequest) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeletePetRequest) GetUuidGuardian() string {
+	if x != nil {
+		return x.UuidGuardian
+	}
+	return ""
+}
+
+type DeletePetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePetResponse) Reset() {
+	*x = DeletePetResponse{}
+	mi := &file_pet_ms_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePetResponse) ProtoMessage() {}
+
+func (x *DeletePetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePetResponse.ProtoReflect.Descriptor instead.
+func (*DeletePetResponse) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeletePetResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetPetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPetRequest) Reset() {
+	*x = GetPetRequest{}
+	mi := &file_pet_ms_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPetRequest) ProtoMessage() {}
+
+func (x *GetPetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPetRequest.ProtoReflect.Descriptor instead.
+func (*GetPetRequest) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetPetRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type GetPetResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	NIdentification int64                  `protobuf:"varint,1,opt,name=n_identification,json=nIdentification,proto3" json:"n_identification,omitempty"`
+	Uuid            string                 `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	UuidGuardian    string                 `protobuf:"bytes,3,opt,name=uuid_guardian,json=uuidGuardian,proto3" json:"uuid_guardian,omitempty"`
+	Name            string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	BirthYear       uint64                 `protobuf:"varint,5,opt,name=birth_year,json=birthYear,proto3" json:"birth_year,omitempty"`
+	Breed           string                 `protobuf:"bytes,6,opt,name=breed,proto3" json:"breed,omitempty"`
+	// Valor numérico legado; prefira species.
+	Specie  string       `protobuf:"bytes,7,opt,name=specie,proto3" json:"specie,omitempty"`
+	Species *SpeciesInfo `protobuf:"bytes,8,opt,name=species,proto3" json:"species,omitempty"`
+	// Partes além da precisão vêm zeradas (ex.: dia 0 quando a precisão é de mês).
+	BirthDate         *date.Date        `protobuf:"bytes,9,opt,name=birth_date,json=birthDate,proto3" json:"birth_date,omitempty"`
+	BirthDateAccuracy BirthDateAccuracy `protobuf:"varint,10,opt,name=birth_date_accuracy,json=birthDateAccuracy,proto3,enum=proto.BirthDateAccuracy" json:"birth_date_accuracy,omitempty"`
+	// Calculada na resposta a partir de birth_date ou, na falta dela, de birth_year.
+	Age           *PetAge               `protobuf:"bytes,11,opt,name=age,proto3" json:"age,omitempty"`
+	Sex           PetSex                `protobuf:"varint,12,opt,name=sex,proto3,enum=proto.PetSex" json:"sex,omitempty"`
+	Neutered      *wrapperspb.BoolValue `protobuf:"bytes,13,opt,name=neutered,proto3" json:"neutered,omitempty"`
+	Color         string                `protobuf:"bytes,14,opt,name=color,proto3" json:"color,omitempty"`
+	Markings      string                `protobuf:"bytes,15,opt,name=markings,proto3" json:"markings,omitempty"`
+	WeightHistory []*WeightMeasurement  `protobuf:"bytes,16,rep,name=weight_history,json=weightHistory,proto3" json:"weight_history,omitempty"`
+	// Pesagem mais recente do histórico.
+	WeightGrams     uint32    `protobuf:"varint,17,opt,name=weight_grams,json=weightGrams,proto3" json:"weight_grams,omitempty"`
+	MicrochipNumber string    `protobuf:"bytes,18,opt,name=microchip_number,json=microchipNumber,proto3" json:"microchip_number,omitempty"`
+	PhotoUrls       []string  `protobuf:"bytes,19,rep,name=photo_urls,json=photoUrls,proto3" json:"photo_urls,omitempty"`
+	Notes           string    `protobuf:"bytes,20,opt,name=notes,proto3" json:"notes,omitempty"`
+	Status          PetStatus `protobuf:"varint,21,opt,name=status,proto3,enum=proto.PetStatus" json:"status,omitempty"`
+	// Motivo da última transição de status.
+	StatusReason    string                 `protobuf:"bytes,22,opt,name=status_reason,json=statusReason,proto3" json:"status_reason,omitempty"`
+	StatusChangedAt *timestamppb.Timestamp `protobuf:"bytes,23,opt,name=status_changed_at,json=statusChangedAt,proto3" json:"status_changed_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetPetResponse) Reset() {
+	*x = GetPetResponse{}
+	mi := &file_pet_ms_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPetResponse) ProtoMessage() {}
+
+func (x *GetPetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPetResponse.ProtoReflect.Descriptor instead.
+func (*GetPetResponse) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetPetResponse) GetNIdentification() int64 {
+	if x != nil {
+		return x.NIdentification
+	}
+	return 0
+}
+
+func (x *GetPetResponse) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *GetPetResponse) GetUuidGuardian() string {
+	if x != nil {
+		return x.UuidGuardian
+	}
+	return ""
+}
+
+func (x *GetPetResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetPetResponse) GetBirthYear() uint64 {
+	if x != nil {
+		return x.BirthYear
+	}
+	return 0
+}
+
+func (x *GetPetResponse) GetBreed() string {
+	if x != nil {
+		return x.Breed
+	}
+	return ""
+}
+
+func (x *GetPetResponse) GetSpecie() string {
+	if x != nil {
+		return x.Specie
+	}
+	return ""
+}
+
+func (x *GetPetResponse) GetSpecies() *SpeciesInfo {
+	if x != nil {
+		return x.Species
+	}
+	return nil
+}
+
+func (x *GetPetResponse) GetBirthDate() *date.Date {
+	if x != nil {
+		return x.BirthDate
+	}
+	return nil
+}
+
+func (x *GetPetResponse) GetBirthDateAccuracy() BirthDateAccuracy {
+	if x != nil {
+		return x.BirthDateAccuracy
+	}
+	return BirthDateAccuracy_BIRTH_DATE_ACCURACY_UNSPECIFIED
+}
+
+func (x *GetPetResponse) GetAge() *PetAge {
+	if x != nil {
+		return x.Age
+	}
+	return nil
+}
+
+func (x *GetPetResponse) GetSex() PetSex {
+	if x != nil {
+		return x.Sex
+	}
+	return PetSex_PET_SEX_UNSPECIFIED
+}
+
+func (x *GetPetResponse) GetNeutered() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Neutered
+	}
+	return nil
+}
+
+func (x *GetPetResponse) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *GetPetResponse) GetMarkings() string {
+	if x != nil {
+		return x.Markings
+	}
+	return ""
+}
+
+func (x *GetPetResponse) GetWeightHistory() []*WeightMeasurement {
+	if x != nil {
+		return x.WeightHistory
+	}
+	return nil
+}
+
+func (x *GetPetResponse) GetWeightGrams() uint32 {
+	if x != nil {
+		return x.WeightGrams
+	}
+	return 0
+}
+
+func (x *GetPetResponse) GetMicrochipNumber() string {
+	if x != nil {
+		return x.MicrochipNumber
+	}
+	return ""
+}
+
+func (x *GetPetResponse) GetPhotoUrls() []string {
+	if x != nil {
+		return x.PhotoUrls
+	}
+	return nil
+}
+
+func (x *GetPetResponse) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *GetPetResponse) GetStatus() PetStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PetStatus_PET_STATUS_UNSPECIFIED
+}
+
+func (x *GetPetResponse) GetStatusReason() string {
+	if x != nil {
+		return x.StatusReason
+	}
+	return ""
+}
+
+func (x *GetPetResponse) GetStatusChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StatusChangedAt
+	}
+	return nil
+}
+
+type TransferPetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	UuidGuardian  string                 `protobuf:"bytes,2,opt,name=uuid_guardian,json=uuidGuardian,proto3" json:"uuid_guardian,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferPetRequest) Reset() {
+	*x = TransferPetRequest{}
+	mi := &file_pet_ms_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferPetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferPetRequest) ProtoMessage() {}
+
+func (x *TransferPetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferPetRequest.ProtoReflect.Descriptor instead.
+func (*TransferPetRequest) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TransferPetRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *TransferPetRequest) GetUuidGuardian() string {
+	if x != nil {
+		return x.UuidGuardian
+	}
+	return ""
+}
+
+// uuid_guardian é obrigatório em ADOPTED (o adotante passa a ser o dono) e opcional em
+// RETURNED (quem recebe o pet de volta); reason é obrigatório em RETURNED.
+type TransitionPetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Status        PetStatus              `protobuf:"varint,2,opt,name=status,proto3,enum=proto.PetStatus" json:"status,omitempty"`
+	UuidGuardian  string                 `protobuf:"bytes,3,opt,name=uuid_guardian,json=uuidGuardian,proto3" json:"uuid_guardian,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransitionPetRequest) Reset() {
+	*x = TransitionPetRequest{}
+	mi := &file_pet_ms_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransitionPetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitionPetRequest) ProtoMessage() {}
+
+func (x *TransitionPetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitionPetRequest.ProtoReflect.Descriptor instead.
+func (*TransitionPetRequest) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TransitionPetRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *TransitionPetRequest) GetStatus() PetStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PetStatus_PET_STATUS_UNSPECIFIED
+}
+
+func (x *TransitionPetRequest) GetUuidGuardian() string {
+	if x != nil {
+		return x.UuidGuardian
+	}
+	return ""
+}
+
+func (x *TransitionPetRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type BatchCreatePetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pets          []*CreatePetRequest    `protobuf:"bytes,1,rep,name=pets,proto3" json:"pets,omitempty"`
+	Mode          BatchMode              `protobuf:"varint,2,opt,name=mode,proto3,enum=proto.BatchMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCreatePetsRequest) Reset() {
+	*x = BatchCreatePetsRequest{}
+	mi := &file_pet_ms_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCreatePetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreatePetsRequest) ProtoMessage() {}
+
+func (x *BatchCreatePetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreatePetsRequest.ProtoReflect.Descriptor instead.
+func (*BatchCreatePetsRequest) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BatchCreatePetsRequest) GetPets() []*CreatePetRequest {
+	if x != nil {
+		return x.Pets
+	}
+	return nil
+}
+
+func (x *BatchCreatePetsRequest) GetMode() BatchMode {
+	if x != nil {
+		return x.Mode
+	}
+	return BatchMode_BATCH_MODE_ALL_OR_NOTHING
+}
+
+type BatchCreatePetsResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         uint32                 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Pet           *CreatePetResponse     `protobuf:"bytes,2,opt,name=pet,proto3" json:"pet,omitempty"`
+	Errors        map[string]string      `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCreatePetsResult) Reset() {
+	*x = BatchCreatePetsResult{}
+	mi := &file_pet_ms_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCreatePetsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreatePetsResult) ProtoMessage() {}
+
+func (x *BatchCreatePetsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreatePetsResult.ProtoReflect.Descriptor instead.
+func (*BatchCreatePetsResult) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BatchCreatePetsResult) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BatchCreatePetsResult) GetPet() *CreatePetResponse {
+	if x != nil {
+		return x.Pet
+	}
+	return nil
+}
+
+func (x *BatchCreatePetsResult) GetErrors() map[string]string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type BatchCreatePetsResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Results       []*BatchCreatePetsResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Committed     bool                     `protobuf:"varint,2,opt,name=committed,proto3" json:"committed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCreatePetsResponse) Reset() {
+	*x = BatchCreatePetsResponse{}
+	mi := &file_pet_ms_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCreatePetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreatePetsResponse) ProtoMessage() {}
+
+func (x *BatchCreatePetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreatePetsResponse.ProtoReflect.Descriptor instead.
+func (*BatchCreatePetsResponse) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BatchCreatePetsResponse) GetResults() []*BatchCreatePetsResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BatchCreatePetsResponse) GetCommitted() bool {
+	if x != nil {
+		return x.Committed
+	}
+	return false
+}
+
+type BatchGetPetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuids         []string               `protobuf:"bytes,1,rep,name=uuids,proto3" json:"uuids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetPetsRequest) Reset() {
+	*x = BatchGetPetsRequest{}
+	mi := &file_pet_ms_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetPetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetPetsRequest) ProtoMessage() {}
+
+func (x *BatchGetPetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetPetsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetPetsRequest) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *BatchGetPetsRequest) GetUuids() []string {
+	if x != nil {
+		return x.Uuids
+	}
+	return nil
+}
+
+type BatchGetPetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pets          []*GetPetResponse      `protobuf:"bytes,1,rep,name=pets,proto3" json:"pets,omitempty"`
+	NotFound      []string               `protobuf:"bytes,2,rep,name=not_found,json=notFound,proto3" json:"not_found,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetPetsResponse) Reset() {
+	*x = BatchGetPetsResponse{}
+	mi := &file_pet_ms_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetPetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetPetsResponse) ProtoMessage() {}
+
+func (x *BatchGetPetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetPetsResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetPetsResponse) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *BatchGetPetsResponse) GetPets() []*GetPetResponse {
+	if x != nil {
+		return x.Pets
+	}
+	return nil
+}
+
+func (x *BatchGetPetsResponse) GetNotFound() []string {
+	if x != nil {
+		return x.NotFound
+	}
+	return nil
+}
+
+// Os itens substituem só os campos básicos; update_mask e campos de perfil são
+// ignorados no lote.
+type BatchUpdatePetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pets          []*UpdatePetRequest    `protobuf:"bytes,1,rep,name=pets,proto3" json:"pets,omitempty"`
+	Mode          BatchMode              `protobuf:"varint,2,opt,name=mode,proto3,enum=proto.BatchMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdatePetsRequest) Reset() {
+	*x = BatchUpdatePetsRequest{}
+	mi := &file_pet_ms_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdatePetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdatePetsRequest) ProtoMessage() {}
+
+func (x *BatchUpdatePetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdatePetsRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpdatePetsRequest) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BatchUpdatePetsRequest) GetPets() []*UpdatePetRequest {
+	if x != nil {
+		return x.Pets
+	}
+	return nil
+}
+
+func (x *BatchUpdatePetsRequest) GetMode() BatchMode {
+	if x != nil {
+		return x.Mode
+	}
+	return BatchMode_BATCH_MODE_ALL_OR_NOTHING
+}
+
+type BatchUpdatePetsResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         uint32                 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Pet           *UpdatePetResponse     `protobuf:"bytes,2,opt,name=pet,proto3" json:"pet,omitempty"`
+	Errors        map[string]string      `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdatePetsResult) Reset() {
+	*x = BatchUpdatePetsResult{}
+	mi := &file_pet_ms_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdatePetsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdatePetsResult) ProtoMessage() {}
+
+func (x *BatchUpdatePetsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdatePetsResult.ProtoReflect.Descriptor instead.
+func (*BatchUpdatePetsResult) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BatchUpdatePetsResult) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BatchUpdatePetsResult) GetPet() *UpdatePetResponse {
+	if x != nil {
+		return x.Pet
+	}
+	return nil
+}
+
+func (x *BatchUpdatePetsResult) GetErrors() map[string]string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type BatchUpdatePetsResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Results       []*BatchUpdatePetsResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Committed     bool                     `protobuf:"varint,2,opt,name=committed,proto3" json:"committed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdatePetsResponse) Reset() {
+	*x = BatchUpdatePetsResponse{}
+	mi := &file_pet_ms_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdatePetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdatePetsResponse) ProtoMessage() {}
+
+func (x *BatchUpdatePetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdatePetsResponse.ProtoReflect.Descriptor instead.
+func (*BatchUpdatePetsResponse) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *BatchUpdatePetsResponse) GetResults() []*BatchUpdatePetsResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BatchUpdatePetsResponse) GetCommitted() bool {
+	if x != nil {
+		return x.Committed
+	}
+	return false
+}
+
+// Cada mensagem do stream é um bloco de pets; os índices dos erros contam
+// os registros desde o início do stream.
+type ImportPetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pets          []*CreatePetRequest    `protobuf:"bytes,1,rep,name=pets,proto3" json:"pets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportPetsRequest) Reset() {
+	*x = ImportPetsRequest{}
+	mi := &file_pet_ms_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportPetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportPetsRequest) ProtoMessage() {}
+
+func (x *ImportPetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportPetsRequest.ProtoReflect.Descriptor instead.
+func (*ImportPetsRequest) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ImportPetsRequest) GetPets() []*CreatePetRequest {
+	if x != nil {
+		return x.Pets
+	}
+	return nil
+}
+
+type ImportPetError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Record        uint64                 `protobuf:"varint,1,opt,name=record,proto3" json:"record,omitempty"`
+	Errors        map[string]string      `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportPetError) Reset() {
+	*x = ImportPetError{}
+	mi := &file_pet_ms_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportPetError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportPetError) ProtoMessage() {}
+
+func (x *ImportPetError) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportPetError.ProtoReflect.Descriptor instead.
+func (*ImportPetError) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ImportPetError) GetRecord() uint64 {
+	if x != nil {
+		return x.Record
+	}
+	return 0
+}
+
+func (x *ImportPetError) GetErrors() map[string]string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type ImportPetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Received      uint64                 `protobuf:"varint,1,opt,name=received,proto3" json:"received,omitempty"`
+	Imported      uint64                 `protobuf:"varint,2,opt,name=imported,proto3" json:"imported,omitempty"`
+	Errors        []*ImportPetError      `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportPetsResponse) Reset() {
+	*x = ImportPetsResponse{}
+	mi := &file_pet_ms_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportPetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportPetsResponse) ProtoMessage() {}
+
+func (x *ImportPetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportPetsResponse.ProtoReflect.Descriptor instead.
+func (*ImportPetsResponse) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ImportPetsResponse) GetReceived() uint64 {
+	if x != nil {
+		return x.Received
+	}
+	return 0
+}
+
+func (x *ImportPetsResponse) GetImported() uint64 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+func (x *ImportPetsResponse) GetErrors() []*ImportPetError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// guardian_roles só vale com uuid_guardian; vazio lista os pets de que ele é dono.
+type ExportPetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UuidGuardian  string                 `protobuf:"bytes,1,opt,name=uuid_guardian,json=uuidGuardian,proto3" json:"uuid_guardian,omitempty"`
+	Species       []uint64               `protobuf:"varint,2,rep,packed,name=species,proto3" json:"species,omitempty"`
+	Breed         string                 `protobuf:"bytes,3,opt,name=breed,proto3" json:"breed,omitempty"`
+	BirthYearFrom uint64                 `protobuf:"varint,4,opt,name=birth_year_from,json=birthYearFrom,proto3" json:"birth_year_from,omitempty"`
+	BirthYearTo   uint64                 `protobuf:"varint,5,opt,name=birth_year_to,json=birthYearTo,proto3" json:"birth_year_to,omitempty"`
+	PageSize      uint32                 `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	GuardianRoles []GuardianRole         `protobuf:"varint,7,rep,packed,name=guardian_roles,json=guardianRoles,proto3,enum=proto.GuardianRole" json:"guardian_roles,omitempty"`
+	Statuses      []PetStatus            `protobuf:"varint,8,rep,packed,name=statuses,proto3,enum=proto.PetStatus" json:"statuses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportPetsRequest) Reset() {
+	*x = ExportPetsRequest{}
+	mi := &file_pet_ms_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportPetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPetsRequest) ProtoMessage() {}
+
+func (x *ExportPetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPetsRequest.ProtoReflect.Descriptor instead.
+func (*ExportPetsRequest) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ExportPetsRequest) GetUuidGuardian() string {
+	if x != nil {
+		return x.UuidGuardian
+	}
+	return ""
+}
+
+func (x *ExportPetsRequest) GetSpecies() []uint64 {
+	if x != nil {
+		return x.Species
+	}
+	return nil
+}
+
+func (x *ExportPetsRequest) GetBreed() string {
+	if x != nil {
+		return x.Breed
+	}
+	return ""
+}
+
+func (x *ExportPetsRequest) GetBirthYearFrom() uint64 {
+	if x != nil {
+		return x.BirthYearFrom
+	}
+	return 0
+}
+
+func (x *ExportPetsRequest) GetBirthYearTo() uint64 {
+	if x != nil {
+		return x.BirthYearTo
+	}
+	return 0
+}
+
+func (x *ExportPetsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ExportPetsRequest) GetGuardianRoles() []GuardianRole {
+	if x != nil {
+		return x.GuardianRoles
+	}
+	return nil
+}
+
+func (x *ExportPetsRequest) GetStatuses() []PetStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+// resume_token é o valor recebido no último WatchPetsResponse; vazio começa
+// pelos eventos publicados a partir de agora.
+type WatchPetsRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	UuidGuardian string                 `protobuf:"bytes,1,opt,name=uuid_guardian,json=uuidGuardian,proto3" json:"uuid_guardian,omitempty"`
+	Species      []uint64               `protobuf:"varint,2,rep,packed,name=species,proto3" json:"species,omitempty"`
+	ResumeToken  string                 `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	// As ligações do guardião são lidas no início do stream.
+	GuardianRoles []GuardianRole `protobuf:"varint,4,rep,packed,name=guardian_roles,json=guardianRoles,proto3,enum=proto.GuardianRole" json:"guardian_roles,omitempty"`
+	Statuses      []PetStatus    `protobuf:"varint,5,rep,packed,name=statuses,proto3,enum=proto.PetStatus" json:"statuses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchPetsRequest) Reset() {
+	*x = WatchPetsRequest{}
+	mi := &file_pet_ms_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchPetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPetsRequest) ProtoMessage() {}
+
+func (x *WatchPetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPetsRequest.ProtoReflect.Descriptor instead.
+func (*WatchPetsRequest) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *WatchPetsRequest) GetUuidGuardian() string {
+	if x != nil {
+		return x.UuidGuardian
+	}
+	return ""
+}
+
+func (x *WatchPetsRequest) GetSpecies() []uint64 {
+	if x != nil {
+		return x.Species
+	}
+	return nil
+}
+
+func (x *WatchPetsRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *WatchPetsRequest) GetGuardianRoles() []GuardianRole {
+	if x != nil {
+		return x.GuardianRoles
+	}
+	return nil
+}
+
+func (x *WatchPetsRequest) GetStatuses() []PetStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+type WatchPetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          PetEventType           `protobuf:"varint,1,opt,name=type,proto3,enum=proto.PetEventType" json:"type,omitempty"`
+	Pet           *GetPetResponse        `protobuf:"bytes,2,opt,name=pet,proto3" json:"pet,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	ResumeToken   string                 `protobuf:"bytes,4,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchPetsResponse) Reset() {
+	*x = WatchPetsResponse{}
+	mi := &file_pet_ms_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchPetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPetsResponse) ProtoMessage() {}
+
+func (x *WatchPetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPetsResponse.ProtoReflect.Descriptor instead.
+func (*WatchPetsResponse) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *WatchPetsResponse) GetType() PetEventType {
+	if x != nil {
+		return x.Type
+	}
+	return PetEventType_PET_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *WatchPetsResponse) GetPet() *GetPetResponse {
+	if x != nil {
+		return x.Pet
+	}
+	return nil
+}
+
+func (x *WatchPetsResponse) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *WatchPetsResponse) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type GetPetAuditLogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	PageSize      uint32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPetAuditLogRequest) Reset() {
+	*x = GetPetAuditLogRequest{}
+	mi := &file_pet_ms_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPetAuditLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPetAuditLogRequest) ProtoMessage() {}
+
+func (x *GetPetAuditLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPetAuditLogRequest.ProtoReflect.Descriptor instead.
+func (*GetPetAuditLogRequest) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetPetAuditLogRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *GetPetAuditLogRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetPetAuditLogRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListGuardianAuditLogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UuidGuardian  string                 `protobuf:"bytes,1,opt,name=uuid_guardian,json=uuidGuardian,proto3" json:"uuid_guardian,omitempty"`
+	PageSize      uint32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGuardianAuditLogRequest) Reset() {
+	*x = ListGuardianAuditLogRequest{}
+	mi := &file_pet_ms_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGuardianAuditLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGuardianAuditLogRequest) ProtoMessage() {}
+
+func (x *ListGuardianAuditLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGuardianAuditLogRequest.ProtoReflect.Descriptor instead.
+func (*ListGuardianAuditLogRequest) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListGuardianAuditLogRequest) GetUuidGuardian() string {
+	if x != nil {
+		return x.UuidGuardian
+	}
+	return ""
+}
+
+func (x *ListGuardianAuditLogRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListGuardianAuditLogRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type AuditFieldChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Before        string                 `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	After         string                 `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditFieldChange) Reset() {
+	*x = AuditFieldChange{}
+	mi := &file_pet_ms_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditFieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditFieldChange) ProtoMessage() {}
+
+func (x *AuditFieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditFieldChange.ProtoReflect.Descriptor instead.
+func (*AuditFieldChange) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AuditFieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *AuditFieldChange) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *AuditFieldChange) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+type AuditEntry struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PetUuid      string                 `protobuf:"bytes,2,opt,name=pet_uuid,json=petUuid,proto3" json:"pet_uuid,omitempty"`
+	UuidGuardian string                 `protobuf:"bytes,3,opt,name=uuid_guardian,json=uuidGuardian,proto3" json:"uuid_guardian,omitempty"`
+	// Só preenchido em transferências.
+	PreviousUuidGuardian string                 `protobuf:"bytes,4,opt,name=previous_uuid_guardian,json=previousUuidGuardian,proto3" json:"previous_uuid_guardian,omitempty"`
+	Action               string                 `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`
+	Principal            string                 `protobuf:"bytes,6,opt,name=principal,proto3" json:"principal,omitempty"`
+	RequestId            string                 `protobuf:"bytes,7,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	OccurredAt           *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	Changes              []*AuditFieldChange    `protobuf:"bytes,9,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *AuditEntry) Reset() {
+	*x = AuditEntry{}
+	mi := &file_pet_ms_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEntry) ProtoMessage() {}
+
+func (x *AuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEntry.ProtoReflect.Descriptor instead.
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AuditEntry) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetPetUuid() string {
+	if x != nil {
+		return x.PetUuid
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetUuidGuardian() string {
+	if x != nil {
+		return x.UuidGuardian
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetPreviousUuidGuardian() string {
+	if x != nil {
+		return x.PreviousUuidGuardian
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetPrincipal() string {
+	if x != nil {
+		return x.Principal
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *AuditEntry) GetChanges() []*AuditFieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// next_page_token vazio indica que não há mais entradas.
+type AuditLogResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*AuditEntry          `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditLogResponse) Reset() {
+	*x = AuditLogResponse{}
+	mi := &file_pet_ms_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogResponse) ProtoMessage() {}
+
+func (x *AuditLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogResponse.ProtoReflect.Descriptor instead.
+func (*AuditLogResponse) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *AuditLogResponse) GetEntries() []*AuditEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *AuditLogResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type SpeciesInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Species       Species                `protobuf:"varint,1,opt,name=species,proto3,enum=proto.Species" json:"species,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpeciesInfo) Reset() {
+	*x = SpeciesInfo{}
+	mi := &file_pet_ms_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpeciesInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpeciesInfo) ProtoMessage() {}
+
+func (x *SpeciesInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpeciesInfo.ProtoReflect.Descriptor instead.
+func (*SpeciesInfo) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SpeciesInfo) GetSpecies() Species {
+	if x != nil {
+		return x.Species
+	}
+	return Species_SPECIES_UNSPECIFIED
+}
+
+func (x *SpeciesInfo) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *SpeciesInfo) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+type CreateSpeciesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSpeciesRequest) Reset() {
+	*x = CreateSpeciesRequest{}
+	mi := &file_pet_ms_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSpeciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSpeciesRequest) ProtoMessage() {}
+
+func (x *CreateSpeciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSpeciesRequest.ProtoReflect.Descriptor instead.
+func (*CreateSpeciesRequest) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CreateSpeciesRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateSpeciesRequest) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+type GetSpeciesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSpeciesRequest) Reset() {
+	*x = GetSpeciesRequest{}
+	mi := &file_pet_ms_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSpeciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSpeciesRequest) ProtoMessage() {}
+
+func (x *GetSpeciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSpeciesRequest.ProtoReflect.Descriptor instead.
+func (*GetSpeciesRequest) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetSpeciesRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ListSpeciesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSpeciesRequest) Reset() {
+	*x = ListSpeciesRequest{}
+	mi := &file_pet_ms_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSpeciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSpeciesRequest) ProtoMessage() {}
+
+func (x *ListSpeciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSpeciesRequest.ProtoReflect.Descriptor instead.
+func (*ListSpeciesRequest) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{32}
+}
+
+type ListSpeciesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Species       []*SpeciesInfo         `protobuf:"bytes,1,rep,name=species,proto3" json:"species,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSpeciesResponse) Reset() {
+	*x = ListSpeciesResponse{}
+	mi := &file_pet_ms_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSpeciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSpeciesResponse) ProtoMessage() {}
+
+func (x *ListSpeciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSpeciesResponse.ProtoReflect.Descriptor instead.
+func (*ListSpeciesResponse) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListSpeciesResponse) GetSpecies() []*SpeciesInfo {
+	if x != nil {
+		return x.Species
+	}
+	return nil
+}
+
+type UpdateSpeciesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSpeciesRequest) Reset() {
+	*x = UpdateSpeciesRequest{}
+	mi := &file_pet_ms_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSpeciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSpeciesRequest) ProtoMessage() {}
+
+func (x *UpdateSpeciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSpeciesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSpeciesRequest) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *UpdateSpeciesRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *UpdateSpeciesRequest) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+type DeleteSpeciesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSpeciesRequest) Reset() {
+	*x = DeleteSpeciesRequest{}
+	mi := &file_pet_ms_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSpeciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSpeciesRequest) ProtoMessage() {}
+
+func (x *DeleteSpeciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSpeciesRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSpeciesRequest) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DeleteSpeciesRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type DeleteSpeciesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSpeciesResponse) Reset() {
+	*x = DeleteSpeciesResponse{}
+	mi := &file_pet_ms_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSpeciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSpeciesResponse) ProtoMessage() {}
+
+func (x *DeleteSpeciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSpeciesResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSpeciesResponse) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DeleteSpeciesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// query vazia lista as raças; species_codes vazio busca em todas as espécies.
+type SearchBreedsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	SpeciesCodes  []string               `protobuf:"bytes,2,rep,name=species_codes,json=speciesCodes,proto3" json:"species_codes,omitempty"`
+	PageSize      uint32                 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchBreedsRequest) Reset() {
+	*x = SearchBreedsRequest{}
+	mi := &file_pet_ms_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchBreedsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchBreedsRequest) ProtoMessage() {}
+
+func (x *SearchBreedsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchBreedsRequest.ProtoReflect.Descriptor instead.
+func (*SearchBreedsRequest) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SearchBreedsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchBreedsRequest) GetSpeciesCodes() []string {
+	if x != nil {
+		return x.SpeciesCodes
+	}
+	return nil
+}
+
+func (x *SearchBreedsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// mixed marca a opção "sem raça definida", aceita em qualquer espécie; nesse
+// caso species vem vazio.
+type BreedInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Species       *SpeciesInfo           `protobuf:"bytes,2,opt,name=species,proto3" json:"species,omitempty"`
+	Aliases       []string               `protobuf:"bytes,3,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	Matched       string                 `protobuf:"bytes,4,opt,name=matched,proto3" json:"matched,omitempty"`
+	Mixed         bool                   `protobuf:"varint,5,opt,name=mixed,proto3" json:"mixed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BreedInfo) Reset() {
+	*x = BreedInfo{}
+	mi := &file_pet_ms_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BreedInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BreedInfo) ProtoMessage() {}
+
+func (x *BreedInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BreedInfo.ProtoReflect.Descriptor instead.
+func (*BreedInfo) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *BreedInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BreedInfo) GetSpecies() *SpeciesInfo {
+	if x != nil {
+		return x.Species
+	}
+	return nil
+}
+
+func (x *BreedInfo) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *BreedInfo) GetMatched() string {
+	if x != nil {
+		return x.Matched
+	}
+	return ""
+}
+
+func (x *BreedInfo) GetMixed() bool {
+	if x != nil {
+		return x.Mixed
+	}
+	return false
+}
+
+type SearchBreedsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Breeds        []*BreedInfo           `protobuf:"bytes,1,rep,name=breeds,proto3" json:"breeds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchBreedsResponse) Reset() {
+	*x = SearchBreedsResponse{}
+	mi := &file_pet_ms_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchBreedsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchBreedsResponse) ProtoMessage() {}
+
+func (x *SearchBreedsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchBreedsResponse.ProtoReflect.Descriptor instead.
+func (*SearchBreedsResponse) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SearchBreedsResponse) GetBreeds() []*BreedInfo {
+	if x != nil {
+		return x.Breeds
+	}
+	return nil
+}
+
+// query é texto livre; todos os termos precisam aparecer no nome, na raça ou nas
+// observações, por prefixo ou com pequenos erros de digitação.
+type SearchPetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	UuidGuardian  string                 `protobuf:"bytes,2,opt,name=uuid_guardian,json=uuidGuardian,proto3" json:"uuid_guardian,omitempty"`
+	Species       []uint64               `protobuf:"varint,3,rep,packed,name=species,proto3" json:"species,omitempty"`
+	PageSize      uint32                 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	GuardianRoles []GuardianRole         `protobuf:"varint,5,rep,packed,name=guardian_roles,json=guardianRoles,proto3,enum=proto.GuardianRole" json:"guardian_roles,omitempty"`
+	Statuses      []PetStatus            `protobuf:"varint,6,rep,packed,name=statuses,proto3,enum=proto.PetStatus" json:"statuses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchPetsRequest) Reset() {
+	*x = SearchPetsRequest{}
+	mi := &file_pet_ms_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchPetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPetsRequest) ProtoMessage() {}
+
+func (x *SearchPetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPetsRequest.ProtoReflect.Descriptor instead.
+func (*SearchPetsRequest) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SearchPetsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchPetsRequest) GetUuidGuardian() string {
+	if x != nil {
+		return x.UuidGuardian
+	}
+	return ""
+}
+
+func (x *SearchPetsRequest) GetSpecies() []uint64 {
+	if x != nil {
+		return x.Species
+	}
+	return nil
+}
+
+func (x *SearchPetsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchPetsRequest) GetGuardianRoles() []GuardianRole {
+	if x != nil {
+		return x.GuardianRoles
+	}
+	return nil
+}
+
+func (x *SearchPetsRequest) GetStatuses() []PetStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+// text traz o campo com os termos entre <mark> e </mark>, sem escape; das
+// observações vem só um trecho.
+type SearchHighlight struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchHighlight) Reset() {
+	*x = SearchHighlight{}
+	mi := &file_pet_ms_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchHighlight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchHighlight) ProtoMessage() {}
+
+func (x *SearchHighlight) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchHighlight.ProtoReflect.Descriptor instead.
+func (*SearchHighlight) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SearchHighlight) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *SearchHighlight) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type PetSearchHit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pet           *GetPetResponse        `protobuf:"bytes,1,opt,name=pet,proto3" json:"pet,omitempty"`
+	Rank          float64                `protobuf:"fixed64,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	Highlights    []*SearchHighlight     `protobuf:"bytes,3,rep,name=highlights,proto3" json:"highlights,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PetSearchHit) Reset() {
+	*x = PetSearchHit{}
+	mi := &file_pet_ms_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PetSearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PetSearchHit) ProtoMessage() {}
+
+func (x *PetSearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PetSearchHit.ProtoReflect.Descriptor instead.
+func (*PetSearchHit) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *PetSearchHit) GetPet() *GetPetResponse {
+	if x != nil {
+		return x.Pet
+	}
+	return nil
+}
+
+func (x *PetSearchHit) GetRank() float64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *PetSearchHit) GetHighlights() []*SearchHighlight {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+type SearchPetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hits          []*PetSearchHit        `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchPetsResponse) Reset() {
+	*x = SearchPetsResponse{}
+	mi := &file_pet_ms_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchPetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPetsResponse) ProtoMessage() {}
+
+func (x *SearchPetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPetsResponse.ProtoReflect.Descriptor instead.
+func (*SearchPetsResponse) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SearchPetsResponse) GetHits() []*PetSearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+// microchip_number aceita espaços, pontos e hífens, como os leitores exibem.
+type LookupByMicrochipRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	MicrochipNumber string                 `protobuf:"bytes,1,opt,name=microchip_number,json=microchipNumber,proto3" json:"microchip_number,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *LookupByMicrochipRequest) Reset() {
+	*x = LookupByMicrochipRequest{}
+	mi := &file_pet_ms_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LookupByMicrochipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupByMicrochipRequest) ProtoMessage() {}
+
+func (x *LookupByMicrochipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupByMicrochipRequest.ProtoReflect.Descriptor instead.
+func (*LookupByMicrochipRequest) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *LookupByMicrochipRequest) GetMicrochipNumber() string {
+	if x != nil {
+		return x.MicrochipNumber
+	}
+	return ""
+}
+
+type PetAge struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Years         uint32                 `protobuf:"varint,1,opt,name=years,proto3" json:"years,omitempty"`
+	Months        uint32                 `protobuf:"varint,2,opt,name=months,proto3" json:"months,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PetAge) Reset() {
+	*x = PetAge{}
+	mi := &file_pet_ms_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PetAge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PetAge) ProtoMessage() {}
+
+func (x *PetAge) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PetAge.ProtoReflect.Descriptor instead.
+func (*PetAge) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *PetAge) GetYears() uint32 {
+	if x != nil {
+		return x.Years
+	}
+	return 0
+}
+
+func (x *PetAge) GetMonths() uint32 {
+	if x != nil {
+		return x.Months
+	}
+	return 0
+}
+
+type WeightMeasurement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Grams         uint32                 `protobuf:"varint,1,opt,name=grams,proto3" json:"grams,omitempty"`
+	MeasuredAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=measured_at,json=measuredAt,proto3" json:"measured_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WeightMeasurement) Reset() {
+	*x = WeightMeasurement{}
+	mi := &file_pet_ms_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WeightMeasurement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeightMeasurement) ProtoMessage() {}
+
+func (x *WeightMeasurement) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeightMeasurement.ProtoReflect.Descriptor instead.
+func (*WeightMeasurement) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *WeightMeasurement) GetGrams() uint32 {
+	if x != nil {
+		return x.Grams
+	}
+	return 0
+}
+
+func (x *WeightMeasurement) GetMeasuredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MeasuredAt
+	}
+	return nil
+}
+
+// next_due_date é calculada a partir de administered_on e booster_interval_days;
+// booster_interval_days zero indica vacina sem reforço.
+type Vaccination struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Uuid                string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	PetUuid             string                 `protobuf:"bytes,2,opt,name=pet_uuid,json=petUuid,proto3" json:"pet_uuid,omitempty"`
+	Vaccine             string                 `protobuf:"bytes,3,opt,name=vaccine,proto3" json:"vaccine,omitempty"`
+	Dose                uint32                 `protobuf:"varint,4,opt,name=dose,proto3" json:"dose,omitempty"`
+	AdministeredOn      *date.Date             `protobuf:"bytes,5,opt,name=administered_on,json=administeredOn,proto3" json:"administered_on,omitempty"`
+	BoosterIntervalDays uint32                 `protobuf:"varint,6,opt,name=booster_interval_days,json=boosterIntervalDays,proto3" json:"booster_interval_days,omitempty"`
+	NextDueDate         *date.Date             `protobuf:"bytes,7,opt,name=next_due_date,json=nextDueDate,proto3" json:"next_due_date,omitempty"`
+	Overdue             bool                   `protobuf:"varint,8,opt,name=overdue,proto3" json:"overdue,omitempty"`
+	LotNumber           string                 `protobuf:"bytes,9,opt,name=lot_number,json=lotNumber,proto3" json:"lot_number,omitempty"`
+	Veterinarian        string                 `protobuf:"bytes,10,opt,name=veterinarian,proto3" json:"veterinarian,omitempty"`
+	Notes               string                 `protobuf:"bytes,11,opt,name=notes,proto3" json:"notes,omitempty"`
+	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt           *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Vaccination) Reset() {
+	*x = Vaccination{}
+	mi := &file_pet_ms_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Vaccination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vaccination) ProtoMessage() {}
+
+func (x *Vaccination) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Vaccination.ProtoReflect.Descriptor instead.
+func (*Vaccination) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *Vaccination) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *Vaccination) GetPetUuid() string {
+	if x != nil {
+		return x.PetUuid
+	}
+	return ""
+}
+
+func (x *Vaccination) GetVaccine() string {
+	if x != nil {
+		return x.Vaccine
+	}
+	return ""
+}
+
+func (x *Vaccination) GetDose() uint32 {
+	if x != nil {
+		return x.Dose
+	}
+	return 0
+}
+
+func (x *Vaccination) GetAdministeredOn() *date.Date {
+	if x != nil {
+		return x.AdministeredOn
+	}
+	return nil
+}
+
+func (x *Vaccination) GetBoosterIntervalDays() uint32 {
+	if x != nil {
+		return x.BoosterIntervalDays
+	}
+	return 0
+}
+
+func (x *Vaccination) GetNextDueDate() *date.Date {
+	if x != nil {
+		return x.NextDueDate
+	}
+	return nil
+}
+
+func (x *Vaccination) GetOverdue() bool {
+	if x != nil {
+		return x.Overdue
+	}
+	return false
+}
+
+func (x *Vaccination) GetLotNumber() string {
+	if x != nil {
+		return x.LotNumber
+	}
+	return ""
+}
+
+func (x *Vaccination) GetVeterinarian() string {
+	if x != nil {
+		return x.Veterinarian
+	}
+	return ""
+}
+
+func (x *Vaccination) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *Vaccination) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Vaccination) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type AddVaccinationRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	PetUuid             string                 `protobuf:"bytes,1,opt,name=pet_uuid,json=petUuid,proto3" json:"pet_uuid,omitempty"`
+	Vaccine             string                 `protobuf:"bytes,2,opt,name=vaccine,proto3" json:"vaccine,omitempty"`
+	Dose                uint32                 `protobuf:"varint,3,opt,name=dose,proto3" json:"dose,omitempty"`
+	AdministeredOn      *date.Date             `protobuf:"bytes,4,opt,name=administered_on,json=administeredOn,proto3" json:"administered_on,omitempty"`
+	BoosterIntervalDays uint32                 `protobuf:"varint,5,opt,name=booster_interval_days,json=boosterIntervalDays,proto3" json:"booster_interval_days,omitempty"`
+	LotNumber           string                 `protobuf:"bytes,6,opt,name=lot_number,json=lotNumber,proto3" json:"lot_number,omitempty"`
+	Veterinarian        string                 `protobuf:"bytes,7,opt,name=veterinarian,proto3" json:"veterinarian,omitempty"`
+	Notes               string                 `protobuf:"bytes,8,opt,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *AddVaccinationRequest) Reset() {
+	*x = AddVaccinationRequest{}
+	mi := &file_pet_ms_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddVaccinationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddVaccinationRequest) ProtoMessage() {}
+
+func (x *AddVaccinationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddVaccinationRequest.ProtoReflect.Descriptor instead.
+func (*AddVaccinationRequest) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *AddVaccinationRequest) GetPetUuid() string {
+	if x != nil {
+		return x.PetUuid
+	}
+	return ""
+}
+
+func (x *AddVaccinationRequest) GetVaccine() string {
+	if x != nil {
+		return x.Vaccine
+	}
+	return ""
+}
+
+func (x *AddVaccinationRequest) GetDose() uint32 {
+	if x != nil {
+		return x.Dose
+	}
+	return 0
+}
+
+func (x *AddVaccinationRequest) GetAdministeredOn() *date.Date {
+	if x != nil {
+		return x.AdministeredOn
+	}
+	return nil
+}
+
+func (x *AddVaccinationRequest) GetBoosterIntervalDays() uint32 {
+	if x != nil {
+		return x.BoosterIntervalDays
+	}
+	return 0
+}
+
+func (x *AddVaccinationRequest) GetLotNumber() string {
+	if x != nil {
+		return x.LotNumber
+	}
+	return ""
+}
+
+func (x *AddVaccinationRequest) GetVeterinarian() string {
+	if x != nil {
+		return x.Veterinarian
+	}
+	return ""
+}
+
+func (x *AddVaccinationRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+// Substitui os dados da dose; o pet não pode ser trocado.
+type UpdateVaccinationRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Uuid                string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Vaccine             string                 `protobuf:"bytes,2,opt,name=vaccine,proto3" json:"vaccine,omitempty"`
+	Dose                uint32                 `protobuf:"varint,3,opt,name=dose,proto3" json:"dose,omitempty"`
+	AdministeredOn      *date.Date             `protobuf:"bytes,4,opt,name=administered_on,json=administeredOn,proto3" json:"administered_on,omitempty"`
+	BoosterIntervalDays uint32                 `protobuf:"varint,5,opt,name=booster_interval_days,json=boosterIntervalDays,proto3" json:"booster_interval_days,omitempty"`
+	LotNumber           string                 `protobuf:"bytes,6,opt,name=lot_number,json=lotNumber,proto3" json:"lot_number,omitempty"`
+	Veterinarian        string                 `protobuf:"bytes,7,opt,name=veterinarian,proto3" json:"veterinarian,omitempty"`
+	Notes               string                 `protobuf:"bytes,8,opt,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *UpdateVaccinationRequest) Reset() {
+	*x = UpdateVaccinationRequest{}
+	mi := &file_pet_ms_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVaccinationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVaccinationRequest) ProtoMessage() {}
+
+func (x *UpdateVaccinationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVaccinationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateVaccinationRequest) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *UpdateVaccinationRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *UpdateVaccinationRequest) GetVaccine() string {
+	if x != nil {
+		return x.Vaccine
+	}
+	return ""
+}
+
+func (x *UpdateVaccinationRequest) GetDose() uint32 {
+	if x != nil {
+		return x.Dose
+	}
+	return 0
+}
+
+func (x *UpdateVaccinationRequest) GetAdministeredOn() *date.Date {
+	if x != nil {
+		return x.AdministeredOn
+	}
+	return nil
+}
+
+func (x *UpdateVaccinationRequest) GetBoosterIntervalDays() uint32 {
+	if x != nil {
+		return x.BoosterIntervalDays
+	}
+	return 0
+}
+
+func (x *UpdateVaccinationRequest) GetLotNumber() string {
+	if x != nil {
+		return x.LotNumber
+	}
+	return ""
+}
+
+func (x *UpdateVaccinationRequest) GetVeterinarian() string {
+	if x != nil {
+		return x.Veterinarian
+	}
+	return ""
+}
+
+func (x *UpdateVaccinationRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+type ListVaccinationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PetUuid       string                 `protobuf:"bytes,1,opt,name=pet_uuid,json=petUuid,proto3" json:"pet_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVaccinationsRequest) Reset() {
+	*x = ListVaccinationsRequest{}
+	mi := &file_pet_ms_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVaccinationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVaccinationsRequest) ProtoMessage() {}
+
+func (x *ListVaccinationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVaccinationsRequest.ProtoReflect.Descriptor instead.
+func (*ListVaccinationsRequest) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListVaccinationsRequest) GetPetUuid() string {
+	if x != nil {
+		return x.PetUuid
+	}
+	return ""
+}
+
+type ListVaccinationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vaccinations  []*Vaccination         `protobuf:"bytes,1,rep,name=vaccinations,proto3" json:"vaccinations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVaccinationsResponse) Reset() {
+	*x = ListVaccinationsResponse{}
+	mi := &file_pet_ms_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVaccinationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVaccinationsResponse) ProtoMessage() {}
+
+func (x *ListVaccinationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVaccinationsResponse.ProtoReflect.Descriptor instead.
+func (*ListVaccinationsResponse) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListVaccinationsResponse) GetVaccinations() []*Vaccination {
+	if x != nil {
+		return x.Vaccinations
+	}
+	return nil
+}
+
+// Só a dose mais recente de cada vacina conta. as_of vazio usa a data de hoje.
+type ListOverdueVaccinationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AsOf          *date.Date             `protobuf:"bytes,1,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	PageSize      uint32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOverdueVaccinationsRequest) Reset() {
+	*x = ListOverdueVaccinationsRequest{}
+	mi := &file_pet_ms_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOverdueVaccinationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOverdueVaccinationsRequest) ProtoMessage() {}
+
+func (x *ListOverdueVaccinationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOverdueVaccinationsRequest.ProtoReflect.Descriptor instead.
+func (*ListOverdueVaccinationsRequest) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListOverdueVaccinationsRequest) GetAsOf() *date.Date {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+func (x *ListOverdueVaccinationsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListOverdueVaccinationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListOverdueVaccinationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vaccinations  []*Vaccination         `protobuf:"bytes,1,rep,name=vaccinations,proto3" json:"vaccinations,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOverdueVaccinationsResponse) Reset() {
+	*x = ListOverdueVaccinationsResponse{}
+	mi := &file_pet_ms_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOverdueVaccinationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOverdueVaccinationsResponse) ProtoMessage() {}
+
+func (x *ListOverdueVaccinationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOverdueVaccinationsResponse.ProtoReflect.Descriptor instead.
+func (*ListOverdueVaccinationsResponse) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListOverdueVaccinationsResponse) GetVaccinations() []*Vaccination {
+	if x != nil {
+		return x.Vaccinations
+	}
+	return nil
+}
+
+func (x *ListOverdueVaccinationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type MedicalRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	PetUuid       string                 `protobuf:"bytes,2,opt,name=pet_uuid,json=petUuid,proto3" json:"pet_uuid,omitempty"`
+	Kind          MedicalRecordKind      `protobuf:"varint,3,opt,name=kind,proto3,enum=proto.MedicalRecordKind" json:"kind,omitempty"`
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Veterinarian  string                 `protobuf:"bytes,6,opt,name=veterinarian,proto3" json:"veterinarian,omitempty"`
+	OccurredOn    *date.Date             `protobuf:"bytes,7,opt,name=occurred_on,json=occurredOn,proto3" json:"occurred_on,omitempty"`
+	FollowUpOn    *date.Date             `protobuf:"bytes,8,opt,name=follow_up_on,json=followUpOn,proto3" json:"follow_up_on,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MedicalRecord) Reset() {
+	*x = MedicalRecord{}
+	mi := &file_pet_ms_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MedicalRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MedicalRecord) ProtoMessage() {}
+
+func (x *MedicalRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MedicalRecord.ProtoReflect.Descriptor instead.
+func (*MedicalRecord) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *MedicalRecord) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *MedicalRecord) GetPetUuid() string {
+	if x != nil {
+		return x.PetUuid
+	}
+	return ""
+}
+
+func (x *MedicalRecord) GetKind() MedicalRecordKind {
+	if x != nil {
+		return x.Kind
+	}
+	return MedicalRecordKind_MEDICAL_RECORD_KIND_UNSPECIFIED
+}
+
+func (x *MedicalRecord) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *MedicalRecord) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *MedicalRecord) GetVeterinarian() string {
+	if x != nil {
+		return x.Veterinarian
+	}
+	return ""
+}
+
+func (x *MedicalRecord) GetOccurredOn() *date.Date {
+	if x != nil {
+		return x.OccurredOn
+	}
+	return nil
+}
+
+func (x *MedicalRecord) GetFollowUpOn() *date.Date {
+	if x != nil {
+		return x.FollowUpOn
+	}
+	return nil
+}
+
+func (x *MedicalRecord) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *MedicalRecord) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type AddMedicalRecordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PetUuid       string                 `protobuf:"bytes,1,opt,name=pet_uuid,json=petUuid,proto3" json:"pet_uuid,omitempty"`
+	Kind          MedicalRecordKind      `protobuf:"varint,2,opt,name=kind,proto3,enum=proto.MedicalRecordKind" json:"kind,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Veterinarian  string                 `protobuf:"bytes,5,opt,name=veterinarian,proto3" json:"veterinarian,omitempty"`
+	OccurredOn    *date.Date             `protobuf:"bytes,6,opt,name=occurred_on,json=occurredOn,proto3" json:"occurred_on,omitempty"`
+	FollowUpOn    *date.Date             `protobuf:"bytes,7,opt,name=follow_up_on,json=followUpOn,proto3" json:"follow_up_on,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddMedicalRecordRequest) Reset() {
+	*x = AddMedicalRecordRequest{}
+	mi := &file_pet_ms_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddMedicalRecordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMedicalRecordRequest) ProtoMessage() {}
+
+func (x *AddMedicalRecordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMedicalRecordRequest.ProtoReflect.Descriptor instead.
+func (*AddMedicalRecordRequest) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *AddMedicalRecordRequest) GetPetUuid() string {
+	if x != nil {
+		return x.PetUuid
+	}
+	return ""
+}
+
+func (x *AddMedicalRecordRequest) GetKind() MedicalRecordKind {
+	if x != nil {
+		return x.Kind
+	}
+	return MedicalRecordKind_MEDICAL_RECORD_KIND_UNSPECIFIED
+}
+
+func (x *AddMedicalRecordRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *AddMedicalRecordRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AddMedicalRecordRequest) GetVeterinarian() string {
+	if x != nil {
+		return x.Veterinarian
+	}
+	return ""
+}
+
+func (x *AddMedicalRecordRequest) GetOccurredOn() *date.Date {
+	if x != nil {
+		return x.OccurredOn
+	}
+	return nil
+}
+
+func (x *AddMedicalRecordRequest) GetFollowUpOn() *date.Date {
+	if x != nil {
+		return x.FollowUpOn
+	}
+	return nil
+}
+
+type UpdateMedicalRecordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Kind          MedicalRecordKind      `protobuf:"varint,2,opt,name=kind,proto3,enum=proto.MedicalRecordKind" json:"kind,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Veterinarian  string                 `protobuf:"bytes,5,opt,name=veterinarian,proto3" json:"veterinarian,omitempty"`
+	OccurredOn    *date.Date             `protobuf:"bytes,6,opt,name=occurred_on,json=occurredOn,proto3" json:"occurred_on,omitempty"`
+	FollowUpOn    *date.Date             `protobuf:"bytes,7,opt,name=follow_up_on,json=followUpOn,proto3" json:"follow_up_on,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMedicalRecordRequest) Reset() {
+	*x = UpdateMedicalRecordRequest{}
+	mi := &file_pet_ms_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMedicalRecordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMedicalRecordRequest) ProtoMessage() {}
+
+func (x *UpdateMedicalRecordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMedicalRecordRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMedicalRecordRequest) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *UpdateMedicalRecordRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *UpdateMedicalRecordRequest) GetKind() MedicalRecordKind {
+	if x != nil {
+		return x.Kind
+	}
+	return MedicalRecordKind_MEDICAL_RECORD_KIND_UNSPECIFIED
+}
+
+func (x *UpdateMedicalRecordRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateMedicalRecordRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateMedicalRecordRequest) GetVeterinarian() string {
+	if x != nil {
+		return x.Veterinarian
+	}
+	return ""
+}
+
+func (x *UpdateMedicalRecordRequest) GetOccurredOn() *date.Date {
+	if x != nil {
+		return x.OccurredOn
+	}
+	return nil
+}
+
+func (x *UpdateMedicalRecordRequest) GetFollowUpOn() *date.Date {
+	if x != nil {
+		return x.FollowUpOn
+	}
+	return nil
+}
+
+type ListMedicalRecordsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PetUuid       string                 `protobuf:"bytes,1,opt,name=pet_uuid,json=petUuid,proto3" json:"pet_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMedicalRecordsRequest) Reset() {
+	*x = ListMedicalRecordsRequest{}
+	mi := &file_pet_ms_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMedicalRecordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMedicalRecordsRequest) ProtoMessage() {}
+
+func (x *ListMedicalRecordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMedicalRecordsRequest.ProtoReflect.Descriptor instead.
+func (*ListMedicalRecordsRequest) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListMedicalRecordsRequest) GetPetUuid() string {
+	if x != nil {
+		return x.PetUuid
+	}
+	return ""
+}
+
+// Do registro mais recente para o mais antigo.
+type ListMedicalRecordsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Records       []*MedicalRecord       `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMedicalRecordsResponse) Reset() {
+	*x = ListMedicalRecordsResponse{}
+	mi := &file_pet_ms_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMedicalRecordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMedicalRecordsResponse) ProtoMessage() {}
+
+func (x *ListMedicalRecordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMedicalRecordsResponse.ProtoReflect.Descriptor instead.
+func (*ListMedicalRecordsResponse) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListMedicalRecordsResponse) GetRecords() []*MedicalRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+// size_bytes é obrigatório e precisa bater com o total de bytes enviados. Fotos
+// aceitam JPEG, PNG e WebP até 10 MiB; documentos, PDF, JPEG e PNG até 20 MiB.
+type AttachmentMetadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PetUuid       string                 `protobuf:"bytes,1,opt,name=pet_uuid,json=petUuid,proto3" json:"pet_uuid,omitempty"`
+	Kind          AttachmentKind         `protobuf:"varint,2,opt,name=kind,proto3,enum=proto.AttachmentKind" json:"kind,omitempty"`
+	FileName      string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType   string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	SizeBytes     uint64                 `protobuf:"varint,5,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachmentMetadata) Reset() {
+	*x = AttachmentMetadata{}
+	mi := &file_pet_ms_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachmentMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentMetadata) ProtoMessage() {}
+
+func (x *AttachmentMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentMetadata.ProtoReflect.Descriptor instead.
+func (*AttachmentMetadata) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *AttachmentMetadata) GetPetUuid() string {
+	if x != nil {
+		return x.PetUuid
+	}
+	return ""
+}
+
+func (x *AttachmentMetadata) GetKind() AttachmentKind {
+	if x != nil {
+		return x.Kind
+	}
+	return AttachmentKind_ATTACHMENT_KIND_UNSPECIFIED
+}
+
+func (x *AttachmentMetadata) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *AttachmentMetadata) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *AttachmentMetadata) GetSizeBytes() uint64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+type UploadAttachmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metadata      *AttachmentMetadata    `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Chunk         []byte                 `protobuf:"bytes,2,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentRequest) Reset() {
+	*x = UploadAttachmentRequest{}
+	mi := &file_pet_ms_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentRequest) ProtoMessage() {}
+
+func (x *UploadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *UploadAttachmentRequest) GetMetadata() *AttachmentMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *UploadAttachmentRequest) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+type Attachment struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Uuid           string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	PetUuid        string                 `protobuf:"bytes,2,opt,name=pet_uuid,json=petUuid,proto3" json:"pet_uuid,omitempty"`
+	Kind           AttachmentKind         `protobuf:"varint,3,opt,name=kind,proto3,enum=proto.AttachmentKind" json:"kind,omitempty"`
+	FileName       string                 `protobuf:"bytes,4,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType    string                 `protobuf:"bytes,5,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	SizeBytes      uint64                 `protobuf:"varint,6,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	ChecksumSha256 string                 `protobuf:"bytes,7,opt,name=checksum_sha256,json=checksumSha256,proto3" json:"checksum_sha256,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_pet_ms_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *Attachment) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *Attachment) GetPetUuid() string {
+	if x != nil {
+		return x.PetUuid
+	}
+	return ""
+}
+
+func (x *Attachment) GetKind() AttachmentKind {
+	if x != nil {
+		return x.Kind
+	}
+	return AttachmentKind_ATTACHMENT_KIND_UNSPECIFIED
+}
+
+func (x *Attachment) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *Attachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Attachment) GetSizeBytes() uint64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *Attachment) GetChecksumSha256() string {
+	if x != nil {
+		return x.ChecksumSha256
+	}
+	return ""
+}
+
+func (x *Attachment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type DownloadAttachmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadAttachmentRequest) Reset() {
+	*x = DownloadAttachmentRequest{}
+	mi := &file_pet_ms_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadAttachmentRequest) ProtoMessage() {}
+
+func (x *DownloadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*DownloadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *DownloadAttachmentRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type DownloadAttachmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachment    *Attachment            `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	Chunk         []byte                 `protobuf:"bytes,2,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadAttachmentResponse) Reset() {
+	*x = DownloadAttachmentResponse{}
+	mi := &file_pet_ms_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadAttachmentResponse) ProtoMessage() {}
+
+func (x *DownloadAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*DownloadAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *DownloadAttachmentResponse) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *DownloadAttachmentResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+type ListAttachmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PetUuid       string                 `protobuf:"bytes,1,opt,name=pet_uuid,json=petUuid,proto3" json:"pet_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttachmentsRequest) Reset() {
+	*x = ListAttachmentsRequest{}
+	mi := &file_pet_ms_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttachmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttachmentsRequest) ProtoMessage() {}
+
+func (x *ListAttachmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttachmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListAttachmentsRequest) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListAttachmentsRequest) GetPetUuid() string {
+	if x != nil {
+		return x.PetUuid
+	}
+	return ""
+}
+
+type ListAttachmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachments   []*Attachment          `protobuf:"bytes,1,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttachmentsResponse) Reset() {
+	*x = ListAttachmentsResponse{}
+	mi := &file_pet_ms_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttachmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttachmentsResponse) ProtoMessage() {}
+
+func (x *ListAttachmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttachmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListAttachmentsResponse) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListAttachmentsResponse) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+type DeleteAttachmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAttachmentRequest) Reset() {
+	*x = DeleteAttachmentRequest{}
+	mi := &file_pet_ms_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAttachmentRequest) ProtoMessage() {}
+
+func (x *DeleteAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *DeleteAttachmentRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type DeleteAttachmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAttachmentResponse) Reset() {
+	*x = DeleteAttachmentResponse{}
+	mi := &file_pet_ms_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAttachmentResponse) ProtoMessage() {}
+
+func (x *DeleteAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *DeleteAttachmentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GuardianAddress struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Street     string                 `protobuf:"bytes,1,opt,name=street,proto3" json:"street,omitempty"`
+	Number     string                 `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
+	Complement string                 `protobuf:"bytes,3,opt,name=complement,proto3" json:"complement,omitempty"`
+	District   string                 `protobuf:"bytes,4,opt,name=district,proto3" json:"district,omitempty"`
+	City       string                 `protobuf:"bytes,5,opt,name=city,proto3" json:"city,omitempty"`
+	State      string                 `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
+	PostalCode string                 `protobuf:"bytes,7,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	// ISO 3166-1 alfa-2.
+	Country       string `protobuf:"bytes,8,opt,name=country,proto3" json:"country,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuardianAddress) Reset() {
+	*x = GuardianAddress{}
+	mi := &file_pet_ms_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuardianAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuardianAddress) ProtoMessage() {}
+
+func (x *GuardianAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuardianAddress.ProtoReflect.Descriptor instead.
+func (*GuardianAddress) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GuardianAddress) GetStreet() string {
+	if x != nil {
+		return x.Street
+	}
+	return ""
+}
+
+func (x *GuardianAddress) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *GuardianAddress) GetComplement() string {
+	if x != nil {
+		return x.Complement
+	}
+	return ""
+}
+
+func (x *GuardianAddress) GetDistrict() string {
+	if x != nil {
+		return x.District
+	}
+	return ""
+}
+
+func (x *GuardianAddress) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *GuardianAddress) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *GuardianAddress) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *GuardianAddress) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+type GuardianConsent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contact       bool                   `protobuf:"varint,1,opt,name=contact,proto3" json:"contact,omitempty"`
+	Marketing     bool                   `protobuf:"varint,2,opt,name=marketing,proto3" json:"marketing,omitempty"`
+	DataSharing   bool                   `protobuf:"varint,3,opt,name=data_sharing,json=dataSharing,proto3" json:"data_sharing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuardianConsent) Reset() {
+	*x = GuardianConsent{}
+	mi := &file_pet_ms_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuardianConsent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuardianConsent) ProtoMessage() {}
+
+func (x *GuardianConsent) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuardianConsent.ProtoReflect.Descriptor instead.
+func (*GuardianConsent) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GuardianConsent) GetContact() bool {
+	if x != nil {
+		return x.Contact
+	}
+	return false
+}
+
+func (x *GuardianConsent) GetMarketing() bool {
+	if x != nil {
+		return x.Marketing
+	}
+	return false
+}
+
+func (x *GuardianConsent) GetDataSharing() bool {
+	if x != nil {
+		return x.DataSharing
+	}
+	return false
+}
+
+type Guardian struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Uuid             string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email            string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Phone            string                 `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`
+	Address          *GuardianAddress       `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	Consent          *GuardianConsent       `protobuf:"bytes,6,opt,name=consent,proto3" json:"consent,omitempty"`
+	ConsentUpdatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=consent_updated_at,json=consentUpdatedAt,proto3" json:"consent_updated_at,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Guardian) Reset() {
+	*x = Guardian{}
+	mi := &file_pet_ms_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Guardian) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Guardian) ProtoMessage() {}
+
+func (x *Guardian) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Guardian.ProtoReflect.Descriptor instead.
+func (*Guardian) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *Guardian) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *Guardian) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Guardian) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Guardian) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Guardian) GetAddress() *GuardianAddress {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *Guardian) GetConsent() *GuardianConsent {
+	if x != nil {
+		return x.Consent
+	}
+	return nil
+}
+
+func (x *Guardian) GetConsentUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ConsentUpdatedAt
+	}
+	return nil
+}
+
+func (x *Guardian) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Guardian) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// email ou phone é obrigatório.
+type CreateGuardianRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Phone         string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+	Address       *GuardianAddress       `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	Consent       *GuardianConsent       `protobuf:"bytes,5,opt,name=consent,proto3" json:"consent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGuardianRequest) Reset() {
+	*x = CreateGuardianRequest{}
+	mi := &file_pet_ms_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGuardianRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGuardianRequest) ProtoMessage() {}
+
+func (x *CreateGuardianRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGuardianRequest.ProtoReflect.Descriptor instead.
+func (*CreateGuardianRequest) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CreateGuardianRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateGuardianRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateGuardianRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *CreateGuardianRequest) GetAddress() *GuardianAddress {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *CreateGuardianRequest) GetConsent() *GuardianConsent {
+	if x != nil {
+		return x.Consent
+	}
+	return nil
+}
+
+type GetGuardianRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGuardianRequest) Reset() {
+	*x = GetGuardianRequest{}
+	mi := &file_pet_ms_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGuardianRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGuardianRequest) ProtoMessage() {}
+
+func (x *GetGuardianRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGuardianRequest.ProtoReflect.Descriptor instead.
+func (*GetGuardianRequest) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetGuardianRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+// Substitui o cadastro inteiro; campos omitidos ficam vazios.
+type UpdateGuardianRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Phone         string                 `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`
+	Address       *GuardianAddress       `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	Consent       *GuardianConsent       `protobuf:"bytes,6,opt,name=consent,proto3" json:"consent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGuardianRequest) Reset() {
+	*x = UpdateGuardianRequest{}
+	mi := &file_pet_ms_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGuardianRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGuardianRequest) ProtoMessage() {}
+
+func (x *UpdateGuardianRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGuardianRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGuardianRequest) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *UpdateGuardianRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *UpdateGuardianRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateGuardianRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UpdateGuardianRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *UpdateGuardianRequest) GetAddress() *GuardianAddress {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *UpdateGuardianRequest) GetConsent() *GuardianConsent {
+	if x != nil {
+		return x.Consent
+	}
+	return nil
+}
+
+type DeleteGuardianRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGuardianRequest) Reset() {
+	*x = DeleteGuardianRequest{}
+	mi := &file_pet_ms_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGuardianRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGuardianRequest) ProtoMessage() {}
+
+func (x *DeleteGuardianRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGuardianRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGuardianRequest) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *DeleteGuardianRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type DeleteGuardianResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGuardianResponse) Reset() {
+	*x = DeleteGuardianResponse{}
+	mi := &file_pet_ms_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGuardianResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGuardianResponse) ProtoMessage() {}
+
+func (x *DeleteGuardianResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGuardianResponse.ProtoReflect.Descriptor instead.
+func (*DeleteGuardianResponse) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *DeleteGuardianResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ListGuardiansRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      uint32                 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGuardiansRequest) Reset() {
+	*x = ListGuardiansRequest{}
+	mi := &file_pet_ms_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGuardiansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGuardiansRequest) ProtoMessage() {}
+
+func (x *ListGuardiansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGuardiansRequest.ProtoReflect.Descriptor instead.
+func (*ListGuardiansRequest) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ListGuardiansRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListGuardiansRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListGuardiansResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UuidGuardian  string                 `protobuf:"bytes,1,opt,name=uuid_guardian,json=uuidGuardian,proto3" json:"uuid_guardian,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	BirthYear     uint64                 `protobuf:"varint,3,opt,name=birth_year,json=birthYear,proto3" json:"birth_year,omitempty"`
-	Breed         string                 `protobuf:"bytes,4,opt,name=breed,proto3" json:"breed,omitempty"`
-	Specie        uint64                 `protobuf:"varint,5,opt,name=specie,proto3" json:"specie,omitempty"`
+	Guardians     []*Guardian            `protobuf:"bytes,1,rep,name=guardians,proto3" json:"guardians,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreatePetRequest) Reset() {
-	*x = CreatePetRequest{}
-	mi := &file_pet_ms_proto_msgTypes[0]
+func (x *ListGuardiansResponse) Reset() {
+	*x = ListGuardiansResponse{}
+	mi := &file_pet_ms_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePetRequest) String() string {
+func (x *ListGuardiansResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePetRequest) ProtoMessage() {}
+func (*ListGuardiansResponse) ProtoMessage() {}
 
-func (x *CreatePetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pet_ms_proto_msgTypes[0]
+func (x *ListGuardiansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -58,74 +6077,118 @@ func (x *CreatePetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePetRequest.ProtoReflect.Descriptor instead.
-func (*CreatePetRequest) Descriptor() ([]byte, []int) {
-	return file_pet_ms_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use ListGuardiansResponse.ProtoReflect.Descriptor instead.
+func (*ListGuardiansResponse) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *CreatePetRequest) GetUuidGuardian() string {
+func (x *ListGuardiansResponse) GetGuardians() []*Guardian {
 	if x != nil {
-		return x.UuidGuardian
+		return x.Guardians
 	}
-	return ""
+	return nil
 }
 
-func (x *CreatePetRequest) GetName() string {
+func (x *ListGuardiansResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.Name
+		return x.NextPageToken
 	}
 	return ""
 }
 
-func (x *CreatePetRequest) GetBirthYear() uint64 {
+// O dono vem sem created_at: ele é o uuid_guardian do pet, não uma ligação.
+type PetGuardian struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PetUuid       string                 `protobuf:"bytes,1,opt,name=pet_uuid,json=petUuid,proto3" json:"pet_uuid,omitempty"`
+	UuidGuardian  string                 `protobuf:"bytes,2,opt,name=uuid_guardian,json=uuidGuardian,proto3" json:"uuid_guardian,omitempty"`
+	Role          GuardianRole           `protobuf:"varint,3,opt,name=role,proto3,enum=proto.GuardianRole" json:"role,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PetGuardian) Reset() {
+	*x = PetGuardian{}
+	mi := &file_pet_ms_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PetGuardian) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PetGuardian) ProtoMessage() {}
+
+func (x *PetGuardian) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[78]
 	if x != nil {
-		return x.BirthYear
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *CreatePetRequest) GetBreed() string {
+// Deprecated: Use PetGuardian.ProtoReflect.Descriptor instead.
+func (*PetGuardian) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *PetGuardian) GetPetUuid() string {
 	if x != nil {
-		return x.Breed
+		return x.PetUuid
 	}
 	return ""
 }
 
-func (x *CreatePetRequest) GetSpecie() uint64 {
+func (x *PetGuardian) GetUuidGuardian() string {
 	if x != nil {
-		return x.Specie
+		return x.UuidGuardian
 	}
-	return 0
+	return ""
 }
 
-type CreatePetResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	NIdentification int64                  `protobuf:"varint,1,opt,name=n_identification,json=nIdentification,proto3" json:"n_identification,omitempty"`
-	Uuid            string                 `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	UuidGuardian    string                 `protobuf:"bytes,3,opt,name=uuid_guardian,json=uuidGuardian,proto3" json:"uuid_guardian,omitempty"`
-	Name            string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	BirthYear       uint64                 `protobuf:"varint,5,opt,name=birth_year,json=birthYear,proto3" json:"birth_year,omitempty"`
-	Breed           string                 `protobuf:"bytes,6,opt,name=breed,proto3" json:"breed,omitempty"`
-	Specie          string                 `protobuf:"bytes,7,opt,name=specie,proto3" json:"specie,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *PetGuardian) GetRole() GuardianRole {
+	if x != nil {
+		return x.Role
+	}
+	return GuardianRole_GUARDIAN_ROLE_UNSPECIFIED
 }
 
-func (x *CreatePetResponse) Reset() {
-	*x = CreatePetResponse{}
-	mi := &file_pet_ms_proto_msgTypes[1]
+func (x *PetGuardian) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type AddPetGuardianRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PetUuid       string                 `protobuf:"bytes,1,opt,name=pet_uuid,json=petUuid,proto3" json:"pet_uuid,omitempty"`
+	UuidGuardian  string                 `protobuf:"bytes,2,opt,name=uuid_guardian,json=uuidGuardian,proto3" json:"uuid_guardian,omitempty"`
+	Role          GuardianRole           `protobuf:"varint,3,opt,name=role,proto3,enum=proto.GuardianRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPetGuardianRequest) Reset() {
+	*x = AddPetGuardianRequest{}
+	mi := &file_pet_ms_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePetResponse) String() string {
+func (x *AddPetGuardianRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePetResponse) ProtoMessage() {}
+func (*AddPetGuardianRequest) ProtoMessage() {}
 
-func (x *CreatePetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pet_ms_proto_msgTypes[1]
+func (x *AddPetGuardianRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -136,86 +6199,195 @@ func (x *CreatePetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePetResponse.ProtoReflect.Descriptor instead.
-func (*CreatePetResponse) Descriptor() ([]byte, []int) {
-	return file_pet_ms_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use AddPetGuardianRequest.ProtoReflect.Descriptor instead.
+func (*AddPetGuardianRequest) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *CreatePetResponse) GetNIdentification() int64 {
+func (x *AddPetGuardianRequest) GetPetUuid() string {
 	if x != nil {
-		return x.NIdentification
+		return x.PetUuid
 	}
-	return 0
+	return ""
 }
 
-func (x *CreatePetResponse) GetUuid() string {
+func (x *AddPetGuardianRequest) GetUuidGuardian() string {
 	if x != nil {
-		return x.Uuid
+		return x.UuidGuardian
 	}
 	return ""
 }
 
-func (x *CreatePetResponse) GetUuidGuardian() string {
+func (x *AddPetGuardianRequest) GetRole() GuardianRole {
 	if x != nil {
-		return x.UuidGuardian
+		return x.Role
+	}
+	return GuardianRole_GUARDIAN_ROLE_UNSPECIFIED
+}
+
+type RemovePetGuardianRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PetUuid       string                 `protobuf:"bytes,1,opt,name=pet_uuid,json=petUuid,proto3" json:"pet_uuid,omitempty"`
+	UuidGuardian  string                 `protobuf:"bytes,2,opt,name=uuid_guardian,json=uuidGuardian,proto3" json:"uuid_guardian,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemovePetGuardianRequest) Reset() {
+	*x = RemovePetGuardianRequest{}
+	mi := &file_pet_ms_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemovePetGuardianRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemovePetGuardianRequest) ProtoMessage() {}
+
+func (x *RemovePetGuardianRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemovePetGuardianRequest.ProtoReflect.Descriptor instead.
+func (*RemovePetGuardianRequest) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *RemovePetGuardianRequest) GetPetUuid() string {
+	if x != nil {
+		return x.PetUuid
 	}
 	return ""
 }
 
-func (x *CreatePetResponse) GetName() string {
+func (x *RemovePetGuardianRequest) GetUuidGuardian() string {
 	if x != nil {
-		return x.Name
+		return x.UuidGuardian
 	}
 	return ""
 }
 
-func (x *CreatePetResponse) GetBirthYear() uint64 {
+type RemovePetGuardianResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemovePetGuardianResponse) Reset() {
+	*x = RemovePetGuardianResponse{}
+	mi := &file_pet_ms_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemovePetGuardianResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemovePetGuardianResponse) ProtoMessage() {}
+
+func (x *RemovePetGuardianResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[81]
 	if x != nil {
-		return x.BirthYear
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *CreatePetResponse) GetBreed() string {
+// Deprecated: Use RemovePetGuardianResponse.ProtoReflect.Descriptor instead.
+func (*RemovePetGuardianResponse) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *RemovePetGuardianResponse) GetMessage() string {
 	if x != nil {
-		return x.Breed
+		return x.Message
 	}
 	return ""
 }
 
-func (x *CreatePetResponse) GetSpecie() string {
+type ListPetGuardiansRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PetUuid       string                 `protobuf:"bytes,1,opt,name=pet_uuid,json=petUuid,proto3" json:"pet_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPetGuardiansRequest) Reset() {
+	*x = ListPetGuardiansRequest{}
+	mi := &file_pet_ms_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPetGuardiansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPetGuardiansRequest) ProtoMessage() {}
+
+func (x *ListPetGuardiansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[82]
 	if x != nil {
-		return x.Specie
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPetGuardiansRequest.ProtoReflect.Descriptor instead.
+func (*ListPetGuardiansRequest) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ListPetGuardiansRequest) GetPetUuid() string {
+	if x != nil {
+		return x.PetUuid
 	}
 	return ""
 }
 
-type UpdatePetRequest struct {
+// O dono primeiro; depois as ligações, da mais antiga para a mais recente.
+type ListPetGuardiansResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Uuid          string                 `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	BirthYear     uint64                 `protobuf:"varint,5,opt,name=birth_year,json=birthYear,proto3" json:"birth_year,omitempty"`
-	Breed         string                 `protobuf:"bytes,6,opt,name=breed,proto3" json:"breed,omitempty"`
-	Specie        uint64                 `protobuf:"varint,7,opt,name=specie,proto3" json:"specie,omitempty"`
+	Guardians     []*PetGuardian         `protobuf:"bytes,1,rep,name=guardians,proto3" json:"guardians,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdatePetRequest) Reset() {
-	*x = UpdatePetRequest{}
-	mi := &file_pet_ms_proto_msgTypes[2]
+func (x *ListPetGuardiansResponse) Reset() {
+	*x = ListPetGuardiansResponse{}
+	mi := &file_pet_ms_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePetRequest) String() string {
+func (x *ListPetGuardiansResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePetRequest) ProtoMessage() {}
+func (*ListPetGuardiansResponse) ProtoMessage() {}
 
-func (x *UpdatePetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pet_ms_proto_msgTypes[2]
+func (x *ListPetGuardiansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -226,74 +6398,159 @@ func (x *UpdatePetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePetRequest.ProtoReflect.Descriptor instead.
-func (*UpdatePetRequest) Descriptor() ([]byte, []int) {
-	return file_pet_ms_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ListPetGuardiansResponse.ProtoReflect.Descriptor instead.
+func (*ListPetGuardiansResponse) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ListPetGuardiansResponse) GetGuardians() []*PetGuardian {
+	if x != nil {
+		return x.Guardians
+	}
+	return nil
+}
+
+// decision_reason, decided_by e decided_at vêm vazios enquanto o pedido está pendente.
+type AdoptionApplication struct {
+	state          protoimpl.MessageState    `protogen:"open.v1"`
+	Uuid           string                    `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	PetUuid        string                    `protobuf:"bytes,2,opt,name=pet_uuid,json=petUuid,proto3" json:"pet_uuid,omitempty"`
+	ApplicantUuid  string                    `protobuf:"bytes,3,opt,name=applicant_uuid,json=applicantUuid,proto3" json:"applicant_uuid,omitempty"`
+	Status         AdoptionApplicationStatus `protobuf:"varint,4,opt,name=status,proto3,enum=proto.AdoptionApplicationStatus" json:"status,omitempty"`
+	Message        string                    `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	DecisionReason string                    `protobuf:"bytes,6,opt,name=decision_reason,json=decisionReason,proto3" json:"decision_reason,omitempty"`
+	DecidedBy      string                    `protobuf:"bytes,7,opt,name=decided_by,json=decidedBy,proto3" json:"decided_by,omitempty"`
+	DecidedAt      *timestamppb.Timestamp    `protobuf:"bytes,8,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
+	CreatedAt      *timestamppb.Timestamp    `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp    `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AdoptionApplication) Reset() {
+	*x = AdoptionApplication{}
+	mi := &file_pet_ms_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdoptionApplication) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdoptionApplication) ProtoMessage() {}
+
+func (x *AdoptionApplication) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdoptionApplication.ProtoReflect.Descriptor instead.
+func (*AdoptionApplication) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *AdoptionApplication) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *AdoptionApplication) GetPetUuid() string {
+	if x != nil {
+		return x.PetUuid
+	}
+	return ""
+}
+
+func (x *AdoptionApplication) GetApplicantUuid() string {
+	if x != nil {
+		return x.ApplicantUuid
+	}
+	return ""
+}
+
+func (x *AdoptionApplication) GetStatus() AdoptionApplicationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AdoptionApplicationStatus_ADOPTION_APPLICATION_STATUS_UNSPECIFIED
+}
+
+func (x *AdoptionApplication) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
 }
 
-func (x *UpdatePetRequest) GetUuid() string {
+func (x *AdoptionApplication) GetDecisionReason() string {
 	if x != nil {
-		return x.Uuid
+		return x.DecisionReason
 	}
 	return ""
 }
 
-func (x *UpdatePetRequest) GetName() string {
+func (x *AdoptionApplication) GetDecidedBy() string {
 	if x != nil {
-		return x.Name
+		return x.DecidedBy
 	}
 	return ""
 }
 
-func (x *UpdatePetRequest) GetBirthYear() uint64 {
+func (x *AdoptionApplication) GetDecidedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.BirthYear
+		return x.DecidedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *UpdatePetRequest) GetBreed() string {
+func (x *AdoptionApplication) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Breed
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdatePetRequest) GetSpecie() uint64 {
+func (x *AdoptionApplication) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Specie
+		return x.UpdatedAt
 	}
-	return 0
+	return nil
 }
 
-type UpdatePetResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	NIdentification int64                  `protobuf:"varint,1,opt,name=n_identification,json=nIdentification,proto3" json:"n_identification,omitempty"`
-	Uuid            string                 `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	UuidGuardian    string                 `protobuf:"bytes,3,opt,name=uuid_guardian,json=uuidGuardian,proto3" json:"uuid_guardian,omitempty"`
-	Name            string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	BirthYear       uint64                 `protobuf:"varint,5,opt,name=birth_year,json=birthYear,proto3" json:"birth_year,omitempty"`
-	Breed           string                 `protobuf:"bytes,6,opt,name=breed,proto3" json:"breed,omitempty"`
-	Specie          string                 `protobuf:"bytes,7,opt,name=specie,proto3" json:"specie,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type SubmitAdoptionApplicationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PetUuid       string                 `protobuf:"bytes,1,opt,name=pet_uuid,json=petUuid,proto3" json:"pet_uuid,omitempty"`
+	ApplicantUuid string                 `protobuf:"bytes,2,opt,name=applicant_uuid,json=applicantUuid,proto3" json:"applicant_uuid,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdatePetResponse) Reset() {
-	*x = UpdatePetResponse{}
-	mi := &file_pet_ms_proto_msgTypes[3]
+func (x *SubmitAdoptionApplicationRequest) Reset() {
+	*x = SubmitAdoptionApplicationRequest{}
+	mi := &file_pet_ms_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePetResponse) String() string {
+func (x *SubmitAdoptionApplicationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePetResponse) ProtoMessage() {}
+func (*SubmitAdoptionApplicationRequest) ProtoMessage() {}
 
-func (x *UpdatePetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pet_ms_proto_msgTypes[3]
+func (x *SubmitAdoptionApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -304,82 +6561,99 @@ func (x *UpdatePetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePetResponse.ProtoReflect.Descriptor instead.
-func (*UpdatePetResponse) Descriptor() ([]byte, []int) {
-	return file_pet_ms_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use SubmitAdoptionApplicationRequest.ProtoReflect.Descriptor instead.
+func (*SubmitAdoptionApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *UpdatePetResponse) GetNIdentification() int64 {
+func (x *SubmitAdoptionApplicationRequest) GetPetUuid() string {
 	if x != nil {
-		return x.NIdentification
+		return x.PetUuid
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdatePetResponse) GetUuid() string {
+func (x *SubmitAdoptionApplicationRequest) GetApplicantUuid() string {
 	if x != nil {
-		return x.Uuid
+		return x.ApplicantUuid
 	}
 	return ""
 }
 
-func (x *UpdatePetResponse) GetUuidGuardian() string {
+func (x *SubmitAdoptionApplicationRequest) GetMessage() string {
 	if x != nil {
-		return x.UuidGuardian
+		return x.Message
 	}
 	return ""
 }
 
-func (x *UpdatePetResponse) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+type GetAdoptionApplicationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdatePetResponse) GetBirthYear() uint64 {
-	if x != nil {
-		return x.BirthYear
-	}
-	return 0
+func (x *GetAdoptionApplicationRequest) Reset() {
+	*x = GetAdoptionApplicationRequest{}
+	mi := &file_pet_ms_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePetResponse) GetBreed() string {
+func (x *GetAdoptionApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAdoptionApplicationRequest) ProtoMessage() {}
+
+func (x *GetAdoptionApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[86]
 	if x != nil {
-		return x.Breed
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UpdatePetResponse) GetSpecie() string {
+// Deprecated: Use GetAdoptionApplicationRequest.ProtoReflect.Descriptor instead.
+func (*GetAdoptionApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *GetAdoptionApplicationRequest) GetUuid() string {
 	if x != nil {
-		return x.Specie
+		return x.Uuid
 	}
 	return ""
 }
 
-type DeletePetRequest struct {
+type DecideAdoptionApplicationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UuidGuardian  string                 `protobuf:"bytes,1,opt,name=uuid_guardian,json=uuidGuardian,proto3" json:"uuid_guardian,omitempty"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeletePetRequest) Reset() {
-	*x = DeletePetRequest{}
-	mi := &file_pet_ms_proto_msgTypes[4]
+func (x *DecideAdoptionApplicationRequest) Reset() {
+	*x = DecideAdoptionApplicationRequest{}
+	mi := &file_pet_ms_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeletePetRequest) String() string {
+func (x *DecideAdoptionApplicationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePetRequest) ProtoMessage() {}
+func (*DecideAdoptionApplicationRequest) ProtoMessage() {}
 
-func (x *DeletePetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pet_ms_proto_msgTypes[4]
+func (x *DecideAdoptionApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -390,40 +6664,50 @@ func (x *DeletePetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePetRequest.ProtoReflect.Descriptor instead.
-func (*DeletePetRequest) Descriptor() ([]byte, []int) {
-	return file_pet_ms_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use DecideAdoptionApplicationRequest.ProtoReflect.Descriptor instead.
+func (*DecideAdoptionApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *DeletePetRequest) GetUuidGuardian() string {
+func (x *DecideAdoptionApplicationRequest) GetUuid() string {
 	if x != nil {
-		return x.UuidGuardian
+		return x.Uuid
 	}
 	return ""
 }
 
-type DeletePetResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+func (x *DecideAdoptionApplicationRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ApproveAdoptionApplicationResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Application *AdoptionApplication   `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
+	Pet         *GetPetResponse        `protobuf:"bytes,2,opt,name=pet,proto3" json:"pet,omitempty"`
+	// Pedidos pendentes do mesmo pet recusados pela aprovação.
+	Rejected      []*AdoptionApplication `protobuf:"bytes,3,rep,name=rejected,proto3" json:"rejected,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeletePetResponse) Reset() {
-	*x = DeletePetResponse{}
-	mi := &file_pet_ms_proto_msgTypes[5]
+func (x *ApproveAdoptionApplicationResponse) Reset() {
+	*x = ApproveAdoptionApplicationResponse{}
+	mi := &file_pet_ms_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeletePetResponse) String() string {
+func (x *ApproveAdoptionApplicationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePetResponse) ProtoMessage() {}
+func (*ApproveAdoptionApplicationResponse) ProtoMessage() {}
 
-func (x *DeletePetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pet_ms_proto_msgTypes[5]
+func (x *ApproveAdoptionApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -434,40 +6718,56 @@ func (x *DeletePetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePetResponse.ProtoReflect.Descriptor instead.
-func (*DeletePetResponse) Descriptor() ([]byte, []int) {
-	return file_pet_ms_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ApproveAdoptionApplicationResponse.ProtoReflect.Descriptor instead.
+func (*ApproveAdoptionApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *DeletePetResponse) GetMessage() string {
+func (x *ApproveAdoptionApplicationResponse) GetApplication() *AdoptionApplication {
 	if x != nil {
-		return x.Message
+		return x.Application
 	}
-	return ""
+	return nil
 }
 
-type GetPetRequest struct {
+func (x *ApproveAdoptionApplicationResponse) GetPet() *GetPetResponse {
+	if x != nil {
+		return x.Pet
+	}
+	return nil
+}
+
+func (x *ApproveAdoptionApplicationResponse) GetRejected() []*AdoptionApplication {
+	if x != nil {
+		return x.Rejected
+	}
+	return nil
+}
+
+type ListPetAdoptionApplicationsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	PetUuid       string                 `protobuf:"bytes,1,opt,name=pet_uuid,json=petUuid,proto3" json:"pet_uuid,omitempty"`
+	PageSize      uint32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPetRequest) Reset() {
-	*x = GetPetRequest{}
-	mi := &file_pet_ms_proto_msgTypes[6]
+func (x *ListPetAdoptionApplicationsRequest) Reset() {
+	*x = ListPetAdoptionApplicationsRequest{}
+	mi := &file_pet_ms_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPetRequest) String() string {
+func (x *ListPetAdoptionApplicationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPetRequest) ProtoMessage() {}
+func (*ListPetAdoptionApplicationsRequest) ProtoMessage() {}
 
-func (x *GetPetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pet_ms_proto_msgTypes[6]
+func (x *ListPetAdoptionApplicationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -478,46 +6778,56 @@ func (x *GetPetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPetRequest.ProtoReflect.Descriptor instead.
-func (*GetPetRequest) Descriptor() ([]byte, []int) {
-	return file_pet_ms_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ListPetAdoptionApplicationsRequest.ProtoReflect.Descriptor instead.
+func (*ListPetAdoptionApplicationsRequest) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *GetPetRequest) GetUuid() string {
+func (x *ListPetAdoptionApplicationsRequest) GetPetUuid() string {
 	if x != nil {
-		return x.Uuid
+		return x.PetUuid
 	}
 	return ""
 }
 
-type GetPetResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	NIdentification int64                  `protobuf:"varint,1,opt,name=n_identification,json=nIdentification,proto3" json:"n_identification,omitempty"`
-	Uuid            string                 `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	UuidGuardian    string                 `protobuf:"bytes,3,opt,name=uuid_guardian,json=uuidGuardian,proto3" json:"uuid_guardian,omitempty"`
-	Name            string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	BirthYear       uint64                 `protobuf:"varint,5,opt,name=birth_year,json=birthYear,proto3" json:"birth_year,omitempty"`
-	Breed           string                 `protobuf:"bytes,6,opt,name=breed,proto3" json:"breed,omitempty"`
-	Specie          string                 `protobuf:"bytes,7,opt,name=specie,proto3" json:"specie,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *ListPetAdoptionApplicationsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
 }
 
-func (x *GetPetResponse) Reset() {
-	*x = GetPetResponse{}
-	mi := &file_pet_ms_proto_msgTypes[7]
+func (x *ListPetAdoptionApplicationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListApplicantAdoptionApplicationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicantUuid string                 `protobuf:"bytes,1,opt,name=applicant_uuid,json=applicantUuid,proto3" json:"applicant_uuid,omitempty"`
+	PageSize      uint32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApplicantAdoptionApplicationsRequest) Reset() {
+	*x = ListApplicantAdoptionApplicationsRequest{}
+	mi := &file_pet_ms_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPetResponse) String() string {
+func (x *ListApplicantAdoptionApplicationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPetResponse) ProtoMessage() {}
+func (*ListApplicantAdoptionApplicationsRequest) ProtoMessage() {}
 
-func (x *GetPetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pet_ms_proto_msgTypes[7]
+func (x *ListApplicantAdoptionApplicationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -528,56 +6838,81 @@ func (x *GetPetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPetResponse.ProtoReflect.Descriptor instead.
-func (*GetPetResponse) Descriptor() ([]byte, []int) {
-	return file_pet_ms_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ListApplicantAdoptionApplicationsRequest.ProtoReflect.Descriptor instead.
+func (*ListApplicantAdoptionApplicationsRequest) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *GetPetResponse) GetNIdentification() int64 {
+func (x *ListApplicantAdoptionApplicationsRequest) GetApplicantUuid() string {
 	if x != nil {
-		return x.NIdentification
+		return x.ApplicantUuid
 	}
-	return 0
+	return ""
 }
 
-func (x *GetPetResponse) GetUuid() string {
+func (x *ListApplicantAdoptionApplicationsRequest) GetPageSize() uint32 {
 	if x != nil {
-		return x.Uuid
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-func (x *GetPetResponse) GetUuidGuardian() string {
+func (x *ListApplicantAdoptionApplicationsRequest) GetPageToken() string {
 	if x != nil {
-		return x.UuidGuardian
+		return x.PageToken
 	}
 	return ""
 }
 
-func (x *GetPetResponse) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Do pedido mais antigo para o mais novo.
+type ListAdoptionApplicationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Applications  []*AdoptionApplication `protobuf:"bytes,1,rep,name=applications,proto3" json:"applications,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPetResponse) GetBirthYear() uint64 {
+func (x *ListAdoptionApplicationsResponse) Reset() {
+	*x = ListAdoptionApplicationsResponse{}
+	mi := &file_pet_ms_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAdoptionApplicationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAdoptionApplicationsResponse) ProtoMessage() {}
+
+func (x *ListAdoptionApplicationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_ms_proto_msgTypes[91]
 	if x != nil {
-		return x.BirthYear
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetPetResponse) GetBreed() string {
+// Deprecated: Use ListAdoptionApplicationsResponse.ProtoReflect.Descriptor instead.
+func (*ListAdoptionApplicationsResponse) Descriptor() ([]byte, []int) {
+	return file_pet_ms_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ListAdoptionApplicationsResponse) GetApplications() []*AdoptionApplication {
 	if x != nil {
-		return x.Breed
+		return x.Applications
 	}
-	return ""
+	return nil
 }
 
-func (x *GetPetResponse) GetSpecie() string {
+func (x *ListAdoptionApplicationsResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.Specie
+		return x.NextPageToken
 	}
 	return ""
 }
@@ -586,14 +6921,29 @@ var File_pet_ms_proto protoreflect.FileDescriptor
 
 const file_pet_ms_proto_rawDesc = "" +
 	"\n" +
-	"\fpet-ms.proto\x12\x05proto\x1a\x1cgoogle/api/annotations.proto\"\x98\x01\n" +
+	"\fpet-ms.proto\x12\x05proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a google/protobuf/field_mask.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x16google/type/date.proto\"\xee\x04\n" +
 	"\x10CreatePetRequest\x12#\n" +
 	"\ruuid_guardian\x18\x01 \x01(\tR\fuuidGuardian\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
 	"\n" +
 	"birth_year\x18\x03 \x01(\x04R\tbirthYear\x12\x14\n" +
 	"\x05breed\x18\x04 \x01(\tR\x05breed\x12\x16\n" +
-	"\x06specie\x18\x05 \x01(\x04R\x06specie\"\xd8\x01\n" +
+	"\x06specie\x18\x05 \x01(\x04R\x06specie\x12'\n" +
+	"\x0fidempotency_key\x18\x06 \x01(\tR\x0eidempotencyKey\x12!\n" +
+	"\fspecies_code\x18\a \x01(\tR\vspeciesCode\x120\n" +
+	"\n" +
+	"birth_date\x18\b \x01(\v2\x11.google.type.DateR\tbirthDate\x12H\n" +
+	"\x13birth_date_accuracy\x18\t \x01(\x0e2\x18.proto.BirthDateAccuracyR\x11birthDateAccuracy\x12\x1f\n" +
+	"\x03sex\x18\n" +
+	" \x01(\x0e2\r.proto.PetSexR\x03sex\x126\n" +
+	"\bneutered\x18\v \x01(\v2\x1a.google.protobuf.BoolValueR\bneutered\x12\x14\n" +
+	"\x05color\x18\f \x01(\tR\x05color\x12\x1a\n" +
+	"\bmarkings\x18\r \x01(\tR\bmarkings\x12!\n" +
+	"\fweight_grams\x18\x0e \x01(\rR\vweightGrams\x12)\n" +
+	"\x10microchip_number\x18\x0f \x01(\tR\x0fmicrochipNumber\x12\x1d\n" +
+	"\n" +
+	"photo_urls\x18\x10 \x03(\tR\tphotoUrls\x12\x14\n" +
+	"\x05notes\x18\x11 \x01(\tR\x05notes\"\x89\a\n" +
 	"\x11CreatePetResponse\x12)\n" +
 	"\x10n_identification\x18\x01 \x01(\x03R\x0fnIdentification\x12\x12\n" +
 	"\x04uuid\x18\x02 \x01(\tR\x04uuid\x12#\n" +
@@ -602,14 +6952,49 @@ const file_pet_ms_proto_rawDesc = "" +
 	"\n" +
 	"birth_year\x18\x05 \x01(\x04R\tbirthYear\x12\x14\n" +
 	"\x05breed\x18\x06 \x01(\tR\x05breed\x12\x16\n" +
-	"\x06specie\x18\a \x01(\tR\x06specie\"\x87\x01\n" +
+	"\x06specie\x18\a \x01(\tR\x06specie\x12,\n" +
+	"\aspecies\x18\b \x01(\v2\x12.proto.SpeciesInfoR\aspecies\x120\n" +
+	"\n" +
+	"birth_date\x18\t \x01(\v2\x11.google.type.DateR\tbirthDate\x12H\n" +
+	"\x13birth_date_accuracy\x18\n" +
+	" \x01(\x0e2\x18.proto.BirthDateAccuracyR\x11birthDateAccuracy\x12\x1f\n" +
+	"\x03age\x18\v \x01(\v2\r.proto.PetAgeR\x03age\x12\x1f\n" +
+	"\x03sex\x18\f \x01(\x0e2\r.proto.PetSexR\x03sex\x126\n" +
+	"\bneutered\x18\r \x01(\v2\x1a.google.protobuf.BoolValueR\bneutered\x12\x14\n" +
+	"\x05color\x18\x0e \x01(\tR\x05color\x12\x1a\n" +
+	"\bmarkings\x18\x0f \x01(\tR\bmarkings\x12?\n" +
+	"\x0eweight_history\x18\x10 \x03(\v2\x18.proto.WeightMeasurementR\rweightHistory\x12!\n" +
+	"\fweight_grams\x18\x11 \x01(\rR\vweightGrams\x12)\n" +
+	"\x10microchip_number\x18\x12 \x01(\tR\x0fmicrochipNumber\x12\x1d\n" +
+	"\n" +
+	"photo_urls\x18\x13 \x03(\tR\tphotoUrls\x12\x14\n" +
+	"\x05notes\x18\x14 \x01(\tR\x05notes\x12(\n" +
+	"\x06status\x18\x15 \x01(\x0e2\x10.proto.PetStatusR\x06status\x12#\n" +
+	"\rstatus_reason\x18\x16 \x01(\tR\fstatusReason\x12F\n" +
+	"\x11status_changed_at\x18\x17 \x01(\v2\x1a.google.protobuf.TimestampR\x0fstatusChangedAt\"\xf1\x04\n" +
 	"\x10UpdatePetRequest\x12\x12\n" +
 	"\x04uuid\x18\x02 \x01(\tR\x04uuid\x12\x12\n" +
 	"\x04name\x18\x04 \x01(\tR\x04name\x12\x1d\n" +
 	"\n" +
 	"birth_year\x18\x05 \x01(\x04R\tbirthYear\x12\x14\n" +
 	"\x05breed\x18\x06 \x01(\tR\x05breed\x12\x16\n" +
-	"\x06specie\x18\a \x01(\x04R\x06specie\"\xd8\x01\n" +
+	"\x06specie\x18\a \x01(\x04R\x06specie\x12!\n" +
+	"\fspecies_code\x18\b \x01(\tR\vspeciesCode\x120\n" +
+	"\n" +
+	"birth_date\x18\t \x01(\v2\x11.google.type.DateR\tbirthDate\x12H\n" +
+	"\x13birth_date_accuracy\x18\n" +
+	" \x01(\x0e2\x18.proto.BirthDateAccuracyR\x11birthDateAccuracy\x12\x1f\n" +
+	"\x03sex\x18\v \x01(\x0e2\r.proto.PetSexR\x03sex\x126\n" +
+	"\bneutered\x18\f \x01(\v2\x1a.google.protobuf.BoolValueR\bneutered\x12\x14\n" +
+	"\x05color\x18\r \x01(\tR\x05color\x12\x1a\n" +
+	"\bmarkings\x18\x0e \x01(\tR\bmarkings\x12!\n" +
+	"\fweight_grams\x18\x0f \x01(\rR\vweightGrams\x12)\n" +
+	"\x10microchip_number\x18\x10 \x01(\tR\x0fmicrochipNumber\x12\x1d\n" +
+	"\n" +
+	"photo_urls\x18\x11 \x03(\tR\tphotoUrls\x12\x14\n" +
+	"\x05notes\x18\x12 \x01(\tR\x05notes\x12;\n" +
+	"\vupdate_mask\x18\x13 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"\x89\a\n" +
 	"\x11UpdatePetResponse\x12)\n" +
 	"\x10n_identification\x18\x01 \x01(\x03R\x0fnIdentification\x12\x12\n" +
 	"\x04uuid\x18\x02 \x01(\tR\x04uuid\x12#\n" +
@@ -618,13 +7003,32 @@ const file_pet_ms_proto_rawDesc = "" +
 	"\n" +
 	"birth_year\x18\x05 \x01(\x04R\tbirthYear\x12\x14\n" +
 	"\x05breed\x18\x06 \x01(\tR\x05breed\x12\x16\n" +
-	"\x06specie\x18\a \x01(\tR\x06specie\"7\n" +
+	"\x06specie\x18\a \x01(\tR\x06specie\x12,\n" +
+	"\aspecies\x18\b \x01(\v2\x12.proto.SpeciesInfoR\aspecies\x120\n" +
+	"\n" +
+	"birth_date\x18\t \x01(\v2\x11.google.type.DateR\tbirthDate\x12H\n" +
+	"\x13birth_date_accuracy\x18\n" +
+	" \x01(\x0e2\x18.proto.BirthDateAccuracyR\x11birthDateAccuracy\x12\x1f\n" +
+	"\x03age\x18\v \x01(\v2\r.proto.PetAgeR\x03age\x12\x1f\n" +
+	"\x03sex\x18\f \x01(\x0e2\r.proto.PetSexR\x03sex\x126\n" +
+	"\bneutered\x18\r \x01(\v2\x1a.google.protobuf.BoolValueR\bneutered\x12\x14\n" +
+	"\x05color\x18\x0e \x01(\tR\x05color\x12\x1a\n" +
+	"\bmarkings\x18\x0f \x01(\tR\bmarkings\x12?\n" +
+	"\x0eweight_history\x18\x10 \x03(\v2\x18.proto.WeightMeasurementR\rweightHistory\x12!\n" +
+	"\fweight_grams\x18\x11 \x01(\rR\vweightGrams\x12)\n" +
+	"\x10microchip_number\x18\x12 \x01(\tR\x0fmicrochipNumber\x12\x1d\n" +
+	"\n" +
+	"photo_urls\x18\x13 \x03(\tR\tphotoUrls\x12\x14\n" +
+	"\x05notes\x18\x14 \x01(\tR\x05notes\x12(\n" +
+	"\x06status\x18\x15 \x01(\x0e2\x10.proto.PetStatusR\x06status\x12#\n" +
+	"\rstatus_reason\x18\x16 \x01(\tR\fstatusReason\x12F\n" +
+	"\x11status_changed_at\x18\x17 \x01(\v2\x1a.google.protobuf.TimestampR\x0fstatusChangedAt\"7\n" +
 	"\x10DeletePetRequest\x12#\n" +
 	"\ruuid_guardian\x18\x01 \x01(\tR\fuuidGuardian\"-\n" +
 	"\x11DeletePetResponse\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\"#\n" +
 	"\rGetPetRequest\x12\x12\n" +
-	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"\xd5\x01\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"\x86\a\n" +
 	"\x0eGetPetResponse\x12)\n" +
 	"\x10n_identification\x18\x01 \x01(\x03R\x0fnIdentification\x12\x12\n" +
 	"\x04uuid\x18\x02 \x01(\tR\x04uuid\x12#\n" +
@@ -633,14 +7037,546 @@ const file_pet_ms_proto_rawDesc = "" +
 	"\n" +
 	"birth_year\x18\x05 \x01(\x04R\tbirthYear\x12\x14\n" +
 	"\x05breed\x18\x06 \x01(\tR\x05breed\x12\x16\n" +
-	"\x06specie\x18\a \x01(\tR\x06specie2\xd7\x02\n" +
+	"\x06specie\x18\a \x01(\tR\x06specie\x12,\n" +
+	"\aspecies\x18\b \x01(\v2\x12.proto.SpeciesInfoR\aspecies\x120\n" +
+	"\n" +
+	"birth_date\x18\t \x01(\v2\x11.google.type.DateR\tbirthDate\x12H\n" +
+	"\x13birth_date_accuracy\x18\n" +
+	" \x01(\x0e2\x18.proto.BirthDateAccuracyR\x11birthDateAccuracy\x12\x1f\n" +
+	"\x03age\x18\v \x01(\v2\r.proto.PetAgeR\x03age\x12\x1f\n" +
+	"\x03sex\x18\f \x01(\x0e2\r.proto.PetSexR\x03sex\x126\n" +
+	"\bneutered\x18\r \x01(\v2\x1a.google.protobuf.BoolValueR\bneutered\x12\x14\n" +
+	"\x05color\x18\x0e \x01(\tR\x05color\x12\x1a\n" +
+	"\bmarkings\x18\x0f \x01(\tR\bmarkings\x12?\n" +
+	"\x0eweight_history\x18\x10 \x03(\v2\x18.proto.WeightMeasurementR\rweightHistory\x12!\n" +
+	"\fweight_grams\x18\x11 \x01(\rR\vweightGrams\x12)\n" +
+	"\x10microchip_number\x18\x12 \x01(\tR\x0fmicrochipNumber\x12\x1d\n" +
+	"\n" +
+	"photo_urls\x18\x13 \x03(\tR\tphotoUrls\x12\x14\n" +
+	"\x05notes\x18\x14 \x01(\tR\x05notes\x12(\n" +
+	"\x06status\x18\x15 \x01(\x0e2\x10.proto.PetStatusR\x06status\x12#\n" +
+	"\rstatus_reason\x18\x16 \x01(\tR\fstatusReason\x12F\n" +
+	"\x11status_changed_at\x18\x17 \x01(\v2\x1a.google.protobuf.TimestampR\x0fstatusChangedAt\"M\n" +
+	"\x12TransferPetRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12#\n" +
+	"\ruuid_guardian\x18\x02 \x01(\tR\fuuidGuardian\"\x91\x01\n" +
+	"\x14TransitionPetRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12(\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x10.proto.PetStatusR\x06status\x12#\n" +
+	"\ruuid_guardian\x18\x03 \x01(\tR\fuuidGuardian\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"k\n" +
+	"\x16BatchCreatePetsRequest\x12+\n" +
+	"\x04pets\x18\x01 \x03(\v2\x17.proto.CreatePetRequestR\x04pets\x12$\n" +
+	"\x04mode\x18\x02 \x01(\x0e2\x10.proto.BatchModeR\x04mode\"\xd6\x01\n" +
+	"\x15BatchCreatePetsResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\rR\x05index\x12*\n" +
+	"\x03pet\x18\x02 \x01(\v2\x18.proto.CreatePetResponseR\x03pet\x12@\n" +
+	"\x06errors\x18\x03 \x03(\v2(.proto.BatchCreatePetsResult.ErrorsEntryR\x06errors\x1a9\n" +
+	"\vErrorsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"o\n" +
+	"\x17BatchCreatePetsResponse\x126\n" +
+	"\aresults\x18\x01 \x03(\v2\x1c.proto.BatchCreatePetsResultR\aresults\x12\x1c\n" +
+	"\tcommitted\x18\x02 \x01(\bR\tcommitted\"+\n" +
+	"\x13BatchGetPetsRequest\x12\x14\n" +
+	"\x05uuids\x18\x01 \x03(\tR\x05uuids\"^\n" +
+	"\x14BatchGetPetsResponse\x12)\n" +
+	"\x04pets\x18\x01 \x03(\v2\x15.proto.GetPetResponseR\x04pets\x12\x1b\n" +
+	"\tnot_found\x18\x02 \x03(\tR\bnotFound\"k\n" +
+	"\x16BatchUpdatePetsRequest\x12+\n" +
+	"\x04pets\x18\x01 \x03(\v2\x17.proto.UpdatePetRequestR\x04pets\x12$\n" +
+	"\x04mode\x18\x02 \x01(\x0e2\x10.proto.BatchModeR\x04mode\"\xd6\x01\n" +
+	"\x15BatchUpdatePetsResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\rR\x05index\x12*\n" +
+	"\x03pet\x18\x02 \x01(\v2\x18.proto.UpdatePetResponseR\x03pet\x12@\n" +
+	"\x06errors\x18\x03 \x03(\v2(.proto.BatchUpdatePetsResult.ErrorsEntryR\x06errors\x1a9\n" +
+	"\vErrorsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"o\n" +
+	"\x17BatchUpdatePetsResponse\x126\n" +
+	"\aresults\x18\x01 \x03(\v2\x1c.proto.BatchUpdatePetsResultR\aresults\x12\x1c\n" +
+	"\tcommitted\x18\x02 \x01(\bR\tcommitted\"@\n" +
+	"\x11ImportPetsRequest\x12+\n" +
+	"\x04pets\x18\x01 \x03(\v2\x17.proto.CreatePetRequestR\x04pets\"\x9e\x01\n" +
+	"\x0eImportPetError\x12\x16\n" +
+	"\x06record\x18\x01 \x01(\x04R\x06record\x129\n" +
+	"\x06errors\x18\x02 \x03(\v2!.proto.ImportPetError.ErrorsEntryR\x06errors\x1a9\n" +
+	"\vErrorsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"{\n" +
+	"\x12ImportPetsResponse\x12\x1a\n" +
+	"\breceived\x18\x01 \x01(\x04R\breceived\x12\x1a\n" +
+	"\bimported\x18\x02 \x01(\x04R\bimported\x12-\n" +
+	"\x06errors\x18\x03 \x03(\v2\x15.proto.ImportPetErrorR\x06errors\"\xbb\x02\n" +
+	"\x11ExportPetsRequest\x12#\n" +
+	"\ruuid_guardian\x18\x01 \x01(\tR\fuuidGuardian\x12\x18\n" +
+	"\aspecies\x18\x02 \x03(\x04R\aspecies\x12\x14\n" +
+	"\x05breed\x18\x03 \x01(\tR\x05breed\x12&\n" +
+	"\x0fbirth_year_from\x18\x04 \x01(\x04R\rbirthYearFrom\x12\"\n" +
+	"\rbirth_year_to\x18\x05 \x01(\x04R\vbirthYearTo\x12\x1b\n" +
+	"\tpage_size\x18\x06 \x01(\rR\bpageSize\x12:\n" +
+	"\x0eguardian_roles\x18\a \x03(\x0e2\x13.proto.GuardianRoleR\rguardianRoles\x12,\n" +
+	"\bstatuses\x18\b \x03(\x0e2\x10.proto.PetStatusR\bstatuses\"\xde\x01\n" +
+	"\x10WatchPetsRequest\x12#\n" +
+	"\ruuid_guardian\x18\x01 \x01(\tR\fuuidGuardian\x12\x18\n" +
+	"\aspecies\x18\x02 \x03(\x04R\aspecies\x12!\n" +
+	"\fresume_token\x18\x03 \x01(\tR\vresumeToken\x12:\n" +
+	"\x0eguardian_roles\x18\x04 \x03(\x0e2\x13.proto.GuardianRoleR\rguardianRoles\x12,\n" +
+	"\bstatuses\x18\x05 \x03(\x0e2\x10.proto.PetStatusR\bstatuses\"\xc5\x01\n" +
+	"\x11WatchPetsResponse\x12'\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x13.proto.PetEventTypeR\x04type\x12'\n" +
+	"\x03pet\x18\x02 \x01(\v2\x15.proto.GetPetResponseR\x03pet\x12;\n" +
+	"\voccurred_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12!\n" +
+	"\fresume_token\x18\x04 \x01(\tR\vresumeToken\"g\n" +
+	"\x15GetPetAuditLogRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\rR\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"~\n" +
+	"\x1bListGuardianAuditLogRequest\x12#\n" +
+	"\ruuid_guardian\x18\x01 \x01(\tR\fuuidGuardian\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\rR\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"V\n" +
+	"\x10AuditFieldChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x16\n" +
+	"\x06before\x18\x02 \x01(\tR\x06before\x12\x14\n" +
+	"\x05after\x18\x03 \x01(\tR\x05after\"\xd7\x02\n" +
+	"\n" +
+	"AuditEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x19\n" +
+	"\bpet_uuid\x18\x02 \x01(\tR\apetUuid\x12#\n" +
+	"\ruuid_guardian\x18\x03 \x01(\tR\fuuidGuardian\x124\n" +
+	"\x16previous_uuid_guardian\x18\x04 \x01(\tR\x14previousUuidGuardian\x12\x16\n" +
+	"\x06action\x18\x05 \x01(\tR\x06action\x12\x1c\n" +
+	"\tprincipal\x18\x06 \x01(\tR\tprincipal\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\a \x01(\tR\trequestId\x12;\n" +
+	"\voccurred_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x121\n" +
+	"\achanges\x18\t \x03(\v2\x17.proto.AuditFieldChangeR\achanges\"g\n" +
+	"\x10AuditLogResponse\x12+\n" +
+	"\aentries\x18\x01 \x03(\v2\x11.proto.AuditEntryR\aentries\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"n\n" +
+	"\vSpeciesInfo\x12(\n" +
+	"\aspecies\x18\x01 \x01(\x0e2\x0e.proto.SpeciesR\aspecies\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12!\n" +
+	"\fdisplay_name\x18\x03 \x01(\tR\vdisplayName\"M\n" +
+	"\x14CreateSpeciesRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\"'\n" +
+	"\x11GetSpeciesRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"\x14\n" +
+	"\x12ListSpeciesRequest\"C\n" +
+	"\x13ListSpeciesResponse\x12,\n" +
+	"\aspecies\x18\x01 \x03(\v2\x12.proto.SpeciesInfoR\aspecies\"M\n" +
+	"\x14UpdateSpeciesRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\"*\n" +
+	"\x14DeleteSpeciesRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"1\n" +
+	"\x15DeleteSpeciesResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"m\n" +
+	"\x13SearchBreedsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12#\n" +
+	"\rspecies_codes\x18\x02 \x03(\tR\fspeciesCodes\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\rR\bpageSize\"\x97\x01\n" +
+	"\tBreedInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12,\n" +
+	"\aspecies\x18\x02 \x01(\v2\x12.proto.SpeciesInfoR\aspecies\x12\x18\n" +
+	"\aaliases\x18\x03 \x03(\tR\aaliases\x12\x18\n" +
+	"\amatched\x18\x04 \x01(\tR\amatched\x12\x14\n" +
+	"\x05mixed\x18\x05 \x01(\bR\x05mixed\"@\n" +
+	"\x14SearchBreedsResponse\x12(\n" +
+	"\x06breeds\x18\x01 \x03(\v2\x10.proto.BreedInfoR\x06breeds\"\xef\x01\n" +
+	"\x11SearchPetsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12#\n" +
+	"\ruuid_guardian\x18\x02 \x01(\tR\fuuidGuardian\x12\x18\n" +
+	"\aspecies\x18\x03 \x03(\x04R\aspecies\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\rR\bpageSize\x12:\n" +
+	"\x0eguardian_roles\x18\x05 \x03(\x0e2\x13.proto.GuardianRoleR\rguardianRoles\x12,\n" +
+	"\bstatuses\x18\x06 \x03(\x0e2\x10.proto.PetStatusR\bstatuses\";\n" +
+	"\x0fSearchHighlight\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\"\x83\x01\n" +
+	"\fPetSearchHit\x12'\n" +
+	"\x03pet\x18\x01 \x01(\v2\x15.proto.GetPetResponseR\x03pet\x12\x12\n" +
+	"\x04rank\x18\x02 \x01(\x01R\x04rank\x126\n" +
+	"\n" +
+	"highlights\x18\x03 \x03(\v2\x16.proto.SearchHighlightR\n" +
+	"highlights\"=\n" +
+	"\x12SearchPetsResponse\x12'\n" +
+	"\x04hits\x18\x01 \x03(\v2\x13.proto.PetSearchHitR\x04hits\"E\n" +
+	"\x18LookupByMicrochipRequest\x12)\n" +
+	"\x10microchip_number\x18\x01 \x01(\tR\x0fmicrochipNumber\"6\n" +
+	"\x06PetAge\x12\x14\n" +
+	"\x05years\x18\x01 \x01(\rR\x05years\x12\x16\n" +
+	"\x06months\x18\x02 \x01(\rR\x06months\"f\n" +
+	"\x11WeightMeasurement\x12\x14\n" +
+	"\x05grams\x18\x01 \x01(\rR\x05grams\x12;\n" +
+	"\vmeasured_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"measuredAt\"\xfa\x03\n" +
+	"\vVaccination\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x19\n" +
+	"\bpet_uuid\x18\x02 \x01(\tR\apetUuid\x12\x18\n" +
+	"\avaccine\x18\x03 \x01(\tR\avaccine\x12\x12\n" +
+	"\x04dose\x18\x04 \x01(\rR\x04dose\x12:\n" +
+	"\x0fadministered_on\x18\x05 \x01(\v2\x11.google.type.DateR\x0eadministeredOn\x122\n" +
+	"\x15booster_interval_days\x18\x06 \x01(\rR\x13boosterIntervalDays\x125\n" +
+	"\rnext_due_date\x18\a \x01(\v2\x11.google.type.DateR\vnextDueDate\x12\x18\n" +
+	"\aoverdue\x18\b \x01(\bR\aoverdue\x12\x1d\n" +
+	"\n" +
+	"lot_number\x18\t \x01(\tR\tlotNumber\x12\"\n" +
+	"\fveterinarian\x18\n" +
+	" \x01(\tR\fveterinarian\x12\x14\n" +
+	"\x05notes\x18\v \x01(\tR\x05notes\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xa9\x02\n" +
+	"\x15AddVaccinationRequest\x12\x19\n" +
+	"\bpet_uuid\x18\x01 \x01(\tR\apetUuid\x12\x18\n" +
+	"\avaccine\x18\x02 \x01(\tR\avaccine\x12\x12\n" +
+	"\x04dose\x18\x03 \x01(\rR\x04dose\x12:\n" +
+	"\x0fadministered_on\x18\x04 \x01(\v2\x11.google.type.DateR\x0eadministeredOn\x122\n" +
+	"\x15booster_interval_days\x18\x05 \x01(\rR\x13boosterIntervalDays\x12\x1d\n" +
+	"\n" +
+	"lot_number\x18\x06 \x01(\tR\tlotNumber\x12\"\n" +
+	"\fveterinarian\x18\a \x01(\tR\fveterinarian\x12\x14\n" +
+	"\x05notes\x18\b \x01(\tR\x05notes\"\xa5\x02\n" +
+	"\x18UpdateVaccinationRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x18\n" +
+	"\avaccine\x18\x02 \x01(\tR\avaccine\x12\x12\n" +
+	"\x04dose\x18\x03 \x01(\rR\x04dose\x12:\n" +
+	"\x0fadministered_on\x18\x04 \x01(\v2\x11.google.type.DateR\x0eadministeredOn\x122\n" +
+	"\x15booster_interval_days\x18\x05 \x01(\rR\x13boosterIntervalDays\x12\x1d\n" +
+	"\n" +
+	"lot_number\x18\x06 \x01(\tR\tlotNumber\x12\"\n" +
+	"\fveterinarian\x18\a \x01(\tR\fveterinarian\x12\x14\n" +
+	"\x05notes\x18\b \x01(\tR\x05notes\"4\n" +
+	"\x17ListVaccinationsRequest\x12\x19\n" +
+	"\bpet_uuid\x18\x01 \x01(\tR\apetUuid\"R\n" +
+	"\x18ListVaccinationsResponse\x126\n" +
+	"\fvaccinations\x18\x01 \x03(\v2\x12.proto.VaccinationR\fvaccinations\"\x84\x01\n" +
+	"\x1eListOverdueVaccinationsRequest\x12&\n" +
+	"\x05as_of\x18\x01 \x01(\v2\x11.google.type.DateR\x04asOf\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\rR\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x81\x01\n" +
+	"\x1fListOverdueVaccinationsResponse\x126\n" +
+	"\fvaccinations\x18\x01 \x03(\v2\x12.proto.VaccinationR\fvaccinations\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xa7\x03\n" +
+	"\rMedicalRecord\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x19\n" +
+	"\bpet_uuid\x18\x02 \x01(\tR\apetUuid\x12,\n" +
+	"\x04kind\x18\x03 \x01(\x0e2\x18.proto.MedicalRecordKindR\x04kind\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\"\n" +
+	"\fveterinarian\x18\x06 \x01(\tR\fveterinarian\x122\n" +
+	"\voccurred_on\x18\a \x01(\v2\x11.google.type.DateR\n" +
+	"occurredOn\x123\n" +
+	"\ffollow_up_on\x18\b \x01(\v2\x11.google.type.DateR\n" +
+	"followUpOn\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xa7\x02\n" +
+	"\x17AddMedicalRecordRequest\x12\x19\n" +
+	"\bpet_uuid\x18\x01 \x01(\tR\apetUuid\x12,\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x18.proto.MedicalRecordKindR\x04kind\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\"\n" +
+	"\fveterinarian\x18\x05 \x01(\tR\fveterinarian\x122\n" +
+	"\voccurred_on\x18\x06 \x01(\v2\x11.google.type.DateR\n" +
+	"occurredOn\x123\n" +
+	"\ffollow_up_on\x18\a \x01(\v2\x11.google.type.DateR\n" +
+	"followUpOn\"\xa3\x02\n" +
+	"\x1aUpdateMedicalRecordRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12,\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x18.proto.MedicalRecordKindR\x04kind\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\"\n" +
+	"\fveterinarian\x18\x05 \x01(\tR\fveterinarian\x122\n" +
+	"\voccurred_on\x18\x06 \x01(\v2\x11.google.type.DateR\n" +
+	"occurredOn\x123\n" +
+	"\ffollow_up_on\x18\a \x01(\v2\x11.google.type.DateR\n" +
+	"followUpOn\"6\n" +
+	"\x19ListMedicalRecordsRequest\x12\x19\n" +
+	"\bpet_uuid\x18\x01 \x01(\tR\apetUuid\"L\n" +
+	"\x1aListMedicalRecordsResponse\x12.\n" +
+	"\arecords\x18\x01 \x03(\v2\x14.proto.MedicalRecordR\arecords\"\xb9\x01\n" +
+	"\x12AttachmentMetadata\x12\x19\n" +
+	"\bpet_uuid\x18\x01 \x01(\tR\apetUuid\x12)\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x15.proto.AttachmentKindR\x04kind\x12\x1b\n" +
+	"\tfile_name\x18\x03 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x05 \x01(\x04R\tsizeBytes\"f\n" +
+	"\x17UploadAttachmentRequest\x125\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x19.proto.AttachmentMetadataR\bmetadata\x12\x14\n" +
+	"\x05chunk\x18\x02 \x01(\fR\x05chunk\"\xa9\x02\n" +
+	"\n" +
+	"Attachment\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x19\n" +
+	"\bpet_uuid\x18\x02 \x01(\tR\apetUuid\x12)\n" +
+	"\x04kind\x18\x03 \x01(\x0e2\x15.proto.AttachmentKindR\x04kind\x12\x1b\n" +
+	"\tfile_name\x18\x04 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x05 \x01(\tR\vcontentType\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x06 \x01(\x04R\tsizeBytes\x12'\n" +
+	"\x0fchecksum_sha256\x18\a \x01(\tR\x0echecksumSha256\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"/\n" +
+	"\x19DownloadAttachmentRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"e\n" +
+	"\x1aDownloadAttachmentResponse\x121\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x11.proto.AttachmentR\n" +
+	"attachment\x12\x14\n" +
+	"\x05chunk\x18\x02 \x01(\fR\x05chunk\"3\n" +
+	"\x16ListAttachmentsRequest\x12\x19\n" +
+	"\bpet_uuid\x18\x01 \x01(\tR\apetUuid\"N\n" +
+	"\x17ListAttachmentsResponse\x123\n" +
+	"\vattachments\x18\x01 \x03(\v2\x11.proto.AttachmentR\vattachments\"-\n" +
+	"\x17DeleteAttachmentRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"4\n" +
+	"\x18DeleteAttachmentResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\xe2\x01\n" +
+	"\x0fGuardianAddress\x12\x16\n" +
+	"\x06street\x18\x01 \x01(\tR\x06street\x12\x16\n" +
+	"\x06number\x18\x02 \x01(\tR\x06number\x12\x1e\n" +
+	"\n" +
+	"complement\x18\x03 \x01(\tR\n" +
+	"complement\x12\x1a\n" +
+	"\bdistrict\x18\x04 \x01(\tR\bdistrict\x12\x12\n" +
+	"\x04city\x18\x05 \x01(\tR\x04city\x12\x14\n" +
+	"\x05state\x18\x06 \x01(\tR\x05state\x12\x1f\n" +
+	"\vpostal_code\x18\a \x01(\tR\n" +
+	"postalCode\x12\x18\n" +
+	"\acountry\x18\b \x01(\tR\acountry\"l\n" +
+	"\x0fGuardianConsent\x12\x18\n" +
+	"\acontact\x18\x01 \x01(\bR\acontact\x12\x1c\n" +
+	"\tmarketing\x18\x02 \x01(\bR\tmarketing\x12!\n" +
+	"\fdata_sharing\x18\x03 \x01(\bR\vdataSharing\"\x82\x03\n" +
+	"\bGuardian\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x14\n" +
+	"\x05phone\x18\x04 \x01(\tR\x05phone\x120\n" +
+	"\aaddress\x18\x05 \x01(\v2\x16.proto.GuardianAddressR\aaddress\x120\n" +
+	"\aconsent\x18\x06 \x01(\v2\x16.proto.GuardianConsentR\aconsent\x12H\n" +
+	"\x12consent_updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x10consentUpdatedAt\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xbb\x01\n" +
+	"\x15CreateGuardianRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x14\n" +
+	"\x05phone\x18\x03 \x01(\tR\x05phone\x120\n" +
+	"\aaddress\x18\x04 \x01(\v2\x16.proto.GuardianAddressR\aaddress\x120\n" +
+	"\aconsent\x18\x05 \x01(\v2\x16.proto.GuardianConsentR\aconsent\"(\n" +
+	"\x12GetGuardianRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"\xcf\x01\n" +
+	"\x15UpdateGuardianRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x14\n" +
+	"\x05phone\x18\x04 \x01(\tR\x05phone\x120\n" +
+	"\aaddress\x18\x05 \x01(\v2\x16.proto.GuardianAddressR\aaddress\x120\n" +
+	"\aconsent\x18\x06 \x01(\v2\x16.proto.GuardianConsentR\aconsent\"+\n" +
+	"\x15DeleteGuardianRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"2\n" +
+	"\x16DeleteGuardianResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"R\n" +
+	"\x14ListGuardiansRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\rR\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"n\n" +
+	"\x15ListGuardiansResponse\x12-\n" +
+	"\tguardians\x18\x01 \x03(\v2\x0f.proto.GuardianR\tguardians\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xb1\x01\n" +
+	"\vPetGuardian\x12\x19\n" +
+	"\bpet_uuid\x18\x01 \x01(\tR\apetUuid\x12#\n" +
+	"\ruuid_guardian\x18\x02 \x01(\tR\fuuidGuardian\x12'\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x13.proto.GuardianRoleR\x04role\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x80\x01\n" +
+	"\x15AddPetGuardianRequest\x12\x19\n" +
+	"\bpet_uuid\x18\x01 \x01(\tR\apetUuid\x12#\n" +
+	"\ruuid_guardian\x18\x02 \x01(\tR\fuuidGuardian\x12'\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x13.proto.GuardianRoleR\x04role\"Z\n" +
+	"\x18RemovePetGuardianRequest\x12\x19\n" +
+	"\bpet_uuid\x18\x01 \x01(\tR\apetUuid\x12#\n" +
+	"\ruuid_guardian\x18\x02 \x01(\tR\fuuidGuardian\"5\n" +
+	"\x19RemovePetGuardianResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"4\n" +
+	"\x17ListPetGuardiansRequest\x12\x19\n" +
+	"\bpet_uuid\x18\x01 \x01(\tR\apetUuid\"L\n" +
+	"\x18ListPetGuardiansResponse\x120\n" +
+	"\tguardians\x18\x01 \x03(\v2\x12.proto.PetGuardianR\tguardians\"\xb8\x03\n" +
+	"\x13AdoptionApplication\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x19\n" +
+	"\bpet_uuid\x18\x02 \x01(\tR\apetUuid\x12%\n" +
+	"\x0eapplicant_uuid\x18\x03 \x01(\tR\rapplicantUuid\x128\n" +
+	"\x06status\x18\x04 \x01(\x0e2 .proto.AdoptionApplicationStatusR\x06status\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x12'\n" +
+	"\x0fdecision_reason\x18\x06 \x01(\tR\x0edecisionReason\x12\x1d\n" +
+	"\n" +
+	"decided_by\x18\a \x01(\tR\tdecidedBy\x129\n" +
+	"\n" +
+	"decided_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tdecidedAt\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"~\n" +
+	" SubmitAdoptionApplicationRequest\x12\x19\n" +
+	"\bpet_uuid\x18\x01 \x01(\tR\apetUuid\x12%\n" +
+	"\x0eapplicant_uuid\x18\x02 \x01(\tR\rapplicantUuid\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"3\n" +
+	"\x1dGetAdoptionApplicationRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"N\n" +
+	" DecideAdoptionApplicationRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\xc3\x01\n" +
+	"\"ApproveAdoptionApplicationResponse\x12<\n" +
+	"\vapplication\x18\x01 \x01(\v2\x1a.proto.AdoptionApplicationR\vapplication\x12'\n" +
+	"\x03pet\x18\x02 \x01(\v2\x15.proto.GetPetResponseR\x03pet\x126\n" +
+	"\brejected\x18\x03 \x03(\v2\x1a.proto.AdoptionApplicationR\brejected\"{\n" +
+	"\"ListPetAdoptionApplicationsRequest\x12\x19\n" +
+	"\bpet_uuid\x18\x01 \x01(\tR\apetUuid\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\rR\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x8d\x01\n" +
+	"(ListApplicantAdoptionApplicationsRequest\x12%\n" +
+	"\x0eapplicant_uuid\x18\x01 \x01(\tR\rapplicantUuid\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\rR\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x8a\x01\n" +
+	" ListAdoptionApplicationsResponse\x12>\n" +
+	"\fapplications\x18\x01 \x03(\v2\x1a.proto.AdoptionApplicationR\fapplications\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken*C\n" +
+	"\tBatchMode\x12\x1d\n" +
+	"\x19BATCH_MODE_ALL_OR_NOTHING\x10\x00\x12\x17\n" +
+	"\x13BATCH_MODE_PER_ITEM\x10\x01*\xa2\x01\n" +
+	"\tPetStatus\x12\x1a\n" +
+	"\x16PET_STATUS_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11PET_STATUS_INTAKE\x10\x01\x12\x18\n" +
+	"\x14PET_STATUS_AVAILABLE\x10\x02\x12\x17\n" +
+	"\x13PET_STATUS_RESERVED\x10\x03\x12\x16\n" +
+	"\x12PET_STATUS_ADOPTED\x10\x04\x12\x17\n" +
+	"\x13PET_STATUS_RETURNED\x10\x05*\xa2\x01\n" +
+	"\fPetEventType\x12\x1e\n" +
+	"\x1aPET_EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16PET_EVENT_TYPE_CREATED\x10\x01\x12\x1a\n" +
+	"\x16PET_EVENT_TYPE_UPDATED\x10\x02\x12\x1a\n" +
+	"\x16PET_EVENT_TYPE_DELETED\x10\x03\x12\x1e\n" +
+	"\x1aPET_EVENT_TYPE_TRANSFERRED\x10\x04*\xcc\x01\n" +
+	"\aSpecies\x12\x17\n" +
+	"\x13SPECIES_UNSPECIFIED\x10\x00\x12\x0f\n" +
+	"\vSPECIES_DOG\x10\x01\x12\x0f\n" +
+	"\vSPECIES_CAT\x10\x02\x12\x10\n" +
+	"\fSPECIES_BIRD\x10\x03\x12\x12\n" +
+	"\x0eSPECIES_RABBIT\x10\x04\x12\x13\n" +
+	"\x0fSPECIES_REPTILE\x10\x05\x12\x12\n" +
+	"\x0eSPECIES_RODENT\x10\x06\x12\x10\n" +
+	"\fSPECIES_FISH\x10\a\x12\x11\n" +
+	"\rSPECIES_HORSE\x10\b\x12\x12\n" +
+	"\x0eSPECIES_CUSTOM\x10d*\xb7\x01\n" +
+	"\x11BirthDateAccuracy\x12#\n" +
+	"\x1fBIRTH_DATE_ACCURACY_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19BIRTH_DATE_ACCURACY_EXACT\x10\x01\x12\x1d\n" +
+	"\x19BIRTH_DATE_ACCURACY_MONTH\x10\x02\x12\x1c\n" +
+	"\x18BIRTH_DATE_ACCURACY_YEAR\x10\x03\x12!\n" +
+	"\x1dBIRTH_DATE_ACCURACY_ESTIMATED\x10\x04*G\n" +
+	"\x06PetSex\x12\x17\n" +
+	"\x13PET_SEX_UNSPECIFIED\x10\x00\x12\x10\n" +
+	"\fPET_SEX_MALE\x10\x01\x12\x12\n" +
+	"\x0ePET_SEX_FEMALE\x10\x02*\xdf\x01\n" +
+	"\x11MedicalRecordKind\x12#\n" +
+	"\x1fMEDICAL_RECORD_KIND_UNSPECIFIED\x10\x00\x12$\n" +
+	" MEDICAL_RECORD_KIND_CONSULTATION\x10\x01\x12!\n" +
+	"\x1dMEDICAL_RECORD_KIND_TREATMENT\x10\x02\x12\x1f\n" +
+	"\x1bMEDICAL_RECORD_KIND_SURGERY\x10\x03\x12\x1c\n" +
+	"\x18MEDICAL_RECORD_KIND_EXAM\x10\x04\x12\x1d\n" +
+	"\x19MEDICAL_RECORD_KIND_OTHER\x10\x05*j\n" +
+	"\x0eAttachmentKind\x12\x1f\n" +
+	"\x1bATTACHMENT_KIND_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15ATTACHMENT_KIND_PHOTO\x10\x01\x12\x1c\n" +
+	"\x18ATTACHMENT_KIND_DOCUMENT\x10\x02*\xa1\x01\n" +
+	"\fGuardianRole\x12\x1d\n" +
+	"\x19GUARDIAN_ROLE_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13GUARDIAN_ROLE_OWNER\x10\x01\x12\x1a\n" +
+	"\x16GUARDIAN_ROLE_CO_OWNER\x10\x02\x12\x18\n" +
+	"\x14GUARDIAN_ROLE_FOSTER\x10\x03\x12#\n" +
+	"\x1fGUARDIAN_ROLE_EMERGENCY_CONTACT\x10\x04*\xf0\x01\n" +
+	"\x19AdoptionApplicationStatus\x12+\n" +
+	"'ADOPTION_APPLICATION_STATUS_UNSPECIFIED\x10\x00\x12'\n" +
+	"#ADOPTION_APPLICATION_STATUS_PENDING\x10\x01\x12(\n" +
+	"$ADOPTION_APPLICATION_STATUS_APPROVED\x10\x02\x12(\n" +
+	"$ADOPTION_APPLICATION_STATUS_REJECTED\x10\x03\x12)\n" +
+	"%ADOPTION_APPLICATION_STATUS_WITHDRAWN\x10\x042\xb5(\n" +
 	"\n" +
 	"PetService\x12M\n" +
 	"\x06Create\x12\x17.proto.CreatePetRequest\x1a\x18.proto.CreatePetResponse\"\x10\x82\xd3\xe4\x93\x02\n" +
 	":\x01*\"\x05/pets\x12T\n" +
 	"\x06Update\x12\x17.proto.UpdatePetRequest\x1a\x18.proto.UpdatePetResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\x1a\f/pets/{uuid}\x12Z\n" +
 	"\x06Delete\x12\x17.proto.DeletePetRequest\x1a\x18.proto.DeletePetResponse\"\x1d\x82\xd3\xe4\x93\x02\x17*\x15/pets/{uuid_guardian}\x12H\n" +
-	"\x03Get\x12\x14.proto.GetPetRequest\x1a\x15.proto.GetPetResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/pets/{uuid}B#Z!https://github.com/LuizFJP/pet-msb\x06proto3"
+	"\x03Get\x12\x14.proto.GetPetRequest\x1a\x15.proto.GetPetResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/pets/{uuid}\x12^\n" +
+	"\bTransfer\x12\x19.proto.TransferPetRequest\x1a\x15.proto.GetPetResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/pets/{uuid}:transfer\x12g\n" +
+	"\rTransitionPet\x12\x1b.proto.TransitionPetRequest\x1a\x15.proto.GetPetResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/pets/{uuid}:transition\x12n\n" +
+	"\x0fBatchCreatePets\x12\x1d.proto.BatchCreatePetsRequest\x1a\x1e.proto.BatchCreatePetsResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/pets:batchCreate\x12_\n" +
+	"\fBatchGetPets\x12\x1a.proto.BatchGetPetsRequest\x1a\x1b.proto.BatchGetPetsResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/pets:batchGet\x12n\n" +
+	"\x0fBatchUpdatePets\x12\x1d.proto.BatchUpdatePetsRequest\x1a\x1e.proto.BatchUpdatePetsResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/pets:batchUpdate\x12C\n" +
+	"\n" +
+	"ImportPets\x12\x18.proto.ImportPetsRequest\x1a\x19.proto.ImportPetsResponse(\x01\x12?\n" +
+	"\n" +
+	"ExportPets\x12\x18.proto.ExportPetsRequest\x1a\x15.proto.GetPetResponse0\x01\x12@\n" +
+	"\tWatchPets\x12\x17.proto.WatchPetsRequest\x1a\x18.proto.WatchPetsResponse0\x01\x12c\n" +
+	"\x0eGetPetAuditLog\x12\x1c.proto.GetPetAuditLogRequest\x1a\x17.proto.AuditLogResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/pets/{uuid}/audit\x12}\n" +
+	"\x14ListGuardianAuditLog\x12\".proto.ListGuardianAuditLogRequest\x1a\x17.proto.AuditLogResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /guardians/{uuid_guardian}/audit\x12U\n" +
+	"\rCreateSpecies\x12\x1b.proto.CreateSpeciesRequest\x1a\x12.proto.SpeciesInfo\"\x13\x82\xd3\xe4\x93\x02\r:\x01*\"\b/species\x12S\n" +
+	"\n" +
+	"GetSpecies\x12\x18.proto.GetSpeciesRequest\x1a\x12.proto.SpeciesInfo\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/species/{code}\x12V\n" +
+	"\vListSpecies\x12\x19.proto.ListSpeciesRequest\x1a\x1a.proto.ListSpeciesResponse\"\x10\x82\xd3\xe4\x93\x02\n" +
+	"\x12\b/species\x12\\\n" +
+	"\rUpdateSpecies\x12\x1b.proto.UpdateSpeciesRequest\x1a\x12.proto.SpeciesInfo\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\x1a\x0f/species/{code}\x12c\n" +
+	"\rDeleteSpecies\x12\x1b.proto.DeleteSpeciesRequest\x1a\x1c.proto.DeleteSpeciesResponse\"\x17\x82\xd3\xe4\x93\x02\x11*\x0f/species/{code}\x12_\n" +
+	"\fSearchBreeds\x12\x1a.proto.SearchBreedsRequest\x1a\x1b.proto.SearchBreedsResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/breeds:search\x12W\n" +
+	"\n" +
+	"SearchPets\x12\x18.proto.SearchPetsRequest\x1a\x19.proto.SearchPetsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/pets:search\x12s\n" +
+	"\x11LookupByMicrochip\x12\x1f.proto.LookupByMicrochipRequest\x1a\x15.proto.GetPetResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/microchips/{microchip_number}\x12l\n" +
+	"\x0eAddVaccination\x12\x1c.proto.AddVaccinationRequest\x1a\x12.proto.Vaccination\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/pets/{pet_uuid}/vaccinations\x12i\n" +
+	"\x11UpdateVaccination\x12\x1f.proto.UpdateVaccinationRequest\x1a\x12.proto.Vaccination\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\x1a\x14/vaccinations/{uuid}\x12z\n" +
+	"\x10ListVaccinations\x12\x1e.proto.ListVaccinationsRequest\x1a\x1f.proto.ListVaccinationsResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/pets/{pet_uuid}/vaccinations\x12\x87\x01\n" +
+	"\x17ListOverdueVaccinations\x12%.proto.ListOverdueVaccinationsRequest\x1a&.proto.ListOverdueVaccinationsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/vaccinations:overdue\x12u\n" +
+	"\x10AddMedicalRecord\x12\x1e.proto.AddMedicalRecordRequest\x1a\x14.proto.MedicalRecord\"+\x82\xd3\xe4\x93\x02%:\x01*\" /pets/{pet_uuid}/medical-records\x12r\n" +
+	"\x13UpdateMedicalRecord\x12!.proto.UpdateMedicalRecordRequest\x1a\x14.proto.MedicalRecord\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\x1a\x17/medical-records/{uuid}\x12\x83\x01\n" +
+	"\x12ListMedicalRecords\x12 .proto.ListMedicalRecordsRequest\x1a!.proto.ListMedicalRecordsResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /pets/{pet_uuid}/medical-records\x12G\n" +
+	"\x10UploadAttachment\x12\x1e.proto.UploadAttachmentRequest\x1a\x11.proto.Attachment(\x01\x12[\n" +
+	"\x12DownloadAttachment\x12 .proto.DownloadAttachmentRequest\x1a!.proto.DownloadAttachmentResponse0\x01\x12v\n" +
+	"\x0fListAttachments\x12\x1d.proto.ListAttachmentsRequest\x1a\x1e.proto.ListAttachmentsResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/pets/{pet_uuid}/attachments\x12p\n" +
+	"\x10DeleteAttachment\x12\x1e.proto.DeleteAttachmentRequest\x1a\x1f.proto.DeleteAttachmentResponse\"\x1b\x82\xd3\xe4\x93\x02\x15*\x13/attachments/{uuid}\x12V\n" +
+	"\x0eCreateGuardian\x12\x1c.proto.CreateGuardianRequest\x1a\x0f.proto.Guardian\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
+	"/guardians\x12T\n" +
+	"\vGetGuardian\x12\x19.proto.GetGuardianRequest\x1a\x0f.proto.Guardian\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/guardians/{uuid}\x12]\n" +
+	"\x0eUpdateGuardian\x12\x1c.proto.UpdateGuardianRequest\x1a\x0f.proto.Guardian\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\x1a\x11/guardians/{uuid}\x12h\n" +
+	"\x0eDeleteGuardian\x12\x1c.proto.DeleteGuardianRequest\x1a\x1d.proto.DeleteGuardianResponse\"\x19\x82\xd3\xe4\x93\x02\x13*\x11/guardians/{uuid}\x12^\n" +
+	"\rListGuardians\x12\x1b.proto.ListGuardiansRequest\x1a\x1c.proto.ListGuardiansResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/guardians\x12i\n" +
+	"\x0eAddPetGuardian\x12\x1c.proto.AddPetGuardianRequest\x1a\x12.proto.PetGuardian\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/pets/{pet_uuid}/guardians\x12\x8a\x01\n" +
+	"\x11RemovePetGuardian\x12\x1f.proto.RemovePetGuardianRequest\x1a .proto.RemovePetGuardianResponse\"2\x82\xd3\xe4\x93\x02,**/pets/{pet_uuid}/guardians/{uuid_guardian}\x12w\n" +
+	"\x10ListPetGuardians\x12\x1e.proto.ListPetGuardiansRequest\x1a\x1f.proto.ListPetGuardiansResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/pets/{pet_uuid}/guardians\x12\x8a\x01\n" +
+	"\x19SubmitAdoptionApplication\x12'.proto.SubmitAdoptionApplicationRequest\x1a\x1a.proto.AdoptionApplication\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/pets/{pet_uuid}/applications\x12x\n" +
+	"\x16GetAdoptionApplication\x12$.proto.GetAdoptionApplicationRequest\x1a\x1a.proto.AdoptionApplication\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/applications/{uuid}\x12\x99\x01\n" +
+	"\x1aApproveAdoptionApplication\x12'.proto.DecideAdoptionApplicationRequest\x1a).proto.ApproveAdoptionApplicationResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/applications/{uuid}:approve\x12\x88\x01\n" +
+	"\x19RejectAdoptionApplication\x12'.proto.DecideAdoptionApplicationRequest\x1a\x1a.proto.AdoptionApplication\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/applications/{uuid}:reject\x12\x8c\x01\n" +
+	"\x1bWithdrawAdoptionApplication\x12'.proto.DecideAdoptionApplicationRequest\x1a\x1a.proto.AdoptionApplication\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/applications/{uuid}:withdraw\x12\x98\x01\n" +
+	"\x1bListPetAdoptionApplications\x12).proto.ListPetAdoptionApplicationsRequest\x1a'.proto.ListAdoptionApplicationsResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/pets/{pet_uuid}/applications\x12\xaf\x01\n" +
+	"!ListApplicantAdoptionApplications\x12/.proto.ListApplicantAdoptionApplicationsRequest\x1a'.proto.ListAdoptionApplicationsResponse\"0\x82\xd3\xe4\x93\x02*\x12(/guardians/{applicant_uuid}/applicationsB#Z!https://github.com/LuizFJP/pet-msb\x06proto3"
 
 var (
 	file_pet_ms_proto_rawDescOnce sync.Once
@@ -654,31 +7590,341 @@ func file_pet_ms_proto_rawDescGZIP() []byte {
 	return file_pet_ms_proto_rawDescData
 }
 
-var file_pet_ms_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_pet_ms_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_pet_ms_proto_msgTypes = make([]protoimpl.MessageInfo, 95)
 var file_pet_ms_proto_goTypes = []any{
-	(*CreatePetRequest)(nil),  // 0: proto.CreatePetRequest
-	(*CreatePetResponse)(nil), // 1: proto.CreatePetResponse
-	(*UpdatePetRequest)(nil),  // 2: proto.UpdatePetRequest
-	(*UpdatePetResponse)(nil), // 3: proto.UpdatePetResponse
-	(*DeletePetRequest)(nil),  // 4: proto.DeletePetRequest
-	(*DeletePetResponse)(nil), // 5: proto.DeletePetResponse
-	(*GetPetRequest)(nil),     // 6: proto.GetPetRequest
-	(*GetPetResponse)(nil),    // 7: proto.GetPetResponse
+	(BatchMode)(0),                                   // 0: proto.BatchMode
+	(PetStatus)(0),                                   // 1: proto.PetStatus
+	(PetEventType)(0),                                // 2: proto.PetEventType
+	(Species)(0),                                     // 3: proto.Species
+	(BirthDateAccuracy)(0),                           // 4: proto.BirthDateAccuracy
+	(PetSex)(0),                                      // 5: proto.PetSex
+	(MedicalRecordKind)(0),                           // 6: proto.MedicalRecordKind
+	(AttachmentKind)(0),                              // 7: proto.AttachmentKind
+	(GuardianRole)(0),                                // 8: proto.GuardianRole
+	(AdoptionApplicationStatus)(0),                   // 9: proto.AdoptionApplicationStatus
+	(*CreatePetRequest)(nil),                         // 10: proto.CreatePetRequest
+	(*CreatePetResponse)(nil),                        // 11: proto.CreatePetResponse
+	(*UpdatePetRequest)(nil),                         // 12: proto.UpdatePetRequest
+	(*UpdatePetResponse)(nil),                        // 13: proto.UpdatePetResponse
+	(*DeletePetRequest)(nil),                         // 14: proto.DeletePetRequest
+	(*DeletePetResponse)(nil),                        // 15: proto.DeletePetResponse
+	(*GetPetRequest)(nil),                            // 16: proto.GetPetRequest
+	(*GetPetResponse)(nil),                           // 17: proto.GetPetResponse
+	(*TransferPetRequest)(nil),                       // 18: proto.TransferPetRequest
+	(*TransitionPetRequest)(nil),                     // 19: proto.TransitionPetRequest
+	(*BatchCreatePetsRequest)(nil),                   // 20: proto.BatchCreatePetsRequest
+	(*BatchCreatePetsResult)(nil),                    // 21: proto.BatchCreatePetsResult
+	(*BatchCreatePetsResponse)(nil),                  // 22: proto.BatchCreatePetsResponse
+	(*BatchGetPetsRequest)(nil),                      // 23: proto.BatchGetPetsRequest
+	(*BatchGetPetsResponse)(nil),                     // 24: proto.BatchGetPetsResponse
+	(*BatchUpdatePetsRequest)(nil),                   // 25: proto.BatchUpdatePetsRequest
+	(*BatchUpdatePetsResult)(nil),                    // 26: proto.BatchUpdatePetsResult
+	(*BatchUpdatePetsResponse)(nil),                  // 27: proto.BatchUpdatePetsResponse
+	(*ImportPetsRequest)(nil),                        // 28: proto.ImportPetsRequest
+	(*ImportPetError)(nil),                           // 29: proto.ImportPetError
+	(*ImportPetsResponse)(nil),                       // 30: proto.ImportPetsResponse
+	(*ExportPetsRequest)(nil),                        // 31: proto.ExportPetsRequest
+	(*WatchPetsRequest)(nil),                         // 32: proto.WatchPetsRequest
+	(*WatchPetsResponse)(nil),                        // 33: proto.WatchPetsResponse
+	(*GetPetAuditLogRequest)(nil),                    // 34: proto.GetPetAuditLogRequest
+	(*ListGuardianAuditLogRequest)(nil),              // 35: proto.ListGuardianAuditLogRequest
+	(*AuditFieldChange)(nil),                         // 36: proto.AuditFieldChange
+	(*AuditEntry)(nil),                               // 37: proto.AuditEntry
+	(*AuditLogResponse)(nil),                         // 38: proto.AuditLogResponse
+	(*SpeciesInfo)(nil),                              // 39: proto.SpeciesInfo
+	(*CreateSpeciesRequest)(nil),                     // 40: proto.CreateSpeciesRequest
+	(*GetSpeciesRequest)(nil),                        // 41: proto.GetSpeciesRequest
+	(*ListSpeciesRequest)(nil),                       // 42: proto.ListSpeciesRequest
+	(*ListSpeciesResponse)(nil),                      // 43: proto.ListSpeciesResponse
+	(*UpdateSpeciesRequest)(nil),                     // 44: proto.UpdateSpeciesRequest
+	(*DeleteSpeciesRequest)(nil),                     // 45: proto.DeleteSpeciesRequest
+	(*DeleteSpeciesResponse)(nil),                    // 46: proto.DeleteSpeciesResponse
+	(*SearchBreedsRequest)(nil),                      // 47: proto.SearchBreedsRequest
+	(*BreedInfo)(nil),                                // 48: proto.BreedInfo
+	(*SearchBreedsResponse)(nil),                     // 49: proto.SearchBreedsResponse
+	(*SearchPetsRequest)(nil),                        // 50: proto.SearchPetsRequest
+	(*SearchHighlight)(nil),                          // 51: proto.SearchHighlight
+	(*PetSearchHit)(nil),                             // 52: proto.PetSearchHit
+	(*SearchPetsResponse)(nil),                       // 53: proto.SearchPetsResponse
+	(*LookupByMicrochipRequest)(nil),                 // 54: proto.LookupByMicrochipRequest
+	(*PetAge)(nil),                                   // 55: proto.PetAge
+	(*WeightMeasurement)(nil),                        // 56: proto.WeightMeasurement
+	(*Vaccination)(nil),                              // 57: proto.Vaccination
+	(*AddVaccinationRequest)(nil),                    // 58: proto.AddVaccinationRequest
+	(*UpdateVaccinationRequest)(nil),                 // 59: proto.UpdateVaccinationRequest
+	(*ListVaccinationsRequest)(nil),                  // 60: proto.ListVaccinationsRequest
+	(*ListVaccinationsResponse)(nil),                 // 61: proto.ListVaccinationsResponse
+	(*ListOverdueVaccinationsRequest)(nil),           // 62: proto.ListOverdueVaccinationsRequest
+	(*ListOverdueVaccinationsResponse)(nil),          // 63: proto.ListOverdueVaccinationsResponse
+	(*MedicalRecord)(nil),                            // 64: proto.MedicalRecord
+	(*AddMedicalRecordRequest)(nil),                  // 65: proto.AddMedicalRecordRequest
+	(*UpdateMedicalRecordRequest)(nil),               // 66: proto.UpdateMedicalRecordRequest
+	(*ListMedicalRecordsRequest)(nil),                // 67: proto.ListMedicalRecordsRequest
+	(*ListMedicalRecordsResponse)(nil),               // 68: proto.ListMedicalRecordsResponse
+	(*AttachmentMetadata)(nil),                       // 69: proto.AttachmentMetadata
+	(*UploadAttachmentRequest)(nil),                  // 70: proto.UploadAttachmentRequest
+	(*Attachment)(nil),                               // 71: proto.Attachment
+	(*DownloadAttachmentRequest)(nil),                // 72: proto.DownloadAttachmentRequest
+	(*DownloadAttachmentResponse)(nil),               // 73: proto.DownloadAttachmentResponse
+	(*ListAttachmentsRequest)(nil),                   // 74: proto.ListAttachmentsRequest
+	(*ListAttachmentsResponse)(nil),                  // 75: proto.ListAttachmentsResponse
+	(*DeleteAttachmentRequest)(nil),                  // 76: proto.DeleteAttachmentRequest
+	(*DeleteAttachmentResponse)(nil),                 // 77: proto.DeleteAttachmentResponse
+	(*GuardianAddress)(nil),                          // 78: proto.GuardianAddress
+	(*GuardianConsent)(nil),                          // 79: proto.GuardianConsent
+	(*Guardian)(nil),                                 // 80: proto.Guardian
+	(*CreateGuardianRequest)(nil),                    // 81: proto.CreateGuardianRequest
+	(*GetGuardianRequest)(nil),                       // 82: proto.GetGuardianRequest
+	(*UpdateGuardianRequest)(nil),                    // 83: proto.UpdateGuardianRequest
+	(*DeleteGuardianRequest)(nil),                    // 84: proto.DeleteGuardianRequest
+	(*DeleteGuardianResponse)(nil),                   // 85: proto.DeleteGuardianResponse
+	(*ListGuardiansRequest)(nil),                     // 86: proto.ListGuardiansRequest
+	(*ListGuardiansResponse)(nil),                    // 87: proto.ListGuardiansResponse
+	(*PetGuardian)(nil),                              // 88: proto.PetGuardian
+	(*AddPetGuardianRequest)(nil),                    // 89: proto.AddPetGuardianRequest
+	(*RemovePetGuardianRequest)(nil),                 // 90: proto.RemovePetGuardianRequest
+	(*RemovePetGuardianResponse)(nil),                // 91: proto.RemovePetGuardianResponse
+	(*ListPetGuardiansRequest)(nil),                  // 92: proto.ListPetGuardiansRequest
+	(*ListPetGuardiansResponse)(nil),                 // 93: proto.ListPetGuardiansResponse
+	(*AdoptionApplication)(nil),                      // 94: proto.AdoptionApplication
+	(*SubmitAdoptionApplicationRequest)(nil),         // 95: proto.SubmitAdoptionApplicationRequest
+	(*GetAdoptionApplicationRequest)(nil),            // 96: proto.GetAdoptionApplicationRequest
+	(*DecideAdoptionApplicationRequest)(nil),         // 97: proto.DecideAdoptionApplicationRequest
+	(*ApproveAdoptionApplicationResponse)(nil),       // 98: proto.ApproveAdoptionApplicationResponse
+	(*ListPetAdoptionApplicationsRequest)(nil),       // 99: proto.ListPetAdoptionApplicationsRequest
+	(*ListApplicantAdoptionApplicationsRequest)(nil), // 100: proto.ListApplicantAdoptionApplicationsRequest
+	(*ListAdoptionApplicationsResponse)(nil),         // 101: proto.ListAdoptionApplicationsResponse
+	nil,                                              // 102: proto.BatchCreatePetsResult.ErrorsEntry
+	nil,                                              // 103: proto.BatchUpdatePetsResult.ErrorsEntry
+	nil,                                              // 104: proto.ImportPetError.ErrorsEntry
+	(*date.Date)(nil),                                // 105: google.type.Date
+	(*wrapperspb.BoolValue)(nil),                     // 106: google.protobuf.BoolValue
+	(*timestamppb.Timestamp)(nil),                    // 107: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),                    // 108: google.protobuf.FieldMask
 }
 var file_pet_ms_proto_depIdxs = []int32{
-	0, // 0: proto.PetService.Create:input_type -> proto.CreatePetRequest
-	2, // 1: proto.PetService.Update:input_type -> proto.UpdatePetRequest
-	4, // 2: proto.PetService.Delete:input_type -> proto.DeletePetRequest
-	6, // 3: proto.PetService.Get:input_type -> proto.GetPetRequest
-	1, // 4: proto.PetService.Create:output_type -> proto.CreatePetResponse
-	3, // 5: proto.PetService.Update:output_type -> proto.UpdatePetResponse
-	5, // 6: proto.PetService.Delete:output_type -> proto.DeletePetResponse
-	7, // 7: proto.PetService.Get:output_type -> proto.GetPetResponse
-	4, // [4:8] is the sub-list for method output_type
-	0, // [0:4] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	105, // 0: proto.CreatePetRequest.birth_date:type_name -> google.type.Date
+	4,   // 1: proto.CreatePetRequest.birth_date_accuracy:type_name -> proto.BirthDateAccuracy
+	5,   // 2: proto.CreatePetRequest.sex:type_name -> proto.PetSex
+	106, // 3: proto.CreatePetRequest.neutered:type_name -> google.protobuf.BoolValue
+	39,  // 4: proto.CreatePetResponse.species:type_name -> proto.SpeciesInfo
+	105, // 5: proto.CreatePetResponse.birth_date:type_name -> google.type.Date
+	4,   // 6: proto.CreatePetResponse.birth_date_accuracy:type_name -> proto.BirthDateAccuracy
+	55,  // 7: proto.CreatePetResponse.age:type_name -> proto.PetAge
+	5,   // 8: proto.CreatePetResponse.sex:type_name -> proto.PetSex
+	106, // 9: proto.CreatePetResponse.neutered:type_name -> google.protobuf.BoolValue
+	56,  // 10: proto.CreatePetResponse.weight_history:type_name -> proto.WeightMeasurement
+	1,   // 11: proto.CreatePetResponse.status:type_name -> proto.PetStatus
+	107, // 12: proto.CreatePetResponse.status_changed_at:type_name -> google.protobuf.Timestamp
+	105, // 13: proto.UpdatePetRequest.birth_date:type_name -> google.type.Date
+	4,   // 14: proto.UpdatePetRequest.birth_date_accuracy:type_name -> proto.BirthDateAccuracy
+	5,   // 15: proto.UpdatePetRequest.sex:type_name -> proto.PetSex
+	106, // 16: proto.UpdatePetRequest.neutered:type_name -> google.protobuf.BoolValue
+	108, // 17: proto.UpdatePetRequest.update_mask:type_name -> google.protobuf.FieldMask
+	39,  // 18: proto.UpdatePetResponse.species:type_name -> proto.SpeciesInfo
+	105, // 19: proto.UpdatePetResponse.birth_date:type_name -> google.type.Date
+	4,   // 20: proto.UpdatePetResponse.birth_date_accuracy:type_name -> proto.BirthDateAccuracy
+	55,  // 21: proto.UpdatePetResponse.age:type_name -> proto.PetAge
+	5,   // 22: proto.UpdatePetResponse.sex:type_name -> proto.PetSex
+	106, // 23: proto.UpdatePetResponse.neutered:type_name -> google.protobuf.BoolValue
+	56,  // 24: proto.UpdatePetResponse.weight_history:type_name -> proto.WeightMeasurement
+	1,   // 25: proto.UpdatePetResponse.status:type_name -> proto.PetStatus
+	107, // 26: proto.UpdatePetResponse.status_changed_at:type_name -> google.protobuf.Timestamp
+	39,  // 27: proto.GetPetResponse.species:type_name -> proto.SpeciesInfo
+	105, // 28: proto.GetPetResponse.birth_date:type_name -> google.type.Date
+	4,   // 29: proto.GetPetResponse.birth_date_accuracy:type_name -> proto.BirthDateAccuracy
+	55,  // 30: proto.GetPetResponse.age:type_name -> proto.PetAge
+	5,   // 31: proto.GetPetResponse.sex:type_name -> proto.PetSex
+	106, // 32: proto.GetPetResponse.neutered:type_name -> google.protobuf.BoolValue
+	56,  // 33: proto.GetPetResponse.weight_history:type_name -> proto.WeightMeasurement
+	1,   // 34: proto.GetPetResponse.status:type_name -> proto.PetStatus
+	107, // 35: proto.GetPetResponse.status_changed_at:type_name -> google.protobuf.Timestamp
+	1,   // 36: proto.TransitionPetRequest.status:type_name -> proto.PetStatus
+	10,  // 37: proto.BatchCreatePetsRequest.pets:type_name -> proto.CreatePetRequest
+	0,   // 38: proto.BatchCreatePetsRequest.mode:type_name -> proto.BatchMode
+	11,  // 39: proto.BatchCreatePetsResult.pet:type_name -> proto.CreatePetResponse
+	102, // 40: proto.BatchCreatePetsResult.errors:type_name -> proto.BatchCreatePetsResult.ErrorsEntry
+	21,  // 41: proto.BatchCreatePetsResponse.results:type_name -> proto.BatchCreatePetsResult
+	17,  // 42: proto.BatchGetPetsResponse.pets:type_name -> proto.GetPetResponse
+	12,  // 43: proto.BatchUpdatePetsRequest.pets:type_name -> proto.UpdatePetRequest
+	0,   // 44: proto.BatchUpdatePetsRequest.mode:type_name -> proto.BatchMode
+	13,  // 45: proto.BatchUpdatePetsResult.pet:type_name -> proto.UpdatePetResponse
+	103, // 46: proto.BatchUpdatePetsResult.errors:type_name -> proto.BatchUpdatePetsResult.ErrorsEntry
+	26,  // 47: proto.BatchUpdatePetsResponse.results:type_name -> proto.BatchUpdatePetsResult
+	10,  // 48: proto.ImportPetsRequest.pets:type_name -> proto.CreatePetRequest
+	104, // 49: proto.ImportPetError.errors:type_name -> proto.ImportPetError.ErrorsEntry
+	29,  // 50: proto.ImportPetsResponse.errors:type_name -> proto.ImportPetError
+	8,   // 51: proto.ExportPetsRequest.guardian_roles:type_name -> proto.GuardianRole
+	1,   // 52: proto.ExportPetsRequest.statuses:type_name -> proto.PetStatus
+	8,   // 53: proto.WatchPetsRequest.guardian_roles:type_name -> proto.GuardianRole
+	1,   // 54: proto.WatchPetsRequest.statuses:type_name -> proto.PetStatus
+	2,   // 55: proto.WatchPetsResponse.type:type_name -> proto.PetEventType
+	17,  // 56: proto.WatchPetsResponse.pet:type_name -> proto.GetPetResponse
+	107, // 57: proto.WatchPetsResponse.occurred_at:type_name -> google.protobuf.Timestamp
+	107, // 58: proto.AuditEntry.occurred_at:type_name -> google.protobuf.Timestamp
+	36,  // 59: proto.AuditEntry.changes:type_name -> proto.AuditFieldChange
+	37,  // 60: proto.AuditLogResponse.entries:type_name -> proto.AuditEntry
+	3,   // 61: proto.SpeciesInfo.species:type_name -> proto.Species
+	39,  // 62: proto.ListSpeciesResponse.species:type_name -> proto.SpeciesInfo
+	39,  // 63: proto.BreedInfo.species:type_name -> proto.SpeciesInfo
+	48,  // 64: proto.SearchBreedsResponse.breeds:type_name -> proto.BreedInfo
+	8,   // 65: proto.SearchPetsRequest.guardian_roles:type_name -> proto.GuardianRole
+	1,   // 66: proto.SearchPetsRequest.statuses:type_name -> proto.PetStatus
+	17,  // 67: proto.PetSearchHit.pet:type_name -> proto.GetPetResponse
+	51,  // 68: proto.PetSearchHit.highlights:type_name -> proto.SearchHighlight
+	52,  // 69: proto.SearchPetsResponse.hits:type_name -> proto.PetSearchHit
+	107, // 70: proto.WeightMeasurement.measured_at:type_name -> google.protobuf.Timestamp
+	105, // 71: proto.Vaccination.administered_on:type_name -> google.type.Date
+	105, // 72: proto.Vaccination.next_due_date:type_name -> google.type.Date
+	107, // 73: proto.Vaccination.created_at:type_name -> google.protobuf.Timestamp
+	107, // 74: proto.Vaccination.updated_at:type_name -> google.protobuf.Timestamp
+	105, // 75: proto.AddVaccinationRequest.administered_on:type_name -> google.type.Date
+	105, // 76: proto.UpdateVaccinationRequest.administered_on:type_name -> google.type.Date
+	57,  // 77: proto.ListVaccinationsResponse.vaccinations:type_name -> proto.Vaccination
+	105, // 78: proto.ListOverdueVaccinationsRequest.as_of:type_name -> google.type.Date
+	57,  // 79: proto.ListOverdueVaccinationsResponse.vaccinations:type_name -> proto.Vaccination
+	6,   // 80: proto.MedicalRecord.kind:type_name -> proto.MedicalRecordKind
+	105, // 81: proto.MedicalRecord.occurred_on:type_name -> google.type.Date
+	105, // 82: proto.MedicalRecord.follow_up_on:type_name -> google.type.Date
+	107, // 83: proto.MedicalRecord.created_at:type_name -> google.protobuf.Timestamp
+	107, // 84: proto.MedicalRecord.updated_at:type_name -> google.protobuf.Timestamp
+	6,   // 85: proto.AddMedicalRecordRequest.kind:type_name -> proto.MedicalRecordKind
+	105, // 86: proto.AddMedicalRecordRequest.occurred_on:type_name -> google.type.Date
+	105, // 87: proto.AddMedicalRecordRequest.follow_up_on:type_name -> google.type.Date
+	6,   // 88: proto.UpdateMedicalRecordRequest.kind:type_name -> proto.MedicalRecordKind
+	105, // 89: proto.UpdateMedicalRecordRequest.occurred_on:type_name -> google.type.Date
+	105, // 90: proto.UpdateMedicalRecordRequest.follow_up_on:type_name -> google.type.Date
+	64,  // 91: proto.ListMedicalRecordsResponse.records:type_name -> proto.MedicalRecord
+	7,   // 92: proto.AttachmentMetadata.kind:type_name -> proto.AttachmentKind
+	69,  // 93: proto.UploadAttachmentRequest.metadata:type_name -> proto.AttachmentMetadata
+	7,   // 94: proto.Attachment.kind:type_name -> proto.AttachmentKind
+	107, // 95: proto.Attachment.created_at:type_name -> google.protobuf.Timestamp
+	71,  // 96: proto.DownloadAttachmentResponse.attachment:type_name -> proto.Attachment
+	71,  // 97: proto.ListAttachmentsResponse.attachments:type_name -> proto.Attachment
+	78,  // 98: proto.Guardian.address:type_name -> proto.GuardianAddress
+	79,  // 99: proto.Guardian.consent:type_name -> proto.GuardianConsent
+	107, // 100: proto.Guardian.consent_updated_at:type_name -> google.protobuf.Timestamp
+	107, // 101: proto.Guardian.created_at:type_name -> google.protobuf.Timestamp
+	107, // 102: proto.Guardian.updated_at:type_name -> google.protobuf.Timestamp
+	78,  // 103: proto.CreateGuardianRequest.address:type_name -> proto.GuardianAddress
+	79,  // 104: proto.CreateGuardianRequest.consent:type_name -> proto.GuardianConsent
+	78,  // 105: proto.UpdateGuardianRequest.address:type_name -> proto.GuardianAddress
+	79,  // 106: proto.UpdateGuardianRequest.consent:type_name -> proto.GuardianConsent
+	80,  // 107: proto.ListGuardiansResponse.guardians:type_name -> proto.Guardian
+	8,   // 108: proto.PetGuardian.role:type_name -> proto.GuardianRole
+	107, // 109: proto.PetGuardian.created_at:type_name -> google.protobuf.Timestamp
+	8,   // 110: proto.AddPetGuardianRequest.role:type_name -> proto.GuardianRole
+	88,  // 111: proto.ListPetGuardiansResponse.guardians:type_name -> proto.PetGuardian
+	9,   // 112: proto.AdoptionApplication.status:type_name -> proto.AdoptionApplicationStatus
+	107, // 113: proto.AdoptionApplication.decided_at:type_name -> google.protobuf.Timestamp
+	107, // 114: proto.AdoptionApplication.created_at:type_name -> google.protobuf.Timestamp
+	107, // 115: proto.AdoptionApplication.updated_at:type_name -> google.protobuf.Timestamp
+	94,  // 116: proto.ApproveAdoptionApplicationResponse.application:type_name -> proto.AdoptionApplication
+	17,  // 117: proto.ApproveAdoptionApplicationResponse.pet:type_name -> proto.GetPetResponse
+	94,  // 118: proto.ApproveAdoptionApplicationResponse.rejected:type_name -> proto.AdoptionApplication
+	94,  // 119: proto.ListAdoptionApplicationsResponse.applications:type_name -> proto.AdoptionApplication
+	10,  // 120: proto.PetService.Create:input_type -> proto.CreatePetRequest
+	12,  // 121: proto.PetService.Update:input_type -> proto.UpdatePetRequest
+	14,  // 122: proto.PetService.Delete:input_type -> proto.DeletePetRequest
+	16,  // 123: proto.PetService.Get:input_type -> proto.GetPetRequest
+	18,  // 124: proto.PetService.Transfer:input_type -> proto.TransferPetRequest
+	19,  // 125: proto.PetService.TransitionPet:input_type -> proto.TransitionPetRequest
+	20,  // 126: proto.PetService.BatchCreatePets:input_type -> proto.BatchCreatePetsRequest
+	23,  // 127: proto.PetService.BatchGetPets:input_type -> proto.BatchGetPetsRequest
+	25,  // 128: proto.PetService.BatchUpdatePets:input_type -> proto.BatchUpdatePetsRequest
+	28,  // 129: proto.PetService.ImportPets:input_type -> proto.ImportPetsRequest
+	31,  // 130: proto.PetService.ExportPets:input_type -> proto.ExportPetsRequest
+	32,  // 131: proto.PetService.WatchPets:input_type -> proto.WatchPetsRequest
+	34,  // 132: proto.PetService.GetPetAuditLog:input_type -> proto.GetPetAuditLogRequest
+	35,  // 133: proto.PetService.ListGuardianAuditLog:input_type -> proto.ListGuardianAuditLogRequest
+	40,  // 134: proto.PetService.CreateSpecies:input_type -> proto.CreateSpeciesRequest
+	41,  // 135: proto.PetService.GetSpecies:input_type -> proto.GetSpeciesRequest
+	42,  // 136: proto.PetService.ListSpecies:input_type -> proto.ListSpeciesRequest
+	44,  // 137: proto.PetService.UpdateSpecies:input_type -> proto.UpdateSpeciesRequest
+	45,  // 138: proto.PetService.DeleteSpecies:input_type -> proto.DeleteSpeciesRequest
+	47,  // 139: proto.PetService.SearchBreeds:input_type -> proto.SearchBreedsRequest
+	50,  // 140: proto.PetService.SearchPets:input_type -> proto.SearchPetsRequest
+	54,  // 141: proto.PetService.LookupByMicrochip:input_type -> proto.LookupByMicrochipRequest
+	58,  // 142: proto.PetService.AddVaccination:input_type -> proto.AddVaccinationRequest
+	59,  // 143: proto.PetService.UpdateVaccination:input_type -> proto.UpdateVaccinationRequest
+	60,  // 144: proto.PetService.ListVaccinations:input_type -> proto.ListVaccinationsRequest
+	62,  // 145: proto.PetService.ListOverdueVaccinations:input_type -> proto.ListOverdueVaccinationsRequest
+	65,  // 146: proto.PetService.AddMedicalRecord:input_type -> proto.AddMedicalRecordRequest
+	66,  // 147: proto.PetService.UpdateMedicalRecord:input_type -> proto.UpdateMedicalRecordRequest
+	67,  // 148: proto.PetService.ListMedicalRecords:input_type -> proto.ListMedicalRecordsRequest
+	70,  // 149: proto.PetService.UploadAttachment:input_type -> proto.UploadAttachmentRequest
+	72,  // 150: proto.PetService.DownloadAttachment:input_type -> proto.DownloadAttachmentRequest
+	74,  // 151: proto.PetService.ListAttachments:input_type -> proto.ListAttachmentsRequest
+	76,  // 152: proto.PetService.DeleteAttachment:input_type -> proto.DeleteAttachmentRequest
+	81,  // 153: proto.PetService.CreateGuardian:input_type -> proto.CreateGuardianRequest
+	82,  // 154: proto.PetService.GetGuardian:input_type -> proto.GetGuardianRequest
+	83,  // 155: proto.PetService.UpdateGuardian:input_type -> proto.UpdateGuardianRequest
+	84,  // 156: proto.PetService.DeleteGuardian:input_type -> proto.DeleteGuardianRequest
+	86,  // 157: proto.PetService.ListGuardians:input_type -> proto.ListGuardiansRequest
+	89,  // 158: proto.PetService.AddPetGuardian:input_type -> proto.AddPetGuardianRequest
+	90,  // 159: proto.PetService.RemovePetGuardian:input_type -> proto.RemovePetGuardianRequest
+	92,  // 160: proto.PetService.ListPetGuardians:input_type -> proto.ListPetGuardiansRequest
+	95,  // 161: proto.PetService.SubmitAdoptionApplication:input_type -> proto.SubmitAdoptionApplicationRequest
+	96,  // 162: proto.PetService.GetAdoptionApplication:input_type -> proto.GetAdoptionApplicationRequest
+	97,  // 163: proto.PetService.ApproveAdoptionApplication:input_type -> proto.DecideAdoptionApplicationRequest
+	97,  // 164: proto.PetService.RejectAdoptionApplication:input_type -> proto.DecideAdoptionApplicationRequest
+	97,  // 165: proto.PetService.WithdrawAdoptionApplication:input_type -> proto.DecideAdoptionApplicationRequest
+	99,  // 166: proto.PetService.ListPetAdoptionApplications:input_type -> proto.ListPetAdoptionApplicationsRequest
+	100, // 167: proto.PetService.ListApplicantAdoptionApplications:input_type -> proto.ListApplicantAdoptionApplicationsRequest
+	11,  // 168: proto.PetService.Create:output_type -> proto.CreatePetResponse
+	13,  // 169: proto.PetService.Update:output_type -> proto.UpdatePetResponse
+	15,  // 170: proto.PetService.Delete:output_type -> proto.DeletePetResponse
+	17,  // 171: proto.PetService.Get:output_type -> proto.GetPetResponse
+	17,  // 172: proto.PetService.Transfer:output_type -> proto.GetPetResponse
+	17,  // 173: proto.PetService.TransitionPet:output_type -> proto.GetPetResponse
+	22,  // 174: proto.PetService.BatchCreatePets:output_type -> proto.BatchCreatePetsResponse
+	24,  // 175: proto.PetService.BatchGetPets:output_type -> proto.BatchGetPetsResponse
+	27,  // 176: proto.PetService.BatchUpdatePets:output_type -> proto.BatchUpdatePetsResponse
+	30,  // 177: proto.PetService.ImportPets:output_type -> proto.ImportPetsResponse
+	17,  // 178: proto.PetService.ExportPets:output_type -> proto.GetPetResponse
+	33,  // 179: proto.PetService.WatchPets:output_type -> proto.WatchPetsResponse
+	38,  // 180: proto.PetService.GetPetAuditLog:output_type -> proto.AuditLogResponse
+	38,  // 181: proto.PetService.ListGuardianAuditLog:output_type -> proto.AuditLogResponse
+	39,  // 182: proto.PetService.CreateSpecies:output_type -> proto.SpeciesInfo
+	39,  // 183: proto.PetService.GetSpecies:output_type -> proto.SpeciesInfo
+	43,  // 184: proto.PetService.ListSpecies:output_type -> proto.ListSpeciesResponse
+	39,  // 185: proto.PetService.UpdateSpecies:output_type -> proto.SpeciesInfo
+	46,  // 186: proto.PetService.DeleteSpecies:output_type -> proto.DeleteSpeciesResponse
+	49,  // 187: proto.PetService.SearchBreeds:output_type -> proto.SearchBreedsResponse
+	53,  // 188: proto.PetService.SearchPets:output_type -> proto.SearchPetsResponse
+	17,  // 189: proto.PetService.LookupByMicrochip:output_type -> proto.GetPetResponse
+	57,  // 190: proto.PetService.AddVaccination:output_type -> proto.Vaccination
+	57,  // 191: proto.PetService.UpdateVaccination:output_type -> proto.Vaccination
+	61,  // 192: proto.PetService.ListVaccinations:output_type -> proto.ListVaccinationsResponse
+	63,  // 193: proto.PetService.ListOverdueVaccinations:output_type -> proto.ListOverdueVaccinationsResponse
+	64,  // 194: proto.PetService.AddMedicalRecord:output_type -> proto.MedicalRecord
+	64,  // 195: proto.PetService.UpdateMedicalRecord:output_type -> proto.MedicalRecord
+	68,  // 196: proto.PetService.ListMedicalRecords:output_type -> proto.ListMedicalRecordsResponse
+	71,  // 197: proto.PetService.UploadAttachment:output_type -> proto.Attachment
+	73,  // 198: proto.PetService.DownloadAttachment:output_type -> proto.DownloadAttachmentResponse
+	75,  // 199: proto.PetService.ListAttachments:output_type -> proto.ListAttachmentsResponse
+	77,  // 200: proto.PetService.DeleteAttachment:output_type -> proto.DeleteAttachmentResponse
+	80,  // 201: proto.PetService.CreateGuardian:output_type -> proto.Guardian
+	80,  // 202: proto.PetService.GetGuardian:output_type -> proto.Guardian
+	80,  // 203: proto.PetService.UpdateGuardian:output_type -> proto.Guardian
+	85,  // 204: proto.PetService.DeleteGuardian:output_type -> proto.DeleteGuardianResponse
+	87,  // 205: proto.PetService.ListGuardians:output_type -> proto.ListGuardiansResponse
+	88,  // 206: proto.PetService.AddPetGuardian:output_type -> proto.PetGuardian
+	91,  // 207: proto.PetService.RemovePetGuardian:output_type -> proto.RemovePetGuardianResponse
+	93,  // 208: proto.PetService.ListPetGuardians:output_type -> proto.ListPetGuardiansResponse
+	94,  // 209: proto.PetService.SubmitAdoptionApplication:output_type -> proto.AdoptionApplication
+	94,  // 210: proto.PetService.GetAdoptionApplication:output_type -> proto.AdoptionApplication
+	98,  // 211: proto.PetService.ApproveAdoptionApplication:output_type -> proto.ApproveAdoptionApplicationResponse
+	94,  // 212: proto.PetService.RejectAdoptionApplication:output_type -> proto.AdoptionApplication
+	94,  // 213: proto.PetService.WithdrawAdoptionApplication:output_type -> proto.AdoptionApplication
+	101, // 214: proto.PetService.ListPetAdoptionApplications:output_type -> proto.ListAdoptionApplicationsResponse
+	101, // 215: proto.PetService.ListApplicantAdoptionApplications:output_type -> proto.ListAdoptionApplicationsResponse
+	168, // [168:216] is the sub-list for method output_type
+	120, // [120:168] is the sub-list for method input_type
+	120, // [120:120] is the sub-list for extension type_name
+	120, // [120:120] is the sub-list for extension extendee
+	0,   // [0:120] is the sub-list for field type_name
 }
 
 func init() { file_pet_ms_proto_init() }
@@ -691,13 +7937,14 @@ func file_pet_ms_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pet_ms_proto_rawDesc), len(file_pet_ms_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   8,
+			NumEnums:      10,
+			NumMessages:   95,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_pet_ms_proto_goTypes,
 		DependencyIndexes: file_pet_ms_proto_depIdxs,
+		EnumInfos:         file_pet_ms_proto_enumTypes,
 		MessageInfos:      file_pet_ms_proto_msgTypes,
 	}.Build()
 	File_pet_ms_proto = out.File
@@ -19,10 +19,54 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PetService_Create_FullMethodName = "/proto.PetService/Create"
-	PetService_Update_FullMethodName = "/proto.PetService/Update"
-	PetService_Delete_FullMethodName = "/proto.PetService/Delete"
-	PetService_Get_FullMethodName    = "/proto.PetService/Get"
+	PetService_Create_FullMethodName                            = "/proto.PetService/Create"
+	PetService_Update_FullMethodName                            = "/proto.PetService/Update"
+	PetService_Delete_FullMethodName                            = "/proto.PetService/Delete"
+	PetService_Get_FullMethodName                               = "/proto.PetService/Get"
+	PetService_Transfer_FullMethodName                          = "/proto.PetService/Transfer"
+	PetService_TransitionPet_FullMethodName                     = "/proto.PetService/TransitionPet"
+	PetService_BatchCreatePets_FullMethodName                   = "/proto.PetService/BatchCreatePets"
+	PetService_BatchGetPets_FullMethodName                      = "/proto.PetService/BatchGetPets"
+	PetService_BatchUpdatePets_FullMethodName                   = "/proto.PetService/BatchUpdatePets"
+	PetService_ImportPets_FullMethodName                        = "/proto.PetService/ImportPets"
+	PetService_ExportPets_FullMethodName                        = "/proto.PetService/ExportPets"
+	PetService_WatchPets_FullMethodName                         = "/proto.PetService/WatchPets"
+	PetService_GetPetAuditLog_FullMethodName                    = "/proto.PetService/GetPetAuditLog"
+	PetService_ListGuardianAuditLog_FullMethodName              = "/proto.PetService/ListGuardianAuditLog"
+	PetService_CreateSpecies_FullMethodName                     = "/proto.PetService/CreateSpecies"
+	PetService_GetSpecies_FullMethodName                        = "/proto.PetService/GetSpecies"
+	PetService_ListSpecies_FullMethodName                       = "/proto.PetService/ListSpecies"
+	PetService_UpdateSpecies_FullMethodName                     = "/proto.PetService/UpdateSpecies"
+	PetService_DeleteSpecies_FullMethodName                     = "/proto.PetService/DeleteSpecies"
+	PetService_SearchBreeds_FullMethodName                      = "/proto.PetService/SearchBreeds"
+	PetService_SearchPets_FullMethodName                        = "/proto.PetService/SearchPets"
+	PetService_LookupByMicrochip_FullMethodName                 = "/proto.PetService/LookupByMicrochip"
+	PetService_AddVaccination_FullMethodName                    = "/proto.PetService/AddVaccination"
+	PetService_UpdateVaccination_FullMethodName                 = "/proto.PetService/UpdateVaccination"
+	PetService_ListVaccinations_FullMethodName                  = "/proto.PetService/ListVaccinations"
+	PetService_ListOverdueVaccinations_FullMethodName           = "/proto.PetService/ListOverdueVaccinations"
+	PetService_AddMedicalRecord_FullMethodName                  = "/proto.PetService/AddMedicalRecord"
+	PetService_UpdateMedicalRecord_FullMethodName               = "/proto.PetService/UpdateMedicalRecord"
+	PetService_ListMedicalRecords_FullMethodName                = "/proto.PetService/ListMedicalRecords"
+	PetService_UploadAttachment_FullMethodName                  = "/proto.PetService/UploadAttachment"
+	PetService_DownloadAttachment_FullMethodName                = "/proto.PetService/DownloadAttachment"
+	PetService_ListAttachments_FullMethodName                   = "/proto.PetService/ListAttachments"
+	PetService_DeleteAttachment_FullMethodName                  = "/proto.PetService/DeleteAttachment"
+	PetService_CreateGuardian_FullMethodName                    = "/proto.PetService/CreateGuardian"
+	PetService_GetGuardian_FullMethodName                       = "/proto.PetService/GetGuardian"
+	PetService_UpdateGuardian_FullMethodName                    = "/proto.PetService/UpdateGuardian"
+	PetService_DeleteGuardian_FullMethodName                    = "/proto.PetService/DeleteGuardian"
+	PetService_ListGuardians_FullMethodName                     = "/proto.PetService/ListGuardians"
+	PetService_AddPetGuardian_FullMethodName                    = "/proto.PetService/AddPetGuardian"
+	PetService_RemovePetGuardian_FullMethodName                 = "/proto.PetService/RemovePetGuardian"
+	PetService_ListPetGuardians_FullMethodName                  = "/proto.PetService/ListPetGuardians"
+	PetService_SubmitAdoptionApplication_FullMethodName         = "/proto.PetService/SubmitAdoptionApplication"
+	PetService_GetAdoptionApplication_FullMethodName            = "/proto.PetService/GetAdoptionApplication"
+	PetService_ApproveAdoptionApplication_FullMethodName        = "/proto.PetService/ApproveAdoptionApplication"
+	PetService_RejectAdoptionApplication_FullMethodName         = "/proto.PetService/RejectAdoptionApplication"
+	PetService_WithdrawAdoptionApplication_FullMethodName       = "/proto.PetService/WithdrawAdoptionApplication"
+	PetService_ListPetAdoptionApplications_FullMethodName       = "/proto.PetService/ListPetAdoptionApplications"
+	PetService_ListApplicantAdoptionApplications_FullMethodName = "/proto.PetService/ListApplicantAdoptionApplications"
 )
 
 // PetServiceClient is the client API for PetService service.
@@ -33,6 +77,60 @@ type PetServiceClient interface {
 	Update(ctx context.Context, in *UpdatePetRequest, opts ...grpc.CallOption) (*UpdatePetResponse, error)
 	Delete(ctx context.Context, in *DeletePetRequest, opts ...grpc.CallOption) (*DeletePetResponse, error)
 	Get(ctx context.Context, in *GetPetRequest, opts ...grpc.CallOption) (*GetPetResponse, error)
+	Transfer(ctx context.Context, in *TransferPetRequest, opts ...grpc.CallOption) (*GetPetResponse, error)
+	TransitionPet(ctx context.Context, in *TransitionPetRequest, opts ...grpc.CallOption) (*GetPetResponse, error)
+	BatchCreatePets(ctx context.Context, in *BatchCreatePetsRequest, opts ...grpc.CallOption) (*BatchCreatePetsResponse, error)
+	BatchGetPets(ctx context.Context, in *BatchGetPetsRequest, opts ...grpc.CallOption) (*BatchGetPetsResponse, error)
+	BatchUpdatePets(ctx context.Context, in *BatchUpdatePetsRequest, opts ...grpc.CallOption) (*BatchUpdatePetsResponse, error)
+	ImportPets(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportPetsRequest, ImportPetsResponse], error)
+	ExportPets(ctx context.Context, in *ExportPetsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetPetResponse], error)
+	WatchPets(ctx context.Context, in *WatchPetsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchPetsResponse], error)
+	GetPetAuditLog(ctx context.Context, in *GetPetAuditLogRequest, opts ...grpc.CallOption) (*AuditLogResponse, error)
+	ListGuardianAuditLog(ctx context.Context, in *ListGuardianAuditLogRequest, opts ...grpc.CallOption) (*AuditLogResponse, error)
+	CreateSpecies(ctx context.Context, in *CreateSpeciesRequest, opts ...grpc.CallOption) (*SpeciesInfo, error)
+	GetSpecies(ctx context.Context, in *GetSpeciesRequest, opts ...grpc.CallOption) (*SpeciesInfo, error)
+	ListSpecies(ctx context.Context, in *ListSpeciesRequest, opts ...grpc.CallOption) (*ListSpeciesResponse, error)
+	UpdateSpecies(ctx context.Context, in *UpdateSpeciesRequest, opts ...grpc.CallOption) (*SpeciesInfo, error)
+	DeleteSpecies(ctx context.Context, in *DeleteSpeciesRequest, opts ...grpc.CallOption) (*DeleteSpeciesResponse, error)
+	SearchBreeds(ctx context.Context, in *SearchBreedsRequest, opts ...grpc.CallOption) (*SearchBreedsResponse, error)
+	SearchPets(ctx context.Context, in *SearchPetsRequest, opts ...grpc.CallOption) (*SearchPetsResponse, error)
+	LookupByMicrochip(ctx context.Context, in *LookupByMicrochipRequest, opts ...grpc.CallOption) (*GetPetResponse, error)
+	AddVaccination(ctx context.Context, in *AddVaccinationRequest, opts ...grpc.CallOption) (*Vaccination, error)
+	UpdateVaccination(ctx context.Context, in *UpdateVaccinationRequest, opts ...grpc.CallOption) (*Vaccination, error)
+	ListVaccinations(ctx context.Context, in *ListVaccinationsRequest, opts ...grpc.CallOption) (*ListVaccinationsResponse, error)
+	ListOverdueVaccinations(ctx context.Context, in *ListOverdueVaccinationsRequest, opts ...grpc.CallOption) (*ListOverdueVaccinationsResponse, error)
+	AddMedicalRecord(ctx context.Context, in *AddMedicalRecordRequest, opts ...grpc.CallOption) (*MedicalRecord, error)
+	UpdateMedicalRecord(ctx context.Context, in *UpdateMedicalRecordRequest, opts ...grpc.CallOption) (*MedicalRecord, error)
+	ListMedicalRecords(ctx context.Context, in *ListMedicalRecordsRequest, opts ...grpc.CallOption) (*ListMedicalRecordsResponse, error)
+	// A primeira mensagem do stream traz só metadata; as seguintes, só chunk.
+	UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAttachmentRequest, Attachment], error)
+	// A primeira mensagem traz attachment; as seguintes, o conteúdo em chunks.
+	DownloadAttachment(ctx context.Context, in *DownloadAttachmentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadAttachmentResponse], error)
+	ListAttachments(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error)
+	DeleteAttachment(ctx context.Context, in *DeleteAttachmentRequest, opts ...grpc.CallOption) (*DeleteAttachmentResponse, error)
+	// O cadastro de guardiões só existe com GUARDIAN_REGISTRY=internal; nos outros
+	// modos as chamadas devolvem UNAVAILABLE.
+	CreateGuardian(ctx context.Context, in *CreateGuardianRequest, opts ...grpc.CallOption) (*Guardian, error)
+	GetGuardian(ctx context.Context, in *GetGuardianRequest, opts ...grpc.CallOption) (*Guardian, error)
+	UpdateGuardian(ctx context.Context, in *UpdateGuardianRequest, opts ...grpc.CallOption) (*Guardian, error)
+	// Guardiões donos de pets não podem ser removidos (FAILED_PRECONDITION).
+	DeleteGuardian(ctx context.Context, in *DeleteGuardianRequest, opts ...grpc.CallOption) (*DeleteGuardianResponse, error)
+	ListGuardians(ctx context.Context, in *ListGuardiansRequest, opts ...grpc.CallOption) (*ListGuardiansResponse, error)
+	// Liga um co-dono, lar temporário ou contato de emergência ao pet. O dono muda só
+	// por Transfer.
+	AddPetGuardian(ctx context.Context, in *AddPetGuardianRequest, opts ...grpc.CallOption) (*PetGuardian, error)
+	RemovePetGuardian(ctx context.Context, in *RemovePetGuardianRequest, opts ...grpc.CallOption) (*RemovePetGuardianResponse, error)
+	ListPetGuardians(ctx context.Context, in *ListPetGuardiansRequest, opts ...grpc.CallOption) (*ListPetGuardiansResponse, error)
+	// Pedido de adoção de um pet disponível (status AVAILABLE).
+	SubmitAdoptionApplication(ctx context.Context, in *SubmitAdoptionApplicationRequest, opts ...grpc.CallOption) (*AdoptionApplication, error)
+	GetAdoptionApplication(ctx context.Context, in *GetAdoptionApplicationRequest, opts ...grpc.CallOption) (*AdoptionApplication, error)
+	// Aprova o pedido, reserva o pet e recusa os outros pedidos pendentes dele numa só
+	// transação.
+	ApproveAdoptionApplication(ctx context.Context, in *DecideAdoptionApplicationRequest, opts ...grpc.CallOption) (*ApproveAdoptionApplicationResponse, error)
+	RejectAdoptionApplication(ctx context.Context, in *DecideAdoptionApplicationRequest, opts ...grpc.CallOption) (*AdoptionApplication, error)
+	WithdrawAdoptionApplication(ctx context.Context, in *DecideAdoptionApplicationRequest, opts ...grpc.CallOption) (*AdoptionApplication, error)
+	ListPetAdoptionApplications(ctx context.Context, in *ListPetAdoptionApplicationsRequest, opts ...grpc.CallOption) (*ListAdoptionApplicationsResponse, error)
+	ListApplicantAdoptionApplications(ctx context.Context, in *ListApplicantAdoptionApplicationsRequest, opts ...grpc.CallOption) (*ListAdoptionApplicationsResponse, error)
 }
 
 type petServiceClient struct {
@@ -83,6 +181,479 @@ func (c *petServiceClient) Get(ctx context.Context, in *GetPetRequest, opts ...g
 	return out, nil
 }
 
+func (c *petServiceClient) Transfer(ctx context.Context, in *TransferPetRequest, opts ...grpc.CallOption) (*GetPetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPetResponse)
+	err := c.cc.Invoke(ctx, PetService_Transfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) TransitionPet(ctx context.Context, in *TransitionPetRequest, opts ...grpc.CallOption) (*GetPetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPetResponse)
+	err := c.cc.Invoke(ctx, PetService_TransitionPet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) BatchCreatePets(ctx context.Context, in *BatchCreatePetsRequest, opts ...grpc.CallOption) (*BatchCreatePetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCreatePetsResponse)
+	err := c.cc.Invoke(ctx, PetService_BatchCreatePets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) BatchGetPets(ctx context.Context, in *BatchGetPetsRequest, opts ...grpc.CallOption) (*BatchGetPetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetPetsResponse)
+	err := c.cc.Invoke(ctx, PetService_BatchGetPets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) BatchUpdatePets(ctx context.Context, in *BatchUpdatePetsRequest, opts ...grpc.CallOption) (*BatchUpdatePetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchUpdatePetsResponse)
+	err := c.cc.Invoke(ctx, PetService_BatchUpdatePets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) ImportPets(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportPetsRequest, ImportPetsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PetService_ServiceDesc.Streams[0], PetService_ImportPets_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportPetsRequest, ImportPetsResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PetService_ImportPetsClient = grpc.ClientStreamingClient[ImportPetsRequest, ImportPetsResponse]
+
+func (c *petServiceClient) ExportPets(ctx context.Context, in *ExportPetsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetPetResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PetService_ServiceDesc.Streams[1], PetService_ExportPets_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportPetsRequest, GetPetResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PetService_ExportPetsClient = grpc.ServerStreamingClient[GetPetResponse]
+
+func (c *petServiceClient) WatchPets(ctx context.Context, in *WatchPetsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchPetsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PetService_ServiceDesc.Streams[2], PetService_WatchPets_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchPetsRequest, WatchPetsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PetService_WatchPetsClient = grpc.ServerStreamingClient[WatchPetsResponse]
+
+func (c *petServiceClient) GetPetAuditLog(ctx context.Context, in *GetPetAuditLogRequest, opts ...grpc.CallOption) (*AuditLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuditLogResponse)
+	err := c.cc.Invoke(ctx, PetService_GetPetAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) ListGuardianAuditLog(ctx context.Context, in *ListGuardianAuditLogRequest, opts ...grpc.CallOption) (*AuditLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuditLogResponse)
+	err := c.cc.Invoke(ctx, PetService_ListGuardianAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) CreateSpecies(ctx context.Context, in *CreateSpeciesRequest, opts ...grpc.CallOption) (*SpeciesInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SpeciesInfo)
+	err := c.cc.Invoke(ctx, PetService_CreateSpecies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) GetSpecies(ctx context.Context, in *GetSpeciesRequest, opts ...grpc.CallOption) (*SpeciesInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SpeciesInfo)
+	err := c.cc.Invoke(ctx, PetService_GetSpecies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) ListSpecies(ctx context.Context, in *ListSpeciesRequest, opts ...grpc.CallOption) (*ListSpeciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSpeciesResponse)
+	err := c.cc.Invoke(ctx, PetService_ListSpecies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) UpdateSpecies(ctx context.Context, in *UpdateSpeciesRequest, opts ...grpc.CallOption) (*SpeciesInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SpeciesInfo)
+	err := c.cc.Invoke(ctx, PetService_UpdateSpecies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) DeleteSpecies(ctx context.Context, in *DeleteSpeciesRequest, opts ...grpc.CallOption) (*DeleteSpeciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSpeciesResponse)
+	err := c.cc.Invoke(ctx, PetService_DeleteSpecies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) SearchBreeds(ctx context.Context, in *SearchBreedsRequest, opts ...grpc.CallOption) (*SearchBreedsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchBreedsResponse)
+	err := c.cc.Invoke(ctx, PetService_SearchBreeds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) SearchPets(ctx context.Context, in *SearchPetsRequest, opts ...grpc.CallOption) (*SearchPetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchPetsResponse)
+	err := c.cc.Invoke(ctx, PetService_SearchPets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) LookupByMicrochip(ctx context.Context, in *LookupByMicrochipRequest, opts ...grpc.CallOption) (*GetPetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPetResponse)
+	err := c.cc.Invoke(ctx, PetService_LookupByMicrochip_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) AddVaccination(ctx context.Context, in *AddVaccinationRequest, opts ...grpc.CallOption) (*Vaccination, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Vaccination)
+	err := c.cc.Invoke(ctx, PetService_AddVaccination_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) UpdateVaccination(ctx context.Context, in *UpdateVaccinationRequest, opts ...grpc.CallOption) (*Vaccination, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Vaccination)
+	err := c.cc.Invoke(ctx, PetService_UpdateVaccination_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) ListVaccinations(ctx context.Context, in *ListVaccinationsRequest, opts ...grpc.CallOption) (*ListVaccinationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListVaccinationsResponse)
+	err := c.cc.Invoke(ctx, PetService_ListVaccinations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) ListOverdueVaccinations(ctx context.Context, in *ListOverdueVaccinationsRequest, opts ...grpc.CallOption) (*ListOverdueVaccinationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOverdueVaccinationsResponse)
+	err := c.cc.Invoke(ctx, PetService_ListOverdueVaccinations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) AddMedicalRecord(ctx context.Context, in *AddMedicalRecordRequest, opts ...grpc.CallOption) (*MedicalRecord, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MedicalRecord)
+	err := c.cc.Invoke(ctx, PetService_AddMedicalRecord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) UpdateMedicalRecord(ctx context.Context, in *UpdateMedicalRecordRequest, opts ...grpc.CallOption) (*MedicalRecord, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MedicalRecord)
+	err := c.cc.Invoke(ctx, PetService_UpdateMedicalRecord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) ListMedicalRecords(ctx context.Context, in *ListMedicalRecordsRequest, opts ...grpc.CallOption) (*ListMedicalRecordsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMedicalRecordsResponse)
+	err := c.cc.Invoke(ctx, PetService_ListMedicalRecords_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAttachmentRequest, Attachment], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PetService_ServiceDesc.Streams[3], PetService_UploadAttachment_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadAttachmentRequest, Attachment]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PetService_UploadAttachmentClient = grpc.ClientStreamingClient[UploadAttachmentRequest, Attachment]
+
+func (c *petServiceClient) DownloadAttachment(ctx context.Context, in *DownloadAttachmentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadAttachmentResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PetService_ServiceDesc.Streams[4], PetService_DownloadAttachment_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DownloadAttachmentRequest, DownloadAttachmentResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PetService_DownloadAttachmentClient = grpc.ServerStreamingClient[DownloadAttachmentResponse]
+
+func (c *petServiceClient) ListAttachments(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAttachmentsResponse)
+	err := c.cc.Invoke(ctx, PetService_ListAttachments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) DeleteAttachment(ctx context.Context, in *DeleteAttachmentRequest, opts ...grpc.CallOption) (*DeleteAttachmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAttachmentResponse)
+	err := c.cc.Invoke(ctx, PetService_DeleteAttachment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) CreateGuardian(ctx context.Context, in *CreateGuardianRequest, opts ...grpc.CallOption) (*Guardian, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Guardian)
+	err := c.cc.Invoke(ctx, PetService_CreateGuardian_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) GetGuardian(ctx context.Context, in *GetGuardianRequest, opts ...grpc.CallOption) (*Guardian, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Guardian)
+	err := c.cc.Invoke(ctx, PetService_GetGuardian_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) UpdateGuardian(ctx context.Context, in *UpdateGuardianRequest, opts ...grpc.CallOption) (*Guardian, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Guardian)
+	err := c.cc.Invoke(ctx, PetService_UpdateGuardian_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) DeleteGuardian(ctx context.Context, in *DeleteGuardianRequest, opts ...grpc.CallOption) (*DeleteGuardianResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteGuardianResponse)
+	err := c.cc.Invoke(ctx, PetService_DeleteGuardian_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) ListGuardians(ctx context.Context, in *ListGuardiansRequest, opts ...grpc.CallOption) (*ListGuardiansResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGuardiansResponse)
+	err := c.cc.Invoke(ctx, PetService_ListGuardians_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) AddPetGuardian(ctx context.Context, in *AddPetGuardianRequest, opts ...grpc.CallOption) (*PetGuardian, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PetGuardian)
+	err := c.cc.Invoke(ctx, PetService_AddPetGuardian_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) RemovePetGuardian(ctx context.Context, in *RemovePetGuardianRequest, opts ...grpc.CallOption) (*RemovePetGuardianResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemovePetGuardianResponse)
+	err := c.cc.Invoke(ctx, PetService_RemovePetGuardian_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) ListPetGuardians(ctx context.Context, in *ListPetGuardiansRequest, opts ...grpc.CallOption) (*ListPetGuardiansResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPetGuardiansResponse)
+	err := c.cc.Invoke(ctx, PetService_ListPetGuardians_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) SubmitAdoptionApplication(ctx context.Context, in *SubmitAdoptionApplicationRequest, opts ...grpc.CallOption) (*AdoptionApplication, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdoptionApplication)
+	err := c.cc.Invoke(ctx, PetService_SubmitAdoptionApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) GetAdoptionApplication(ctx context.Context, in *GetAdoptionApplicationRequest, opts ...grpc.CallOption) (*AdoptionApplication, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdoptionApplication)
+	err := c.cc.Invoke(ctx, PetService_GetAdoptionApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) ApproveAdoptionApplication(ctx context.Context, in *DecideAdoptionApplicationRequest, opts ...grpc.CallOption) (*ApproveAdoptionApplicationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveAdoptionApplicationResponse)
+	err := c.cc.Invoke(ctx, PetService_ApproveAdoptionApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) RejectAdoptionApplication(ctx context.Context, in *DecideAdoptionApplicationRequest, opts ...grpc.CallOption) (*AdoptionApplication, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdoptionApplication)
+	err := c.cc.Invoke(ctx, PetService_RejectAdoptionApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) WithdrawAdoptionApplication(ctx context.Context, in *DecideAdoptionApplicationRequest, opts ...grpc.CallOption) (*AdoptionApplication, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdoptionApplication)
+	err := c.cc.Invoke(ctx, PetService_WithdrawAdoptionApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) ListPetAdoptionApplications(ctx context.Context, in *ListPetAdoptionApplicationsRequest, opts ...grpc.CallOption) (*ListAdoptionApplicationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAdoptionApplicationsResponse)
+	err := c.cc.Invoke(ctx, PetService_ListPetAdoptionApplications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) ListApplicantAdoptionApplications(ctx context.Context, in *ListApplicantAdoptionApplicationsRequest, opts ...grpc.CallOption) (*ListAdoptionApplicationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAdoptionApplicationsResponse)
+	err := c.cc.Invoke(ctx, PetService_ListApplicantAdoptionApplications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PetServiceServer is the server API for PetService service.
 // All implementations must embed UnimplementedPetServiceServer
 // for forward compatibility.
@@ -91,117 +662,1052 @@ type PetServiceServer interface {
 	Update(context.Context, *UpdatePetRequest) (*UpdatePetResponse, error)
 	Delete(context.Context, *DeletePetRequest) (*DeletePetResponse, error)
 	Get(context.Context, *GetPetRequest) (*GetPetResponse, error)
+	Transfer(context.Context, *TransferPetRequest) (*GetPetResponse, error)
+	TransitionPet(context.Context, *TransitionPetRequest) (*GetPetResponse, error)
+	BatchCreatePets(context.Context, *BatchCreatePetsRequest) (*BatchCreatePetsResponse, error)
+	BatchGetPets(context.Context, *BatchGetPetsRequest) (*BatchGetPetsResponse, error)
+	BatchUpdatePets(context.Context, *BatchUpdatePetsRequest) (*BatchUpdatePetsResponse, error)
+	ImportPets(grpc.ClientStreamingServer[ImportPetsRequest, ImportPetsResponse]) error
+	ExportPets(*ExportPetsRequest, grpc.ServerStreamingServer[GetPetResponse]) error
+	WatchPets(*WatchPetsRequest, grpc.ServerStreamingServer[WatchPetsResponse]) error
+	GetPetAuditLog(context.Context, *GetPetAuditLogRequest) (*AuditLogResponse, error)
+	ListGuardianAuditLog(context.Context, *ListGuardianAuditLogRequest) (*AuditLogResponse, error)
+	CreateSpecies(context.Context, *CreateSpeciesRequest) (*SpeciesInfo, error)
+	GetSpecies(context.Context, *GetSpeciesRequest) (*SpeciesInfo, error)
+	ListSpecies(context.Context, *ListSpeciesRequest) (*ListSpeciesResponse, error)
+	UpdateSpecies(context.Context, *UpdateSpeciesRequest) (*SpeciesInfo, error)
+	DeleteSpecies(context.Context, *DeleteSpeciesRequest) (*DeleteSpeciesResponse, error)
+	SearchBreeds(context.Context, *SearchBreedsRequest) (*SearchBreedsResponse, error)
+	SearchPets(context.Context, *SearchPetsRequest) (*SearchPetsResponse, error)
+	LookupByMicrochip(context.Context, *LookupByMicrochipRequest) (*GetPetResponse, error)
+	AddVaccination(context.Context, *AddVaccinationRequest) (*Vaccination, error)
+	UpdateVaccination(context.Context, *UpdateVaccinationRequest) (*Vaccination, error)
+	ListVaccinations(context.Context, *ListVaccinationsRequest) (*ListVaccinationsResponse, error)
+	ListOverdueVaccinations(context.Context, *ListOverdueVaccinationsRequest) (*ListOverdueVaccinationsResponse, error)
+	AddMedicalRecord(context.Context, *AddMedicalRecordRequest) (*MedicalRecord, error)
+	UpdateMedicalRecord(context.Context, *UpdateMedicalRecordRequest) (*MedicalRecord, error)
+	ListMedicalRecords(context.Context, *ListMedicalRecordsRequest) (*ListMedicalRecordsResponse, error)
+	// A primeira mensagem do stream traz só metadata; as seguintes, só chunk.
+	UploadAttachment(grpc.ClientStreamingServer[UploadAttachmentRequest, Attachment]) error
+	// A primeira mensagem traz attachment; as seguintes, o conteúdo em chunks.
+	DownloadAttachment(*DownloadAttachmentRequest, grpc.ServerStreamingServer[DownloadAttachmentResponse]) error
+	ListAttachments(context.Context, *ListAttachmentsRequest) (*ListAttachmentsResponse, error)
+	DeleteAttachment(context.Context, *DeleteAttachmentRequest) (*DeleteAttachmentResponse, error)
+	// O cadastro de guardiões só existe com GUARDIAN_REGISTRY=internal; nos outros
+	// modos as chamadas devolvem UNAVAILABLE.
+	CreateGuardian(context.Context, *CreateGuardianRequest) (*Guardian, error)
+	GetGuardian(context.Context, *GetGuardianRequest) (*Guardian, error)
+	UpdateGuardian(context.Context, *UpdateGuardianRequest) (*Guardian, error)
+	// Guardiões donos de pets não podem ser removidos (FAILED_PRECONDITION).
+	DeleteGuardian(context.Context, *DeleteGuardianRequest) (*DeleteGuardianResponse, error)
+	ListGuardians(context.Context, *ListGuardiansRequest) (*ListGuardiansResponse, error)
+	// Liga um co-dono, lar temporário ou contato de emergência ao pet. O dono muda só
+	// por Transfer.
+	AddPetGuardian(context.Context, *AddPetGuardianRequest) (*PetGuardian, error)
+	RemovePetGuardian(context.Context, *RemovePetGuardianRequest) (*RemovePetGuardianResponse, error)
+	ListPetGuardians(context.Context, *ListPetGuardiansRequest) (*ListPetGuardiansResponse, error)
+	// Pedido de adoção de um pet disponível (status AVAILABLE).
+	SubmitAdoptionApplication(context.Context, *SubmitAdoptionApplicationRequest) (*AdoptionApplication, error)
+	GetAdoptionApplication(context.Context, *GetAdoptionApplicationRequest) (*AdoptionApplication, error)
+	// Aprova o pedido, reserva o pet e recusa os outros pedidos pendentes dele numa só
+	// transação.
+	ApproveAdoptionApplication(context.Context, *DecideAdoptionApplicationRequest) (*ApproveAdoptionApplicationResponse, error)
+	RejectAdoptionApplication(context.Context, *DecideAdoptionApplicationRequest) (*AdoptionApplication, error)
+	WithdrawAdoptionApplication(context.Context, *DecideAdoptionApplicationRequest) (*AdoptionApplication, error)
+	ListPetAdoptionApplications(context.Context, *ListPetAdoptionApplicationsRequest) (*ListAdoptionApplicationsResponse, error)
+	ListApplicantAdoptionApplications(context.Context, *ListApplicantAdoptionApplicationsRequest) (*ListAdoptionApplicationsResponse, error)
+	mustEmbedUnimplementedPetServiceServer()
+}
+
+// UnimplementedPetServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPetServiceServer struct{}
+
+func (UnimplementedPetServiceServer) Create(context.Context, *CreatePetRequest) (*CreatePetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedPetServiceServer) Update(context.Context, *UpdatePetRequest) (*UpdatePetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedPetServiceServer) Delete(context.Context, *DeletePetRequest) (*DeletePetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedPetServiceServer) Get(context.Context, *GetPetRequest) (*GetPetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedPetServiceServer) Transfer(context.Context, *TransferPetRequest) (*GetPetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Transfer not implemented")
+}
+func (UnimplementedPetServiceServer) TransitionPet(context.Context, *TransitionPetRequest) (*GetPetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransitionPet not implemented")
+}
+func (UnimplementedPetServiceServer) BatchCreatePets(context.Context, *BatchCreatePetsRequest) (*BatchCreatePetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreatePets not implemented")
+}
+func (UnimplementedPetServiceServer) BatchGetPets(context.Context, *BatchGetPetsRequest) (*BatchGetPetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetPets not implemented")
+}
+func (UnimplementedPetServiceServer) BatchUpdatePets(context.Context, *BatchUpdatePetsRequest) (*BatchUpdatePetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdatePets not implemented")
+}
+func (UnimplementedPetServiceServer) ImportPets(grpc.ClientStreamingServer[ImportPetsRequest, ImportPetsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportPets not implemented")
+}
+func (UnimplementedPetServiceServer) ExportPets(*ExportPetsRequest, grpc.ServerStreamingServer[GetPetResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportPets not implemented")
+}
+func (UnimplementedPetServiceServer) WatchPets(*WatchPetsRequest, grpc.ServerStreamingServer[WatchPetsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchPets not implemented")
+}
+func (UnimplementedPetServiceServer) GetPetAuditLog(context.Context, *GetPetAuditLogRequest) (*AuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPetAuditLog not implemented")
+}
+func (UnimplementedPetServiceServer) ListGuardianAuditLog(context.Context, *ListGuardianAuditLogRequest) (*AuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGuardianAuditLog not implemented")
+}
+func (UnimplementedPetServiceServer) CreateSpecies(context.Context, *CreateSpeciesRequest) (*SpeciesInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSpecies not implemented")
+}
+func (UnimplementedPetServiceServer) GetSpecies(context.Context, *GetSpeciesRequest) (*SpeciesInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSpecies not implemented")
+}
+func (UnimplementedPetServiceServer) ListSpecies(context.Context, *ListSpeciesRequest) (*ListSpeciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSpecies not implemented")
+}
+func (UnimplementedPetServiceServer) UpdateSpecies(context.Context, *UpdateSpeciesRequest) (*SpeciesInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSpecies not implemented")
+}
+func (UnimplementedPetServiceServer) DeleteSpecies(context.Context, *DeleteSpeciesRequest) (*DeleteSpeciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSpecies not implemented")
+}
+func (UnimplementedPetServiceServer) SearchBreeds(context.Context, *SearchBreedsRequest) (*SearchBreedsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchBreeds not implemented")
+}
+func (UnimplementedPetServiceServer) SearchPets(context.Context, *SearchPetsRequest) (*SearchPetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchPets not implemented")
+}
+func (UnimplementedPetServiceServer) LookupByMicrochip(context.Context, *LookupByMicrochipRequest) (*GetPetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupByMicrochip not implemented")
+}
+func (UnimplementedPetServiceServer) AddVaccination(context.Context, *AddVaccinationRequest) (*Vaccination, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddVaccination not implemented")
+}
+func (UnimplementedPetServiceServer) UpdateVaccination(context.Context, *UpdateVaccinationRequest) (*Vaccination, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVaccination not implemented")
+}
+func (UnimplementedPetServiceServer) ListVaccinations(context.Context, *ListVaccinationsRequest) (*ListVaccinationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVaccinations not implemented")
+}
+func (UnimplementedPetServiceServer) ListOverdueVaccinations(context.Context, *ListOverdueVaccinationsRequest) (*ListOverdueVaccinationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOverdueVaccinations not implemented")
+}
+func (UnimplementedPetServiceServer) AddMedicalRecord(context.Context, *AddMedicalRecordRequest) (*MedicalRecord, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMedicalRecord not implemented")
+}
+func (UnimplementedPetServiceServer) UpdateMedicalRecord(context.Context, *UpdateMedicalRecordRequest) (*MedicalRecord, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMedicalRecord not implemented")
+}
+func (UnimplementedPetServiceServer) ListMedicalRecords(context.Context, *ListMedicalRecordsRequest) (*ListMedicalRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMedicalRecords not implemented")
+}
+func (UnimplementedPetServiceServer) UploadAttachment(grpc.ClientStreamingServer[UploadAttachmentRequest, Attachment]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadAttachment not implemented")
+}
+func (UnimplementedPetServiceServer) DownloadAttachment(*DownloadAttachmentRequest, grpc.ServerStreamingServer[DownloadAttachmentResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadAttachment not implemented")
+}
+func (UnimplementedPetServiceServer) ListAttachments(context.Context, *ListAttachmentsRequest) (*ListAttachmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAttachments not implemented")
+}
+func (UnimplementedPetServiceServer) DeleteAttachment(context.Context, *DeleteAttachmentRequest) (*DeleteAttachmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAttachment not implemented")
+}
+func (UnimplementedPetServiceServer) CreateGuardian(context.Context, *CreateGuardianRequest) (*Guardian, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGuardian not implemented")
+}
+func (UnimplementedPetServiceServer) GetGuardian(context.Context, *GetGuardianRequest) (*Guardian, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGuardian not implemented")
+}
+func (UnimplementedPetServiceServer) UpdateGuardian(context.Context, *UpdateGuardianRequest) (*Guardian, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGuardian not implemented")
+}
+func (UnimplementedPetServiceServer) DeleteGuardian(context.Context, *DeleteGuardianRequest) (*DeleteGuardianResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGuardian not implemented")
+}
+func (UnimplementedPetServiceServer) ListGuardians(context.Context, *ListGuardiansRequest) (*ListGuardiansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGuardians not implemented")
+}
+func (UnimplementedPetServiceServer) AddPetGuardian(context.Context, *AddPetGuardianRequest) (*PetGuardian, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPetGuardian not implemented")
+}
+func (UnimplementedPetServiceServer) RemovePetGuardian(context.Context, *RemovePetGuardianRequest) (*RemovePetGuardianResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePetGuardian not implemented")
+}
+func (UnimplementedPetServiceServer) ListPetGuardians(context.Context, *ListPetGuardiansRequest) (*ListPetGuardiansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPetGuardians not implemented")
+}
+func (UnimplementedPetServiceServer) SubmitAdoptionApplication(context.Context, *SubmitAdoptionApplicationRequest) (*AdoptionApplication, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitAdoptionApplication not implemented")
+}
+func (UnimplementedPetServiceServer) GetAdoptionApplication(context.Context, *GetAdoptionApplicationRequest) (*AdoptionApplication, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAdoptionApplication not implemented")
+}
+func (UnimplementedPetServiceServer) ApproveAdoptionApplication(context.Context, *DecideAdoptionApplicationRequest) (*ApproveAdoptionApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveAdoptionApplication not implemented")
+}
+func (UnimplementedPetServiceServer) RejectAdoptionApplication(context.Context, *DecideAdoptionApplicationRequest) (*AdoptionApplication, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectAdoptionApplication not implemented")
+}
+func (UnimplementedPetServiceServer) WithdrawAdoptionApplication(context.Context, *DecideAdoptionApplicationRequest) (*AdoptionApplication, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawAdoptionApplication not implemented")
+}
+func (UnimplementedPetServiceServer) ListPetAdoptionApplications(context.Context, *ListPetAdoptionApplicationsRequest) (*ListAdoptionApplicationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPetAdoptionApplications not implemented")
+}
+func (UnimplementedPetServiceServer) ListApplicantAdoptionApplications(context.Context, *ListApplicantAdoptionApplicationsRequest) (*ListAdoptionApplicationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListApplicantAdoptionApplications not implemented")
+}
+func (UnimplementedPetServiceServer) mustEmbedUnimplementedPetServiceServer() {}
+func (UnimplementedPetServiceServer) testEmbeddedByValue()                    {}
+
+// UnsafePetServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PetServiceServer will
+// result in compilation errors.
+type UnsafePetServiceServer interface {
 	mustEmbedUnimplementedPetServiceServer()
 }
 
-// UnimplementedPetServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedPetServiceServer struct{}
+func RegisterPetServiceServer(s grpc.ServiceRegistrar, srv PetServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPetServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PetService_ServiceDesc, srv)
+}
+
+func _PetService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).Create(ctx, req.(*CreatePetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).Update(ctx, req.(*UpdatePetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).Delete(ctx, req.(*DeletePetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).Get(ctx, req.(*GetPetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetService_Transfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferPetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).Transfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_Transfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).Transfer(ctx, req.(*TransferPetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetService_TransitionPet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitionPetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).TransitionPet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_TransitionPet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).TransitionPet(ctx, req.(*TransitionPetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetService_BatchCreatePets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreatePetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).BatchCreatePets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_BatchCreatePets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).BatchCreatePets(ctx, req.(*BatchCreatePetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetService_BatchGetPets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetPetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).BatchGetPets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_BatchGetPets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).BatchGetPets(ctx, req.(*BatchGetPetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetService_BatchUpdatePets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdatePetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).BatchUpdatePets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_BatchUpdatePets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).BatchUpdatePets(ctx, req.(*BatchUpdatePetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetService_ImportPets_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PetServiceServer).ImportPets(&grpc.GenericServerStream[ImportPetsRequest, ImportPetsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PetService_ImportPetsServer = grpc.ClientStreamingServer[ImportPetsRequest, ImportPetsResponse]
+
+func _PetService_ExportPets_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportPetsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PetServiceServer).ExportPets(m, &grpc.GenericServerStream[ExportPetsRequest, GetPetResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PetService_ExportPetsServer = grpc.ServerStreamingServer[GetPetResponse]
+
+func _PetService_WatchPets_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchPetsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PetServiceServer).WatchPets(m, &grpc.GenericServerStream[WatchPetsRequest, WatchPetsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PetService_WatchPetsServer = grpc.ServerStreamingServer[WatchPetsResponse]
+
+func _PetService_GetPetAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPetAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).GetPetAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_GetPetAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).GetPetAuditLog(ctx, req.(*GetPetAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetService_ListGuardianAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGuardianAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).ListGuardianAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_ListGuardianAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).ListGuardianAuditLog(ctx, req.(*ListGuardianAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetService_CreateSpecies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSpeciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).CreateSpecies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_CreateSpecies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).CreateSpecies(ctx, req.(*CreateSpeciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetService_GetSpecies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSpeciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).GetSpecies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_GetSpecies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).GetSpecies(ctx, req.(*GetSpeciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetService_ListSpecies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSpeciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).ListSpecies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_ListSpecies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).ListSpecies(ctx, req.(*ListSpeciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetService_UpdateSpecies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSpeciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).UpdateSpecies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_UpdateSpecies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).UpdateSpecies(ctx, req.(*UpdateSpeciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetService_DeleteSpecies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSpeciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).DeleteSpecies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_DeleteSpecies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).DeleteSpecies(ctx, req.(*DeleteSpeciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetService_SearchBreeds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchBreedsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).SearchBreeds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_SearchBreeds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).SearchBreeds(ctx, req.(*SearchBreedsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetService_SearchPets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchPetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).SearchPets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_SearchPets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).SearchPets(ctx, req.(*SearchPetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetService_LookupByMicrochip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupByMicrochipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).LookupByMicrochip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_LookupByMicrochip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).LookupByMicrochip(ctx, req.(*LookupByMicrochipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetService_AddVaccination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddVaccinationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).AddVaccination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_AddVaccination_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).AddVaccination(ctx, req.(*AddVaccinationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetService_UpdateVaccination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVaccinationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).UpdateVaccination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_UpdateVaccination_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).UpdateVaccination(ctx, req.(*UpdateVaccinationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetService_ListVaccinations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVaccinationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).ListVaccinations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_ListVaccinations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).ListVaccinations(ctx, req.(*ListVaccinationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetService_ListOverdueVaccinations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOverdueVaccinationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).ListOverdueVaccinations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_ListOverdueVaccinations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).ListOverdueVaccinations(ctx, req.(*ListOverdueVaccinationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetService_AddMedicalRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMedicalRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).AddMedicalRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_AddMedicalRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).AddMedicalRecord(ctx, req.(*AddMedicalRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetService_UpdateMedicalRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMedicalRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).UpdateMedicalRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_UpdateMedicalRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).UpdateMedicalRecord(ctx, req.(*UpdateMedicalRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetService_ListMedicalRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMedicalRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).ListMedicalRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_ListMedicalRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).ListMedicalRecords(ctx, req.(*ListMedicalRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetService_UploadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PetServiceServer).UploadAttachment(&grpc.GenericServerStream[UploadAttachmentRequest, Attachment]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PetService_UploadAttachmentServer = grpc.ClientStreamingServer[UploadAttachmentRequest, Attachment]
+
+func _PetService_DownloadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadAttachmentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PetServiceServer).DownloadAttachment(m, &grpc.GenericServerStream[DownloadAttachmentRequest, DownloadAttachmentResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PetService_DownloadAttachmentServer = grpc.ServerStreamingServer[DownloadAttachmentResponse]
+
+func _PetService_ListAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttachmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).ListAttachments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_ListAttachments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).ListAttachments(ctx, req.(*ListAttachmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetService_DeleteAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).DeleteAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_DeleteAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).DeleteAttachment(ctx, req.(*DeleteAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetService_CreateGuardian_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGuardianRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).CreateGuardian(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_CreateGuardian_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).CreateGuardian(ctx, req.(*CreateGuardianRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetService_GetGuardian_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGuardianRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).GetGuardian(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_GetGuardian_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).GetGuardian(ctx, req.(*GetGuardianRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetService_UpdateGuardian_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGuardianRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).UpdateGuardian(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_UpdateGuardian_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).UpdateGuardian(ctx, req.(*UpdateGuardianRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func (UnimplementedPetServiceServer) Create(context.Context, *CreatePetRequest) (*CreatePetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+func _PetService_DeleteGuardian_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGuardianRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).DeleteGuardian(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_DeleteGuardian_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).DeleteGuardian(ctx, req.(*DeleteGuardianRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedPetServiceServer) Update(context.Context, *UpdatePetRequest) (*UpdatePetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+
+func _PetService_ListGuardians_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGuardiansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).ListGuardians(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_ListGuardians_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).ListGuardians(ctx, req.(*ListGuardiansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedPetServiceServer) Delete(context.Context, *DeletePetRequest) (*DeletePetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+
+func _PetService_AddPetGuardian_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPetGuardianRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).AddPetGuardian(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_AddPetGuardian_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).AddPetGuardian(ctx, req.(*AddPetGuardianRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedPetServiceServer) Get(context.Context, *GetPetRequest) (*GetPetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+
+func _PetService_RemovePetGuardian_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePetGuardianRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).RemovePetGuardian(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_RemovePetGuardian_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).RemovePetGuardian(ctx, req.(*RemovePetGuardianRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedPetServiceServer) mustEmbedUnimplementedPetServiceServer() {}
-func (UnimplementedPetServiceServer) testEmbeddedByValue()                    {}
 
-// UnsafePetServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to PetServiceServer will
-// result in compilation errors.
-type UnsafePetServiceServer interface {
-	mustEmbedUnimplementedPetServiceServer()
+func _PetService_ListPetGuardians_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPetGuardiansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).ListPetGuardians(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_ListPetGuardians_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).ListPetGuardians(ctx, req.(*ListPetGuardiansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterPetServiceServer(s grpc.ServiceRegistrar, srv PetServiceServer) {
-	// If the following call pancis, it indicates UnimplementedPetServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _PetService_SubmitAdoptionApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitAdoptionApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&PetService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(PetServiceServer).SubmitAdoptionApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_SubmitAdoptionApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).SubmitAdoptionApplication(ctx, req.(*SubmitAdoptionApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _PetService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreatePetRequest)
+func _PetService_GetAdoptionApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAdoptionApplicationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PetServiceServer).Create(ctx, in)
+		return srv.(PetServiceServer).GetAdoptionApplication(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PetService_Create_FullMethodName,
+		FullMethod: PetService_GetAdoptionApplication_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PetServiceServer).Create(ctx, req.(*CreatePetRequest))
+		return srv.(PetServiceServer).GetAdoptionApplication(ctx, req.(*GetAdoptionApplicationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PetService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdatePetRequest)
+func _PetService_ApproveAdoptionApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecideAdoptionApplicationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PetServiceServer).Update(ctx, in)
+		return srv.(PetServiceServer).ApproveAdoptionApplication(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PetService_Update_FullMethodName,
+		FullMethod: PetService_ApproveAdoptionApplication_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PetServiceServer).Update(ctx, req.(*UpdatePetRequest))
+		return srv.(PetServiceServer).ApproveAdoptionApplication(ctx, req.(*DecideAdoptionApplicationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PetService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeletePetRequest)
+func _PetService_RejectAdoptionApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecideAdoptionApplicationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PetServiceServer).Delete(ctx, in)
+		return srv.(PetServiceServer).RejectAdoptionApplication(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PetService_Delete_FullMethodName,
+		FullMethod: PetService_RejectAdoptionApplication_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PetServiceServer).Delete(ctx, req.(*DeletePetRequest))
+		return srv.(PetServiceServer).RejectAdoptionApplication(ctx, req.(*DecideAdoptionApplicationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PetService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPetRequest)
+func _PetService_WithdrawAdoptionApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecideAdoptionApplicationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PetServiceServer).Get(ctx, in)
+		return srv.(PetServiceServer).WithdrawAdoptionApplication(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PetService_Get_FullMethodName,
+		FullMethod: PetService_WithdrawAdoptionApplication_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PetServiceServer).Get(ctx, req.(*GetPetRequest))
+		return srv.(PetServiceServer).WithdrawAdoptionApplication(ctx, req.(*DecideAdoptionApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetService_ListPetAdoptionApplications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPetAdoptionApplicationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).ListPetAdoptionApplications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_ListPetAdoptionApplications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).ListPetAdoptionApplications(ctx, req.(*ListPetAdoptionApplicationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetService_ListApplicantAdoptionApplications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApplicantAdoptionApplicationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).ListApplicantAdoptionApplications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_ListApplicantAdoptionApplications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).ListApplicantAdoptionApplications(ctx, req.(*ListApplicantAdoptionApplicationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -229,7 +1735,189 @@ var PetService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Get",
 			Handler:    _PetService_Get_Handler,
 		},
+		{
+			MethodName: "Transfer",
+			Handler:    _PetService_Transfer_Handler,
+		},
+		{
+			MethodName: "TransitionPet",
+			Handler:    _PetService_TransitionPet_Handler,
+		},
+		{
+			MethodName: "BatchCreatePets",
+			Handler:    _PetService_BatchCreatePets_Handler,
+		},
+		{
+			MethodName: "BatchGetPets",
+			Handler:    _PetService_BatchGetPets_Handler,
+		},
+		{
+			MethodName: "BatchUpdatePets",
+			Handler:    _PetService_BatchUpdatePets_Handler,
+		},
+		{
+			MethodName: "GetPetAuditLog",
+			Handler:    _PetService_GetPetAuditLog_Handler,
+		},
+		{
+			MethodName: "ListGuardianAuditLog",
+			Handler:    _PetService_ListGuardianAuditLog_Handler,
+		},
+		{
+			MethodName: "CreateSpecies",
+			Handler:    _PetService_CreateSpecies_Handler,
+		},
+		{
+			MethodName: "GetSpecies",
+			Handler:    _PetService_GetSpecies_Handler,
+		},
+		{
+			MethodName: "ListSpecies",
+			Handler:    _PetService_ListSpecies_Handler,
+		},
+		{
+			MethodName: "UpdateSpecies",
+			Handler:    _PetService_UpdateSpecies_Handler,
+		},
+		{
+			MethodName: "DeleteSpecies",
+			Handler:    _PetService_DeleteSpecies_Handler,
+		},
+		{
+			MethodName: "SearchBreeds",
+			Handler:    _PetService_SearchBreeds_Handler,
+		},
+		{
+			MethodName: "SearchPets",
+			Handler:    _PetService_SearchPets_Handler,
+		},
+		{
+			MethodName: "LookupByMicrochip",
+			Handler:    _PetService_LookupByMicrochip_Handler,
+		},
+		{
+			MethodName: "AddVaccination",
+			Handler:    _PetService_AddVaccination_Handler,
+		},
+		{
+			MethodName: "UpdateVaccination",
+			Handler:    _PetService_UpdateVaccination_Handler,
+		},
+		{
+			MethodName: "ListVaccinations",
+			Handler:    _PetService_ListVaccinations_Handler,
+		},
+		{
+			MethodName: "ListOverdueVaccinations",
+			Handler:    _PetService_ListOverdueVaccinations_Handler,
+		},
+		{
+			MethodName: "AddMedicalRecord",
+			Handler:    _PetService_AddMedicalRecord_Handler,
+		},
+		{
+			MethodName: "UpdateMedicalRecord",
+			Handler:    _PetService_UpdateMedicalRecord_Handler,
+		},
+		{
+			MethodName: "ListMedicalRecords",
+			Handler:    _PetService_ListMedicalRecords_Handler,
+		},
+		{
+			MethodName: "ListAttachments",
+			Handler:    _PetService_ListAttachments_Handler,
+		},
+		{
+			MethodName: "DeleteAttachment",
+			Handler:    _PetService_DeleteAttachment_Handler,
+		},
+		{
+			MethodName: "CreateGuardian",
+			Handler:    _PetService_CreateGuardian_Handler,
+		},
+		{
+			MethodName: "GetGuardian",
+			Handler:    _PetService_GetGuardian_Handler,
+		},
+		{
+			MethodName: "UpdateGuardian",
+			Handler:    _PetService_UpdateGuardian_Handler,
+		},
+		{
+			MethodName: "DeleteGuardian",
+			Handler:    _PetService_DeleteGuardian_Handler,
+		},
+		{
+			MethodName: "ListGuardians",
+			Handler:    _PetService_ListGuardians_Handler,
+		},
+		{
+			MethodName: "AddPetGuardian",
+			Handler:    _PetService_AddPetGuardian_Handler,
+		},
+		{
+			MethodName: "RemovePetGuardian",
+			Handler:    _PetService_RemovePetGuardian_Handler,
+		},
+		{
+			MethodName: "ListPetGuardians",
+			Handler:    _PetService_ListPetGuardians_Handler,
+		},
+		{
+			MethodName: "SubmitAdoptionApplication",
+			Handler:    _PetService_SubmitAdoptionApplication_Handler,
+		},
+		{
+			MethodName: "GetAdoptionApplication",
+			Handler:    _PetService_GetAdoptionApplication_Handler,
+		},
+		{
+			MethodName: "ApproveAdoptionApplication",
+			Handler:    _PetService_ApproveAdoptionApplication_Handler,
+		},
+		{
+			MethodName: "RejectAdoptionApplication",
+			Handler:    _PetService_RejectAdoptionApplication_Handler,
+		},
+		{
+			MethodName: "WithdrawAdoptionApplication",
+			Handler:    _PetService_WithdrawAdoptionApplication_Handler,
+		},
+		{
+			MethodName: "ListPetAdoptionApplications",
+			Handler:    _PetService_ListPetAdoptionApplications_Handler,
+		},
+		{
+			MethodName: "ListApplicantAdoptionApplications",
+			Handler:    _PetService_ListApplicantAdoptionApplications_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportPets",
+			Handler:       _PetService_ImportPets_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportPets",
+			Handler:       _PetService_ExportPets_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchPets",
+			Handler:       _PetService_WatchPets_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadAttachment",
+			Handler:       _PetService_UploadAttachment_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadAttachment",
+			Handler:       _PetService_DownloadAttachment_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "pet-ms.proto",
 }
@@ -0,0 +1,475 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.32.0--rc1
+// source: pet_events.proto
+
+package pet_ms
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PetEventEnvelope é a mensagem publicada no broker pela outbox. event_id é
+// estável entre reenvios e deve ser usado pelos consumidores para deduplicar.
+type PetEventEnvelope struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	EventId    string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType  string                 `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	PetUuid    string                 `protobuf:"bytes,4,opt,name=pet_uuid,json=petUuid,proto3" json:"pet_uuid,omitempty"`
+	// tenant_id é a organização dona do pet; vazio nas instalações de um só tenant.
+	TenantId string `protobuf:"bytes,5,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*PetEventEnvelope_Created
+	//	*PetEventEnvelope_Updated
+	//	*PetEventEnvelope_Transferred
+	//	*PetEventEnvelope_Deleted
+	Payload       isPetEventEnvelope_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PetEventEnvelope) Reset() {
+	*x = PetEventEnvelope{}
+	mi := &file_pet_events_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PetEventEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PetEventEnvelope) ProtoMessage() {}
+
+func (x *PetEventEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_events_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PetEventEnvelope.ProtoReflect.Descriptor instead.
+func (*PetEventEnvelope) Descriptor() ([]byte, []int) {
+	return file_pet_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PetEventEnvelope) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *PetEventEnvelope) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *PetEventEnvelope) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *PetEventEnvelope) GetPetUuid() string {
+	if x != nil {
+		return x.PetUuid
+	}
+	return ""
+}
+
+func (x *PetEventEnvelope) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *PetEventEnvelope) GetPayload() isPetEventEnvelope_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *PetEventEnvelope) GetCreated() *PetCreated {
+	if x != nil {
+		if x, ok := x.Payload.(*PetEventEnvelope_Created); ok {
+			return x.Created
+		}
+	}
+	return nil
+}
+
+func (x *PetEventEnvelope) GetUpdated() *PetUpdated {
+	if x != nil {
+		if x, ok := x.Payload.(*PetEventEnvelope_Updated); ok {
+			return x.Updated
+		}
+	}
+	return nil
+}
+
+func (x *PetEventEnvelope) GetTransferred() *PetTransferred {
+	if x != nil {
+		if x, ok := x.Payload.(*PetEventEnvelope_Transferred); ok {
+			return x.Transferred
+		}
+	}
+	return nil
+}
+
+func (x *PetEventEnvelope) GetDeleted() *PetDeleted {
+	if x != nil {
+		if x, ok := x.Payload.(*PetEventEnvelope_Deleted); ok {
+			return x.Deleted
+		}
+	}
+	return nil
+}
+
+type isPetEventEnvelope_Payload interface {
+	isPetEventEnvelope_Payload()
+}
+
+type PetEventEnvelope_Created struct {
+	Created *PetCreated `protobuf:"bytes,10,opt,name=created,proto3,oneof"`
+}
+
+type PetEventEnvelope_Updated struct {
+	Updated *PetUpdated `protobuf:"bytes,11,opt,name=updated,proto3,oneof"`
+}
+
+type PetEventEnvelope_Transferred struct {
+	Transferred *PetTransferred `protobuf:"bytes,12,opt,name=transferred,proto3,oneof"`
+}
+
+type PetEventEnvelope_Deleted struct {
+	Deleted *PetDeleted `protobuf:"bytes,13,opt,name=deleted,proto3,oneof"`
+}
+
+func (*PetEventEnvelope_Created) isPetEventEnvelope_Payload() {}
+
+func (*PetEventEnvelope_Updated) isPetEventEnvelope_Payload() {}
+
+func (*PetEventEnvelope_Transferred) isPetEventEnvelope_Payload() {}
+
+func (*PetEventEnvelope_Deleted) isPetEventEnvelope_Payload() {}
+
+type PetCreated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pet           *GetPetResponse        `protobuf:"bytes,1,opt,name=pet,proto3" json:"pet,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PetCreated) Reset() {
+	*x = PetCreated{}
+	mi := &file_pet_events_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PetCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PetCreated) ProtoMessage() {}
+
+func (x *PetCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_events_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PetCreated.ProtoReflect.Descriptor instead.
+func (*PetCreated) Descriptor() ([]byte, []int) {
+	return file_pet_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PetCreated) GetPet() *GetPetResponse {
+	if x != nil {
+		return x.Pet
+	}
+	return nil
+}
+
+type PetUpdated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pet           *GetPetResponse        `protobuf:"bytes,1,opt,name=pet,proto3" json:"pet,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PetUpdated) Reset() {
+	*x = PetUpdated{}
+	mi := &file_pet_events_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PetUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PetUpdated) ProtoMessage() {}
+
+func (x *PetUpdated) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_events_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PetUpdated.ProtoReflect.Descriptor instead.
+func (*PetUpdated) Descriptor() ([]byte, []int) {
+	return file_pet_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PetUpdated) GetPet() *GetPetResponse {
+	if x != nil {
+		return x.Pet
+	}
+	return nil
+}
+
+type PetTransferred struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Pet                  *GetPetResponse        `protobuf:"bytes,1,opt,name=pet,proto3" json:"pet,omitempty"`
+	PreviousUuidGuardian string                 `protobuf:"bytes,2,opt,name=previous_uuid_guardian,json=previousUuidGuardian,proto3" json:"previous_uuid_guardian,omitempty"`
+	UuidGuardian         string                 `protobuf:"bytes,3,opt,name=uuid_guardian,json=uuidGuardian,proto3" json:"uuid_guardian,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *PetTransferred) Reset() {
+	*x = PetTransferred{}
+	mi := &file_pet_events_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PetTransferred) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PetTransferred) ProtoMessage() {}
+
+func (x *PetTransferred) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_events_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PetTransferred.ProtoReflect.Descriptor instead.
+func (*PetTransferred) Descriptor() ([]byte, []int) {
+	return file_pet_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PetTransferred) GetPet() *GetPetResponse {
+	if x != nil {
+		return x.Pet
+	}
+	return nil
+}
+
+func (x *PetTransferred) GetPreviousUuidGuardian() string {
+	if x != nil {
+		return x.PreviousUuidGuardian
+	}
+	return ""
+}
+
+func (x *PetTransferred) GetUuidGuardian() string {
+	if x != nil {
+		return x.UuidGuardian
+	}
+	return ""
+}
+
+type PetDeleted struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pet           *GetPetResponse        `protobuf:"bytes,1,opt,name=pet,proto3" json:"pet,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PetDeleted) Reset() {
+	*x = PetDeleted{}
+	mi := &file_pet_events_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PetDeleted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PetDeleted) ProtoMessage() {}
+
+func (x *PetDeleted) ProtoReflect() protoreflect.Message {
+	mi := &file_pet_events_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PetDeleted.ProtoReflect.Descriptor instead.
+func (*PetDeleted) Descriptor() ([]byte, []int) {
+	return file_pet_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PetDeleted) GetPet() *GetPetResponse {
+	if x != nil {
+		return x.Pet
+	}
+	return nil
+}
+
+var File_pet_events_proto protoreflect.FileDescriptor
+
+const file_pet_events_proto_rawDesc = "" +
+	"\n" +
+	"\x10pet_events.proto\x12\x05proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\fpet-ms.proto\"\x94\x03\n" +
+	"\x10PetEventEnvelope\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x02 \x01(\tR\teventType\x12;\n" +
+	"\voccurred_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12\x19\n" +
+	"\bpet_uuid\x18\x04 \x01(\tR\apetUuid\x12\x1b\n" +
+	"\ttenant_id\x18\x05 \x01(\tR\btenantId\x12-\n" +
+	"\acreated\x18\n" +
+	" \x01(\v2\x11.proto.PetCreatedH\x00R\acreated\x12-\n" +
+	"\aupdated\x18\v \x01(\v2\x11.proto.PetUpdatedH\x00R\aupdated\x129\n" +
+	"\vtransferred\x18\f \x01(\v2\x15.proto.PetTransferredH\x00R\vtransferred\x12-\n" +
+	"\adeleted\x18\r \x01(\v2\x11.proto.PetDeletedH\x00R\adeletedB\t\n" +
+	"\apayload\"5\n" +
+	"\n" +
+	"PetCreated\x12'\n" +
+	"\x03pet\x18\x01 \x01(\v2\x15.proto.GetPetResponseR\x03pet\"5\n" +
+	"\n" +
+	"PetUpdated\x12'\n" +
+	"\x03pet\x18\x01 \x01(\v2\x15.proto.GetPetResponseR\x03pet\"\x94\x01\n" +
+	"\x0ePetTransferred\x12'\n" +
+	"\x03pet\x18\x01 \x01(\v2\x15.proto.GetPetResponseR\x03pet\x124\n" +
+	"\x16previous_uuid_guardian\x18\x02 \x01(\tR\x14previousUuidGuardian\x12#\n" +
+	"\ruuid_guardian\x18\x03 \x01(\tR\fuuidGuardian\"5\n" +
+	"\n" +
+	"PetDeleted\x12'\n" +
+	"\x03pet\x18\x01 \x01(\v2\x15.proto.GetPetResponseR\x03petB#Z!https://github.com/LuizFJP/pet-msb\x06proto3"
+
+var (
+	file_pet_events_proto_rawDescOnce sync.Once
+	file_pet_events_proto_rawDescData []byte
+)
+
+func file_pet_events_proto_rawDescGZIP() []byte {
+	file_pet_events_proto_rawDescOnce.Do(func() {
+		file_pet_events_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_pet_events_proto_rawDesc), len(file_pet_events_proto_rawDesc)))
+	})
+	return file_pet_events_proto_rawDescData
+}
+
+var file_pet_events_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_pet_events_proto_goTypes = []any{
+	(*PetEventEnvelope)(nil),      // 0: proto.PetEventEnvelope
+	(*PetCreated)(nil),            // 1: proto.PetCreated
+	(*PetUpdated)(nil),            // 2: proto.PetUpdated
+	(*PetTransferred)(nil),        // 3: proto.PetTransferred
+	(*PetDeleted)(nil),            // 4: proto.PetDeleted
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+	(*GetPetResponse)(nil),        // 6: proto.GetPetResponse
+}
+var file_pet_events_proto_depIdxs = []int32{
+	5, // 0: proto.PetEventEnvelope.occurred_at:type_name -> google.protobuf.Timestamp
+	1, // 1: proto.PetEventEnvelope.created:type_name -> proto.PetCreated
+	2, // 2: proto.PetEventEnvelope.updated:type_name -> proto.PetUpdated
+	3, // 3: proto.PetEventEnvelope.transferred:type_name -> proto.PetTransferred
+	4, // 4: proto.PetEventEnvelope.deleted:type_name -> proto.PetDeleted
+	6, // 5: proto.PetCreated.pet:type_name -> proto.GetPetResponse
+	6, // 6: proto.PetUpdated.pet:type_name -> proto.GetPetResponse
+	6, // 7: proto.PetTransferred.pet:type_name -> proto.GetPetResponse
+	6, // 8: proto.PetDeleted.pet:type_name -> proto.GetPetResponse
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_pet_events_proto_init() }
+func file_pet_events_proto_init() {
+	if File_pet_events_proto != nil {
+		return
+	}
+	file_pet_ms_proto_init()
+	file_pet_events_proto_msgTypes[0].OneofWrappers = []any{
+		(*PetEventEnvelope_Created)(nil),
+		(*PetEventEnvelope_Updated)(nil),
+		(*PetEventEnvelope_Transferred)(nil),
+		(*PetEventEnvelope_Deleted)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pet_events_proto_rawDesc), len(file_pet_events_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pet_events_proto_goTypes,
+		DependencyIndexes: file_pet_events_proto_depIdxs,
+		MessageInfos:      file_pet_events_proto_msgTypes,
+	}.Build()
+	File_pet_events_proto = out.File
+	file_pet_events_proto_goTypes = nil
+	file_pet_events_proto_depIdxs = nil
+}